@@ -0,0 +1,252 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package address parses and validates Cardano addresses, exposing their
+// network and credentials
+package address
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo/internal/base58"
+	"github.com/blinklabs-io/kupogo/internal/bech32"
+)
+
+// Network IDs carried in Shelley address headers
+const (
+	NetworkIDTestnet byte = 0
+	NetworkIDMainnet byte = 1
+)
+
+// Header types of Shelley addresses
+const (
+	headerTypeBase         byte = 0
+	headerTypePointer      byte = 4
+	headerTypeEnterprise   byte = 6
+	headerTypeByron        byte = 8
+	headerTypeReward       byte = 14
+	headerTypeRewardScript byte = 15
+)
+
+const hashLength = 28
+
+// ErrNetworkMismatch is returned by CheckNetwork when an address belongs to
+// another network
+var ErrNetworkMismatch = errors.New("address network mismatch")
+
+// Credential is a payment or stake credential
+type Credential struct {
+	// Hash is the key hash or script hash
+	Hash   []byte
+	Script bool
+}
+
+// Hex returns the hex encoded hash of the credential
+func (c Credential) Hex() string {
+	return hex.EncodeToString(c.Hash)
+}
+
+// Pointer locates the stake registration certificate of a pointer address
+type Pointer struct {
+	SlotNo    uint64
+	TxIndex   uint64
+	CertIndex uint64
+}
+
+// Address is a parsed Cardano address
+type Address struct {
+	raw    []byte
+	text   string
+	header byte
+	// NetworkID is the network of the address. Byron addresses are on
+	// mainnet unless they carry a protocol magic attribute
+	NetworkID byte
+	// Payment is the payment credential, or nil for reward and Byron
+	// addresses
+	Payment *Credential
+	// Stake is the stake credential of base and reward addresses
+	Stake *Credential
+	// Pointer is set for pointer addresses
+	Pointer *Pointer
+	byron   *Byron
+}
+
+// Parse parses a bech32 Shelley address or stake address, or a base58 Byron
+// address
+func Parse(address string) (*Address, error) {
+	hrp, data, err := bech32.Decode(address)
+	if err != nil {
+		if strings.HasPrefix(address, "addr") || strings.HasPrefix(address, "stake") {
+			return nil, fmt.Errorf("invalid bech32 address: %s", err)
+		}
+		raw, err := base58.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address: %s", err)
+		}
+		return parseByron(raw, address)
+	}
+	ret, err := FromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	if expected := ret.hrp(); hrp != expected {
+		return nil, fmt.Errorf("invalid address prefix %s, expected %s", hrp, expected)
+	}
+	// Bech32 strings may be all uppercase, but lowercase is canonical
+	ret.text = strings.ToLower(address)
+	return ret, nil
+}
+
+// FromBytes parses the binary form of an address
+func FromBytes(data []byte) (*Address, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty address")
+	}
+	header := data[0]
+	addrType := header >> 4
+	if addrType == headerTypeByron {
+		return parseByron(data, base58.Encode(data))
+	}
+	ret := &Address{
+		raw:       append([]byte{}, data...),
+		header:    header,
+		NetworkID: header & 0x0f,
+	}
+	payload := data[1:]
+	switch {
+	case addrType <= 3:
+		if len(payload) != 2*hashLength {
+			return nil, fmt.Errorf("invalid base address length %d", len(data))
+		}
+		ret.Payment = &Credential{Hash: payload[:hashLength], Script: addrType&1 == 1}
+		ret.Stake = &Credential{Hash: payload[hashLength:], Script: addrType&2 == 2}
+	case addrType == headerTypePointer || addrType == headerTypePointer+1:
+		if len(payload) < hashLength+3 {
+			return nil, fmt.Errorf("invalid pointer address length %d", len(data))
+		}
+		ret.Payment = &Credential{Hash: payload[:hashLength], Script: addrType&1 == 1}
+		pointer, err := decodePointer(payload[hashLength:])
+		if err != nil {
+			return nil, err
+		}
+		ret.Pointer = pointer
+	case addrType == headerTypeEnterprise || addrType == headerTypeEnterprise+1:
+		if len(payload) != hashLength {
+			return nil, fmt.Errorf("invalid enterprise address length %d", len(data))
+		}
+		ret.Payment = &Credential{Hash: payload, Script: addrType&1 == 1}
+	case addrType == headerTypeReward || addrType == headerTypeRewardScript:
+		if len(payload) != hashLength {
+			return nil, fmt.Errorf("invalid reward address length %d", len(data))
+		}
+		ret.Stake = &Credential{Hash: payload, Script: addrType == headerTypeRewardScript}
+	default:
+		return nil, fmt.Errorf("unknown address type %d", addrType)
+	}
+	return ret, nil
+}
+
+// decodePointer decodes the three variable-length naturals of a pointer
+func decodePointer(data []byte) (*Pointer, error) {
+	var values [3]uint64
+	for i := range values {
+		var value uint64
+		for {
+			if len(data) == 0 {
+				return nil, errors.New("truncated address pointer")
+			}
+			if value > (1<<64-1)>>7 {
+				return nil, errors.New("address pointer overflow")
+			}
+			b := data[0]
+			data = data[1:]
+			value = value<<7 | uint64(b&0x7f)
+			if b&0x80 == 0 {
+				break
+			}
+		}
+		values[i] = value
+	}
+	if len(data) > 0 {
+		return nil, errors.New("trailing bytes after address pointer")
+	}
+	return &Pointer{SlotNo: values[0], TxIndex: values[1], CertIndex: values[2]}, nil
+}
+
+func (a *Address) hrp() string {
+	prefix := "addr"
+	if a.IsReward() {
+		prefix = "stake"
+	}
+	if a.NetworkID != NetworkIDMainnet {
+		prefix += "_test"
+	}
+	return prefix
+}
+
+// IsByron returns whether the address is a Byron bootstrap address
+func (a *Address) IsByron() bool {
+	return a.header>>4 == headerTypeByron
+}
+
+// IsReward returns whether the address is a reward (stake) address
+func (a *Address) IsReward() bool {
+	addrType := a.header >> 4
+	return addrType == headerTypeReward || addrType == headerTypeRewardScript
+}
+
+// Bytes returns the binary form of the address
+func (a *Address) Bytes() []byte {
+	return append([]byte{}, a.raw...)
+}
+
+// String returns the bech32 or, for Byron addresses, base58 form
+func (a *Address) String() string {
+	if a.text == "" {
+		text, err := bech32.Encode(a.hrp(), a.raw)
+		if err == nil {
+			a.text = text
+		}
+	}
+	return a.text
+}
+
+// CheckNetwork returns ErrNetworkMismatch if the address does not belong to
+// the network with the given network ID
+func (a *Address) CheckNetwork(networkID byte) error {
+	if a.NetworkID != networkID {
+		return fmt.Errorf("%w: address has network ID %d, expected %d", ErrNetworkMismatch, a.NetworkID, networkID)
+	}
+	return nil
+}
+
+// StakeAddress returns the reward address of the stake credential of a base
+// or reward address
+func (a *Address) StakeAddress() (*Address, bool) {
+	if a.Stake == nil {
+		return nil, false
+	}
+	header := headerTypeReward << 4
+	if a.Stake.Script {
+		header = headerTypeRewardScript << 4
+	}
+	ret, err := FromBytes(append([]byte{header | a.NetworkID}, a.Stake.Hash...))
+	if err != nil {
+		return nil, false
+	}
+	return ret, true
+}
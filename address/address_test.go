@@ -0,0 +1,109 @@
+package address
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo/internal/bech32"
+)
+
+// Test vectors from CIP-19
+const (
+	testPaymentKeyHash = "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	testStakeKeyHash   = "337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251"
+	testScriptHash     = "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+)
+
+func TestParse(t *testing.T) {
+	testDefs := []struct {
+		address   string
+		networkID byte
+		payment   string
+		script    bool
+		stake     string
+		pointer   *Pointer
+	}{
+		{"addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x", 1, testPaymentKeyHash, false, testStakeKeyHash, nil},
+		{"addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh", 1, testScriptHash, true, testStakeKeyHash, nil},
+		{"addr1yx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerkr0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shs2z78ve", 1, testPaymentKeyHash, false, testScriptHash, nil},
+		{"addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k", 1, testPaymentKeyHash, false, "", &Pointer{SlotNo: 2498243, TxIndex: 27, CertIndex: 3}},
+		{"addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8", 1, testPaymentKeyHash, false, "", nil},
+		{"addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx", 1, testScriptHash, true, "", nil},
+		{"addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae", 0, testPaymentKeyHash, false, testStakeKeyHash, nil},
+		{"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw", 1, "", false, testStakeKeyHash, nil},
+		{"Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi", 1, "", false, "", nil},
+	}
+	for _, testDef := range testDefs {
+		addr, err := Parse(testDef.address)
+		if err != nil {
+			t.Errorf("Expected no error parsing %s, got %s", testDef.address, err)
+			continue
+		}
+		if addr.NetworkID != testDef.networkID {
+			t.Errorf("Expected network ID %d for %s, got %d", testDef.networkID, testDef.address, addr.NetworkID)
+		}
+		if (addr.Payment == nil && testDef.payment != "") ||
+			(addr.Payment != nil && (addr.Payment.Hex() != testDef.payment || addr.Payment.Script != testDef.script)) {
+			t.Errorf("Unexpected payment credential for %s: %+v", testDef.address, addr.Payment)
+		}
+		if (addr.Stake == nil && testDef.stake != "") || (addr.Stake != nil && addr.Stake.Hex() != testDef.stake) {
+			t.Errorf("Unexpected stake credential for %s: %+v", testDef.address, addr.Stake)
+		}
+		if (addr.Pointer == nil) != (testDef.pointer == nil) || (addr.Pointer != nil && *addr.Pointer != *testDef.pointer) {
+			t.Errorf("Unexpected pointer for %s: %+v", testDef.address, addr.Pointer)
+		}
+		if addr.String() != testDef.address {
+			t.Errorf("Expected %s to round trip, got %s", testDef.address, addr.String())
+		}
+		roundTrip, err := FromBytes(addr.Bytes())
+		if err != nil || roundTrip.String() != testDef.address {
+			t.Errorf("Expected %s to round trip through bytes, got %v, %v", testDef.address, roundTrip, err)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, address := range []string{
+		"",
+		// Bad checksum
+		"addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl9",
+		// Byron address with a corrupted checksum
+		"Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAj",
+		"not an address",
+	} {
+		if _, err := Parse(address); err == nil {
+			t.Errorf("Expected error parsing %q", address)
+		}
+	}
+}
+
+func TestParsePrefixMismatch(t *testing.T) {
+	addr, err := Parse("addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	mismatched, err := bech32.Encode("addr_test", addr.Bytes())
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if _, err := Parse(mismatched); err == nil {
+		t.Fatalf("Expected error parsing mainnet address with testnet prefix")
+	}
+}
+
+func TestCheckNetwork(t *testing.T) {
+	addr, err := Parse("addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := addr.CheckNetwork(NetworkIDTestnet); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := addr.CheckNetwork(NetworkIDMainnet); !errors.Is(err, ErrNetworkMismatch) {
+		t.Fatalf("Expected ErrNetworkMismatch, got %v", err)
+	}
+	stake, ok := addr.StakeAddress()
+	if !ok || stake.String() != "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn" {
+		t.Fatalf("Unexpected stake address: %v", stake)
+	}
+}
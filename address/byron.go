@@ -0,0 +1,148 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package address
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/blinklabs-io/kupogo/internal/cbor"
+)
+
+// ByronType is the spending data type of a Byron address
+type ByronType uint64
+
+const (
+	ByronTypePubKey ByronType = 0
+	ByronTypeScript ByronType = 1
+	ByronTypeRedeem ByronType = 2
+)
+
+// Byron attribute keys
+const (
+	byronAttributeDerivationPath uint64 = 1
+	byronAttributeProtocolMagic  uint64 = 2
+)
+
+// Byron holds the attributes of a Byron bootstrap address
+type Byron struct {
+	// Root is the hash of the address spending data and attributes
+	Root []byte
+	Type ByronType
+	// DerivationPath is the encrypted HD derivation path of legacy Daedalus
+	// wallets, or nil
+	DerivationPath []byte
+	// ProtocolMagic is the network magic of testnet addresses, or nil on
+	// mainnet
+	ProtocolMagic *uint32
+}
+
+// Byron returns the attributes of a Byron address, or false for Shelley
+// addresses
+func (a *Address) Byron() (*Byron, bool) {
+	return a.byron, a.byron != nil
+}
+
+// parseByron validates a Byron address, the CBOR encoding of
+// [tag 24 (payload), crc32 (payload)] where the payload is
+// [root, attributes, type]
+func parseByron(raw []byte, text string) (*Address, error) {
+	value, err := cbor.Decode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Byron address: %s", err)
+	}
+	items, ok := value.([]any)
+	if !ok || len(items) != 2 {
+		return nil, errors.New("invalid Byron address structure")
+	}
+	tag, ok := items[0].(cbor.Tag)
+	if !ok || tag.Number != cbor.TagEncodedCBOR {
+		return nil, errors.New("invalid Byron address payload")
+	}
+	payload, ok := tag.Content.([]byte)
+	if !ok {
+		return nil, errors.New("invalid Byron address payload")
+	}
+	checksum, ok := items[1].(uint64)
+	if !ok || uint64(crc32.ChecksumIEEE(payload)) != checksum {
+		return nil, errors.New("invalid Byron address checksum")
+	}
+	inner, err := cbor.Decode(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Byron address payload: %s", err)
+	}
+	fields, ok := inner.([]any)
+	if !ok || len(fields) != 3 {
+		return nil, errors.New("invalid Byron address payload")
+	}
+	root, ok := fields[0].([]byte)
+	if !ok || len(root) != hashLength {
+		return nil, errors.New("invalid Byron address root")
+	}
+	attributes, ok := fields[1].(cbor.Map)
+	if !ok {
+		return nil, errors.New("invalid Byron address attributes")
+	}
+	addrType, ok := fields[2].(uint64)
+	if !ok {
+		return nil, errors.New("invalid Byron address type")
+	}
+	byron := &Byron{
+		Root: root,
+		Type: ByronType(addrType),
+	}
+	// Attribute values are themselves CBOR encoded
+	if value, ok := attributes.Get(byronAttributeDerivationPath); ok {
+		encoded, ok := value.([]byte)
+		if !ok {
+			return nil, errors.New("invalid Byron derivation path attribute")
+		}
+		path, err := cbor.Decode(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Byron derivation path attribute: %s", err)
+		}
+		if byron.DerivationPath, ok = path.([]byte); !ok {
+			return nil, errors.New("invalid Byron derivation path attribute")
+		}
+	}
+	if value, ok := attributes.Get(byronAttributeProtocolMagic); ok {
+		encoded, ok := value.([]byte)
+		if !ok {
+			return nil, errors.New("invalid Byron protocol magic attribute")
+		}
+		magic, err := cbor.Decode(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Byron protocol magic attribute: %s", err)
+		}
+		magicValue, ok := magic.(uint64)
+		if !ok || magicValue > 1<<32-1 {
+			return nil, errors.New("invalid Byron protocol magic attribute")
+		}
+		protocolMagic := uint32(magicValue)
+		byron.ProtocolMagic = &protocolMagic
+	}
+	ret := &Address{
+		raw:       append([]byte{}, raw...),
+		text:      text,
+		header:    headerTypeByron << 4,
+		NetworkID: NetworkIDMainnet,
+		byron:     byron,
+	}
+	if byron.ProtocolMagic != nil {
+		ret.NetworkID = NetworkIDTestnet
+	}
+	return ret, nil
+}
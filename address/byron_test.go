@@ -0,0 +1,75 @@
+package address
+
+import (
+	"bytes"
+	"hash/crc32"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo/internal/base58"
+	"github.com/blinklabs-io/kupogo/internal/cbor"
+)
+
+// testByronAddress builds a Byron address with the given attributes
+func testByronAddress(derivationPath []byte, protocolMagic *uint64) string {
+	var attributes []byte
+	count := 0
+	if derivationPath != nil {
+		count++
+	}
+	if protocolMagic != nil {
+		count++
+	}
+	attributes = cbor.AppendMapHeader(attributes, count)
+	if derivationPath != nil {
+		attributes = cbor.AppendUint(attributes, 1)
+		attributes = cbor.AppendBytes(attributes, cbor.AppendBytes(nil, derivationPath))
+	}
+	if protocolMagic != nil {
+		attributes = cbor.AppendUint(attributes, 2)
+		attributes = cbor.AppendBytes(attributes, cbor.AppendUint(nil, *protocolMagic))
+	}
+	payload := cbor.AppendArrayHeader(nil, 3)
+	payload = cbor.AppendBytes(payload, bytes.Repeat([]byte{0xab}, 28))
+	payload = append(payload, attributes...)
+	payload = cbor.AppendUint(payload, 0)
+	raw := cbor.AppendArrayHeader(nil, 2)
+	raw = cbor.AppendTag(raw, cbor.TagEncodedCBOR)
+	raw = cbor.AppendBytes(raw, payload)
+	raw = cbor.AppendUint(raw, uint64(crc32.ChecksumIEEE(payload)))
+	return base58.Encode(raw)
+}
+
+func TestParseByron(t *testing.T) {
+	magic := uint64(1097911063)
+	addr, err := Parse(testByronAddress([]byte{1, 2, 3}, &magic))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	byron, ok := addr.Byron()
+	if !ok || !addr.IsByron() {
+		t.Fatalf("Expected Byron address")
+	}
+	if addr.NetworkID != NetworkIDTestnet || byron.ProtocolMagic == nil || *byron.ProtocolMagic != 1097911063 {
+		t.Fatalf("Unexpected protocol magic: %v", byron.ProtocolMagic)
+	}
+	if !bytes.Equal(byron.DerivationPath, []byte{1, 2, 3}) || byron.Type != ByronTypePubKey || len(byron.Root) != 28 {
+		t.Fatalf("Unexpected attributes: %+v", byron)
+	}
+	if addr.Payment != nil || addr.Stake != nil {
+		t.Fatalf("Expected no Shelley credentials")
+	}
+	if _, err := addr.PaymentPattern(); err == nil {
+		t.Fatalf("Expected error for Byron payment pattern")
+	}
+	mainnet, err := Parse("Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if byron, _ := mainnet.Byron(); byron.ProtocolMagic != nil || byron.DerivationPath != nil {
+		t.Fatalf("Unexpected attributes: %+v", byron)
+	}
+	fromBytes, err := FromBytes(mainnet.Bytes())
+	if err != nil || fromBytes.String() != mainnet.String() {
+		t.Fatalf("Expected Byron address to round trip through bytes, got %v, %v", fromBytes, err)
+	}
+}
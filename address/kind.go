@@ -0,0 +1,83 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package address
+
+// Kind is the type of an address
+type Kind int
+
+const (
+	KindBase Kind = iota
+	KindPointer
+	KindEnterprise
+	KindReward
+	KindByron
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindBase:
+		return "base"
+	case KindPointer:
+		return "pointer"
+	case KindEnterprise:
+		return "enterprise"
+	case KindReward:
+		return "reward"
+	case KindByron:
+		return "byron"
+	default:
+		return "unknown"
+	}
+}
+
+// Kind returns the type of the address
+func (a *Address) Kind() Kind {
+	switch addrType := a.header >> 4; {
+	case addrType <= 3:
+		return KindBase
+	case addrType <= 5:
+		return KindPointer
+	case addrType <= 7:
+		return KindEnterprise
+	case addrType == headerTypeByron:
+		return KindByron
+	default:
+		return KindReward
+	}
+}
+
+// IsScript returns whether the payment part of the address is a script
+// hash. Reward addresses are checked for a script stake credential instead,
+// and Byron addresses are never scripts
+func (a *Address) IsScript() bool {
+	switch {
+	case a.Payment != nil:
+		return a.Payment.Script
+	case a.Stake != nil:
+		return a.Stake.Script
+	default:
+		return false
+	}
+}
+
+// Classify parses an address and returns its type and whether its payment
+// part is a script
+func Classify(address string) (Kind, bool, error) {
+	parsed, err := Parse(address)
+	if err != nil {
+		return 0, false, err
+	}
+	return parsed.Kind(), parsed.IsScript(), nil
+}
@@ -0,0 +1,27 @@
+package address
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	testDefs := []struct {
+		address string
+		kind    Kind
+		script  bool
+	}{
+		{"addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x", KindBase, false},
+		{"addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh", KindBase, true},
+		{"addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k", KindPointer, false},
+		{"addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx", KindEnterprise, true},
+		{"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw", KindReward, false},
+		{"Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi", KindByron, false},
+	}
+	for _, testDef := range testDefs {
+		kind, script, err := Classify(testDef.address)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if kind != testDef.kind || script != testDef.script {
+			t.Errorf("Expected %s (script=%v) for %s, got %s (script=%v)", testDef.kind, testDef.script, testDef.address, kind, script)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package address
+
+import "errors"
+
+// ErrNoPaymentCredential is returned for addresses without a payment
+// credential, such as reward and Byron addresses
+var ErrNoPaymentCredential = errors.New("address has no payment credential")
+
+// ErrNoStakeCredential is returned for addresses without a stake credential
+var ErrNoStakeCredential = errors.New("address has no stake credential")
+
+// PaymentPattern returns the Kupo pattern matching all outputs spendable by
+// the payment key or script of the address, regardless of their stake part
+func (a *Address) PaymentPattern() (string, error) {
+	if a.Payment == nil {
+		return "", ErrNoPaymentCredential
+	}
+	return a.Payment.Hex() + "/*", nil
+}
+
+// StakePattern returns the Kupo pattern matching all outputs delegated to the
+// stake credential of the address, regardless of their payment part
+func (a *Address) StakePattern() (string, error) {
+	if a.Stake == nil {
+		return "", ErrNoStakeCredential
+	}
+	return "*/" + a.Stake.Hex(), nil
+}
+
+// PaymentCredentialPattern parses an address and returns the Kupo pattern
+// matching everything spendable by its payment credential
+func PaymentCredentialPattern(address string) (string, error) {
+	parsed, err := Parse(address)
+	if err != nil {
+		return "", err
+	}
+	return parsed.PaymentPattern()
+}
@@ -0,0 +1,34 @@
+package address
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPaymentCredentialPattern(t *testing.T) {
+	pattern, err := PaymentCredentialPattern("addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if pattern != testPaymentKeyHash+"/*" {
+		t.Fatalf("Unexpected pattern: %s", pattern)
+	}
+	_, err = PaymentCredentialPattern("stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw")
+	if !errors.Is(err, ErrNoPaymentCredential) {
+		t.Fatalf("Expected ErrNoPaymentCredential, got %v", err)
+	}
+}
+
+func TestStakePattern(t *testing.T) {
+	addr, err := Parse("stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	pattern, err := addr.StakePattern()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if pattern != "*/"+testStakeKeyHash {
+		t.Fatalf("Unexpected pattern: %s", pattern)
+	}
+}
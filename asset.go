@@ -0,0 +1,70 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo/internal/bech32"
+	"golang.org/x/crypto/blake2b"
+)
+
+// AssetID identifies a native asset using Kupo's "policy_id.asset_name"
+// notation, with both parts hex encoded. The ".asset_name" part is omitted for
+// assets with an empty name
+type AssetID string
+
+// NewAssetID builds an AssetID from a hex policy ID and hex asset name
+func NewAssetID(policyID string, assetName string) AssetID {
+	if assetName == "" {
+		return AssetID(policyID)
+	}
+	return AssetID(policyID + "." + assetName)
+}
+
+// PolicyID returns the hex encoded policy ID of the asset
+func (a AssetID) PolicyID() string {
+	policyID, _, _ := strings.Cut(string(a), ".")
+	return policyID
+}
+
+// AssetName returns the hex encoded asset name of the asset
+func (a AssetID) AssetName() string {
+	_, assetName, _ := strings.Cut(string(a), ".")
+	return assetName
+}
+
+func (a AssetID) String() string {
+	return string(a)
+}
+
+// Fingerprint returns the CIP-14 asset fingerprint, such as
+// "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"
+func (a AssetID) Fingerprint() (string, error) {
+	policyID, err := hex.DecodeString(a.PolicyID())
+	if err != nil {
+		return "", fmt.Errorf("invalid policy ID: %s", err)
+	}
+	assetName, err := hex.DecodeString(a.AssetName())
+	if err != nil {
+		return "", fmt.Errorf("invalid asset name: %s", err)
+	}
+	hash, _ := blake2b.New(20, nil)
+	hash.Write(policyID)
+	hash.Write(assetName)
+	return bech32.Encode("asset", hash.Sum(nil))
+}
@@ -0,0 +1,20 @@
+package kupogo
+
+import (
+	"testing"
+)
+
+func TestAssetIDFingerprint(t *testing.T) {
+	// Test vector from CIP-14
+	assetID := NewAssetID("7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "")
+	fingerprint, err := assetID.Fingerprint()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if fingerprint != "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3" {
+		t.Fatalf("Unexpected fingerprint: %s", fingerprint)
+	}
+	if _, err := AssetID("zz.00").Fingerprint(); err == nil {
+		t.Fatalf("Expected error for invalid policy ID")
+	}
+}
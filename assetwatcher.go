@@ -0,0 +1,191 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// AssetEventType identifies the kind of change reported by an AssetWatcher
+type AssetEventType int
+
+const (
+	// AssetArrived is reported when an output holding the asset is created
+	AssetArrived AssetEventType = iota
+	// AssetLeft is reported when an output holding the asset is spent
+	AssetLeft
+)
+
+func (t AssetEventType) String() string {
+	switch t {
+	case AssetArrived:
+		return "arrived"
+	case AssetLeft:
+		return "left"
+	default:
+		return "unknown"
+	}
+}
+
+// AssetEvent describes a quantity of an asset arriving at or leaving an
+// address
+type AssetEvent struct {
+	Type     AssetEventType
+	Asset    AssetID
+	Quantity int
+	// Match is the output the asset arrived in or left
+	Match Match
+}
+
+// AssetWatcherConfig configures an AssetWatcher
+type AssetWatcherConfig struct {
+	// Asset is the policy or asset pattern to watch, such as MatchPolicy or
+	// MatchAsset
+	Asset Pattern
+	// Address, if set, restricts events to outputs at addresses matching it,
+	// such as MatchAddress or MatchCredential
+	Address Pattern
+	// Interval between polls, defaulting to 10 seconds
+	Interval time.Duration
+	// OnEvent is called for every change found while running
+	OnEvent func(AssetEvent)
+	// OnError is called when a poll fails while running
+	OnError func(error)
+	// CursorStore and CursorName are used as with WatcherConfig
+	CursorStore CursorStore
+	CursorName  string
+}
+
+// AssetWatcher tracks the arrival and departure of tokens of a policy or
+// asset by polling Kupo, for uses such as verifying airdrops or detecting NFT
+// sales
+type AssetWatcher struct {
+	watcher *Watcher
+	config  AssetWatcherConfig
+	err     error
+}
+
+// NewAssetWatcher creates a watcher for the configured asset
+func NewAssetWatcher(client *Client, config AssetWatcherConfig) *AssetWatcher {
+	var err error
+	kind, kindErr := config.Asset.Kind()
+	if kindErr != nil {
+		err = fmt.Errorf("invalid asset pattern: %s", kindErr)
+	} else if kind != PatternKindPolicyID && kind != PatternKindAssetID {
+		err = fmt.Errorf("invalid asset pattern: %s pattern given", kind)
+	}
+	return &AssetWatcher{
+		watcher: NewWatcher(client, WatcherConfig{
+			Pattern:     string(config.Asset),
+			Interval:    config.Interval,
+			CursorStore: config.CursorStore,
+			CursorName:  config.CursorName,
+		}),
+		config: config,
+		err:    err,
+	}
+}
+
+// Poll returns the asset changes since the previous poll. Like Watcher.Poll,
+// the first poll reports every asset held as arrived unless a cursor was saved
+func (w *AssetWatcher) Poll() ([]AssetEvent, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	events, err := w.watcher.Poll()
+	if err != nil {
+		return nil, err
+	}
+	var ret []AssetEvent
+	for _, event := range events {
+		if w.config.Address != "" && !w.config.Address.MatchesAddress(event.Match.Address) {
+			continue
+		}
+		eventType := AssetArrived
+		if event.Type == WatchEventSpent {
+			eventType = AssetLeft
+		}
+		for _, asset := range w.matchingAssets(event.Match.Value) {
+			ret = append(ret, AssetEvent{
+				Type:     eventType,
+				Asset:    asset,
+				Quantity: event.Match.Value.Assets[string(asset)],
+				Match:    event.Match,
+			})
+		}
+	}
+	return ret, nil
+}
+
+// matchingAssets returns the watched assets of a value, sorted
+func (w *AssetWatcher) matchingAssets(value Value) []AssetID {
+	var ret []AssetID
+	for asset := range value.Assets {
+		if w.config.Asset.MatchesAsset(AssetID(asset)) {
+			ret = append(ret, AssetID(asset))
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i] < ret[j]
+	})
+	return ret
+}
+
+// Holdings returns the quantities of the watched assets held as of the last
+// poll, at matching addresses only if an address is configured
+func (w *AssetWatcher) Holdings() map[AssetID]int {
+	ret := make(map[AssetID]int)
+	for _, match := range w.watcher.UTxOs() {
+		if w.config.Address != "" && !w.config.Address.MatchesAddress(match.Address) {
+			continue
+		}
+		for _, asset := range w.matchingAssets(match.Value) {
+			ret[asset] += match.Value.Assets[string(asset)]
+		}
+	}
+	return ret
+}
+
+// Run polls until the context is cancelled, delivering changes and errors to
+// the configured callbacks
+func (w *AssetWatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.watcher.config.Interval)
+	defer ticker.Stop()
+	for {
+		events, err := w.Poll()
+		if err != nil {
+			if w.config.OnError != nil {
+				w.config.OnError(err)
+			}
+		} else {
+			if w.config.OnEvent != nil {
+				for _, event := range events {
+					w.config.OnEvent(event)
+				}
+			}
+			if err := w.watcher.SaveCursor(); err != nil && w.config.OnError != nil {
+				w.config.OnError(err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestAssetWatcher_Poll(t *testing.T) {
+	policy := "11111111111111111111111111111111111111111111111111111111"
+	nft := NewAssetID(policy, "4e4654")
+	other := NewAssetID(policy, "4f54")
+	buyer := "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"
+	var mu sync.Mutex
+	utxos := Matches{
+		{TransactionID: "aa", Address: buyer, Value: Value{Coins: 2, Assets: Assets{string(nft): 1}}, CreatedAt: Point{SlotNo: 1}},
+		{TransactionID: "bb", Address: "addr_other", Value: Value{Coins: 2, Assets: Assets{string(other): 5}}, CreatedAt: Point{SlotNo: 2}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/matches/"+policy+".*" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mu.Lock()
+			respBody, _ := json.Marshal(utxos)
+			mu.Unlock()
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	watcher := NewAssetWatcher(
+		NewClient(server.URL),
+		AssetWatcherConfig{
+			Asset:   MatchPolicy(policy),
+			Address: MatchAddress(buyer),
+		},
+	)
+	events, err := watcher.Poll()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(events) != 1 || events[0].Type != AssetArrived || events[0].Asset != nft ||
+		events[0].Quantity != 1 {
+		t.Fatalf("Unexpected initial events: %+v", events)
+	}
+
+	mu.Lock()
+	utxos = utxos[1:]
+	mu.Unlock()
+	events, err = watcher.Poll()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(events) != 1 || events[0].Type != AssetLeft || events[0].Match.TransactionID != "aa" {
+		t.Fatalf("Unexpected events: %+v", events)
+	}
+	if holdings := watcher.Holdings(); len(holdings) != 0 {
+		t.Fatalf("Expected no holdings at the address, got %v", holdings)
+	}
+}
+
+func TestAssetWatcherInvalidPattern(t *testing.T) {
+	watcher := NewAssetWatcher(NewClient("http://localhost"), AssetWatcherConfig{Asset: "*"})
+	if _, err := watcher.Poll(); err == nil {
+		t.Fatalf("Expected an error for a non-asset pattern")
+	}
+}
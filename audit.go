@@ -0,0 +1,114 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// AuditAction identifies a pattern mutation recorded in the audit log
+type AuditAction string
+
+const (
+	AuditAddPattern      AuditAction = "add_pattern"
+	AuditAddPatterns     AuditAction = "add_patterns"
+	AuditDeletePattern   AuditAction = "delete_pattern"
+	AuditReplacePatterns AuditAction = "replace_patterns"
+)
+
+// AuditEntry records a pattern mutation made through the client
+type AuditEntry struct {
+	Time time.Time `json:"time"`
+	// Actor is who made the change, as set with the AuditActor call option
+	Actor  string      `json:"actor,omitempty"`
+	Action AuditAction `json:"action"`
+	// Patterns are the patterns added or deleted. For replacements, they are
+	// the added patterns and Removed holds the deleted ones
+	Patterns []string `json:"patterns"`
+	Removed  []string `json:"removed,omitempty"`
+	// RollbackTo is the point Kupo rolled back to when adding patterns
+	RollbackTo *Point        `json:"rollback_to,omitempty"`
+	Limit      RollbackLimit `json:"limit,omitempty"`
+	// Error is the error the mutation failed with, if any
+	Error string `json:"error,omitempty"`
+}
+
+// AuditSink receives the audit log of a client
+type AuditSink interface {
+	RecordAudit(ctx context.Context, entry AuditEntry) error
+}
+
+// AuditSinkFunc adapts a function to an AuditSink
+type AuditSinkFunc func(ctx context.Context, entry AuditEntry) error
+
+func (f AuditSinkFunc) RecordAudit(ctx context.Context, entry AuditEntry) error {
+	return f(ctx, entry)
+}
+
+// JSONAuditSink returns a sink writing entries to w as JSON lines
+func JSONAuditSink(w io.Writer) AuditSink {
+	var mu sync.Mutex
+	encoder := json.NewEncoder(w)
+	return AuditSinkFunc(func(ctx context.Context, entry AuditEntry) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return encoder.Encode(entry)
+	})
+}
+
+// WithAuditLog records every pattern mutation made through the client,
+// successful or not, into a sink. Sink errors are logged, if a logger is
+// configured, and otherwise ignored so auditing never fails a mutation that
+// Kupo already applied
+func WithAuditLog(sink AuditSink) ClientOption {
+	return func(c *Client) {
+		c.auditSink = sink
+	}
+}
+
+// AuditActor sets who the pattern mutations made with the context are
+// attributed to in the audit log
+func AuditActor(actor string) CallOption {
+	return func(o *callOptions) {
+		o.actor = actor
+	}
+}
+
+// audit records an entry for a mutation which ended with err
+func (c *Client) audit(ctx context.Context, entry AuditEntry, err error) {
+	if c.auditSink == nil {
+		return
+	}
+	entry.Time = time.Now()
+	if opts := callOptionsFrom(ctx); opts != nil {
+		entry.Actor = opts.actor
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if err := c.auditSink.RecordAudit(ctx, entry); err != nil && c.logger != nil {
+		c.logger.Log(
+			ctx,
+			c.logLevels.Error,
+			"failed to record audit entry",
+			"action", entry.Action,
+			"error", err,
+		)
+	}
+}
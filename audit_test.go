@@ -0,0 +1,84 @@
+package kupogo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAuditLog(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/patterns":
+				_, _ = w.Write([]byte(`["*"]`))
+			case r.Method == http.MethodGet && r.URL.Path == "/checkpoints":
+				_, _ = w.Write([]byte(`[{"slot_no":100,"header_hash":"aa"}]`))
+			case r.Method == http.MethodPut:
+				_, _ = w.Write([]byte(`[]`))
+			case r.Method == http.MethodDelete && r.URL.Path == "/patterns/*":
+				_, _ = w.Write([]byte(`{"deleted":1}`))
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}),
+	)
+	defer server.Close()
+	var buf bytes.Buffer
+	client := NewClient(server.URL, WithAuditLog(JSONAuditSink(&buf)))
+	ctx := WithCallOptions(context.Background(), AuditActor("ops"))
+
+	rollbackTo := Point{SlotNo: 42, HeaderHash: "bb"}
+	if _, err := client.AddPatternContext(ctx, "addr1", rollbackTo, RollbackLimitWithinSafeZone); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if _, err := client.DeletePatternContext(ctx, "addr2"); err == nil {
+		t.Fatalf("Expected an error deleting addr2")
+	}
+	if _, err := client.EnsurePatternsContext(ctx, []Pattern{MatchPolicy(testPolicyID)}, EnsurePatternsOptions{}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	var entries []AuditEntry
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry AuditEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if entry.Actor != "ops" || entry.Time.IsZero() {
+			t.Errorf("Unexpected entry: %+v", entry)
+		}
+		entries = append(entries, entry)
+	}
+	var actions []AuditAction
+	for _, entry := range entries {
+		actions = append(actions, entry.Action)
+	}
+	expected := []AuditAction{
+		AuditAddPattern,
+		AuditDeletePattern,
+		AuditAddPatterns,
+		AuditDeletePattern,
+		AuditReplacePatterns,
+	}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("Expected actions %v, got %v", expected, actions)
+	}
+	if *entries[0].RollbackTo != rollbackTo || entries[0].Limit != RollbackLimitWithinSafeZone {
+		t.Errorf("Unexpected add entry: %+v", entries[0])
+	}
+	if entries[1].Error == "" {
+		t.Errorf("Expected the failed deletion to be recorded with its error")
+	}
+	replace := entries[4]
+	if !reflect.DeepEqual(replace.Patterns, []string{string(MatchPolicy(testPolicyID))}) ||
+		!reflect.DeepEqual(replace.Removed, []string{"*"}) ||
+		replace.RollbackTo.SlotNo != 100 {
+		t.Errorf("Unexpected replace entry: %+v", replace)
+	}
+}
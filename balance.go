@@ -0,0 +1,61 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+// GetUTxOsAt returns the outputs matching a pattern which were unspent as of
+// the given slot, i.e. outputs created at or before the slot which were not
+// spent at or before it. The two underlying queries are not atomic, so an
+// output spent in between them is reported once
+func (c *Client) GetUTxOsAt(pattern string, slotNo int) (Matches, error) {
+	// Outputs which are still unspent today
+	unspent, err := c.GetMatchesWithOptions(
+		pattern,
+		MatchOptions{
+			Unspent:       true,
+			CreatedBefore: slotNo + 1,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	// Outputs which have since been spent, but were not yet at the slot
+	spentLater, err := c.GetMatchesWithOptions(
+		pattern,
+		MatchOptions{
+			CreatedBefore: slotNo + 1,
+			SpentAfter:    slotNo,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	// An output spent between the two queries can show up in both, so only
+	// count each output once
+	return append(*unspent, *spentLater...).Dedup(), nil
+}
+
+// GetBalanceAt returns the balance of the outputs matching a pattern as of the
+// given slot
+func (c *Client) GetBalanceAt(pattern string, slotNo int) (*Value, error) {
+	utxos, err := c.GetUTxOsAt(pattern, slotNo)
+	if err != nil {
+		return nil, err
+	}
+	var balance Value
+	for _, match := range utxos {
+		balance = balance.Add(match.Value)
+	}
+	return &balance, nil
+}
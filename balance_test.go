@@ -0,0 +1,93 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetBalanceAt(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/matches/addr1" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			var matches Matches
+			switch r.URL.RawQuery {
+			case "unspent&created_before=1001":
+				matches = Matches{
+					{TransactionID: "aa", Value: Value{Coins: 10}},
+				}
+			case "created_before=1001&spent_after=1000":
+				matches = Matches{
+					{
+						TransactionID: "bb",
+						Value:         Value{Coins: 5, Assets: Assets{"cc": 1}},
+						SpentAt:       &Point{SlotNo: 1500},
+					},
+				}
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			respBody, _ := json.Marshal(matches)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	client := &Client{KupoUrl: server.URL}
+	balance, err := client.GetBalanceAt("addr1", 1000)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := &Value{Coins: 15, Assets: Assets{"cc": 1}}
+	if !reflect.DeepEqual(balance, expected) {
+		t.Errorf("Expected balance %v, got %v", expected, balance)
+	}
+}
+
+func TestClient_GetBalanceAtSpentBetweenQueries(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The output is spent after the first query was answered, so it
+			// shows up as both unspent and spent later
+			var matches Matches
+			switch r.URL.RawQuery {
+			case "unspent&created_before=1001":
+				matches = Matches{
+					{TransactionID: "aa", Value: Value{Coins: 10}},
+				}
+			case "created_before=1001&spent_after=1000":
+				matches = Matches{
+					{
+						TransactionID: "aa",
+						Value:         Value{Coins: 10},
+						SpentAt:       &Point{SlotNo: 1500},
+					},
+				}
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			respBody, _ := json.Marshal(matches)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	client := &Client{KupoUrl: server.URL}
+	balance, err := client.GetBalanceAt("addr1", 1000)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := &Value{Coins: 10}
+	if !reflect.DeepEqual(balance, expected) {
+		t.Errorf("Expected balance %v, got %v", expected, balance)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BalanceInterval selects the windows of a balance series
+type BalanceInterval int
+
+const (
+	// BalanceIntervalSlots uses windows of a fixed number of slots
+	BalanceIntervalSlots BalanceInterval = iota
+	// BalanceIntervalEpoch uses the epochs of the network
+	BalanceIntervalEpoch
+	// BalanceIntervalDay uses UTC calendar days
+	BalanceIntervalDay
+)
+
+const defaultBalanceWindow = 3600
+
+// BalanceSeriesOptions configures a balance series
+type BalanceSeriesOptions struct {
+	Interval BalanceInterval
+	// Window is the number of slots per window of BalanceIntervalSlots,
+	// defaulting to an hour of mainnet slots
+	Window int
+	// FromSlot and ToSlot bound the series, inclusive. ToSlot defaults to
+	// Kupo's most recent checkpoint
+	FromSlot int
+	ToSlot   int
+}
+
+// BalancePoint is the balance at the end of a window of a balance series
+type BalancePoint struct {
+	// SlotNo is the last slot of the window
+	SlotNo  int       `json:"slot_no"`
+	Time    time.Time `json:"time"`
+	Epoch   int       `json:"epoch"`
+	Balance Value     `json:"balance"`
+}
+
+// BalanceSeries walks the matches, which must include spent outputs, and
+// emits the balance at the end of every window between opts.FromSlot and
+// opts.ToSlot, oldest first. Slots are mapped to epochs and time with the
+// network. It stops at the first error returned by emit
+func (m Matches) BalanceSeries(
+	network Network,
+	opts BalanceSeriesOptions,
+	emit func(BalancePoint) error,
+) error {
+	if opts.Interval == BalanceIntervalSlots && opts.Window <= 0 {
+		opts.Window = defaultBalanceWindow
+	}
+	type event struct {
+		slotNo int
+		value  Value
+		spent  bool
+	}
+	events := make([]event, 0, len(m)*2)
+	for _, match := range m {
+		events = append(events, event{slotNo: match.CreatedAt.SlotNo, value: match.Value})
+		if match.SpentAt != nil {
+			events = append(events, event{slotNo: match.SpentAt.SlotNo, value: match.Value, spent: true})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].slotNo < events[j].slotNo
+	})
+	var balance Value
+	next := 0
+	for start := opts.FromSlot; start <= opts.ToSlot; {
+		end := balanceWindowEnd(network, opts, start)
+		if end > opts.ToSlot {
+			end = opts.ToSlot
+		}
+		for ; next < len(events) && events[next].slotNo <= end; next++ {
+			if events[next].spent {
+				balance = balance.Sub(events[next].value)
+			} else {
+				balance = balance.Add(events[next].value)
+			}
+		}
+		epoch, _ := network.SlotToEpoch(end)
+		point := BalancePoint{
+			SlotNo:  end,
+			Time:    network.SlotToTime(end).UTC(),
+			Epoch:   epoch,
+			Balance: balance.Clone(),
+		}
+		if err := emit(point); err != nil {
+			return err
+		}
+		start = end + 1
+	}
+	return nil
+}
+
+// balanceWindowEnd returns the last slot of the window starting at start
+func balanceWindowEnd(network Network, opts BalanceSeriesOptions, start int) int {
+	var end int
+	switch opts.Interval {
+	case BalanceIntervalEpoch:
+		epoch, _ := network.SlotToEpoch(start)
+		end = network.EpochToSlot(epoch+1) - 1
+	case BalanceIntervalDay:
+		day := network.SlotToTime(start).UTC()
+		midnight := time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, time.UTC)
+		end = network.TimeToSlot(midnight) - 1
+	default:
+		end = start + opts.Window - 1
+	}
+	if end < start {
+		return start
+	}
+	return end
+}
+
+// GetBalanceSeries fetches the spent and unspent matches of a pattern and
+// emits its balance over time, using the client's network to map slots to
+// epochs and time
+func (c *Client) GetBalanceSeries(
+	ctx context.Context,
+	pattern string,
+	opts BalanceSeriesOptions,
+	emit func(BalancePoint) error,
+) error {
+	if opts.ToSlot <= 0 {
+		checkpoints, err := c.GetCheckpointsContext(ctx)
+		if err != nil {
+			return err
+		}
+		if len(*checkpoints) == 0 {
+			return errors.New("no checkpoint to end series at")
+		}
+		opts.ToSlot = (*checkpoints)[0].SlotNo
+	}
+	matches, _, err := c.getMatches(ctx, pattern, MatchOptions{CreatedBefore: opts.ToSlot + 1})
+	if err != nil {
+		return err
+	}
+	return matches.BalanceSeries(c.Network(), opts, emit)
+}
+
+// BalanceSeriesCSV returns an emit function writing balance points to w as
+// CSV, preceded by a header row. Assets are listed in a single column as
+// space separated "asset:quantity" pairs
+func BalanceSeriesCSV(w io.Writer) func(BalancePoint) error {
+	writer := csv.NewWriter(w)
+	header := false
+	return func(point BalancePoint) error {
+		if !header {
+			if err := writer.Write([]string{"slot_no", "time", "epoch", "coins", "assets"}); err != nil {
+				return fmt.Errorf("failed to write: %s", err)
+			}
+			header = true
+		}
+		assets := make([]string, 0, len(point.Balance.Assets))
+		for asset, quantity := range point.Balance.Assets {
+			assets = append(assets, asset+":"+strconv.Itoa(quantity))
+		}
+		sort.Strings(assets)
+		record := []string{
+			strconv.Itoa(point.SlotNo),
+			point.Time.Format(time.RFC3339),
+			strconv.Itoa(point.Epoch),
+			strconv.Itoa(point.Balance.Coins),
+			strings.Join(assets, " "),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write: %s", err)
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("failed to write: %s", err)
+		}
+		return nil
+	}
+}
+
+// BalanceSeriesJSONL returns an emit function writing balance points to w as
+// JSON Lines
+func BalanceSeriesJSONL(w io.Writer) func(BalancePoint) error {
+	encoder := json.NewEncoder(w)
+	return func(point BalancePoint) error {
+		if err := encoder.Encode(point); err != nil {
+			return fmt.Errorf("failed to write: %s", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,76 @@
+package kupogo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetBalanceSeries(t *testing.T) {
+	network := Network{
+		SlotConfig: SlotConfig{
+			ZeroTime:   time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			SlotLength: time.Hour,
+		},
+		EpochLength: 48,
+	}
+	matches := Matches{
+		{Value: Value{Coins: 10}, CreatedAt: Point{SlotNo: 5}, SpentAt: &Point{SlotNo: 30}},
+		{Value: Value{Coins: 7, Assets: Assets{"a.b": 1}}, CreatedAt: Point{SlotNo: 30}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/checkpoints" {
+				_, _ = w.Write([]byte(`[{"slot_no":71,"header_hash":"aa"}]`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(matches)
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL, WithNetwork(network))
+
+	var points []BalancePoint
+	collect := func(point BalancePoint) error {
+		points = append(points, point)
+		return nil
+	}
+	if err := client.GetBalanceSeries(context.Background(), "addr", BalanceSeriesOptions{Interval: BalanceIntervalDay}, collect); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := []BalancePoint{
+		{SlotNo: 23, Time: network.SlotToTime(23), Epoch: 0, Balance: Value{Coins: 10}},
+		{SlotNo: 47, Time: network.SlotToTime(47), Epoch: 0, Balance: Value{Coins: 7, Assets: Assets{"a.b": 1}}},
+		{SlotNo: 71, Time: network.SlotToTime(71), Epoch: 1, Balance: Value{Coins: 7, Assets: Assets{"a.b": 1}}},
+	}
+	if !reflect.DeepEqual(points, expected) {
+		t.Fatalf("Unexpected day series %+v", points)
+	}
+
+	var buf bytes.Buffer
+	opts := BalanceSeriesOptions{Interval: BalanceIntervalEpoch, ToSlot: 50}
+	if err := matches.BalanceSeries(network, opts, BalanceSeriesCSV(&buf)); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expectedCSV := "slot_no,time,epoch,coins,assets\n" +
+		"47,2024-01-02T23:00:00Z,0,7,a.b:1\n" +
+		"50,2024-01-03T02:00:00Z,1,7,a.b:1\n"
+	if buf.String() != expectedCSV {
+		t.Fatalf("Unexpected CSV:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	opts = BalanceSeriesOptions{Window: 10, ToSlot: 9}
+	if err := matches.BalanceSeries(network, opts, BalanceSeriesJSONL(&buf)); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expectedJSONL := `{"slot_no":9,"time":"2024-01-01T09:00:00Z","epoch":0,"balance":{"coins":10,"assets":{}}}` + "\n"
+	if buf.String() != expectedJSONL {
+		t.Fatalf("Unexpected JSONL:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,365 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blockfrost serves a subset of the Blockfrost API backed by Kupo, so
+// applications written against a Blockfrost SDK can use a self-hosted Kupo.
+// Only the endpoints listed on NewHandler are supported
+package blockfrost
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+const (
+	defaultCount = 100
+	maxCount     = 100
+)
+
+// Amount is a quantity of lovelace or a native asset
+type Amount struct {
+	Unit     string `json:"unit"`
+	Quantity string `json:"quantity"`
+}
+
+// AddressUTxO is an unspent output as returned by /addresses/{address}/utxos
+type AddressUTxO struct {
+	Address             string   `json:"address"`
+	TxHash              string   `json:"tx_hash"`
+	TxIndex             int      `json:"tx_index"`
+	OutputIndex         int      `json:"output_index"`
+	Amount              []Amount `json:"amount"`
+	Block               string   `json:"block"`
+	DataHash            *string  `json:"data_hash"`
+	InlineDatum         *string  `json:"inline_datum"`
+	ReferenceScriptHash *string  `json:"reference_script_hash"`
+}
+
+// Script is a script as returned by /scripts/{hash}
+type Script struct {
+	ScriptHash     string `json:"script_hash"`
+	Type           string `json:"type"`
+	SerialisedSize *int   `json:"serialised_size"`
+}
+
+// TxMetadata is a metadata label as returned by /txs/{hash}/metadata
+type TxMetadata struct {
+	Label        string `json:"label"`
+	JSONMetadata any    `json:"json_metadata"`
+}
+
+// Error is Blockfrost's error body
+type Error struct {
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error"`
+	Message    string `json:"message"`
+}
+
+type handler struct {
+	client kupogo.KupoClient
+}
+
+// NewHandler serves these Blockfrost endpoints, with or without the /api/v0
+// prefix:
+//
+//	GET /health
+//	GET /addresses/{address}/utxos
+//	GET /addresses/{address}/utxos/{asset}
+//	GET /scripts/{hash}
+//	GET /scripts/{hash}/cbor
+//	GET /scripts/datum/{hash}/cbor
+//	GET /txs/{hash}/metadata
+//
+// Transaction metadata is only found for transactions with an output matched
+// by Kupo's patterns
+func NewHandler(client kupogo.KupoClient) http.Handler {
+	return &handler{client: client}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/api/v0")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "health":
+		h.health(w)
+	case len(parts) >= 3 && len(parts) <= 4 && parts[0] == "addresses" && parts[2] == "utxos":
+		asset := ""
+		if len(parts) == 4 {
+			asset = parts[3]
+		}
+		h.addressUTxOs(w, r, parts[1], asset)
+	case len(parts) == 4 && parts[0] == "scripts" && parts[1] == "datum" && parts[3] == "cbor":
+		h.datumCBOR(w, parts[2])
+	case len(parts) == 2 && parts[0] == "scripts":
+		h.script(w, parts[1])
+	case len(parts) == 3 && parts[0] == "scripts" && parts[2] == "cbor":
+		h.scriptCBOR(w, parts[1])
+	case len(parts) == 3 && parts[0] == "txs" && parts[2] == "metadata":
+		h.txMetadata(w, parts[1])
+	default:
+		writeError(w, http.StatusNotFound, "The requested component has not been found.")
+	}
+}
+
+func (h *handler) health(w http.ResponseWriter) {
+	health, err := h.client.GetHealth()
+	healthy := err == nil && health.IsConnected()
+	writeJSON(w, map[string]bool{"is_healthy": healthy})
+}
+
+func (h *handler) addressUTxOs(w http.ResponseWriter, r *http.Request, address string, asset string) {
+	count, page, ok := pagination(r)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "Invalid pagination parameters")
+		return
+	}
+	opts := kupogo.MatchOptions{Unspent: true, Order: kupogo.MatchOrderOldestFirst}
+	if r.URL.Query().Get("order") == "desc" {
+		opts.Order = kupogo.MatchOrderMostRecentFirst
+	}
+	if asset != "" && asset != "lovelace" {
+		if len(asset) < 56 {
+			writeError(w, http.StatusBadRequest, "Invalid asset")
+			return
+		}
+		opts.PolicyID = asset[:56]
+		opts.AssetName = asset[56:]
+	}
+	matches, err := h.client.GetMatchesWithOptions(address, opts)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	utxos := *matches
+	// Kupo orders by slot only, Blockfrost by position within the block too
+	sort.SliceStable(utxos, func(i, j int) bool {
+		a, b := utxos[i], utxos[j]
+		if a.CreatedAt.SlotNo != b.CreatedAt.SlotNo {
+			if opts.Order == kupogo.MatchOrderMostRecentFirst {
+				return a.CreatedAt.SlotNo > b.CreatedAt.SlotNo
+			}
+			return a.CreatedAt.SlotNo < b.CreatedAt.SlotNo
+		}
+		if a.TransactionIndex != b.TransactionIndex {
+			return a.TransactionIndex < b.TransactionIndex
+		}
+		return a.OutputIndex < b.OutputIndex
+	})
+	start := (page - 1) * count
+	if start > len(utxos) {
+		start = len(utxos)
+	}
+	end := start + count
+	if end > len(utxos) {
+		end = len(utxos)
+	}
+	ret := make([]AddressUTxO, 0, end-start)
+	for _, match := range utxos[start:end] {
+		utxo, err := h.addressUTxO(match)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ret = append(ret, utxo)
+	}
+	writeJSON(w, ret)
+}
+
+func (h *handler) addressUTxO(match kupogo.Match) (AddressUTxO, error) {
+	utxo := AddressUTxO{
+		Address:             match.Address,
+		TxHash:              match.TransactionID,
+		TxIndex:             match.OutputIndex,
+		OutputIndex:         match.OutputIndex,
+		Amount:              amounts(match.Value),
+		Block:               match.CreatedAt.HeaderHash,
+		DataHash:            match.DatumHash,
+		ReferenceScriptHash: match.ScriptHash,
+	}
+	if match.DatumHash != nil && match.DatumType != nil && *match.DatumType == "inline" {
+		datum, err := h.client.GetDatumByHash(*match.DatumHash)
+		if err != nil {
+			return utxo, err
+		}
+		if datum != nil {
+			utxo.InlineDatum = &datum.Datum
+		}
+	}
+	return utxo, nil
+}
+
+// amounts converts a value into Blockfrost's amounts, with lovelace first and
+// units being the concatenated policy ID and asset name
+func amounts(value kupogo.Value) []Amount {
+	ret := []Amount{{Unit: "lovelace", Quantity: strconv.Itoa(value.Coins)}}
+	assets := make([]string, 0, len(value.Assets))
+	for asset := range value.Assets {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	for _, asset := range assets {
+		assetID := kupogo.AssetID(asset)
+		ret = append(ret, Amount{
+			Unit:     assetID.PolicyID() + assetID.AssetName(),
+			Quantity: strconv.Itoa(value.Assets[asset]),
+		})
+	}
+	return ret
+}
+
+// scriptType maps a Kupo script language to a Blockfrost script type. Plutus
+// versions follow Blockfrost's naming and unknown languages are passed through
+func scriptType(script *kupogo.ScriptResponse) string {
+	if script.IsNative() {
+		return "timelock"
+	}
+	if version := script.Version(); version > 0 {
+		return "plutusV" + strconv.Itoa(version)
+	}
+	return script.Language
+}
+
+func (h *handler) getScript(w http.ResponseWriter, hash string) *kupogo.ScriptResponse {
+	script, err := h.client.GetScriptByHash(hash)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+	if script == nil {
+		writeError(w, http.StatusNotFound, "The requested component has not been found.")
+		return nil
+	}
+	return script
+}
+
+func (h *handler) script(w http.ResponseWriter, hash string) {
+	script := h.getScript(w, hash)
+	if script == nil {
+		return
+	}
+	ret := Script{
+		ScriptHash: hash,
+		Type:       scriptType(script),
+	}
+	if !script.IsNative() {
+		size := len(script.Script) / 2
+		ret.SerialisedSize = &size
+	}
+	writeJSON(w, ret)
+}
+
+func (h *handler) scriptCBOR(w http.ResponseWriter, hash string) {
+	script := h.getScript(w, hash)
+	if script == nil {
+		return
+	}
+	if script.IsNative() {
+		writeJSON(w, map[string]*string{"cbor": nil})
+		return
+	}
+	writeJSON(w, map[string]string{"cbor": script.Script})
+}
+
+func (h *handler) datumCBOR(w http.ResponseWriter, hash string) {
+	datum, err := h.client.GetDatumByHash(hash)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if datum == nil {
+		writeError(w, http.StatusNotFound, "The requested component has not been found.")
+		return
+	}
+	writeJSON(w, map[string]string{"cbor": datum.Datum})
+}
+
+func (h *handler) txMetadata(w http.ResponseWriter, txHash string) {
+	// Kupo looks up metadata by slot, found from the outputs of the transaction
+	matches, err := h.client.GetMatches(kupogo.MatchTransaction(txHash).String())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if len(*matches) == 0 {
+		writeError(w, http.StatusNotFound, "The requested component has not been found.")
+		return
+	}
+	metadata, err := h.client.GetMetadata((*matches)[0].CreatedAt.SlotNo, txHash)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret := []TxMetadata{}
+	for _, item := range *metadata {
+		labels, err := item.JSON()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		for label, value := range labels {
+			ret = append(ret, TxMetadata{Label: label, JSONMetadata: value})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		a, _ := strconv.Atoi(ret[i].Label)
+		b, _ := strconv.Atoi(ret[j].Label)
+		return a < b
+	})
+	writeJSON(w, ret)
+}
+
+// pagination parses Blockfrost's count and page query parameters
+func pagination(r *http.Request) (int, int, bool) {
+	count, page := defaultCount, 1
+	query := r.URL.Query()
+	if value := query.Get("count"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > maxCount {
+			return 0, 0, false
+		}
+		count = n
+	}
+	if value := query.Get("page"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return 0, 0, false
+		}
+		page = n
+	}
+	return count, page, true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(Error{
+		StatusCode: statusCode,
+		Error:      http.StatusText(statusCode),
+		Message:    message,
+	})
+}
@@ -0,0 +1,137 @@
+package blockfrost
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/kupogotest"
+)
+
+const (
+	testPolicy = "00000000000000000000000000000000000000000000000000000000"
+	testTx     = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+)
+
+func testServer() *httptest.Server {
+	fake := kupogotest.NewFake()
+	datumHash := "dd"
+	inline := "inline"
+	fake.AddMatches(
+		kupogo.Match{
+			TransactionID: testTx,
+			OutputIndex:   0,
+			Address:       "addr1",
+			Value: kupogo.Value{
+				Coins:  1000000,
+				Assets: kupogo.Assets{testPolicy + ".746f6b656e": 2},
+			},
+			DatumHash: &datumHash,
+			DatumType: &inline,
+			CreatedAt: kupogo.Point{SlotNo: 10, HeaderHash: "hh"},
+		},
+		kupogo.Match{
+			TransactionID: testTx,
+			OutputIndex:   1,
+			Address:       "addr1",
+			Value:         kupogo.Value{Coins: 2000000},
+			CreatedAt:     kupogo.Point{SlotNo: 10, HeaderHash: "hh"},
+		},
+	)
+	fake.AddDatum("dd", "d87980")
+	fake.AddScript("ss", "plutus:v2", "4e4d01000033222220051200120011")
+	fake.AddMetadata(10, kupogo.MetadataItem{
+		Hash:   "mm",
+		Schema: json.RawMessage(`{"674":{"map":[{"k":{"string":"msg"},"v":{"list":[{"string":"hi"}]}}]}}`),
+	})
+	return httptest.NewServer(NewHandler(fake))
+}
+
+func get(t *testing.T, url string, v any) int {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	return resp.StatusCode
+}
+
+func TestAddressUTxOs(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+	var utxos []AddressUTxO
+	if status := get(t, server.URL+"/api/v0/addresses/addr1/utxos", &utxos); status != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	if len(utxos) != 2 {
+		t.Fatalf("Expected 2 UTxOs, got %d", len(utxos))
+	}
+	expectedAmount := []Amount{
+		{Unit: "lovelace", Quantity: "1000000"},
+		{Unit: testPolicy + "746f6b656e", Quantity: "2"},
+	}
+	if !reflect.DeepEqual(utxos[0].Amount, expectedAmount) {
+		t.Fatalf("Expected %v, got %v", expectedAmount, utxos[0].Amount)
+	}
+	if utxos[0].InlineDatum == nil || *utxos[0].InlineDatum != "d87980" {
+		t.Fatalf("Expected inline datum, got %v", utxos[0].InlineDatum)
+	}
+	if status := get(t, server.URL+"/addresses/addr1/utxos?count=1&page=2", &utxos); status != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	if len(utxos) != 1 || utxos[0].OutputIndex != 1 {
+		t.Fatalf("Unexpected second page: %v", utxos)
+	}
+	if status := get(t, server.URL+"/addresses/addr1/utxos/"+testPolicy+"746f6b656e", &utxos); status != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	if len(utxos) != 1 || utxos[0].OutputIndex != 0 {
+		t.Fatalf("Unexpected asset UTxOs: %v", utxos)
+	}
+}
+
+func TestScripts(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+	var script Script
+	if status := get(t, server.URL+"/scripts/ss", &script); status != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	if script.Type != "plutusV2" || script.SerialisedSize == nil || *script.SerialisedSize != 15 {
+		t.Fatalf("Unexpected script: %+v", script)
+	}
+	var cbor map[string]string
+	get(t, server.URL+"/scripts/datum/dd/cbor", &cbor)
+	if cbor["cbor"] != "d87980" {
+		t.Fatalf("Unexpected datum: %v", cbor)
+	}
+	var apiErr Error
+	if status := get(t, server.URL+"/scripts/missing", &apiErr); status != http.StatusNotFound {
+		t.Fatalf("Expected status 404, got %d", status)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("Unexpected error body: %+v", apiErr)
+	}
+}
+
+func TestTxMetadata(t *testing.T) {
+	server := testServer()
+	defer server.Close()
+	var metadata []TxMetadata
+	if status := get(t, server.URL+"/txs/"+testTx+"/metadata", &metadata); status != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	expected := []TxMetadata{
+		{Label: "674", JSONMetadata: map[string]any{"msg": []any{"hi"}}},
+	}
+	if !reflect.DeepEqual(metadata, expected) {
+		t.Fatalf("Expected %v, got %v", expected, metadata)
+	}
+}
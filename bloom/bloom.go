@@ -0,0 +1,115 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bloom provides a Bloom filter over output references, answering
+// "might this output be one of mine?" without keeping the full UTxO set
+package bloom
+
+import (
+	"hash/fnv"
+	"math"
+	"strconv"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// Filter is a Bloom filter. It may report false positives but never false
+// negatives. It is not safe for concurrent writes
+type Filter struct {
+	bits   []uint64
+	m      uint64
+	hashes int
+}
+
+// New creates a filter sized for the expected number of entries and the
+// desired false positive rate
+func New(expected int, falsePositiveRate float64) *Filter {
+	if expected < 1 {
+		expected = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.01
+	}
+	m := math.Ceil(
+		-float64(expected) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2),
+	)
+	k := int(math.Round(m / float64(expected) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	words := (uint64(m) + 63) / 64
+	return &Filter{
+		bits:   make([]uint64, words),
+		m:      words * 64,
+		hashes: k,
+	}
+}
+
+// FromMatches builds a filter holding the output references of the matches
+func FromMatches(matches kupogo.Matches, falsePositiveRate float64) *Filter {
+	f := New(len(matches), falsePositiveRate)
+	for _, match := range matches {
+		f.AddOutputReference(match.OutputReference())
+	}
+	return f
+}
+
+// Add adds a key to the filter
+func (f *Filter) Add(key []byte) {
+	h1, h2 := hashKey(key)
+	for i := 0; i < f.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % f.m
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// Test reports whether the key may have been added
+func (f *Filter) Test(key []byte) bool {
+	h1, h2 := hashKey(key)
+	for i := 0; i < f.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// AddOutputReference adds an output reference to the filter
+func (f *Filter) AddOutputReference(ref kupogo.OutputReference) {
+	f.Add(outputReferenceKey(ref))
+}
+
+// TestOutputReference reports whether the output reference may have been
+// added
+func (f *Filter) TestOutputReference(ref kupogo.OutputReference) bool {
+	return f.Test(outputReferenceKey(ref))
+}
+
+func outputReferenceKey(ref kupogo.OutputReference) []byte {
+	key := make([]byte, 0, len(ref.TransactionID)+8)
+	key = append(key, ref.TransactionID...)
+	key = append(key, '#')
+	return strconv.AppendInt(key, int64(ref.OutputIndex), 10)
+}
+
+// hashKey derives the two base hashes used for double hashing
+func hashKey(key []byte) (uint64, uint64) {
+	h := fnv.New64a()
+	_, _ = h.Write(key)
+	h1 := h.Sum64()
+	_, _ = h.Write([]byte{0xff})
+	h2 := h.Sum64() | 1
+	return h1, h2
+}
@@ -0,0 +1,35 @@
+package bloom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestFilter(t *testing.T) {
+	var matches kupogo.Matches
+	for i := 0; i < 1000; i++ {
+		matches = append(
+			matches,
+			kupogo.Match{TransactionID: fmt.Sprintf("%064x", i), OutputIndex: i % 3},
+		)
+	}
+	f := FromMatches(matches, 0.01)
+	for _, match := range matches {
+		if !f.TestOutputReference(match.OutputReference()) {
+			t.Fatalf("Expected %s to be in the filter", match.OutputReference())
+		}
+	}
+	falsePositives := 0
+	for i := 1000; i < 11000; i++ {
+		ref := kupogo.OutputReference{TransactionID: fmt.Sprintf("%064x", i)}
+		if f.TestOutputReference(ref) {
+			falsePositives++
+		}
+	}
+	// Allow some slack over the configured 1% rate
+	if falsePositives > 300 {
+		t.Errorf("Expected a false positive rate near 1%%, got %d/10000", falsePositives)
+	}
+}
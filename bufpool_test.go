@@ -0,0 +1,44 @@
+package kupogo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadBody(t *testing.T) {
+	first, err := readBody(strings.NewReader("first body"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	// The returned data must not share the pooled buffer
+	second, err := readBody(strings.NewReader("other"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if string(first) != "first body" || string(second) != "other" {
+		t.Fatalf("Unexpected bodies %q and %q", first, second)
+	}
+}
+
+func TestPutBufferDropsLargeBuffers(t *testing.T) {
+	buf := bytes.NewBuffer(make([]byte, 0, maxPooledBufferSize+1))
+	putBuffer(buf)
+	for i := 0; i < 10; i++ {
+		if getBuffer() == buf {
+			t.Fatalf("Expected large buffer not to be pooled")
+		}
+	}
+}
+
+func BenchmarkReadBody(b *testing.B) {
+	body := bytes.Repeat([]byte(`{"transaction_id":"aa","value":{"coins":1}},`), 10000)
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := readBody(bytes.NewReader(body)); err != nil {
+			b.Fatalf("Expected no error, got %s", err)
+		}
+	}
+}
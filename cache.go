@@ -0,0 +1,352 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// staleHeader is set on responses served from cache because Kupo was
+// unreachable, holding the time the response was originally received
+const staleHeader = "X-Kupogo-Stale-Since"
+
+// Staleness describes a result served from cache because Kupo was unreachable
+type Staleness struct {
+	URL string
+	// StoredAt is when the result was originally received from Kupo
+	StoredAt time.Time
+	Age      time.Duration
+	// Err is why a fresh result could not be fetched
+	Err error
+}
+
+type staleFallback struct {
+	maxAge  time.Duration
+	onStale func(Staleness)
+}
+
+// cacheEntryPrefix marks cached responses stored with their headers ahead of
+// the body. Values without it are bare bodies
+const cacheEntryPrefix = "kupogo-cache-v1\n"
+
+// cachedHeaders are the response headers stored with cached responses, so
+// that cache hits report the checkpoint the result is consistent with
+var cachedHeaders = []string{"Content-Type", mostRecentCheckpointHeader}
+
+// Cache stores responses keyed by request URL
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte)
+}
+
+// StaleCache is a Cache which retains expired entries so they can be served
+// when Kupo is unreachable
+type StaleCache interface {
+	Cache
+	// GetStale returns a value regardless of expiry, along with when it was
+	// stored
+	GetStale(key string) ([]byte, time.Time, bool)
+}
+
+// defaultStaleRetention is how long NewTTLCache keeps expired entries for
+// stale fallback, long enough to bridge indexer maintenance
+const defaultStaleRetention = 24 * time.Hour
+
+// minTTLCacheSweep is the entry count below which Set does not sweep
+const minTTLCacheSweep = 64
+
+// TTLCache is an in-memory cache whose entries expire after a fixed duration.
+// Expired entries are kept for stale fallback during a retention period, then
+// evicted when looked up or when the cache grows
+type TTLCache struct {
+	ttl       time.Duration
+	retention time.Duration
+	mu        sync.Mutex
+	entries   map[string]ttlCacheEntry
+	sweepAt   int
+	now       func() time.Time
+}
+
+type ttlCacheEntry struct {
+	value     []byte
+	storedAt  time.Time
+	expiresAt time.Time
+}
+
+// NewTTLCache creates an in-memory cache with the given entry lifetime,
+// keeping expired entries for stale fallback for 24 hours
+func NewTTLCache(ttl time.Duration) *TTLCache {
+	return NewTTLCacheWithRetention(ttl, defaultStaleRetention)
+}
+
+// NewTTLCacheWithRetention creates an in-memory cache with the given entry
+// lifetime, keeping expired entries for stale fallback for retention. A
+// retention of 0 evicts entries as soon as they expire
+func NewTTLCacheWithRetention(ttl time.Duration, retention time.Duration) *TTLCache {
+	return &TTLCache{
+		ttl:       ttl,
+		retention: retention,
+		entries:   make(map[string]ttlCacheEntry),
+		sweepAt:   minTTLCacheSweep,
+		now:       time.Now,
+	}
+}
+
+// Get returns the cached value for a key, if present and not expired
+func (c *TTLCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.lookup(key)
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// GetStale returns the cached value for a key even if it has expired, as
+// long as it is within the retention period
+func (c *TTLCache) GetStale(key string) ([]byte, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.lookup(key)
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	return entry.value, entry.storedAt, true
+}
+
+// Set caches a value for the configured TTL. Entries past their retention
+// period are swept whenever the cache has doubled in size since the last
+// sweep
+func (c *TTLCache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	c.entries[key] = ttlCacheEntry{
+		value:     value,
+		storedAt:  now,
+		expiresAt: now.Add(c.ttl),
+	}
+	if len(c.entries) >= c.sweepAt {
+		for key, entry := range c.entries {
+			if c.evictable(entry, now) {
+				delete(c.entries, key)
+			}
+		}
+		c.sweepAt = max(2*len(c.entries), minTTLCacheSweep)
+	}
+}
+
+// lookup returns the entry for a key, evicting it if past its retention
+// period
+func (c *TTLCache) lookup(key string) (ttlCacheEntry, bool) {
+	entry, ok := c.entries[key]
+	if !ok {
+		return entry, false
+	}
+	if c.evictable(entry, c.now()) {
+		delete(c.entries, key)
+		return entry, false
+	}
+	return entry, true
+}
+
+func (c *TTLCache) evictable(entry ttlCacheEntry, now time.Time) bool {
+	return !now.Before(entry.expiresAt.Add(c.retention))
+}
+
+// Purge removes expired entries
+func (c *TTLCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// cacheScopes numbers the cache scopes of cloned clients
+var cacheScopes atomic.Uint64
+
+// newCacheScope returns a cache scope not used by any other client
+func newCacheScope() string {
+	return "scope-" + strconv.FormatUint(cacheScopes.Add(1), 10)
+}
+
+// cacheKey returns the key of a request in the cache. Besides the URL, it
+// covers the Accept header, the headers set with CallHeader and the scope of
+// the client, so that responses are never served to a caller using other
+// credentials. Header values are hashed to keep them out of the cache
+func (c *Client) cacheKey(req *http.Request) string {
+	key := req.URL.String()
+	if accept := req.Header.Get("Accept"); accept != "application/json" {
+		key = accept + " " + key
+	}
+	if opts := callOptionsFrom(req.Context()); opts != nil && len(opts.header) > 0 {
+		names := make([]string, 0, len(opts.header))
+		for name := range opts.header {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		hash := sha256.New()
+		for _, name := range names {
+			for _, value := range opts.header[name] {
+				fmt.Fprintf(hash, "%s: %s\n", name, value)
+			}
+		}
+		key = "headers-" + hex.EncodeToString(hash.Sum(nil)[:16]) + " " + key
+	}
+	if c.cacheScope != "" {
+		key = c.cacheScope + " " + key
+	}
+	return key
+}
+
+// doCached serves a GET request from the cache, or performs it and caches a
+// successful response
+func (c *Client) doCached(req *http.Request) (*http.Response, error) {
+	key := c.cacheKey(req)
+	if value, ok := c.cache.Get(key); ok {
+		return cachedResponse(req, value), nil
+	}
+	resp, err := c.doRequest(req)
+	if err != nil {
+		if stale := c.serveStale(req, key, err); stale != nil {
+			return stale, nil
+		}
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		if stale := c.serveStale(
+			req,
+			key,
+			fmt.Errorf("status code %d", resp.StatusCode),
+		); stale != nil {
+			resp.Body.Close()
+			return stale, nil
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+	body, err := readBody(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Set(key, encodeCacheEntry(resp.Header, body))
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// serveStale returns the last known response for a request which could not be
+// served by Kupo, if stale fallback is enabled and the cache has one recent
+// enough
+func (c *Client) serveStale(req *http.Request, key string, reqErr error) *http.Response {
+	if c.staleFallback == nil {
+		return nil
+	}
+	staleCache, ok := c.cache.(StaleCache)
+	if !ok {
+		return nil
+	}
+	value, storedAt, ok := staleCache.GetStale(key)
+	if !ok {
+		return nil
+	}
+	age := time.Since(storedAt)
+	if c.staleFallback.maxAge > 0 && age > c.staleFallback.maxAge {
+		return nil
+	}
+	if c.staleFallback.onStale != nil {
+		c.staleFallback.onStale(
+			Staleness{
+				URL:      req.URL.String(),
+				StoredAt: storedAt,
+				Age:      age,
+				Err:      reqErr,
+			},
+		)
+	}
+	resp := cachedResponse(req, value)
+	resp.Header.Set(staleHeader, storedAt.UTC().Format(time.RFC3339))
+	return resp
+}
+
+func cachedResponse(req *http.Request, value []byte) *http.Response {
+	header, body := decodeCacheEntry(value)
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", "application/json")
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// encodeCacheEntry returns the cache value for a response, holding the
+// cached headers in MIME format ahead of the body
+func encodeCacheEntry(header http.Header, body []byte) []byte {
+	kept := make(http.Header)
+	for _, name := range cachedHeaders {
+		if value := header.Get(name); value != "" {
+			kept.Set(name, value)
+		}
+	}
+	var buf bytes.Buffer
+	buf.Grow(len(cacheEntryPrefix) + len(body) + 128)
+	buf.WriteString(cacheEntryPrefix)
+	_ = kept.Write(&buf)
+	buf.WriteString("\r\n")
+	buf.Write(body)
+	return buf.Bytes()
+}
+
+// decodeCacheEntry splits a cache value into the stored headers and body
+func decodeCacheEntry(value []byte) (http.Header, []byte) {
+	if !bytes.HasPrefix(value, []byte(cacheEntryPrefix)) {
+		return make(http.Header), value
+	}
+	rest := value[len(cacheEntryPrefix):]
+	src := bytes.NewReader(rest)
+	reader := bufio.NewReader(src)
+	header, err := textproto.NewReader(reader).ReadMIMEHeader()
+	if err != nil {
+		return make(http.Header), value
+	}
+	consumed := len(rest) - src.Len() - reader.Buffered()
+	return http.Header(header), rest[consumed:]
+}
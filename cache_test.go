@@ -0,0 +1,235 @@
+package kupogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_WithCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			_, _ = w.Write([]byte(`[{"transaction_id":"aa","value":{"coins":1}}]`))
+		}),
+	)
+	defer server.Close()
+
+	cache := NewTTLCache(time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	client := NewClient(server.URL, WithCache(cache))
+	for i := 0; i < 3; i++ {
+		matches, err := client.GetMatches("addr1")
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if len(*matches) != 1 || (*matches)[0].TransactionID != "aa" {
+			t.Fatalf("Unexpected matches: %v", *matches)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+	if _, err := client.GetMatches("addr2"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+	now = now.Add(2 * time.Minute)
+	if _, err := client.GetMatches("addr1"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if requests != 3 {
+		t.Errorf("Expected expired entry to be refetched, got %d requests", requests)
+	}
+}
+
+func TestClient_WithStaleFallback(t *testing.T) {
+	down := false
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if down {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`[{"transaction_id":"aa","value":{"coins":1}}]`))
+		}),
+	)
+	defer server.Close()
+
+	var stale []Staleness
+	client := NewClient(
+		server.URL,
+		WithCache(NewTTLCache(0)),
+		WithStaleFallback(0, func(s Staleness) {
+			stale = append(stale, s)
+		}),
+	)
+	if _, err := client.GetMatches("addr1"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	down = true
+	matches, err := client.GetMatches("addr1")
+	if err != nil {
+		t.Fatalf("Expected stale result, got %s", err)
+	}
+	if len(*matches) != 1 || (*matches)[0].TransactionID != "aa" {
+		t.Errorf("Unexpected matches: %v", *matches)
+	}
+	if len(stale) != 1 || stale[0].Err == nil {
+		t.Errorf("Expected staleness to be reported, got %v", stale)
+	}
+	if _, err := client.GetMatches("addr2"); err == nil {
+		t.Errorf("Expected error for uncached result")
+	}
+	server.Close()
+	if _, err := client.GetMatches("addr1"); err != nil {
+		t.Fatalf("Expected stale result for unreachable server, got %s", err)
+	}
+	if len(stale) != 2 {
+		t.Errorf("Expected staleness to be reported, got %v", stale)
+	}
+}
+
+func TestClient_WithCacheCheckpoint(t *testing.T) {
+	checkpoint := 100
+	failing := false
+	// Large enough for the body to outgrow the header reader's buffer
+	body := `[` + strings.Repeat(`{"transaction_id":"aa","value":{"coins":1}},`, 200) +
+		`{"transaction_id":"aa","value":{"coins":1}}]`
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if failing {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Header().Set(mostRecentCheckpointHeader, strconv.Itoa(checkpoint))
+			_, _ = w.Write([]byte(body))
+		}),
+	)
+	defer server.Close()
+
+	cache := NewTTLCache(time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	client := NewClient(server.URL, WithCache(cache), WithStaleFallback(0, nil))
+	for i := 0; i < 2; i++ {
+		matches, slotNo, err := client.getMatches(context.Background(), "addr1", MatchOptions{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if slotNo != 100 || len(*matches) != 201 {
+			t.Fatalf("Expected 201 matches at checkpoint 100, got %d at %d", len(*matches), slotNo)
+		}
+		checkpoint = 200
+	}
+	now = now.Add(2 * time.Minute)
+	failing = true
+	_, slotNo, err := client.getMatches(context.Background(), "addr1", MatchOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if slotNo != 100 {
+		t.Fatalf("Expected the stale response at checkpoint 100, got %d", slotNo)
+	}
+}
+
+func TestTTLCacheEviction(t *testing.T) {
+	cache := NewTTLCacheWithRetention(time.Minute, time.Hour)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	cache.Set("a", []byte("a"))
+	now = now.Add(30 * time.Minute)
+	if _, ok := cache.Get("a"); ok {
+		t.Fatalf("Expected an expired entry")
+	}
+	if _, _, ok := cache.GetStale("a"); !ok {
+		t.Fatalf("Expected the expired entry to be retained")
+	}
+	now = now.Add(time.Hour)
+	if _, _, ok := cache.GetStale("a"); ok {
+		t.Fatalf("Expected the entry to be evicted after its retention")
+	}
+	if len(cache.entries) != 0 {
+		t.Fatalf("Expected the evicted entry to be removed, got %d entries", len(cache.entries))
+	}
+
+	// Entries which are never looked up again are swept as the cache grows
+	for i := 0; i < 1000; i++ {
+		cache.Set(strconv.Itoa(i), []byte("x"))
+		now = now.Add(time.Minute)
+	}
+	if len(cache.entries) > 2*61+minTTLCacheSweep {
+		t.Fatalf("Expected entries past their retention to be swept, got %d entries", len(cache.entries))
+	}
+}
+
+func TestClient_WithCacheIdentity(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant := r.Header.Get("Authorization")
+			requests[tenant]++
+			_, _ = w.Write([]byte(`[{"transaction_id":"` + strings.TrimPrefix(tenant, "Bearer ") + `","value":{"coins":1}}]`))
+		}),
+	)
+	defer server.Close()
+
+	parent := NewClient(server.URL, WithCache(NewTTLCache(time.Minute)))
+	tenantA := parent.Clone(WithHeader("Authorization", "Bearer aa"))
+	tenantB := parent.Clone(WithHeader("Authorization", "Bearer bb"))
+	for _, test := range []struct {
+		client *Client
+		ctx    context.Context
+		txID   string
+	}{
+		{client: tenantA, ctx: context.Background(), txID: "aa"},
+		{client: tenantB, ctx: context.Background(), txID: "bb"},
+		{client: tenantA, ctx: context.Background(), txID: "aa"},
+		{
+			client: parent,
+			ctx:    WithCallOptions(context.Background(), CallHeader("Authorization", "Bearer cc")),
+			txID:   "cc",
+		},
+		{
+			client: parent,
+			ctx:    WithCallOptions(context.Background(), CallHeader("Authorization", "Bearer dd")),
+			txID:   "dd",
+		},
+		{
+			client: parent,
+			ctx:    WithCallOptions(context.Background(), CallHeader("Authorization", "Bearer cc")),
+			txID:   "cc",
+		},
+	} {
+		matches, err := test.client.GetMatchesContext(test.ctx, "addr1")
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if len(*matches) != 1 || (*matches)[0].TransactionID != test.txID {
+			t.Fatalf("Expected the response for %s, got %v", test.txID, *matches)
+		}
+	}
+	for _, tenant := range []string{"Bearer aa", "Bearer bb", "Bearer cc", "Bearer dd"} {
+		if requests[tenant] != 1 {
+			t.Errorf("Expected 1 request for %s, got %d", tenant, requests[tenant])
+		}
+	}
+	// A clone which does not change what is sent shares the cached entries
+	if _, err := parent.Clone(WithTimeout(time.Minute)).GetMatchesContext(
+		WithCallOptions(context.Background(), CallHeader("Authorization", "Bearer cc")),
+		"addr1",
+	); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if requests["Bearer cc"] != 1 {
+		t.Errorf("Expected the cached response to be shared, got %d requests", requests["Bearer cc"])
+	}
+}
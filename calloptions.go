@@ -0,0 +1,151 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// CallOption overrides the client configuration for the calls made with a
+// context returned by WithCallOptions
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	timeout      time.Duration
+	header       http.Header
+	noCache      bool
+	noRetry      bool
+	capture      *ResponseInfo
+	progress     func(Progress)
+	actor        string
+	dryRun       func(ChangePlan)
+	filter       func(Match) bool
+	lazyMetadata bool
+}
+
+type callOptionsKey struct{}
+
+// WithCallOptions returns a context applying the given options to every
+// request made with it, on top of options already set on ctx. It lets a
+// single client serve both latency sensitive and tolerant batch calls, such
+// as with GetMatchesContext(WithCallOptions(ctx, CallTimeout(time.Second)), ...)
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	ret := &callOptions{}
+	if existing := callOptionsFrom(ctx); existing != nil {
+		*ret = *existing
+		ret.header = existing.header.Clone()
+	}
+	for _, opt := range opts {
+		opt(ret)
+	}
+	return context.WithValue(ctx, callOptionsKey{}, ret)
+}
+
+func callOptionsFrom(ctx context.Context) *callOptions {
+	ret, _ := ctx.Value(callOptionsKey{}).(*callOptions)
+	return ret
+}
+
+// CallTimeout limits the time of each request, including reading the
+// response body
+func CallTimeout(timeout time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.timeout = timeout
+	}
+}
+
+// CallHeader sets a header on each request
+func CallHeader(key string, value string) CallOption {
+	return func(o *callOptions) {
+		if o.header == nil {
+			o.header = make(http.Header)
+		}
+		o.header.Set(key, value)
+	}
+}
+
+// NoCache bypasses the response cache configured with WithCache, neither
+// reading nor storing responses
+func NoCache() CallOption {
+	return func(o *callOptions) {
+		o.noCache = true
+	}
+}
+
+// NoRetry makes a single attempt for each request, without failing over to
+// other instances configured with WithDiscovery
+func NoRetry() CallOption {
+	return func(o *callOptions) {
+		o.noRetry = true
+	}
+}
+
+// LazyMetadata makes GetMetadata leave MetadataItem.Raw unset, deferring the
+// hex decoding of payloads to RawBytes. It spares allocations when ingesting
+// metadata in bulk for its schema only. Payloads are still checked to be
+// valid hex
+func LazyMetadata() CallOption {
+	return func(o *callOptions) {
+		o.lazyMetadata = true
+	}
+}
+
+func noCache(req *http.Request) bool {
+	opts := callOptionsFrom(req.Context())
+	return opts != nil && opts.noCache
+}
+
+func lazyMetadata(req *http.Request) bool {
+	opts := callOptionsFrom(req.Context())
+	return opts != nil && opts.lazyMetadata
+}
+
+func noRetry(req *http.Request) bool {
+	opts := callOptionsFrom(req.Context())
+	return opts != nil && opts.noRetry
+}
+
+// applyCallOptions applies the call options of the request context, returning
+// the request to send and a function to call once its response is done with
+func applyCallOptions(req *http.Request) (*http.Request, context.CancelFunc) {
+	opts := callOptionsFrom(req.Context())
+	if opts == nil {
+		return req, func() {}
+	}
+	for key, values := range opts.header {
+		req.Header[key] = values
+	}
+	if opts.timeout <= 0 {
+		return req, func() {}
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), opts.timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// cancelBody cancels the context of a request once its response body is
+// closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
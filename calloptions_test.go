@@ -0,0 +1,91 @@
+package kupogo
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithCallOptions(t *testing.T) {
+	var calls int
+	var priority string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/matches/slow" {
+			time.Sleep(100 * time.Millisecond)
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		calls++
+		priority = r.Header.Get("X-Priority")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, WithCache(NewLRUCache(10, 0)))
+
+	ctx := WithCallOptions(context.Background(), CallHeader("X-Priority", "interactive"))
+	if _, err := client.GetMatchesContext(ctx, "*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if priority != "interactive" {
+		t.Fatalf("Expected the call header, got %q", priority)
+	}
+	if _, err := client.GetMatchesContext(ctx, "*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if calls != 1 {
+		t.Fatalf("Expected a cached response, got %d calls", calls)
+	}
+	if _, err := client.GetMatchesContext(WithCallOptions(ctx, NoCache()), "*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected the cache to be bypassed, got %d calls", calls)
+	}
+
+	ctx = WithCallOptions(ctx, CallTimeout(10*time.Millisecond))
+	if _, err := client.GetMatchesContext(ctx, "slow"); err == nil {
+		t.Fatalf("Expected the call to time out")
+	}
+	if _, err := client.GetMatchesContext(context.Background(), "slow"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+}
+
+func TestLazyMetadata(t *testing.T) {
+	const rawHex = "a11902a2a1636d736781781c4d696e737761703a205377617020457861637420496e204f72646572"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw := rawHex
+		if r.URL.Path == "/metadata/2" {
+			raw = "zz"
+		}
+		_, _ = w.Write([]byte(`[{"hash":"aa","raw":"` + raw + `","schema":{}}]`))
+	}))
+	defer server.Close()
+
+	client := &Client{KupoUrl: server.URL}
+	ctx := WithCallOptions(context.Background(), LazyMetadata())
+	metadataList, err := client.GetMetadataContext(ctx, 1, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	item := (*metadataList)[0]
+	if item.Raw != nil {
+		t.Fatalf("Expected raw data to be left undecoded")
+	}
+	rawData, err := item.RawBytes()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if hex.EncodeToString(rawData) != rawHex || item.RawHex() != rawHex {
+		t.Fatalf("Unexpected raw data %x", rawData)
+	}
+	if _, err := client.GetMetadataContext(ctx, 2, ""); err == nil {
+		t.Fatalf("Expected an error for invalid hex in lazy mode")
+	}
+	if _, err := client.GetMetadata(2, ""); err == nil {
+		t.Fatalf("Expected an error for invalid hex")
+	}
+}
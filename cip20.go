@@ -0,0 +1,133 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+)
+
+// TransactionMessage is the CIP-20 message of a transaction
+type TransactionMessage struct {
+	TransactionID string
+	SlotNo        int
+	Message       []string
+}
+
+// Message returns the lines of the CIP-20 message under label 674, or nil if
+// there is none. A message given as a single string rather than a list is
+// accepted
+func (m MetadataItem) Message() ([]string, error) {
+	value, ok, err := m.Label(MetadataLabelMessage)
+	if err != nil || !ok {
+		return nil, err
+	}
+	fields, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid CIP-20 metadata: expected map")
+	}
+	switch msg := fields["msg"].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{msg}, nil
+	case []any:
+		ret := make([]string, 0, len(msg))
+		for _, line := range msg {
+			lineString, ok := line.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid CIP-20 message line: %v", line)
+			}
+			ret = append(ret, lineString)
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("invalid CIP-20 message: %v", msg)
+	}
+}
+
+// Message returns the CIP-20 message lines across all metadata items
+func (m Metadata) Message() ([]string, error) {
+	var ret []string
+	for _, item := range m {
+		lines, err := item.Message()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, lines...)
+	}
+	return ret, nil
+}
+
+// GetTransactionMessage returns the CIP-20 message of the transaction at
+// slotNo, or nil if it has none
+func (c *Client) GetTransactionMessage(slotNo int, txId string) ([]string, error) {
+	return c.GetTransactionMessageContext(context.Background(), slotNo, txId)
+}
+
+// GetTransactionMessageContext is like GetTransactionMessage with a request
+// context
+func (c *Client) GetTransactionMessageContext(
+	ctx context.Context,
+	slotNo int,
+	txId string,
+) ([]string, error) {
+	metadata, err := c.GetMetadataContext(ctx, slotNo, txId)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Message()
+}
+
+// GetMessagesRange returns the CIP-20 messages of transactions which created
+// outputs matching pattern between fromSlot and toSlot, as described for
+// GetMetadataRange
+func (c *Client) GetMessagesRange(
+	pattern string,
+	fromSlot int,
+	toSlot int,
+	concurrency int,
+) ([]TransactionMessage, error) {
+	return c.GetMessagesRangeContext(context.Background(), pattern, fromSlot, toSlot, concurrency)
+}
+
+// GetMessagesRangeContext is like GetMessagesRange with a request context
+func (c *Client) GetMessagesRangeContext(
+	ctx context.Context,
+	pattern string,
+	fromSlot int,
+	toSlot int,
+	concurrency int,
+) ([]TransactionMessage, error) {
+	txs, err := c.GetMetadataRangeContext(ctx, pattern, fromSlot, toSlot, concurrency)
+	if err != nil {
+		return nil, err
+	}
+	var ret []TransactionMessage
+	for _, tx := range txs {
+		lines, err := tx.Metadata.Message()
+		if err != nil {
+			return nil, fmt.Errorf("transaction %s: %s", tx.TransactionID, err)
+		}
+		if len(lines) > 0 {
+			ret = append(ret, TransactionMessage{
+				TransactionID: tx.TransactionID,
+				SlotNo:        tx.SlotNo,
+				Message:       lines,
+			})
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,56 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestMetadataMessage(t *testing.T) {
+	testDefs := []struct {
+		schema   string
+		expected []string
+		err      bool
+	}{
+		{`{"674": {"map": [{"k": {"string": "msg"}, "v": {"list": [{"string": "Invoice 42"}, {"string": "Thanks"}]}}]}}`, []string{"Invoice 42", "Thanks"}, false},
+		{`{"674": {"map": [{"k": {"string": "msg"}, "v": {"string": "single"}}]}}`, []string{"single"}, false},
+		{`{"721": {"map": []}}`, nil, false},
+		{`{"674": {"map": [{"k": {"string": "msg"}, "v": {"list": [{"int": 1}]}}]}}`, nil, true},
+		{`{"674": {"int": 1}}`, nil, true},
+	}
+	for _, testDef := range testDefs {
+		lines, err := MetadataItem{Schema: json.RawMessage(testDef.schema)}.Message()
+		if testDef.err {
+			if err == nil {
+				t.Fatalf("Expected error for %s", testDef.schema)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if !reflect.DeepEqual(lines, testDef.expected) {
+			t.Fatalf("Expected %v, got %v", testDef.expected, lines)
+		}
+	}
+}
+
+func TestGetTransactionMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metadata/10" || r.URL.Query().Get("transaction_id") != "aa" {
+			t.Errorf("Unexpected request: %s", r.URL)
+		}
+		fmt.Fprint(w, `[{"hash":"mh","raw":"a0","schema":{"674":{"map":[{"k":{"string":"msg"},"v":{"list":[{"string":"memo"}]}}]}}}]`)
+	}))
+	defer server.Close()
+	lines, err := NewClient(server.URL).GetTransactionMessage(10, "aa")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(lines, []string{"memo"}) {
+		t.Fatalf("Unexpected message: %v", lines)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CIP25Metadata is decoded CIP-25 NFT metadata from label 721
+type CIP25Metadata struct {
+	// Version is 1 or 2. In version 2 policy IDs and asset names are given as
+	// bytes rather than text
+	Version int
+	// Assets maps hex encoded policy IDs to hex encoded asset names to NFTs
+	Assets map[string]map[string]CIP25NFT
+}
+
+// CIP25NFT is the metadata of a single NFT
+type CIP25NFT struct {
+	Name        string
+	Image       string
+	MediaType   string
+	Description string
+	Files       []CIP25File
+	// Properties holds any other fields as plain JSON values
+	Properties map[string]any
+}
+
+// CIP25File is an entry in the files of an NFT
+type CIP25File struct {
+	Name      string
+	MediaType string
+	Src       string
+}
+
+// Asset returns the metadata of an NFT
+func (m CIP25Metadata) Asset(assetID AssetID) (CIP25NFT, bool) {
+	nft, ok := m.Assets[assetID.PolicyID()][assetID.AssetName()]
+	return nft, ok
+}
+
+// CIP25 decodes the CIP-25 metadata under label 721, returning nil if there
+// is none
+func (m MetadataItem) CIP25() (*CIP25Metadata, error) {
+	value, ok, err := m.Label(MetadataLabelNFT)
+	if err != nil || !ok {
+		return nil, err
+	}
+	policies, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid CIP-25 metadata: expected map")
+	}
+	ret := &CIP25Metadata{
+		Version: 1,
+		Assets:  make(map[string]map[string]CIP25NFT),
+	}
+	if version, ok := policies["version"]; ok {
+		switch fmt.Sprint(version) {
+		case "1", "1.0":
+		case "2", "2.0":
+			ret.Version = 2
+		default:
+			return nil, fmt.Errorf("unsupported CIP-25 version: %v", version)
+		}
+	}
+	for policyKey, assetsValue := range policies {
+		if policyKey == "version" {
+			continue
+		}
+		policyID, err := cip25Key(policyKey, ret.Version, false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIP-25 policy ID %q: %s", policyKey, err)
+		}
+		assets, ok := assetsValue.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid CIP-25 assets of policy %s", policyID)
+		}
+		nfts := make(map[string]CIP25NFT, len(assets))
+		for assetKey, nftValue := range assets {
+			assetName, err := cip25Key(assetKey, ret.Version, true)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIP-25 asset name %q: %s", assetKey, err)
+			}
+			fields, ok := nftValue.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid CIP-25 metadata of asset %s", assetName)
+			}
+			nfts[assetName] = decodeCIP25NFT(fields)
+		}
+		ret.Assets[policyID] = nfts
+	}
+	return ret, nil
+}
+
+// cip25Key returns a policy ID or asset name key as hex. Bytes keys are
+// converted by DetailedSchemaToJSON to hex with a 0x prefix. Text asset names
+// are UTF-8
+func cip25Key(key string, version int, assetName bool) (string, error) {
+	if strings.HasPrefix(key, "0x") {
+		return key[2:], nil
+	}
+	if version == 2 {
+		return "", fmt.Errorf("expected bytes in version 2")
+	}
+	if assetName {
+		return hex.EncodeToString([]byte(key)), nil
+	}
+	if _, err := hex.DecodeString(key); err != nil {
+		return "", err
+	}
+	return strings.ToLower(key), nil
+}
+
+func decodeCIP25NFT(fields map[string]any) CIP25NFT {
+	ret := CIP25NFT{
+		Name:        cip25String(fields["name"]),
+		Image:       cip25String(fields["image"]),
+		MediaType:   cip25String(fields["mediaType"]),
+		Description: cip25String(fields["description"]),
+	}
+	if files, ok := fields["files"].([]any); ok {
+		for _, fileValue := range files {
+			file, ok := fileValue.(map[string]any)
+			if !ok {
+				continue
+			}
+			ret.Files = append(ret.Files, CIP25File{
+				Name:      cip25String(file["name"]),
+				MediaType: cip25String(file["mediaType"]),
+				Src:       cip25String(file["src"]),
+			})
+		}
+	}
+	for key, value := range fields {
+		switch key {
+		case "name", "image", "mediaType", "description", "files":
+			continue
+		}
+		if ret.Properties == nil {
+			ret.Properties = make(map[string]any)
+		}
+		ret.Properties[key] = value
+	}
+	return ret
+}
+
+// cip25String joins strings which CIP-25 allows to be split into a list to
+// fit the 64 byte metadata string limit
+func cip25String(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []any:
+		var sb strings.Builder
+		for _, part := range v {
+			if s, ok := part.(string); ok {
+				sb.WriteString(s)
+			}
+		}
+		return sb.String()
+	case json.Number:
+		return v.String()
+	default:
+		return ""
+	}
+}
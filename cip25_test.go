@@ -0,0 +1,65 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMetadataCIP25(t *testing.T) {
+	policyID := "1c4b7e0e0b8c2b8b4d1a9e2b0e6a5b1f8e3c2d1a0b9c8d7e6f5a4b3c"
+	item := MetadataItem{Schema: json.RawMessage(`{"721": {"map": [
+		{"k": {"string": "` + policyID + `"}, "v": {"map": [
+			{"k": {"string": "Token1"}, "v": {"map": [
+				{"k": {"string": "name"}, "v": {"string": "Token #1"}},
+				{"k": {"string": "image"}, "v": {"list": [{"string": "ipfs://Qm"}, {"string": "abc"}]}},
+				{"k": {"string": "mediaType"}, "v": {"string": "image/png"}},
+				{"k": {"string": "files"}, "v": {"list": [{"map": [
+					{"k": {"string": "name"}, "v": {"string": "hi-res"}},
+					{"k": {"string": "mediaType"}, "v": {"string": "image/png"}},
+					{"k": {"string": "src"}, "v": {"string": "ipfs://Qmhires"}}
+				]}]}},
+				{"k": {"string": "rarity"}, "v": {"string": "rare"}}
+			]}}
+		]}}
+	]}}`)}
+	metadata, err := item.CIP25()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	nft, ok := metadata.Asset(NewAssetID(policyID, "546f6b656e31"))
+	if !ok {
+		t.Fatalf("Expected asset in %+v", metadata)
+	}
+	expected := CIP25NFT{
+		Name:      "Token #1",
+		Image:     "ipfs://Qmabc",
+		MediaType: "image/png",
+		Files:     []CIP25File{{Name: "hi-res", MediaType: "image/png", Src: "ipfs://Qmhires"}},
+		Properties: map[string]any{
+			"rarity": "rare",
+		},
+	}
+	if metadata.Version != 1 || !reflect.DeepEqual(nft, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, nft)
+	}
+}
+
+func TestMetadataCIP25Version2(t *testing.T) {
+	item := MetadataItem{Schema: json.RawMessage(`{"721": {"map": [
+		{"k": {"bytes": "aabb"}, "v": {"map": [
+			{"k": {"bytes": "0102"}, "v": {"map": [{"k": {"string": "name"}, "v": {"string": "Two"}}]}}
+		]}},
+		{"k": {"string": "version"}, "v": {"int": 2}}
+	]}}`)}
+	metadata, err := item.CIP25()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if nft, ok := metadata.Asset(NewAssetID("aabb", "0102")); metadata.Version != 2 || !ok || nft.Name != "Two" {
+		t.Fatalf("Unexpected metadata: %+v", metadata)
+	}
+	if metadata, err := (MetadataItem{Schema: json.RawMessage(`{"674": {"int": 1}}`)}).CIP25(); err != nil || metadata != nil {
+		t.Fatalf("Expected no metadata, got %v, %v", metadata, err)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+)
+
+// CIP67Label is an asset name label as defined by CIP-67
+type CIP67Label uint16
+
+const (
+	CIP67LabelReferenceNFT CIP67Label = 100
+	CIP67LabelNFT          CIP67Label = 222
+	CIP67LabelFT           CIP67Label = 333
+	CIP67LabelRFT          CIP67Label = 444
+)
+
+// cip67PrefixLength is the length of a hex encoded CIP-67 label prefix
+const cip67PrefixLength = 8
+
+// ParseCIP67Label detects a CIP-67 label prefix on a hex encoded asset name. It
+// returns the label and the remaining hex encoded asset name, or false if the
+// asset name does not carry a valid label
+func ParseCIP67Label(assetName string) (CIP67Label, string, bool) {
+	if len(assetName) < cip67PrefixLength {
+		return 0, "", false
+	}
+	prefix, err := hex.DecodeString(assetName[:cip67PrefixLength])
+	if err != nil {
+		return 0, "", false
+	}
+	// The prefix is laid out as [0000 | 16 bits label | 8 bits checksum | 0000]
+	if prefix[0]&0xf0 != 0 || prefix[3]&0x0f != 0 {
+		return 0, "", false
+	}
+	labelBytes := []byte{
+		prefix[0]<<4 | prefix[1]>>4,
+		prefix[1]<<4 | prefix[2]>>4,
+	}
+	checksum := prefix[2]<<4 | prefix[3]>>4
+	if crc8(labelBytes) != checksum {
+		return 0, "", false
+	}
+	label := CIP67Label(uint16(labelBytes[0])<<8 | uint16(labelBytes[1]))
+	return label, assetName[cip67PrefixLength:], true
+}
+
+func (l CIP67Label) String() string {
+	switch l {
+	case CIP67LabelReferenceNFT:
+		return "reference NFT"
+	case CIP67LabelNFT:
+		return "NFT"
+	case CIP67LabelFT:
+		return "FT"
+	case CIP67LabelRFT:
+		return "RFT"
+	default:
+		return fmt.Sprintf("label %d", uint16(l))
+	}
+}
+
+// Prefix returns the hex encoded asset name prefix for the label
+func (l CIP67Label) Prefix() string {
+	labelBytes := []byte{byte(l >> 8), byte(l)}
+	checksum := crc8(labelBytes)
+	prefix := []byte{
+		labelBytes[0] >> 4,
+		labelBytes[0]<<4 | labelBytes[1]>>4,
+		labelBytes[1]<<4 | checksum>>4,
+		checksum << 4,
+	}
+	return hex.EncodeToString(prefix)
+}
+
+// AssetName prefixes a hex encoded asset name with the label
+func (l CIP67Label) AssetName(name string) string {
+	return l.Prefix() + name
+}
+
+// IsReference returns whether the label is that of CIP-68 reference tokens,
+// which hold the datum describing the matching user tokens
+func (l CIP67Label) IsReference() bool {
+	return l == CIP67LabelReferenceNFT
+}
+
+// IsUser returns whether the label is that of a CIP-68 user token
+func (l CIP67Label) IsUser() bool {
+	switch l {
+	case CIP67LabelNFT, CIP67LabelFT, CIP67LabelRFT:
+		return true
+	default:
+		return false
+	}
+}
+
+// CIP67Label returns the CIP-67 label of the asset name, or false if it does
+// not carry one
+func (a AssetID) CIP67Label() (CIP67Label, bool) {
+	label, _, ok := ParseCIP67Label(a.AssetName())
+	return label, ok
+}
+
+// WithCIP67Label returns the asset of the same policy with its label replaced,
+// such as the reference token of a CIP-68 user token. It returns false if the
+// asset name does not carry a label
+func (a AssetID) WithCIP67Label(label CIP67Label) (AssetID, bool) {
+	_, name, ok := ParseCIP67Label(a.AssetName())
+	if !ok {
+		return "", false
+	}
+	return NewAssetID(a.PolicyID(), label.AssetName(name)), true
+}
+
+// CIP67Assets returns the assets of the value carrying a CIP-67 label,
+// grouped by label
+func (v Value) CIP67Assets() map[CIP67Label][]AssetID {
+	ret := make(map[CIP67Label][]AssetID)
+	for asset := range v.Assets {
+		assetID := AssetID(asset)
+		if label, ok := assetID.CIP67Label(); ok {
+			ret[label] = append(ret[label], assetID)
+		}
+	}
+	for _, assets := range ret {
+		sort.Slice(assets, func(i, j int) bool {
+			return assets[i] < assets[j]
+		})
+	}
+	return ret
+}
+
+// crc8 computes the CRC-8 checksum (polynomial 0x07) used by CIP-67
+func crc8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
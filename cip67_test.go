@@ -0,0 +1,72 @@
+package kupogo
+
+import "testing"
+
+func TestParseCIP67Label(t *testing.T) {
+	testDefs := []struct {
+		assetName string
+		label     CIP67Label
+		name      string
+		valid     bool
+	}{
+		{assetName: "000643b04d794e4654", label: CIP67LabelReferenceNFT, name: "4d794e4654", valid: true},
+		{assetName: "000de1404d794e4654", label: CIP67LabelNFT, name: "4d794e4654", valid: true},
+		{assetName: "0014df10", label: CIP67LabelFT, name: "", valid: true},
+		{assetName: "001bc2804d79524654", label: CIP67LabelRFT, name: "4d79524654", valid: true},
+		// Bad checksum
+		{assetName: "000de1504d794e4654"},
+		// Not a label at all
+		{assetName: "4d794e4654"},
+		{assetName: ""},
+	}
+	for _, testDef := range testDefs {
+		label, name, ok := ParseCIP67Label(testDef.assetName)
+		if ok != testDef.valid {
+			t.Errorf("Expected valid=%v for %q, got %v", testDef.valid, testDef.assetName, ok)
+			continue
+		}
+		if label != testDef.label || name != testDef.name {
+			t.Errorf(
+				"Expected label %d and name %q for %q, got %d and %q",
+				testDef.label,
+				testDef.name,
+				testDef.assetName,
+				label,
+				name,
+			)
+		}
+	}
+}
+
+func TestCIP67LabelAssetName(t *testing.T) {
+	for _, label := range []CIP67Label{CIP67LabelReferenceNFT, CIP67LabelNFT, CIP67LabelFT, CIP67LabelRFT, 1} {
+		parsed, name, ok := ParseCIP67Label(label.AssetName("4d79"))
+		if !ok || parsed != label || name != "4d79" {
+			t.Errorf("Expected label %d to round trip, got %d %q %v", label, parsed, name, ok)
+		}
+	}
+	if prefix := CIP67LabelNFT.Prefix(); prefix != "000de140" {
+		t.Fatalf("Expected prefix 000de140, got %s", prefix)
+	}
+}
+
+func TestAssetIDCIP67(t *testing.T) {
+	policyID := "aabb"
+	user := NewAssetID(policyID, "000de1404d794e4654")
+	reference, ok := user.WithCIP67Label(CIP67LabelReferenceNFT)
+	if !ok || reference != NewAssetID(policyID, "000643b04d794e4654") {
+		t.Fatalf("Unexpected reference token: %s", reference)
+	}
+	if label, ok := reference.CIP67Label(); !ok || !label.IsReference() || label.IsUser() {
+		t.Fatalf("Expected reference label, got %d", label)
+	}
+	plain := NewAssetID(policyID, "4d794e4654")
+	if _, ok := plain.WithCIP67Label(CIP67LabelReferenceNFT); ok {
+		t.Fatalf("Expected no label on %s", plain)
+	}
+	value := Value{Assets: Assets{string(user): 1, string(reference): 1, string(plain): 1}}
+	assets := value.CIP67Assets()
+	if len(assets) != 2 || assets[CIP67LabelNFT][0] != user || assets[CIP67LabelReferenceNFT][0] != reference {
+		t.Fatalf("Unexpected classification: %v", assets)
+	}
+}
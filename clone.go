@@ -0,0 +1,90 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+// Clone returns a client derived from this one with the given options applied
+// on top of its own, for example to use another timeout or set headers for a
+// tenant. The derived client shares the connections, caches and middleware of
+// this one unless the options replace the HTTP client or transport, in which
+// case they are created anew with the middleware of both. Stats are counted
+// separately for each client. A derived client with added middleware, such as
+// WithHeader credentials, or another HTTP client keeps its own entries in the
+// shared response cache, as Kupo may answer it differently
+func (c *Client) Clone(opts ...ClientOption) *Client {
+	ret := &Client{
+		KupoUrl:        c.KupoUrl,
+		httpClient:     c.baseHTTPClient,
+		transport:      c.transport,
+		slowRequests:   c.slowRequests,
+		requestIDs:     c.requestIDs,
+		logger:         c.logger,
+		logLevels:      c.logLevels,
+		cache:          c.cache,
+		cacheScope:     c.cacheScope,
+		contentCache:   c.contentCache,
+		staleFallback:  c.staleFallback,
+		lenient:        c.lenient,
+		network:        c.network,
+		datumDecoders:  c.datumDecoders,
+		versionCheck:   c.versionCheck,
+		redirectPolicy: c.redirectPolicy,
+		timeout:        c.timeout,
+		jsonNumbers:    c.jsonNumbers,
+		auditSink:      c.auditSink,
+		confirm:        c.confirm,
+	}
+	// Memoized datums and scripts are shared
+	ret.contentMemo.once.Do(func() {
+		ret.contentMemo.cache = c.contentMemo.get()
+	})
+	// Copied so that appending does not modify this client's middleware
+	ret.middleware = append([]Middleware(nil), c.middleware...)
+	for _, opt := range opts {
+		opt(ret)
+	}
+	if len(ret.middleware) > len(c.middleware) ||
+		ret.httpClient != c.baseHTTPClient ||
+		ret.transport != c.transport {
+		ret.cacheScope = newCacheScope()
+	}
+	ret.baseHTTPClient = ret.httpClient
+	if ret.httpClient != c.baseHTTPClient || ret.transport != c.transport {
+		ret.applyTransport()
+		ret.applyMiddleware(ret.middleware)
+	} else {
+		// Keep the connections of this client, only wrapping its transport
+		// with the added middleware
+		ret.httpClient = c.httpClient
+		ret.applyMiddleware(ret.middleware[len(c.middleware):])
+	}
+	ret.applyRedirectPolicy()
+	ret.applyTimeout()
+	return ret
+}
+
+// applyTimeout replaces the HTTP client with a copy using the configured
+// timeout
+func (c *Client) applyTimeout() {
+	if c.timeout <= 0 {
+		return
+	}
+	base := c.httpClient
+	if base == nil {
+		base = defaultHTTPClient
+	}
+	httpClient := *base
+	httpClient.Timeout = c.timeout
+	c.httpClient = &httpClient
+}
@@ -0,0 +1,50 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClientClone(t *testing.T) {
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	parent := NewClient(server.URL, WithHeader("X-Tenant", "parent"))
+	child := parent.Clone(WithHeader("X-Priority", "batch"), WithTimeout(time.Second))
+	if _, err := child.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if headers.Get("X-Tenant") != "parent" || headers.Get("X-Priority") != "batch" {
+		t.Fatalf("Expected headers of both clients, got %v", headers)
+	}
+	if child.httpClient.Timeout != time.Second || parent.httpClient.Timeout != 5*time.Minute {
+		t.Fatalf("Expected only the derived client to use the new timeout")
+	}
+	if child.contentMemo.get() != parent.contentMemo.get() {
+		t.Fatalf("Expected memoized content to be shared")
+	}
+	if len(parent.Stats()) != 0 || child.Stats()["matches"].Calls != 1 {
+		t.Fatalf("Expected stats to be counted separately")
+	}
+
+	if _, err := parent.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if headers.Get("X-Priority") != "" {
+		t.Fatalf("Expected the parent not to get the derived client's headers")
+	}
+
+	child = parent.Clone(WithTransport(TransportConfig{MaxIdleConnsPerHost: 2}))
+	if _, err := child.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if headers.Get("X-Tenant") != "parent" {
+		t.Fatalf("Expected the parent's middleware on the new transport")
+	}
+}
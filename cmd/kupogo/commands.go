@@ -0,0 +1,296 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func init() {
+	commands["matches"] = command{
+		usage:       "matches [flags] <pattern>",
+		description: "list outputs matching a pattern",
+		run:         runMatches,
+	}
+	commands["patterns"] = command{
+		usage:       "patterns [-redundant] [pattern]",
+		description: "list registered patterns, or those subsumed by another",
+		run:         runPatterns,
+	}
+	commands["datum"] = command{
+		usage:       "datum <hash>",
+		description: "get a datum by hash",
+		run:         runDatum,
+	}
+	commands["script"] = command{
+		usage:       "script <hash>",
+		description: "get a script by hash",
+		run:         runScript,
+	}
+	commands["metadata"] = command{
+		usage:       "metadata [-tx <id>] <slot>",
+		description: "get transaction metadata in a block",
+		run:         runMetadata,
+	}
+	commands["health"] = command{
+		usage:       "health [-ready] [-max-lag <slots>]",
+		description: "show Kupo's health, or check readiness for probes",
+		run:         runHealth,
+	}
+	commands["checkpoints"] = command{
+		usage:       "checkpoints [slot]",
+		description: "list checkpoints, or find the one at or before a slot",
+		run:         runCheckpoints,
+	}
+}
+
+func runMatches(env *environment, args []string) error {
+	fs := flag.NewFlagSet("matches", flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	var opts kupogo.MatchOptions
+	fs.BoolVar(&opts.Spent, "spent", false, "only spent outputs")
+	fs.BoolVar(&opts.Unspent, "unspent", false, "only unspent outputs")
+	fs.IntVar(&opts.CreatedAfter, "created-after", 0, "only outputs created after this slot")
+	fs.IntVar(&opts.CreatedBefore, "created-before", 0, "only outputs created before this slot")
+	fs.StringVar(&opts.PolicyID, "policy-id", "", "only outputs holding assets of this policy")
+	fs.StringVar(&opts.AssetName, "asset-name", "", "only outputs holding this asset name (requires -policy-id)")
+	fs.StringVar(&opts.TransactionID, "tx", "", "only outputs of this transaction")
+	order := fs.String("order", "", "most_recent_first or oldest_first")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return errUsage
+	}
+	opts.Order = kupogo.MatchOrder(*order)
+	matches, err := env.client.GetMatchesWithOptions(fs.Arg(0), opts)
+	if err != nil {
+		return err
+	}
+	if env.output == "table" {
+		rows := make([][]string, 0, len(*matches))
+		for _, match := range *matches {
+			spentAt := ""
+			if match.SpentAt != nil {
+				spentAt = strconv.Itoa(match.SpentAt.SlotNo)
+			}
+			rows = append(rows, []string{
+				match.OutputReference().String(),
+				match.Address,
+				strconv.Itoa(match.Value.Coins),
+				strconv.Itoa(len(match.Value.Assets)),
+				strconv.Itoa(match.CreatedAt.SlotNo),
+				spentAt,
+			})
+		}
+		return writeTable(
+			env.stdout,
+			[]string{"OUTPUT", "ADDRESS", "COINS", "ASSETS", "CREATED", "SPENT"},
+			rows,
+		)
+	}
+	return writeJSON(env.stdout, matches)
+}
+
+func runPatterns(env *environment, args []string) error {
+	fs := flag.NewFlagSet("patterns", flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	redundant := fs.Bool("redundant", false, "only list patterns subsumed by another pattern")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	var patterns *kupogo.Patterns
+	var err error
+	switch fs.NArg() {
+	case 0:
+		patterns, err = env.client.GetAllPatterns()
+	case 1:
+		patterns, err = env.client.GetPattern(fs.Arg(0))
+	default:
+		return errUsage
+	}
+	if err != nil {
+		return err
+	}
+	if *redundant {
+		return writeRedundantPatterns(env, patterns.Redundant())
+	}
+	if env.output == "table" {
+		rows := make([][]string, 0, len(*patterns))
+		for _, pattern := range *patterns {
+			rows = append(rows, []string{string(pattern)})
+		}
+		return writeTable(env.stdout, []string{"PATTERN"}, rows)
+	}
+	return writeJSON(env.stdout, patterns)
+}
+
+func writeRedundantPatterns(env *environment, overlaps []kupogo.PatternOverlap) error {
+	if env.output == "table" {
+		rows := make([][]string, 0, len(overlaps))
+		for _, overlap := range overlaps {
+			rows = append(rows, []string{string(overlap.Pattern), string(overlap.SubsumedBy)})
+		}
+		return writeTable(env.stdout, []string{"PATTERN", "SUBSUMED BY"}, rows)
+	}
+	type redundantPattern struct {
+		Pattern    kupogo.Pattern `json:"pattern"`
+		SubsumedBy kupogo.Pattern `json:"subsumed_by"`
+	}
+	ret := make([]redundantPattern, 0, len(overlaps))
+	for _, overlap := range overlaps {
+		ret = append(ret, redundantPattern(overlap))
+	}
+	return writeJSON(env.stdout, ret)
+}
+
+func runDatum(env *environment, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	datum, err := env.client.GetDatumByHash(args[0])
+	if err != nil {
+		return err
+	}
+	if datum == nil {
+		return fmt.Errorf("datum not found: %s", args[0])
+	}
+	if env.output == "table" {
+		return writeTable(env.stdout, []string{"DATUM"}, [][]string{{datum.Datum}})
+	}
+	return writeJSON(env.stdout, datum)
+}
+
+func runScript(env *environment, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	script, err := env.client.GetScriptByHash(args[0])
+	if err != nil {
+		return err
+	}
+	if script == nil {
+		return fmt.Errorf("script not found: %s", args[0])
+	}
+	if env.output == "table" {
+		return writeTable(
+			env.stdout,
+			[]string{"LANGUAGE", "SCRIPT"},
+			[][]string{{script.Language, script.Script}},
+		)
+	}
+	return writeJSON(env.stdout, script)
+}
+
+func runMetadata(env *environment, args []string) error {
+	fs := flag.NewFlagSet("metadata", flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	txID := fs.String("tx", "", "only metadata of this transaction")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return errUsage
+	}
+	slotNo, err := strconv.Atoi(fs.Arg(0))
+	if err != nil {
+		return errUsage
+	}
+	metadata, err := env.client.GetMetadata(slotNo, *txID)
+	if err != nil {
+		return err
+	}
+	if env.output == "table" {
+		rows := make([][]string, 0, len(*metadata))
+		for _, item := range *metadata {
+			rows = append(rows, []string{item.Hash, item.RawHex()})
+		}
+		return writeTable(env.stdout, []string{"HASH", "RAW"}, rows)
+	}
+	return writeJSON(env.stdout, metadata)
+}
+
+func runHealth(env *environment, args []string) error {
+	fs := flag.NewFlagSet("health", flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	ready := fs.Bool("ready", false, "exit with an error unless Kupo is ready")
+	maxLag := fs.Int("max-lag", 0, "maximum slots behind the node tip when checking readiness")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return errUsage
+	}
+	if *ready {
+		if err := env.client.CheckReady(env.ctx, *maxLag); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintln(env.stdout, "ok")
+		return err
+	}
+	health, err := env.client.GetHealth()
+	if err != nil {
+		return err
+	}
+	if env.output == "table" {
+		optional := func(v *int) string {
+			if v == nil {
+				return ""
+			}
+			return strconv.Itoa(*v)
+		}
+		return writeTable(
+			env.stdout,
+			[]string{"CONNECTION", "CHECKPOINT", "NODE TIP", "VERSION"},
+			[][]string{{
+				health.ConnectionStatus,
+				optional(health.MostRecentCheckpoint),
+				optional(health.MostRecentNodeTip),
+				health.Version,
+			}},
+		)
+	}
+	return writeJSON(env.stdout, health)
+}
+
+func runCheckpoints(env *environment, args []string) error {
+	var checkpoints kupogo.Checkpoints
+	switch len(args) {
+	case 0:
+		all, err := env.client.GetCheckpoints()
+		if err != nil {
+			return err
+		}
+		checkpoints = *all
+	case 1:
+		slotNo, err := strconv.Atoi(args[0])
+		if err != nil {
+			return errUsage
+		}
+		point, err := env.client.GetCheckpointBySlot(slotNo, false)
+		if err != nil {
+			return err
+		}
+		if point == nil {
+			return fmt.Errorf("no checkpoint at or before slot %d", slotNo)
+		}
+		checkpoints = kupogo.Checkpoints{*point}
+	default:
+		return errUsage
+	}
+	if env.output == "table" {
+		rows := make([][]string, 0, len(checkpoints))
+		for _, point := range checkpoints {
+			rows = append(rows, []string{strconv.Itoa(point.SlotNo), point.HeaderHash})
+		}
+		return writeTable(env.stdout, []string{"SLOT", "HEADER HASH"}, rows)
+	}
+	return writeJSON(env.stdout, checkpoints)
+}
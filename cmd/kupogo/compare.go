@@ -0,0 +1,88 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func init() {
+	commands["compare"] = command{
+		usage:       "compare <other-url> [pattern...]",
+		description: "report divergences with another Kupo instance",
+		run:         runCompare,
+	}
+}
+
+var errDiverged = errors.New("instances diverge")
+
+func runCompare(env *environment, args []string) error {
+	if len(args) < 1 {
+		return errUsage
+	}
+	other := kupogo.NewClient(strings.TrimSuffix(args[0], "/"))
+	opts := kupogo.ConsistencyOptions{}
+	if len(args) > 1 {
+		opts.Patterns = args[1:]
+	}
+	report, err := kupogo.CompareInstances(env.ctx, env.client, other, opts)
+	if err != nil {
+		return err
+	}
+	if env.output == "table" {
+		err = writeTable(env.stdout, []string{"KIND", "SUBJECT", "THIS", "OTHER"}, compareRows(report))
+	} else {
+		err = writeJSON(env.stdout, report)
+	}
+	if err != nil {
+		return err
+	}
+	if !report.Consistent() {
+		return errDiverged
+	}
+	return nil
+}
+
+func compareRows(report *kupogo.ConsistencyReport) [][]string {
+	var rows [][]string
+	for _, pattern := range report.PatternsOnlyA {
+		rows = append(rows, []string{"pattern", string(pattern), "registered", ""})
+	}
+	for _, pattern := range report.PatternsOnlyB {
+		rows = append(rows, []string{"pattern", string(pattern), "", "registered"})
+	}
+	for _, mismatch := range report.Checkpoints {
+		rows = append(rows, []string{"checkpoint", strconv.Itoa(mismatch.SlotNo), mismatch.A, mismatch.B})
+	}
+	describe := func(match *kupogo.Match) string {
+		if match == nil {
+			return "missing"
+		}
+		spent := "unspent"
+		if match.SpentAt != nil {
+			spent = fmt.Sprintf("spent at %d", match.SpentAt.SlotNo)
+		}
+		return fmt.Sprintf("%d lovelace, %d assets, %s", match.Value.Coins, len(match.Value.Assets), spent)
+	}
+	for _, divergence := range report.Matches {
+		rows = append(rows, []string{"match", divergence.OutputReference.String(), describe(divergence.A), describe(divergence.B)})
+	}
+	return rows
+}
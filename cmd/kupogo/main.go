@@ -0,0 +1,122 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command kupogo is a command line client for Kupo
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+const defaultKupoUrl = "http://localhost:1442"
+
+// command is a kupogo subcommand
+type command struct {
+	usage       string
+	description string
+	run         func(env *environment, args []string) error
+}
+
+// environment holds the global options shared by all commands
+type environment struct {
+	ctx    context.Context
+	client *kupogo.Client
+	output string
+	stdout io.Writer
+	stderr io.Writer
+}
+
+var commands = map[string]command{}
+
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := run(ctx, os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "ERROR:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) error {
+	fs := flag.NewFlagSet("kupogo", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	kupoUrl := os.Getenv("KUPO_URL")
+	if kupoUrl == "" {
+		kupoUrl = defaultKupoUrl
+	}
+	fs.StringVar(&kupoUrl, "url", kupoUrl, "Kupo URL (also set with KUPO_URL)")
+	output := fs.String("output", "json", "output format: json or table")
+	fs.Usage = func() {
+		usage(fs, stderr)
+	}
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if *output != "json" && *output != "table" {
+		fmt.Fprintf(stderr, "unknown output format: %s\n", *output)
+		return errUsage
+	}
+	if fs.NArg() == 0 {
+		usage(fs, stderr)
+		return errUsage
+	}
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command: %s\n", fs.Arg(0))
+		usage(fs, stderr)
+		return errUsage
+	}
+	env := &environment{
+		ctx:    ctx,
+		client: kupogo.NewClient(strings.TrimSuffix(kupoUrl, "/")),
+		output: *output,
+		stdout: stdout,
+		stderr: stderr,
+	}
+	err := cmd.run(env, fs.Args()[1:])
+	if errors.Is(err, errUsage) {
+		fmt.Fprintf(stderr, "usage: kupogo %s\n", cmd.usage)
+	}
+	return err
+}
+
+func usage(fs *flag.FlagSet, w io.Writer) {
+	fmt.Fprintln(w, "usage: kupogo [flags] <command> [args]")
+	fmt.Fprintln(w, "\nflags:")
+	fs.PrintDefaults()
+	fmt.Fprintln(w, "\ncommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-40s %s\n", commands[name].usage, commands[name].description)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/matches/addr1":
+				if r.URL.RawQuery != "unspent" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_, _ = w.Write([]byte(`[{"transaction_id":"aa","output_index":1,"address":"addr1","value":{"coins":5},"created_at":{"slot_no":10}}]`))
+			case "/checkpoints":
+				_, _ = w.Write([]byte(`[{"slot_no":20,"header_hash":"bb"}]`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer server.Close()
+
+	var stdout, stderr bytes.Buffer
+	err := run(
+		context.Background(),
+		[]string{"-url", server.URL, "-output", "table", "matches", "-unspent", "addr1"},
+		&stdout,
+		&stderr,
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s (%s)", err, stderr.String())
+	}
+	expected := "OUTPUT  ADDRESS  COINS  ASSETS  CREATED  SPENT\n1@aa    addr1    5      0       10       \n"
+	if stdout.String() != expected {
+		t.Errorf("Expected:\n%q\ngot:\n%q", expected, stdout.String())
+	}
+
+	stdout.Reset()
+	if err := run(context.Background(), []string{"-url", server.URL, "checkpoints"}, &stdout, &stderr); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !strings.Contains(stdout.String(), `"header_hash": "bb"`) {
+		t.Errorf("Unexpected JSON output: %s", stdout.String())
+	}
+
+	if err := run(context.Background(), []string{"datum"}, &stdout, &stderr); !errors.Is(err, errUsage) {
+		t.Errorf("Expected usage error, got %v", err)
+	}
+}
+
+func TestRunHealthReady(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"connection_status":"connected","most_recent_checkpoint":90,"most_recent_node_tip":100}`))
+		}),
+	)
+	defer server.Close()
+
+	var stdout, stderr bytes.Buffer
+	if err := run(context.Background(), []string{"-url", server.URL, "health", "-ready", "-max-lag", "10"}, &stdout, &stderr); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if stdout.String() != "ok\n" {
+		t.Errorf("Unexpected output: %q", stdout.String())
+	}
+	if err := run(context.Background(), []string{"-url", server.URL, "health", "-ready", "-max-lag", "5"}, &stdout, &stderr); err == nil {
+		t.Errorf("Expected error when lagging behind")
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/blinklabs-io/kupogo/kupoproxy"
+)
+
+func init() {
+	commands["proxy"] = command{
+		usage:       "proxy [-listen :1443] [-rate 0] [-burst 10] [-allow-writes]",
+		description: "serve a caching, rate limited proxy in front of Kupo",
+		run:         runProxy,
+	}
+}
+
+func runProxy(env *environment, args []string) error {
+	fs := flag.NewFlagSet("proxy", flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	listen := fs.String("listen", ":1443", "address to listen on")
+	rate := fs.Float64("rate", 0, "requests per second per client, 0 for unlimited")
+	burst := fs.Int("burst", 10, "request burst per client")
+	allowWrites := fs.Bool("allow-writes", false, "allow requests modifying patterns")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return errUsage
+	}
+	config := kupoproxy.Config{
+		Upstream:    env.client.KupoUrl,
+		RateLimit:   *rate,
+		Burst:       *burst,
+		AllowWrites: *allowWrites,
+	}
+	// Tokens are given as comma separated name=token pairs
+	if value := os.Getenv("KUPOGO_PROXY_TOKENS"); value != "" {
+		tokens := make(map[string]string)
+		for _, pair := range strings.Split(value, ",") {
+			name, token, ok := strings.Cut(pair, "=")
+			if !ok || token == "" {
+				return fmt.Errorf("invalid KUPOGO_PROXY_TOKENS entry, expected name=token")
+			}
+			tokens[token] = name
+		}
+		config.Authorize = kupoproxy.BearerTokens(tokens)
+	}
+	proxy, err := kupoproxy.New(config)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Addr:              *listen,
+		Handler:           proxy,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-env.ctx.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(ctx)
+	}()
+	fmt.Fprintf(env.stderr, "proxying %s on %s\n", env.client.KupoUrl, *listen)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func init() {
+	commands["watch"] = command{
+		usage:       "watch [-interval 10s] [-jsonl] <pattern>",
+		description: "tail created and spent outputs of a pattern",
+		run:         runWatch,
+	}
+}
+
+// watchEvent is the JSON lines representation of a watch event
+type watchEvent struct {
+	Type  string       `json:"type"`
+	Match kupogo.Match `json:"match"`
+}
+
+func runWatch(env *environment, args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	interval := fs.Duration("interval", 10*time.Second, "poll interval")
+	jsonLines := fs.Bool("jsonl", false, "write events as JSON lines")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return errUsage
+	}
+	var outputErr error
+	watcher := kupogo.NewWatcher(
+		env.client,
+		kupogo.WatcherConfig{
+			Pattern:  fs.Arg(0),
+			Interval: *interval,
+			OnEvent: func(event kupogo.WatchEvent) {
+				if *jsonLines {
+					outputErr = writeJSONLine(
+						env.stdout,
+						watchEvent{Type: event.Type.String(), Match: event.Match},
+					)
+					return
+				}
+				_, outputErr = fmt.Fprintf(
+					env.stdout,
+					"%-7s %s %s %d lovelace, %d assets (slot %d)\n",
+					event.Type,
+					event.Match.OutputReference(),
+					event.Match.Address,
+					event.Match.Value.Coins,
+					len(event.Match.Value.Assets),
+					event.Match.CreatedAt.SlotNo,
+				)
+			},
+			OnError: func(err error) {
+				fmt.Fprintln(env.stderr, "ERROR:", err)
+			},
+		},
+	)
+	err := watcher.Run(env.ctx)
+	if outputErr != nil {
+		return outputErr
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return nil
+	}
+	return err
+}
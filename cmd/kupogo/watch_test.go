@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestRunWatch(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[{"transaction_id":"aa","address":"addr1","value":{"coins":5}}]`))
+		}),
+	)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	env := &environment{
+		ctx:    ctx,
+		client: kupogo.NewClient(server.URL),
+		stdout: &stdout,
+		stderr: &stderr,
+	}
+	if err := runWatch(env, []string{"-interval", "10ms", "-jsonl", "addr1"}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := `{"type":"created","match":{"transaction_index":0,"transaction_id":"aa","output_index":0,"address":"addr1","value":{"coins":5,"assets":{}},"datum_hash":null,"datum_type":null,"script_hash":null,"created_at":{"slot_no":0,"header_hash":""},"spent_at":null}}` + "\n"
+	if stdout.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, stdout.String())
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package coinselect implements coin selection over Kupo matches
+package coinselect
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// Result holds the outcome of a coin selection
+type Result struct {
+	Inputs kupogo.Matches
+	// Change is the value selected in excess of the target
+	Change kupogo.Value
+}
+
+// Total returns the combined value of the selected inputs
+func (r *Result) Total() kupogo.Value {
+	var ret kupogo.Value
+	for _, input := range r.Inputs {
+		ret = ret.Add(input.Value)
+	}
+	return ret
+}
+
+// selection tracks the state of an ongoing coin selection
+type selection struct {
+	available kupogo.Matches
+	selected  kupogo.Matches
+	total     kupogo.Value
+}
+
+func newSelection(utxos kupogo.Matches) *selection {
+	s := &selection{}
+	for _, utxo := range utxos {
+		if utxo.SpentAt != nil {
+			continue
+		}
+		s.available = append(s.available, utxo)
+	}
+	return s
+}
+
+func (s *selection) take(idx int) {
+	utxo := s.available[idx]
+	s.available = append(s.available[:idx], s.available[idx+1:]...)
+	s.selected = append(s.selected, utxo)
+	s.total = s.total.Add(utxo.Value)
+}
+
+func (s *selection) result(target kupogo.Value) *Result {
+	return &Result{
+		Inputs: s.selected,
+		Change: s.total.Sub(target),
+	}
+}
+
+// component returns the quantity of an asset in a value, where an empty asset
+// ID refers to lovelace
+func component(value kupogo.Value, asset string) int {
+	if asset == "" {
+		return value.Coins
+	}
+	return value.Assets[asset]
+}
+
+// components lists the assets of the target in a stable order, followed by
+// lovelace
+func components(target kupogo.Value) []string {
+	ret := make([]string, 0, len(target.Assets)+1)
+	for asset := range target.Assets {
+		ret = append(ret, asset)
+	}
+	sort.Strings(ret)
+	return append(ret, "")
+}
+
+// LargestFirst selects inputs covering the target by repeatedly picking the
+// available UTxO holding the largest quantity of each outstanding asset, and
+// then of lovelace
+func LargestFirst(utxos kupogo.Matches, target kupogo.Value) (*Result, error) {
+	s := newSelection(utxos)
+	for _, asset := range components(target) {
+		for component(s.total, asset) < component(target, asset) {
+			best := -1
+			for idx, utxo := range s.available {
+				quantity := component(utxo.Value, asset)
+				if quantity <= 0 {
+					continue
+				}
+				if best < 0 || quantity > component(s.available[best].Value, asset) {
+					best = idx
+				}
+			}
+			if best < 0 {
+				return nil, ErrInsufficientFunds
+			}
+			s.take(best)
+		}
+	}
+	return s.result(target), nil
+}
+
+// RandomImprove implements the random-improve algorithm described in CIP-2.
+// Inputs are first picked at random until each asset of the target is
+// covered, then further random inputs are added where they bring the selected
+// quantity closer to twice the target without exceeding three times it. A nil
+// rnd uses a time-seeded source
+func RandomImprove(
+	utxos kupogo.Matches,
+	target kupogo.Value,
+	rnd *rand.Rand,
+) (*Result, error) {
+	if rnd == nil {
+		// #nosec G404 -- coin selection does not need a secure source
+		rnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	s := newSelection(utxos)
+	for _, asset := range components(target) {
+		want := component(target, asset)
+		if want <= 0 {
+			continue
+		}
+		// Random selection
+		for component(s.total, asset) < want {
+			candidates := s.candidates(asset)
+			if len(candidates) == 0 {
+				return nil, ErrInsufficientFunds
+			}
+			s.take(candidates[rnd.Intn(len(candidates))])
+		}
+		// Improvement
+		for {
+			candidates := s.candidates(asset)
+			if len(candidates) == 0 {
+				break
+			}
+			idx := candidates[rnd.Intn(len(candidates))]
+			current := component(s.total, asset)
+			next := current + component(s.available[idx].Value, asset)
+			if next > 3*want || distance(next, 2*want) >= distance(current, 2*want) {
+				break
+			}
+			s.take(idx)
+		}
+	}
+	return s.result(target), nil
+}
+
+// candidates returns the indexes of available UTxOs holding the asset
+func (s *selection) candidates(asset string) []int {
+	var ret []int
+	for idx, utxo := range s.available {
+		if component(utxo.Value, asset) > 0 {
+			ret = append(ret, idx)
+		}
+	}
+	return ret
+}
+
+func distance(a int, b int) int {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,75 @@
+package coinselect
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+var testUTxOs = kupogo.Matches{
+	{TransactionID: "aa", Value: kupogo.Value{Coins: 1000000}},
+	{TransactionID: "bb", Value: kupogo.Value{Coins: 5000000}},
+	{
+		TransactionID: "cc",
+		Value: kupogo.Value{
+			Coins:  1500000,
+			Assets: kupogo.Assets{"policy.token": 100},
+		},
+	},
+	{TransactionID: "dd", Value: kupogo.Value{Coins: 3000000}},
+	{
+		TransactionID: "ee",
+		Value:         kupogo.Value{Coins: 90000000},
+		SpentAt:       &kupogo.Point{SlotNo: 1},
+	},
+}
+
+func TestLargestFirst(t *testing.T) {
+	target := kupogo.Value{
+		Coins:  6000000,
+		Assets: kupogo.Assets{"policy.token": 10},
+	}
+	result, err := LargestFirst(testUTxOs, target)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(result.Inputs) != 2 ||
+		result.Inputs[0].TransactionID != "cc" ||
+		result.Inputs[1].TransactionID != "bb" {
+		t.Errorf("Unexpected inputs selected: %v", result.Inputs)
+	}
+	if result.Change.Coins != 500000 || result.Change.Assets["policy.token"] != 90 {
+		t.Errorf("Unexpected change: %v", result.Change)
+	}
+	if _, err := LargestFirst(testUTxOs, kupogo.Value{Coins: 20000000}); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("Expected insufficient funds, got %v", err)
+	}
+}
+
+func TestRandomImprove(t *testing.T) {
+	target := kupogo.Value{Coins: 2000000}
+	for seed := int64(0); seed < 20; seed++ {
+		result, err := RandomImprove(testUTxOs, target, rand.New(rand.NewSource(seed)))
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		total := result.Total()
+		if !total.Covers(target) {
+			t.Fatalf("Selection %v does not cover target", result.Inputs)
+		}
+		if !total.Sub(target).Covers(result.Change) || !result.Change.Covers(total.Sub(target)) {
+			t.Errorf("Change %v does not match selection", result.Change)
+		}
+		for _, input := range result.Inputs {
+			if input.SpentAt != nil {
+				t.Errorf("Spent output %s selected", input.TransactionID)
+			}
+		}
+	}
+	_, err := RandomImprove(testUTxOs, kupogo.Value{Assets: kupogo.Assets{"policy.other": 1}}, nil)
+	if !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("Expected insufficient funds, got %v", err)
+	}
+}
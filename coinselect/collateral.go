@@ -0,0 +1,92 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coinselect
+
+import (
+	"sort"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// CollateralOptions controls which UTxOs are considered suitable collateral
+type CollateralOptions struct {
+	// Target is the amount of lovelace the collateral must cover
+	Target int
+	// MinCoins and MaxCoins bound the lovelace of each candidate. A zero
+	// MaxCoins means no upper bound
+	MinCoins int
+	MaxCoins int
+	// MaxInputs limits the number of collateral inputs, defaulting to the
+	// ledger limit of 3
+	MaxInputs int
+}
+
+const defaultMaxCollateralInputs = 3
+
+// SelectCollateral picks pure-ADA UTxOs without a datum or reference script to
+// use as collateral. Candidates closest to the target are preferred so large
+// UTxOs are not tied up as collateral
+func SelectCollateral(
+	utxos kupogo.Matches,
+	opts CollateralOptions,
+) (kupogo.Matches, error) {
+	maxInputs := opts.MaxInputs
+	if maxInputs <= 0 {
+		maxInputs = defaultMaxCollateralInputs
+	}
+	var candidates kupogo.Matches
+	for _, utxo := range utxos {
+		if !isCollateralCandidate(utxo, opts) {
+			continue
+		}
+		candidates = append(candidates, utxo)
+	}
+	// A single UTxO covering the target is best, taking the smallest one
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Value.Coins < candidates[j].Value.Coins
+	})
+	for _, candidate := range candidates {
+		if candidate.Value.Coins >= opts.Target {
+			return kupogo.Matches{candidate}, nil
+		}
+	}
+	// Otherwise combine the largest candidates
+	var ret kupogo.Matches
+	total := 0
+	for idx := len(candidates) - 1; idx >= 0 && len(ret) < maxInputs; idx-- {
+		ret = append(ret, candidates[idx])
+		total += candidates[idx].Value.Coins
+		if total >= opts.Target {
+			return ret, nil
+		}
+	}
+	return nil, ErrInsufficientFunds
+}
+
+func isCollateralCandidate(utxo kupogo.Match, opts CollateralOptions) bool {
+	if utxo.SpentAt != nil || len(utxo.Value.Assets) > 0 {
+		return false
+	}
+	if utxo.DatumHash != nil || utxo.ScriptHash != nil {
+		return false
+	}
+	if utxo.Value.Coins < opts.MinCoins {
+		return false
+	}
+	if opts.MaxCoins > 0 && utxo.Value.Coins > opts.MaxCoins {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+package coinselect
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestSelectCollateral(t *testing.T) {
+	datumHash := "d87980"
+	utxos := kupogo.Matches{
+		{TransactionID: "aa", Value: kupogo.Value{Coins: 2000000}},
+		{TransactionID: "bb", Value: kupogo.Value{Coins: 8000000}},
+		{TransactionID: "cc", Value: kupogo.Value{Coins: 5500000}},
+		{TransactionID: "dd", Value: kupogo.Value{Coins: 5000000, Assets: kupogo.Assets{"policy": 1}}},
+		{TransactionID: "ee", Value: kupogo.Value{Coins: 5000000}, DatumHash: &datumHash},
+		{TransactionID: "ff", Value: kupogo.Value{Coins: 100000000}},
+	}
+	opts := CollateralOptions{Target: 5000000, MaxCoins: 50000000}
+	collateral, err := SelectCollateral(utxos, opts)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(collateral) != 1 || collateral[0].TransactionID != "cc" {
+		t.Errorf("Expected smallest sufficient UTxO, got %v", collateral)
+	}
+
+	opts.Target = 10000000
+	collateral, err = SelectCollateral(utxos, opts)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(collateral) != 2 || collateral[0].TransactionID != "bb" || collateral[1].TransactionID != "cc" {
+		t.Errorf("Expected combined collateral, got %v", collateral)
+	}
+
+	opts.Target = 20000000
+	if _, err := SelectCollateral(utxos, opts); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("Expected insufficient funds, got %v", err)
+	}
+}
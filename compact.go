@@ -0,0 +1,209 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+// MatchSet is a read-only, indexed collection of matches, implemented by both
+// Matches and CompactMatches
+type MatchSet interface {
+	Len() int
+	At(i int) Match
+}
+
+// Len returns the number of matches
+func (m Matches) Len() int {
+	return len(m)
+}
+
+// At returns the match at index i
+func (m Matches) At(i int) Match {
+	return m[i]
+}
+
+// FilterMatches returns the matches in the set for which keep returns true
+func FilterMatches(set MatchSet, keep func(Match) bool) Matches {
+	var ret Matches
+	for i := 0; i < set.Len(); i++ {
+		match := set.At(i)
+		if keep(match) {
+			ret = append(ret, match)
+		}
+	}
+	return ret
+}
+
+// noString marks an absent optional string in CompactMatches
+const noString = ^uint32(0)
+
+// CompactMatches stores matches column by column, with strings such as
+// addresses, transaction IDs and asset IDs interned in a shared table. It uses
+// far less memory than Matches for large UTxO sets
+type CompactMatches struct {
+	strings     []string
+	stringIndex map[string]uint32
+
+	transactionIDs     []uint32
+	transactionIndexes []uint32
+	outputIndexes      []uint32
+	addresses          []uint32
+	coins              []int64
+	// Assets of match i are assetIDs/assetQuantities[assetOffsets[i]:assetOffsets[i+1]]
+	assetOffsets    []uint32
+	assetIDs        []uint32
+	assetQuantities []int64
+	datumHashes     []uint32
+	datumTypes      []uint32
+	scriptHashes    []uint32
+	createdSlots    []int64
+	createdHashes   []uint32
+	// A spent slot of -1 means unspent
+	spentSlots  []int64
+	spentHashes []uint32
+}
+
+// NewCompactMatches creates an empty compact match store
+func NewCompactMatches() *CompactMatches {
+	return &CompactMatches{
+		stringIndex:  make(map[string]uint32),
+		assetOffsets: []uint32{0},
+	}
+}
+
+// CompactMatchesFrom copies matches into a compact store
+func CompactMatchesFrom(matches Matches) *CompactMatches {
+	c := NewCompactMatches()
+	for _, match := range matches {
+		c.Append(match)
+	}
+	return c
+}
+
+func (c *CompactMatches) intern(s string) uint32 {
+	if idx, ok := c.stringIndex[s]; ok {
+		return idx
+	}
+	idx := uint32(len(c.strings))
+	c.strings = append(c.strings, s)
+	c.stringIndex[s] = idx
+	return idx
+}
+
+func (c *CompactMatches) internOptional(s *string) uint32 {
+	if s == nil {
+		return noString
+	}
+	return c.intern(*s)
+}
+
+func (c *CompactMatches) optional(idx uint32) *string {
+	if idx == noString {
+		return nil
+	}
+	s := c.strings[idx]
+	return &s
+}
+
+// Append adds a match to the store
+func (c *CompactMatches) Append(match Match) {
+	c.transactionIDs = append(c.transactionIDs, c.intern(match.TransactionID))
+	c.transactionIndexes = append(c.transactionIndexes, uint32(match.TransactionIndex))
+	c.outputIndexes = append(c.outputIndexes, uint32(match.OutputIndex))
+	c.addresses = append(c.addresses, c.intern(match.Address))
+	c.coins = append(c.coins, int64(match.Value.Coins))
+	for asset, quantity := range match.Value.Assets {
+		c.assetIDs = append(c.assetIDs, c.intern(asset))
+		c.assetQuantities = append(c.assetQuantities, int64(quantity))
+	}
+	c.assetOffsets = append(c.assetOffsets, uint32(len(c.assetIDs)))
+	c.datumHashes = append(c.datumHashes, c.internOptional(match.DatumHash))
+	c.datumTypes = append(c.datumTypes, c.internOptional(match.DatumType))
+	c.scriptHashes = append(c.scriptHashes, c.internOptional(match.ScriptHash))
+	c.createdSlots = append(c.createdSlots, int64(match.CreatedAt.SlotNo))
+	c.createdHashes = append(c.createdHashes, c.intern(match.CreatedAt.HeaderHash))
+	if match.SpentAt != nil {
+		c.spentSlots = append(c.spentSlots, int64(match.SpentAt.SlotNo))
+		c.spentHashes = append(c.spentHashes, c.intern(match.SpentAt.HeaderHash))
+	} else {
+		c.spentSlots = append(c.spentSlots, -1)
+		c.spentHashes = append(c.spentHashes, noString)
+	}
+}
+
+// Len returns the number of matches
+func (c *CompactMatches) Len() int {
+	return len(c.transactionIDs)
+}
+
+// At materializes the match at index i
+func (c *CompactMatches) At(i int) Match {
+	match := Match{
+		TransactionIndex: int(c.transactionIndexes[i]),
+		TransactionID:    c.strings[c.transactionIDs[i]],
+		OutputIndex:      int(c.outputIndexes[i]),
+		Address:          c.strings[c.addresses[i]],
+		Value:            Value{Coins: int(c.coins[i])},
+		DatumHash:        c.optional(c.datumHashes[i]),
+		DatumType:        c.optional(c.datumTypes[i]),
+		ScriptHash:       c.optional(c.scriptHashes[i]),
+		CreatedAt: Point{
+			SlotNo:     int(c.createdSlots[i]),
+			HeaderHash: c.strings[c.createdHashes[i]],
+		},
+	}
+	start, end := c.assetOffsets[i], c.assetOffsets[i+1]
+	if end > start {
+		match.Value.Assets = make(Assets, end-start)
+		for j := start; j < end; j++ {
+			match.Value.Assets[c.strings[c.assetIDs[j]]] = int(c.assetQuantities[j])
+		}
+	}
+	if c.spentSlots[i] >= 0 {
+		match.SpentAt = &Point{
+			SlotNo:     int(c.spentSlots[i]),
+			HeaderHash: c.strings[c.spentHashes[i]],
+		}
+	}
+	return match
+}
+
+// Address returns the address of the match at index i without materializing
+// it
+func (c *CompactMatches) Address(i int) string {
+	return c.strings[c.addresses[i]]
+}
+
+// IsSpent reports whether the match at index i has been spent
+func (c *CompactMatches) IsSpent(i int) bool {
+	return c.spentSlots[i] >= 0
+}
+
+// Balance sums the values of the unspent matches without materializing them
+func (c *CompactMatches) Balance() Value {
+	var balance Value
+	for i := 0; i < c.Len(); i++ {
+		if c.IsSpent(i) {
+			continue
+		}
+		balance.Coins += int(c.coins[i])
+		start, end := c.assetOffsets[i], c.assetOffsets[i+1]
+		for j := start; j < end; j++ {
+			if balance.Assets == nil {
+				balance.Assets = make(Assets)
+			}
+			balance.Assets[c.strings[c.assetIDs[j]]] += int(c.assetQuantities[j])
+		}
+	}
+	balance.Assets.prune()
+	return balance
+}
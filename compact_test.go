@@ -0,0 +1,52 @@
+package kupogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompactMatches(t *testing.T) {
+	datumHash := "abcd"
+	matches := Matches{
+		{
+			TransactionID: "aa",
+			OutputIndex:   0,
+			Address:       "addr1",
+			Value:         Value{Coins: 10, Assets: Assets{"p.a": 2, "p.b": 1}},
+			DatumHash:     &datumHash,
+			CreatedAt:     Point{SlotNo: 1, HeaderHash: "h1"},
+		},
+		{
+			TransactionID: "aa",
+			OutputIndex:   1,
+			Address:       "addr1",
+			Value:         Value{Coins: 5},
+			CreatedAt:     Point{SlotNo: 1, HeaderHash: "h1"},
+			SpentAt:       &Point{SlotNo: 2, HeaderHash: "h2"},
+		},
+		{
+			TransactionID: "bb",
+			Address:       "addr2",
+			Value:         Value{Coins: 3, Assets: Assets{"p.a": 1}},
+			CreatedAt:     Point{SlotNo: 3, HeaderHash: "h3"},
+		},
+	}
+	compact := CompactMatchesFrom(matches)
+	if compact.Len() != len(matches) {
+		t.Fatalf("Expected %d matches, got %d", len(matches), compact.Len())
+	}
+	for i, match := range matches {
+		if !reflect.DeepEqual(compact.At(i), match) {
+			t.Errorf("Expected match %v, got %v", match, compact.At(i))
+		}
+	}
+	if !reflect.DeepEqual(compact.Balance(), matches.Balance()) {
+		t.Errorf("Expected balance %v, got %v", matches.Balance(), compact.Balance())
+	}
+	filtered := FilterMatches(compact, func(m Match) bool {
+		return m.Address == "addr1"
+	})
+	if !reflect.DeepEqual(filtered, matches[:2]) {
+		t.Errorf("Unexpected filtered matches: %v", filtered)
+	}
+}
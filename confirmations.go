@@ -0,0 +1,69 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import "context"
+
+// Confirmation describes how deep an output is below the chain tip
+type Confirmation struct {
+	// Slots between the block creating the output and the tip
+	Slots int
+	// Blocks from the block creating the output up to the tip, including
+	// both. It is a lower bound when the block is older than all checkpoints
+	// returned by Kupo
+	Blocks int
+	// OnChain is false if the block creating the output was rolled back
+	OnChain bool
+	Tip     Point
+}
+
+// Confirmations returns the number of slots the output of a match is below
+// tip, or 0 if it was created after it
+func Confirmations(match Match, tip Point) int {
+	if match.CreatedAt.SlotNo >= tip.SlotNo {
+		return 0
+	}
+	return tip.SlotNo - match.CreatedAt.SlotNo
+}
+
+// GetConfirmations fetches Kupo's checkpoints and returns how deep the output
+// of a match is below the most recent one
+func (c *Client) GetConfirmations(match Match) (*Confirmation, error) {
+	return c.GetConfirmationsContext(context.Background(), match)
+}
+
+// GetConfirmationsContext is like GetConfirmations with a request context
+func (c *Client) GetConfirmationsContext(ctx context.Context, match Match) (*Confirmation, error) {
+	checkpoints, err := c.GetCheckpointsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return checkpoints.Confirmations(match), nil
+}
+
+// Confirmations returns how deep the output of a match is below the most
+// recent checkpoint
+func (c Checkpoints) Confirmations(match Match) *Confirmation {
+	ret := &Confirmation{}
+	if len(c) == 0 {
+		return ret
+	}
+	ret.Tip = c[0]
+	ret.Blocks, ret.OnChain = c.Depth(match.CreatedAt)
+	if ret.OnChain {
+		ret.Slots = Confirmations(match, ret.Tip)
+	}
+	return ret
+}
@@ -0,0 +1,49 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConfirmations(t *testing.T) {
+	tip := Point{SlotNo: 1000}
+	if confirmations := Confirmations(Match{CreatedAt: Point{SlotNo: 900}}, tip); confirmations != 100 {
+		t.Fatalf("Expected 100 confirmations, got %d", confirmations)
+	}
+	if confirmations := Confirmations(Match{CreatedAt: Point{SlotNo: 1001}}, tip); confirmations != 0 {
+		t.Fatalf("Expected 0 confirmations, got %d", confirmations)
+	}
+}
+
+func TestClient_GetConfirmations(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			respBody, _ := json.Marshal(Checkpoints{
+				{SlotNo: 130, HeaderHash: "h130"},
+				{SlotNo: 120, HeaderHash: "h120"},
+				{SlotNo: 110, HeaderHash: "h110"},
+			})
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	confirmation, err := client.GetConfirmations(Match{CreatedAt: Point{SlotNo: 120, HeaderHash: "h120"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := Confirmation{Slots: 10, Blocks: 2, OnChain: true, Tip: Point{SlotNo: 130, HeaderHash: "h130"}}
+	if *confirmation != expected {
+		t.Fatalf("Expected %+v, got %+v", expected, *confirmation)
+	}
+	confirmation, err = client.GetConfirmations(Match{CreatedAt: Point{SlotNo: 125, HeaderHash: "h125"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if confirmation.OnChain || confirmation.Slots != 0 {
+		t.Fatalf("Expected rolled back output, got %+v", *confirmation)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+)
+
+// ConsistencyOptions configures CompareInstances
+type ConsistencyOptions struct {
+	// Patterns whose matches are compared, defaulting to the patterns
+	// registered on both instances
+	Patterns []string
+}
+
+// CheckpointMismatch is a slot at which two instances know of different
+// blocks
+type CheckpointMismatch struct {
+	SlotNo int
+	A      string
+	B      string
+}
+
+// MatchDivergence is an output which two instances report differently. A or
+// B is nil if the output is only known to the other instance
+type MatchDivergence struct {
+	Pattern string
+	OutputReference
+	A *Match
+	B *Match
+}
+
+// ConsistencyReport lists the divergences between two Kupo instances
+type ConsistencyReport struct {
+	TipA Point
+	TipB Point
+	// SlotNo is the most recent slot reached by both instances, up to which
+	// matches are compared
+	SlotNo int
+	// PatternsOnlyA and PatternsOnlyB are the patterns registered on only one
+	// instance
+	PatternsOnlyA Patterns
+	PatternsOnlyB Patterns
+	Checkpoints   []CheckpointMismatch
+	Matches       []MatchDivergence
+}
+
+// Consistent returns whether no divergence was found
+func (r ConsistencyReport) Consistent() bool {
+	return len(r.PatternsOnlyA) == 0 && len(r.PatternsOnlyB) == 0 &&
+		len(r.Checkpoints) == 0 && len(r.Matches) == 0
+}
+
+// CompareInstances compares the patterns, checkpoints and matches of two
+// Kupo instances, such as the blue and green instances of a deployment. As
+// instances may be synchronized to different tips, matches are only compared
+// up to the most recent slot reached by both, with outputs spent after it
+// considered unspent
+func CompareInstances(
+	ctx context.Context,
+	a *Client,
+	b *Client,
+	opts ConsistencyOptions,
+) (*ConsistencyReport, error) {
+	report := &ConsistencyReport{}
+	patternsA, err := a.GetAllPatternsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	patternsB, err := b.GetAllPatternsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.PatternsOnlyA = patternDifference(*patternsA, *patternsB)
+	report.PatternsOnlyB = patternDifference(*patternsB, *patternsA)
+	checkpointsA, err := a.GetCheckpointsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	checkpointsB, err := b.GetCheckpointsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(*checkpointsA) == 0 || len(*checkpointsB) == 0 {
+		return nil, errors.New("no checkpoint to compare at")
+	}
+	report.TipA, report.TipB = (*checkpointsA)[0], (*checkpointsB)[0]
+	report.SlotNo = report.TipA.SlotNo
+	if report.TipB.SlotNo < report.SlotNo {
+		report.SlotNo = report.TipB.SlotNo
+	}
+	hashesB := make(map[int]string, len(*checkpointsB))
+	for _, point := range *checkpointsB {
+		hashesB[point.SlotNo] = point.HeaderHash
+	}
+	for _, point := range *checkpointsA {
+		if hash, ok := hashesB[point.SlotNo]; ok && hash != point.HeaderHash {
+			report.Checkpoints = append(report.Checkpoints, CheckpointMismatch{
+				SlotNo: point.SlotNo,
+				A:      point.HeaderHash,
+				B:      hash,
+			})
+		}
+	}
+	patterns := opts.Patterns
+	if patterns == nil {
+		for _, pattern := range *patternsA {
+			if len(patternDifference(Patterns{pattern}, *patternsB)) == 0 {
+				patterns = append(patterns, string(pattern))
+			}
+		}
+	}
+	for _, pattern := range patterns {
+		divergences, err := compareMatches(ctx, a, b, pattern, report.SlotNo)
+		if err != nil {
+			return nil, err
+		}
+		report.Matches = append(report.Matches, divergences...)
+	}
+	return report, nil
+}
+
+// patternDifference returns the patterns of a not found in b
+func patternDifference(a Patterns, b Patterns) Patterns {
+	var ret Patterns
+	for _, pattern := range a {
+		found := false
+		for _, other := range b {
+			if pattern.Equal(other) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ret = append(ret, pattern)
+		}
+	}
+	return ret
+}
+
+func compareMatches(
+	ctx context.Context,
+	a *Client,
+	b *Client,
+	pattern string,
+	slotNo int,
+) ([]MatchDivergence, error) {
+	opts := MatchOptions{CreatedBefore: slotNo + 1}
+	matchesA, _, err := a.getMatches(ctx, pattern, opts)
+	if err != nil {
+		return nil, err
+	}
+	matchesB, _, err := b.getMatches(ctx, pattern, opts)
+	if err != nil {
+		return nil, err
+	}
+	byRef := func(matches Matches) map[OutputReference]Match {
+		ret := make(map[OutputReference]Match, len(matches))
+		for _, match := range matches {
+			if match.SpentAt != nil && match.SpentAt.SlotNo > slotNo {
+				match.SpentAt = nil
+			}
+			ret[match.OutputReference()] = match
+		}
+		return ret
+	}
+	refsA, refsB := byRef(*matchesA), byRef(*matchesB)
+	var ret []MatchDivergence
+	for ref, matchA := range refsA {
+		matchA := matchA
+		divergence := MatchDivergence{Pattern: pattern, OutputReference: ref, A: &matchA}
+		if matchB, ok := refsB[ref]; ok {
+			if reflect.DeepEqual(matchA, matchB) {
+				continue
+			}
+			divergence.B = &matchB
+		}
+		ret = append(ret, divergence)
+	}
+	for ref, matchB := range refsB {
+		if _, ok := refsA[ref]; !ok {
+			matchB := matchB
+			ret = append(ret, MatchDivergence{Pattern: pattern, OutputReference: ref, B: &matchB})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].TransactionID != ret[j].TransactionID {
+			return ret[i].TransactionID < ret[j].TransactionID
+		}
+		return ret[i].OutputIndex < ret[j].OutputIndex
+	})
+	return ret, nil
+}
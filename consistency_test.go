@@ -0,0 +1,76 @@
+package kupogo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newInstanceServer(t *testing.T, patterns Patterns, checkpoints Checkpoints, matches Matches) *httptest.Server {
+	return httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/patterns":
+				_ = json.NewEncoder(w).Encode(patterns)
+			case "/checkpoints":
+				_ = json.NewEncoder(w).Encode(checkpoints)
+			default:
+				if r.URL.Query().Get("created_before") != "21" {
+					t.Errorf("Expected matches up to the common slot, got %s", r.URL.RawQuery)
+				}
+				_ = json.NewEncoder(w).Encode(matches)
+			}
+		}),
+	)
+}
+
+func TestCompareInstances(t *testing.T) {
+	shared := Match{TransactionID: "t1", Value: Value{Coins: 1}, CreatedAt: Point{SlotNo: 5}}
+	spentLater := shared
+	spentLater.SpentAt = &Point{SlotNo: 30}
+	differs := Match{TransactionID: "t2", Value: Value{Coins: 2}, CreatedAt: Point{SlotNo: 10}}
+	differsB := differs
+	differsB.Value.Coins = 3
+	onlyA := Match{TransactionID: "t3", CreatedAt: Point{SlotNo: 15}}
+
+	serverA := newInstanceServer(
+		t,
+		Patterns{"*", "addr1"},
+		Checkpoints{{SlotNo: 20, HeaderHash: "x"}, {SlotNo: 10, HeaderHash: "a"}},
+		Matches{shared, differs, onlyA},
+	)
+	defer serverA.Close()
+	serverB := newInstanceServer(
+		t,
+		Patterns{"*/*", "addr2"},
+		Checkpoints{{SlotNo: 30, HeaderHash: "c"}, {SlotNo: 20, HeaderHash: "b"}, {SlotNo: 10, HeaderHash: "a"}},
+		Matches{spentLater, differsB},
+	)
+	defer serverB.Close()
+
+	report, err := CompareInstances(context.Background(), NewClient(serverA.URL), NewClient(serverB.URL), ConsistencyOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if report.Consistent() || report.SlotNo != 20 {
+		t.Fatalf("Unexpected report %+v", report)
+	}
+	if len(report.PatternsOnlyA) != 1 || report.PatternsOnlyA[0] != "addr1" ||
+		len(report.PatternsOnlyB) != 1 || report.PatternsOnlyB[0] != "addr2" {
+		t.Fatalf("Unexpected pattern differences %v and %v", report.PatternsOnlyA, report.PatternsOnlyB)
+	}
+	if len(report.Checkpoints) != 1 || report.Checkpoints[0] != (CheckpointMismatch{SlotNo: 20, A: "x", B: "b"}) {
+		t.Fatalf("Unexpected checkpoint mismatches %+v", report.Checkpoints)
+	}
+	if len(report.Matches) != 2 {
+		t.Fatalf("Expected 2 match divergences, got %+v", report.Matches)
+	}
+	if first := report.Matches[0]; first.TransactionID != "t2" || first.A.Value.Coins != 2 || first.B.Value.Coins != 3 {
+		t.Fatalf("Unexpected divergence %+v", first)
+	}
+	if second := report.Matches[1]; second.TransactionID != "t3" || second.A == nil || second.B != nil {
+		t.Fatalf("Unexpected divergence %+v", second)
+	}
+}
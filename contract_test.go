@@ -0,0 +1,168 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo/openapi"
+)
+
+func TestContractTypes(t *testing.T) {
+	spec, err := openapi.Kupo()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	checkContractTypes(t, spec)
+}
+
+func TestContractPaths(t *testing.T) {
+	spec, err := openapi.Kupo()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	checkContractPaths(t, spec)
+}
+
+// TestContractUpstream checks the client against a copy of Kupo's published
+// specification, such as docs/api/v2.7.yaml from the Kupo repository, given
+// by KUPO_OPENAPI_SPEC
+func TestContractUpstream(t *testing.T) {
+	path := os.Getenv("KUPO_OPENAPI_SPEC")
+	if path == "" {
+		t.Skip("KUPO_OPENAPI_SPEC not set")
+	}
+	spec, err := openapi.Load(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	checkContractTypes(t, spec)
+	checkContractPaths(t, spec)
+}
+
+func checkContractTypes(t *testing.T, spec *openapi.Spec) {
+	testDefs := []struct {
+		schema string
+		value  any
+		// known lists the drift handled elsewhere, such as by custom decoding
+		known []string
+	}{
+		{schema: "Match", value: Match{}},
+		{schema: "Point", value: Point{}},
+		{schema: "Value", value: Value{}},
+		{schema: "Datum", value: DatumResponse{}},
+		{schema: "Script", value: ScriptResponse{}},
+		// The raw metadata is hex decoded by GetMetadata
+		{schema: "Metadata", value: MetadataItem{}, known: []string{"raw"}},
+		{schema: "Health", value: Health{}},
+		{schema: "PutPattern", value: addPatternRequest{}},
+		{schema: "PutPatterns", value: addPatternsRequest{}},
+	}
+	for _, testDef := range testDefs {
+		drift, err := spec.CheckType(testDef.schema, testDef.value)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		known := make(map[string]bool)
+		for _, field := range testDef.known {
+			known[field] = true
+		}
+		for _, d := range drift {
+			if !known[d.Field] {
+				t.Errorf("Contract drift: %s", d)
+			}
+		}
+	}
+}
+
+func checkContractPaths(t *testing.T, spec *openapi.Spec) {
+	var mu sync.Mutex
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r)
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/health":
+			_, _ = w.Write([]byte(`{"connection_status":"connected"}`))
+		case "/datums/abcd":
+			_, _ = w.Write([]byte(`{"datum":"d87980"}`))
+		case "/scripts/abcd":
+			_, _ = w.Write([]byte(`{"language":"native","script":"8200581c"}`))
+		case "/checkpoints/10":
+			_, _ = w.Write([]byte(`{"slot_no":10,"header_hash":"abcd"}`))
+		default:
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+	calls := []func() error{
+		func() error { _, err := client.GetAllMatches(); return err },
+		func() error { _, err := client.GetMatches("*@abcd"); return err },
+		func() error {
+			_, err := client.GetMatchesWithOptions("*", MatchOptions{Unspent: true})
+			return err
+		},
+		func() error {
+			_, err := client.GetMatchesWithOptions("*", MatchOptions{
+				Spent:         true,
+				CreatedAfter:  1,
+				CreatedBefore: 2,
+				SpentAfter:    3,
+				SpentBefore:   4,
+				PolicyID:      "abcd",
+				AssetName:     "ef",
+				TransactionID: "abcd",
+				Order:         MatchOrderOldestFirst,
+			})
+			return err
+		},
+		func() error { _, err := client.GetMetadata(10, ""); return err },
+		func() error { _, err := client.GetMetadata(10, "abcd"); return err },
+		func() error { _, err := client.GetAllPatterns(); return err },
+		func() error { _, err := client.GetPattern("*"); return err },
+		func() error {
+			_, err := client.AddPattern("*", Point{SlotNo: 10, HeaderHash: "abcd"}, "")
+			return err
+		},
+		func() error {
+			_, err := client.AddPatterns([]string{"*"}, Point{SlotNo: 10, HeaderHash: "abcd"}, "")
+			return err
+		},
+		func() error { _, err := client.GetScriptByHash("abcd"); return err },
+		func() error { _, err := client.GetDatumByHash("abcd"); return err },
+		func() error { _, err := client.GetCheckpoints(); return err },
+		func() error { _, err := client.GetCheckpointBySlot(10, true); return err },
+		func() error { _, err := client.GetHealth(); return err },
+	}
+	for _, call := range calls {
+		if err := call(); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	}
+	for _, req := range requests {
+		template, ok := spec.MatchPath(req.Method, req.URL.Path)
+		if !ok {
+			t.Errorf("Request %s %s is not in the specification", req.Method, req.URL.Path)
+			continue
+		}
+		params, err := spec.QueryParameters(req.Method, template)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		known := make(map[string]bool, len(params))
+		for _, param := range params {
+			known[param] = true
+		}
+		for _, pair := range strings.Split(req.URL.RawQuery, "&") {
+			name, _, _ := strings.Cut(pair, "=")
+			if name != "" && !known[name] {
+				t.Errorf("Query parameter %s of %s %s is not in the specification", name, req.Method, template)
+			}
+		}
+	}
+}
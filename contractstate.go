@@ -0,0 +1,205 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ContractOutput is an unspent output of a contract with its resolved datum
+type ContractOutput struct {
+	Match Match
+	// Datum is the hex encoded CBOR datum of the output, empty if it has none
+	// or Kupo does not know it
+	Datum string
+}
+
+// ContractStateChange describes how the state of a contract changed between
+// two polls
+type ContractStateChange struct {
+	Created []ContractOutput
+	Spent   Matches
+	// State is the full state after the change, oldest output first
+	State []ContractOutput
+}
+
+// ContractTrackerConfig configures a ContractTracker
+type ContractTrackerConfig struct {
+	// Pattern selects the contract's outputs, usually its script address or
+	// MatchCredential of its script hash
+	Pattern string
+	// Interval between polls, defaulting to 10 seconds
+	Interval time.Duration
+	// OnChange is called with every change found while running
+	OnChange func(ContractStateChange)
+	// OnError is called when a poll fails while running
+	OnError func(error)
+}
+
+// ContractTracker follows the unspent outputs of a contract with their
+// datums, exposing its current state. On-chain state machines usually keep
+// their state in the datum of their latest unspent output
+type ContractTracker struct {
+	client *Client
+	config ContractTrackerConfig
+	mu     sync.Mutex
+	state  map[OutputReference]ContractOutput
+	// Datums are immutable, so they are only fetched once
+	datums map[string]string
+}
+
+// NewContractTracker creates a tracker for the configured pattern
+func NewContractTracker(client *Client, config ContractTrackerConfig) *ContractTracker {
+	if config.Interval <= 0 {
+		config.Interval = defaultWatchInterval
+	}
+	return &ContractTracker{
+		client: client,
+		config: config,
+		state:  make(map[OutputReference]ContractOutput),
+		datums: make(map[string]string),
+	}
+}
+
+// Poll fetches the unspent outputs of the contract, resolves the datums of
+// new outputs and returns the change since the previous poll. The first poll
+// reports every unspent output as created. The state is left untouched if a
+// datum cannot be fetched
+func (t *ContractTracker) Poll() (*ContractStateChange, error) {
+	return t.PollContext(context.Background())
+}
+
+// PollContext is like Poll with a request context
+func (t *ContractTracker) PollContext(ctx context.Context) (*ContractStateChange, error) {
+	matches, _, err := t.client.getMatches(ctx, t.config.Pattern, MatchOptions{Unspent: true})
+	if err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	previous := t.state
+	t.mu.Unlock()
+	current := make(map[OutputReference]ContractOutput, len(*matches))
+	change := &ContractStateChange{}
+	for _, match := range *matches {
+		ref := match.OutputReference()
+		if output, ok := previous[ref]; ok {
+			current[ref] = output
+			continue
+		}
+		datum, err := t.resolveDatum(ctx, match)
+		if err != nil {
+			return nil, err
+		}
+		output := ContractOutput{Match: match, Datum: datum}
+		current[ref] = output
+		change.Created = append(change.Created, output)
+	}
+	for ref, output := range previous {
+		if _, ok := current[ref]; !ok {
+			change.Spent = append(change.Spent, output.Match)
+		}
+	}
+	sort.Slice(change.Created, func(i, j int) bool {
+		return matchLess(change.Created[i].Match, change.Created[j].Match)
+	})
+	sort.Slice(change.Spent, func(i, j int) bool {
+		return matchLess(change.Spent[i], change.Spent[j])
+	})
+	t.mu.Lock()
+	t.state = current
+	t.mu.Unlock()
+	change.State = sortedContractOutputs(current)
+	return change, nil
+}
+
+func (t *ContractTracker) resolveDatum(ctx context.Context, match Match) (string, error) {
+	if match.DatumHash == nil {
+		return "", nil
+	}
+	hash := *match.DatumHash
+	t.mu.Lock()
+	datum, ok := t.datums[hash]
+	t.mu.Unlock()
+	if ok {
+		return datum, nil
+	}
+	// Inline datums are also served by hash
+	resp, err := t.client.GetDatumByHashContext(ctx, hash)
+	if err != nil {
+		return "", err
+	}
+	if resp == nil {
+		return "", nil
+	}
+	t.mu.Lock()
+	t.datums[hash] = resp.Datum
+	t.mu.Unlock()
+	return resp.Datum, nil
+}
+
+// State returns the unspent outputs of the contract as of the last poll,
+// oldest first
+func (t *ContractTracker) State() []ContractOutput {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return sortedContractOutputs(t.state)
+}
+
+// Latest returns the most recently created unspent output of the contract,
+// or false if it has none
+func (t *ContractTracker) Latest() (ContractOutput, bool) {
+	state := t.State()
+	if len(state) == 0 {
+		return ContractOutput{}, false
+	}
+	return state[len(state)-1], true
+}
+
+// Run polls the contract until the context is cancelled, delivering changes
+// and errors to the configured callbacks
+func (t *ContractTracker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(t.config.Interval)
+	defer ticker.Stop()
+	for {
+		change, err := t.PollContext(ctx)
+		if err != nil {
+			if t.config.OnError != nil && ctx.Err() == nil {
+				t.config.OnError(err)
+			}
+		} else if (len(change.Created) > 0 || len(change.Spent) > 0) &&
+			t.config.OnChange != nil {
+			t.config.OnChange(*change)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func sortedContractOutputs(outputs map[OutputReference]ContractOutput) []ContractOutput {
+	ret := make([]ContractOutput, 0, len(outputs))
+	for _, output := range outputs {
+		ret = append(ret, output)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return matchLess(ret[i].Match, ret[j].Match)
+	})
+	return ret
+}
@@ -0,0 +1,85 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestContractTracker(t *testing.T) {
+	datumA, datumB := "aa", "bb"
+	var mu sync.Mutex
+	matches := Matches{
+		{TransactionID: "t1", Address: "script", DatumHash: &datumA, CreatedAt: Point{SlotNo: 10}},
+		{TransactionID: "t2", Address: "script", CreatedAt: Point{SlotNo: 5}},
+	}
+	datumRequests := 0
+	failDatums := false
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			if strings.HasPrefix(r.URL.Path, "/datums/") {
+				datumRequests++
+				if failDatums {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				hash := strings.TrimPrefix(r.URL.Path, "/datums/")
+				_, _ = w.Write([]byte(`{"datum":"d8799f` + hash + `ff"}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(matches)
+		}),
+	)
+	defer server.Close()
+	tracker := NewContractTracker(NewClient(server.URL), ContractTrackerConfig{Pattern: "script"})
+
+	change, err := tracker.Poll()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(change.Created) != 2 || change.Created[0].Match.TransactionID != "t2" {
+		t.Fatalf("Expected 2 created outputs, oldest first, got %+v", change.Created)
+	}
+	latest, ok := tracker.Latest()
+	if !ok || latest.Match.TransactionID != "t1" || latest.Datum != "d8799faaff" {
+		t.Fatalf("Unexpected latest output %+v", latest)
+	}
+
+	mu.Lock()
+	matches = Matches{
+		matches[0],
+		{TransactionID: "t3", Address: "script", DatumHash: &datumB, CreatedAt: Point{SlotNo: 20}},
+	}
+	mu.Unlock()
+	change, err = tracker.Poll()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(change.Created) != 1 || change.Created[0].Datum != "d8799fbbff" {
+		t.Fatalf("Unexpected created outputs %+v", change.Created)
+	}
+	if len(change.Spent) != 1 || change.Spent[0].TransactionID != "t2" {
+		t.Fatalf("Unexpected spent outputs %+v", change.Spent)
+	}
+	if len(change.State) != 2 || datumRequests != 2 {
+		t.Fatalf("Expected 2 outputs and 2 datum requests, got %d and %d", len(change.State), datumRequests)
+	}
+
+	// A failed datum fetch leaves the state as it was
+	mu.Lock()
+	failDatums = true
+	other := "cc"
+	matches = append(matches, Match{TransactionID: "t4", DatumHash: &other, CreatedAt: Point{SlotNo: 30}})
+	mu.Unlock()
+	if _, err := tracker.Poll(); err == nil {
+		t.Fatalf("Expected error when the datum cannot be fetched")
+	}
+	if state := tracker.State(); len(state) != 2 {
+		t.Fatalf("Expected state to be unchanged, got %d outputs", len(state))
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// fetchOptionPrefix starts the pseudo headers set by WithFetchOptions, which
+// the js/wasm transport turns into Fetch API options instead of sending them
+const fetchOptionPrefix = "js.fetch:"
+
+// corsSafelistedContentTypes are the content types a browser sends without a
+// CORS preflight request
+var corsSafelistedContentTypes = map[string]bool{
+	"application/x-www-form-urlencoded": true,
+	"multipart/form-data":               true,
+	"text/plain":                        true,
+}
+
+// CORSSafeRequests is middleware which removes the request headers that make
+// browsers send a CORS preflight request, such as X-Request-ID and cache
+// validators, so that queries from a web page are simple requests. Kupo, or a
+// proxy in front of it, must still allow the page's origin, and expose the
+// X-Most-Recent-Checkpoint header for the features which rely on it. The
+// Fetch API options set with WithFetchOptions are kept
+func CORSSafeRequests() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			safe := req.Clone(req.Context())
+			for name, values := range safe.Header {
+				if !corsSafelistedHeader(name, values) {
+					safe.Header.Del(name)
+				}
+			}
+			return next.RoundTrip(safe)
+		})
+	}
+}
+
+// WithCORSSafeRequests shapes requests with CORSSafeRequests, for clients
+// running in a browser under GOOS=js
+func WithCORSSafeRequests() ClientOption {
+	return WithMiddleware(CORSSafeRequests())
+}
+
+func corsSafelistedHeader(name string, values []string) bool {
+	if strings.HasPrefix(name, fetchOptionPrefix) {
+		return true
+	}
+	switch http.CanonicalHeaderKey(name) {
+	case "Accept", "Accept-Language", "Content-Language", "Range":
+		return true
+	case "Content-Type":
+		for _, value := range values {
+			mediaType, _, err := mime.ParseMediaType(value)
+			if err != nil || !corsSafelistedContentTypes[mediaType] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCORSSafeRequests(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r.Header.Clone()
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+	client := NewClient(
+		server.URL,
+		WithRequestID(nil),
+		WithCORSSafeRequests(),
+	)
+	if _, err := client.GetCheckpoints(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if received.Get(RequestIDHeader) != "" {
+		t.Fatalf("Expected request ID header to be removed")
+	}
+	if received.Get("Accept") != "application/json" {
+		t.Fatalf("Expected Accept header to be kept, got %q", received.Get("Accept"))
+	}
+}
+
+func TestCORSSafelistedHeader(t *testing.T) {
+	testDefs := []struct {
+		name   string
+		values []string
+		safe   bool
+	}{
+		{"accept", []string{"application/json"}, true},
+		{"Content-Type", []string{"text/plain; charset=utf-8"}, true},
+		{"Content-Type", []string{"application/json"}, false},
+		{"If-None-Match", []string{`"abc"`}, false},
+		{RequestIDHeader, []string{"abc"}, false},
+	}
+	for _, testDef := range testDefs {
+		if safe := corsSafelistedHeader(testDef.name, testDef.values); safe != testDef.safe {
+			t.Errorf("Header %s %v: expected %v, got %v", testDef.name, testDef.values, testDef.safe, safe)
+		}
+	}
+}
+
+func TestCORSSafeRequestsFetchOptions(t *testing.T) {
+	var received http.Header
+	transport := CORSSafeRequests()(RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		received = req.Header.Clone()
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	}))
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/matches/*", nil)
+	req.Header.Set("js.fetch:mode", "cors")
+	req.Header.Set("X-Request-Id", "abc")
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if received.Get("js.fetch:mode") != "cors" {
+		t.Fatalf("Expected the fetch option to be kept, got %v", received)
+	}
+	if received.Get("X-Request-Id") != "" {
+		t.Fatalf("Expected unsafe headers to be removed, got %v", received)
+	}
+}
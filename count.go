@@ -0,0 +1,88 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// CountMatches returns the number of outputs matching the pattern and
+// options. The response is counted as it downloads without keeping the
+// matches, so memory use stays flat however many there are
+func (c *Client) CountMatches(pattern string, opts MatchOptions) (int, error) {
+	return c.CountMatchesContext(context.Background(), pattern, opts)
+}
+
+// CountMatchesContext is like CountMatches with a request context
+func (c *Client) CountMatchesContext(
+	ctx context.Context,
+	pattern string,
+	opts MatchOptions,
+) (int, error) {
+	// The response cache would keep the whole body
+	ctx = WithCallOptions(ctx, NoCache())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.matchesURL(pattern, opts), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get matches: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf(
+			"failed to get matches: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	count, err := countJSONArray(resp.Body)
+	if err != nil {
+		c.logDecodeFailure(req, err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// countJSONArray counts the elements of a JSON array read from r
+func countJSONArray(r io.Reader) (int, error) {
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read array: %s", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return 0, fmt.Errorf("expected JSON array, got %v", token)
+	}
+	count := 0
+	// Decoding into an empty struct validates and skips each element
+	// without keeping any of it
+	var skip struct{}
+	for decoder.More() {
+		if err := decoder.Decode(&skip); err != nil {
+			return count, fmt.Errorf("failed to decode element %d: %s", count, err)
+		}
+		count++
+	}
+	if _, err := decoder.Token(); err != nil {
+		return count, fmt.Errorf("failed to read array end: %s", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,55 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCountMatches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "unspent" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"transaction_id":"aa","value":{"coins":1,"assets":{"a.b":2}}},{"transaction_id":"bb"}]`))
+	}))
+	defer server.Close()
+
+	count, err := NewClient(server.URL).CountMatches("*", MatchOptions{Unspent: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if count != 2 {
+		t.Fatalf("Expected 2 matches, got %d", count)
+	}
+}
+
+func TestCountJSONArray(t *testing.T) {
+	testDefs := []struct {
+		input    string
+		expected int
+		fail     bool
+	}{
+		{input: `[]`, expected: 0},
+		{input: ` [ {"a":[1,2,{"b":3}]} , {} ] `, expected: 2},
+		{input: `{}`, fail: true},
+		{input: `[{},`, fail: true},
+	}
+	for _, testDef := range testDefs {
+		count, err := countJSONArray(strings.NewReader(testDef.input))
+		if testDef.fail {
+			if err == nil {
+				t.Errorf("%s: Expected an error", testDef.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: Expected no error, got %s", testDef.input, err)
+		}
+		if count != testDef.expected {
+			t.Errorf("%s: Expected %d, got %d", testDef.input, testDef.expected, count)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// redactedHeaders are never written out in full by the curl debug output
+var redactedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Cookie":              true,
+	"Proxy-Authorization": true,
+	"X-Api-Key":           true,
+}
+
+// WithCurlDebug writes every request to out as an equivalent curl command,
+// with secret headers redacted, followed by up to maxResponseBytes of the
+// response body
+func WithCurlDebug(out io.Writer, maxResponseBytes int) ClientOption {
+	return WithMiddleware(
+		func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				fmt.Fprintln(out, CurlCommand(req))
+				resp, err := next.RoundTrip(req)
+				if err != nil {
+					fmt.Fprintf(out, "# error: %s\n", err)
+					return nil, err
+				}
+				fmt.Fprintf(out, "# status: %d\n", resp.StatusCode)
+				if maxResponseBytes > 0 {
+					prefix := make([]byte, maxResponseBytes)
+					n, _ := io.ReadFull(resp.Body, prefix)
+					prefix = prefix[:n]
+					fmt.Fprintf(out, "# response: %s\n", prefix)
+					resp.Body = struct {
+						io.Reader
+						io.Closer
+					}{
+						io.MultiReader(bytes.NewReader(prefix), resp.Body),
+						resp.Body,
+					}
+				}
+				return resp, nil
+			})
+		},
+	)
+}
+
+// CurlCommand returns a curl command equivalent to the request, with secret
+// headers and URL passwords redacted
+func CurlCommand(req *http.Request) string {
+	var sb strings.Builder
+	sb.WriteString("curl")
+	if req.Method != http.MethodGet {
+		sb.WriteString(" -X " + req.Method)
+	}
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range req.Header.Values(name) {
+			if redactedHeaders[http.CanonicalHeaderKey(name)] {
+				value = "REDACTED"
+			}
+			sb.WriteString(" -H " + shellQuote(name+": "+value))
+		}
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, err := io.ReadAll(body)
+			body.Close()
+			if err == nil && len(data) > 0 {
+				sb.WriteString(" --data " + shellQuote(string(data)))
+			}
+		}
+	}
+	url := *req.URL
+	if url.User != nil {
+		if _, ok := url.User.Password(); ok {
+			url.User = nil
+		}
+	}
+	sb.WriteString(" " + shellQuote(url.String()))
+	return sb.String()
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
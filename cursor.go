@@ -0,0 +1,106 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CursorStore persists the last processed point of named consumers, such as
+// watchers, so they resume where they left off after a restart
+type CursorStore interface {
+	// LoadCursor returns the saved point, or nil if there is none
+	LoadCursor(name string) (*Point, error)
+	SaveCursor(name string, point Point) error
+}
+
+// FileCursorStore keeps cursors in a JSON file, rewritten atomically on every
+// save
+type FileCursorStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileCursorStore creates a cursor store backed by the file at path, which
+// is created on the first save
+func NewFileCursorStore(path string) *FileCursorStore {
+	return &FileCursorStore{path: path}
+}
+
+func (s *FileCursorStore) load() (map[string]Point, error) {
+	cursors := make(map[string]Point)
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cursors, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cursor file: %s", err)
+	}
+	if err := json.Unmarshal(data, &cursors); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cursors: %s", err)
+	}
+	return cursors, nil
+}
+
+// LoadCursor returns the saved point for a name, or nil if there is none
+func (s *FileCursorStore) LoadCursor(name string) (*Point, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cursors, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	point, ok := cursors[name]
+	if !ok {
+		return nil, nil
+	}
+	return &point, nil
+}
+
+// SaveCursor saves the point for a name
+func (s *FileCursorStore) SaveCursor(name string, point Point) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cursors, err := s.load()
+	if err != nil {
+		return err
+	}
+	cursors[name] = point
+	data, err := json.Marshal(cursors)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cursors: %s", err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create cursor file: %s", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write cursor file: %s", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close cursor file: %s", err)
+	}
+	if err := os.Rename(f.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to rename cursor file: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileCursorStore(t *testing.T) {
+	store := NewFileCursorStore(filepath.Join(t.TempDir(), "cursors.json"))
+	cursor, err := store.LoadCursor("w1")
+	if err != nil || cursor != nil {
+		t.Fatalf("Expected no cursor, got %v, %v", cursor, err)
+	}
+	if err := store.SaveCursor("w1", Point{SlotNo: 10, HeaderHash: "aa"}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := store.SaveCursor("w2", Point{SlotNo: 20}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	cursor, err = store.LoadCursor("w1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if cursor == nil || *cursor != (Point{SlotNo: 10, HeaderHash: "aa"}) {
+		t.Errorf("Unexpected cursor: %v", cursor)
+	}
+}
+
+func TestWatcher_ResumeFromCursor(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var matches Matches
+			switch r.URL.RawQuery {
+			case "unspent":
+				matches = Matches{
+					{TransactionID: "aa", CreatedAt: Point{SlotNo: 50}},
+					{TransactionID: "bb", CreatedAt: Point{SlotNo: 150}},
+				}
+			case "created_before=101&spent_after=100":
+				matches = Matches{
+					{TransactionID: "cc", CreatedAt: Point{SlotNo: 60}, SpentAt: &Point{SlotNo: 120}},
+					{TransactionID: "dd", CreatedAt: Point{SlotNo: 70}, SpentAt: &Point{SlotNo: 250}},
+				}
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			respBody, _ := json.Marshal(matches)
+			w.Header().Set("X-Most-Recent-Checkpoint", "200")
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	store := NewFileCursorStore(filepath.Join(t.TempDir(), "cursors.json"))
+	if err := store.SaveCursor("addr1", Point{SlotNo: 100}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	watcher := NewWatcher(
+		&Client{KupoUrl: server.URL},
+		WatcherConfig{Pattern: "addr1", CursorStore: store},
+	)
+	events, err := watcher.Poll()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %v", events)
+	}
+	if events[0].Type != WatchEventSpent || events[0].Match.TransactionID != "cc" {
+		t.Errorf("Expected cc to be spent, got %v", events[0])
+	}
+	if events[1].Type != WatchEventCreated || events[1].Match.TransactionID != "bb" {
+		t.Errorf("Expected bb to be created, got %v", events[1])
+	}
+	if err := watcher.SaveCursor(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	cursor, _ := store.LoadCursor("addr1")
+	if cursor == nil || cursor.SlotNo != 200 {
+		t.Errorf("Expected cursor at slot 200, got %v", cursor)
+	}
+}
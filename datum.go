@@ -0,0 +1,135 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/blinklabs-io/kupogo/internal/cbor"
+)
+
+// Constr is a decoded Plutus constructor application
+type Constr struct {
+	Index  uint64
+	Fields []any
+}
+
+// PlutusMapEntry is a key/value pair of a PlutusMap
+type PlutusMapEntry struct {
+	Key   any
+	Value any
+}
+
+// PlutusMap is a decoded Plutus map. Entries are kept in encoding order
+type PlutusMap []PlutusMapEntry
+
+// DecodeDatum decodes a hex encoded CBOR Plutus datum, as returned by
+// GetDatumByHash. Constructors decode to Constr, integers to *big.Int, byte
+// strings to []byte, lists to []any and maps to PlutusMap
+func DecodeDatum(datum string) (any, error) {
+	data, err := hex.DecodeString(datum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode datum hex: %s", err)
+	}
+	return DecodeDatumBytes(data)
+}
+
+// DecodeDatumBytes is like DecodeDatum for raw CBOR bytes
+func DecodeDatumBytes(data []byte) (any, error) {
+	value, err := cbor.Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode datum: %s", err)
+	}
+	return plutusData(value)
+}
+
+func plutusData(value any) (any, error) {
+	switch v := value.(type) {
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	case int64:
+		return big.NewInt(v), nil
+	case *big.Int:
+		return v, nil
+	case []byte:
+		return v, nil
+	case []any:
+		return plutusList(v)
+	case cbor.Map:
+		ret := make(PlutusMap, 0, len(v))
+		for _, entry := range v {
+			key, err := plutusData(entry.Key)
+			if err != nil {
+				return nil, err
+			}
+			value, err := plutusData(entry.Value)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, PlutusMapEntry{Key: key, Value: value})
+		}
+		return ret, nil
+	case cbor.Tag:
+		return plutusConstr(v)
+	}
+	return nil, fmt.Errorf("unexpected %T in datum", value)
+}
+
+func plutusList(items []any) ([]any, error) {
+	ret := make([]any, 0, len(items))
+	for _, item := range items {
+		value, err := plutusData(item)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, value)
+	}
+	return ret, nil
+}
+
+// plutusConstr decodes a constructor. Constructors 0-6 use tags 121-127,
+// 7-127 use tags 1280-1400 and any other uses tag 102 with an explicit index
+func plutusConstr(tag cbor.Tag) (any, error) {
+	var index uint64
+	content := tag.Content
+	switch {
+	case tag.Number >= 121 && tag.Number <= 127:
+		index = tag.Number - 121
+	case tag.Number >= 1280 && tag.Number <= 1400:
+		index = tag.Number - 1280 + 7
+	case tag.Number == 102:
+		pair, ok := tag.Content.([]any)
+		if !ok || len(pair) != 2 {
+			return nil, fmt.Errorf("invalid constructor with tag 102")
+		}
+		if index, ok = pair[0].(uint64); !ok {
+			return nil, fmt.Errorf("invalid constructor index")
+		}
+		content = pair[1]
+	default:
+		return nil, fmt.Errorf("unexpected tag %d in datum", tag.Number)
+	}
+	items, ok := content.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid fields of constructor %d", index)
+	}
+	fields, err := plutusList(items)
+	if err != nil {
+		return nil, err
+	}
+	return Constr{Index: index, Fields: fields}, nil
+}
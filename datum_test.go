@@ -0,0 +1,51 @@
+package kupogo
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeDatum(t *testing.T) {
+	testDefs := []struct {
+		datum    string
+		expected any
+	}{
+		{
+			// Constr 0 [1000000, h'abcd']
+			datum:    "d8799f1a000f424042abcdff",
+			expected: Constr{Index: 0, Fields: []any{big.NewInt(1000000), []byte{0xab, 0xcd}}},
+		},
+		{
+			// Constr 7 [-5]
+			datum:    "d905009f24ff",
+			expected: Constr{Index: 7, Fields: []any{big.NewInt(-5)}},
+		},
+		{
+			// Constr 200 []
+			datum:    "d8668218c880",
+			expected: Constr{Index: 200, Fields: []any{}},
+		},
+		{
+			// {h'01': [2]}
+			datum:    "a141018102",
+			expected: PlutusMap{{Key: []byte{0x01}, Value: []any{big.NewInt(2)}}},
+		},
+	}
+	for _, testDef := range testDefs {
+		value, err := DecodeDatum(testDef.datum)
+		if err != nil {
+			t.Fatalf("Expected no error decoding %s, got %s", testDef.datum, err)
+		}
+		if !reflect.DeepEqual(value, testDef.expected) {
+			t.Fatalf("Unexpected value for %s: %#v", testDef.datum, value)
+		}
+	}
+	// Text strings are not Plutus data
+	if _, err := DecodeDatum("6161"); err == nil {
+		t.Fatalf("Expected error for text string datum")
+	}
+	if _, err := DecodeDatum("d87a"); err == nil {
+		t.Fatalf("Expected error for truncated datum")
+	}
+}
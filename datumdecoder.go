@@ -0,0 +1,175 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DatumDecoder decodes the datum of a match into a protocol-specific value.
+// The datum is given as decoded by DecodeDatum
+type DatumDecoder func(match Match, datum any) (any, error)
+
+// DatumShape reports whether a datum, as decoded by DecodeDatum, has a shape
+// handled by a decoder
+type DatumShape func(datum any) bool
+
+type shapeDecoder struct {
+	shape   DatumShape
+	decoder DatumDecoder
+}
+
+// DatumDecoders is a registry of datum decoders, keyed by the hash of the
+// script locking an output or by datum shape. It is safe for concurrent use
+type DatumDecoders struct {
+	mu       sync.RWMutex
+	byScript map[string]DatumDecoder
+	byShape  []shapeDecoder
+}
+
+// DefaultDatumDecoders is the registry used by clients without
+// WithDatumDecoders, where contrib packages register their decoders
+var DefaultDatumDecoders = NewDatumDecoders()
+
+// NewDatumDecoders creates an empty registry
+func NewDatumDecoders() *DatumDecoders {
+	return &DatumDecoders{byScript: make(map[string]DatumDecoder)}
+}
+
+// RegisterScript registers a decoder for the datums of outputs locked by the
+// script with the given hash, replacing any previous one
+func (r *DatumDecoders) RegisterScript(scriptHash string, decoder DatumDecoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byScript[scriptHash] = decoder
+}
+
+// RegisterShape registers a decoder for datums matching shape. Shapes are
+// tried in registration order, after script decoders
+func (r *DatumDecoders) RegisterShape(shape DatumShape, decoder DatumDecoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byShape = append(r.byShape, shapeDecoder{shape: shape, decoder: decoder})
+}
+
+// Decode decodes the hex encoded CBOR datum of a match with the decoder
+// registered for its script hash or, failing that, the first one whose shape
+// matches. It returns false if no decoder applies
+func (r *DatumDecoders) Decode(match Match, datum string) (any, bool, error) {
+	value, err := DecodeDatum(datum)
+	if err != nil {
+		return nil, false, err
+	}
+	r.mu.RLock()
+	decoder := r.lookup(match, value)
+	r.mu.RUnlock()
+	if decoder == nil {
+		return nil, false, nil
+	}
+	ret, err := decoder(match, value)
+	if err != nil {
+		return nil, true, err
+	}
+	return ret, true, nil
+}
+
+func (r *DatumDecoders) lookup(match Match, value any) DatumDecoder {
+	if scriptHash, ok := match.PaymentScriptHash(); ok {
+		if decoder, ok := r.byScript[scriptHash]; ok {
+			return decoder
+		}
+	}
+	for _, entry := range r.byShape {
+		if entry.shape(value) {
+			return entry.decoder
+		}
+	}
+	return nil
+}
+
+// WithDatumDecoders sets the registry used to decode datums of enriched
+// matches
+func WithDatumDecoders(decoders *DatumDecoders) ClientOption {
+	return func(c *Client) {
+		c.datumDecoders = decoders
+	}
+}
+
+// EnrichedMatch is a match with its resolved and decoded datum
+type EnrichedMatch struct {
+	Match Match
+	// Datum is the hex encoded CBOR datum, empty if the output has none or
+	// Kupo does not know it
+	Datum string
+	// DecodedDatum is the value returned by the registered datum decoder, nil
+	// if no decoder applies
+	DecodedDatum any
+}
+
+type EnrichedMatches []EnrichedMatch
+
+// GetEnrichedMatches is like GetMatches, additionally resolving the datum of
+// each match and decoding it with the client's datum decoders
+func (c *Client) GetEnrichedMatches(pattern string, opts MatchOptions) (EnrichedMatches, error) {
+	return c.GetEnrichedMatchesContext(context.Background(), pattern, opts)
+}
+
+// GetEnrichedMatchesContext is like GetEnrichedMatches with a request context
+func (c *Client) GetEnrichedMatchesContext(
+	ctx context.Context,
+	pattern string,
+	opts MatchOptions,
+) (EnrichedMatches, error) {
+	matches, _, err := c.getMatches(ctx, pattern, opts)
+	if err != nil {
+		return nil, err
+	}
+	decoders := c.datumDecoders
+	if decoders == nil {
+		decoders = DefaultDatumDecoders
+	}
+	// Outputs often share datums, which are immutable
+	datums := make(map[string]string)
+	ret := make(EnrichedMatches, 0, len(*matches))
+	for _, match := range *matches {
+		enriched := EnrichedMatch{Match: match}
+		if match.DatumHash != nil {
+			hash := *match.DatumHash
+			datum, ok := datums[hash]
+			if !ok {
+				resp, err := c.GetDatumByHashContext(ctx, hash)
+				if err != nil {
+					return nil, err
+				}
+				if resp != nil {
+					datum = resp.Datum
+				}
+				datums[hash] = datum
+			}
+			enriched.Datum = datum
+		}
+		if enriched.Datum != "" {
+			decoded, _, err := decoders.Decode(match, enriched.Datum)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode datum of %s: %s", match.OutputReference(), err)
+			}
+			enriched.DecodedDatum = decoded
+		}
+		ret = append(ret, enriched)
+	}
+	return ret, nil
+}
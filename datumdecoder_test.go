@@ -0,0 +1,85 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testPool struct {
+	Reserve int64
+}
+
+func TestGetEnrichedMatches(t *testing.T) {
+	scriptHash := "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+	poolDatum, otherDatum := "aa", "bb"
+	matches := Matches{
+		{TransactionID: "t1", Address: "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx", DatumHash: &poolDatum},
+		{TransactionID: "t2", Address: "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8", DatumHash: &otherDatum},
+		{TransactionID: "t3", Address: "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8", DatumHash: &otherDatum},
+		{TransactionID: "t4", Address: "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"},
+	}
+	datumRequests := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch strings.TrimPrefix(r.URL.Path, "/datums/") {
+			case "aa":
+				datumRequests++
+				// Constr 0 [42]
+				_, _ = w.Write([]byte(`{"datum":"d8799f182aff"}`))
+			case "bb":
+				datumRequests++
+				// Constr 1 []
+				_, _ = w.Write([]byte(`{"datum":"d87a80"}`))
+			default:
+				_ = json.NewEncoder(w).Encode(matches)
+			}
+		}),
+	)
+	defer server.Close()
+	decoders := NewDatumDecoders()
+	decoders.RegisterScript(scriptHash, func(match Match, datum any) (any, error) {
+		constr := datum.(Constr)
+		return testPool{Reserve: constr.Fields[0].(*big.Int).Int64()}, nil
+	})
+	decoders.RegisterShape(
+		func(datum any) bool {
+			constr, ok := datum.(Constr)
+			return ok && constr.Index == 1
+		},
+		func(match Match, datum any) (any, error) {
+			return "closed", nil
+		},
+	)
+	client := NewClient(server.URL, WithDatumDecoders(decoders))
+
+	enriched, err := client.GetEnrichedMatches("*", MatchOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(enriched) != 4 || datumRequests != 2 {
+		t.Fatalf("Expected 4 matches and 2 datum requests, got %d and %d", len(enriched), datumRequests)
+	}
+	if pool, ok := enriched[0].DecodedDatum.(testPool); !ok || pool.Reserve != 42 {
+		t.Fatalf("Unexpected decoded datum %#v", enriched[0].DecodedDatum)
+	}
+	if enriched[1].DecodedDatum != "closed" || enriched[2].Datum != "d87a80" {
+		t.Fatalf("Unexpected shape decoded match %+v", enriched[1])
+	}
+	if enriched[3].Datum != "" || enriched[3].DecodedDatum != nil {
+		t.Fatalf("Expected match without datum to be left alone, got %+v", enriched[3])
+	}
+
+	failing := NewDatumDecoders()
+	failing.RegisterScript(scriptHash, func(match Match, datum any) (any, error) {
+		return nil, errors.New("bad pool")
+	})
+	client = NewClient(server.URL, WithDatumDecoders(failing))
+	if _, err := client.GetEnrichedMatches("*", MatchOptions{}); err == nil {
+		t.Fatalf("Expected decoder error")
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/blinklabs-io/kupogo/internal/cbor"
+)
+
+// Byte strings longer than this are encoded in chunks, as done by the ledger
+const datumChunkSize = 64
+
+// DatumToJSON converts a hex encoded CBOR datum, as returned by
+// GetDatumByHash, to the "detailed schema" JSON used by cardano-cli and
+// Ogmios
+func DatumToJSON(datum string) (json.RawMessage, error) {
+	value, err := DecodeDatum(datum)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalDatumJSON(value)
+}
+
+// DatumFromJSON converts a datum in the "detailed schema" JSON to hex encoded
+// CBOR. The datum is encoded as cardano-node does, which may differ from the
+// encoding originally found on-chain and so change its hash
+func DatumFromJSON(data []byte) (string, error) {
+	value, err := UnmarshalDatumJSON(data)
+	if err != nil {
+		return "", err
+	}
+	cborData, err := EncodeDatum(value)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(cborData), nil
+}
+
+// MarshalDatumJSON converts a datum value, as returned by DecodeDatum, to the
+// "detailed schema" JSON. Integers may also be given as int
+func MarshalDatumJSON(value any) (json.RawMessage, error) {
+	jsonValue, err := datumJSONValue(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonValue)
+}
+
+func datumJSONValue(value any) (any, error) {
+	switch v := value.(type) {
+	case Constr:
+		fields, err := datumJSONList(v.Fields)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"constructor": v.Index, "fields": fields}, nil
+	case *big.Int:
+		return map[string]any{"int": json.Number(v.String())}, nil
+	case int:
+		return map[string]any{"int": v}, nil
+	case []byte:
+		return map[string]any{"bytes": hex.EncodeToString(v)}, nil
+	case []any:
+		items, err := datumJSONList(v)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"list": items}, nil
+	case PlutusMap:
+		entries := make([]map[string]any, 0, len(v))
+		for _, entry := range v {
+			key, err := datumJSONValue(entry.Key)
+			if err != nil {
+				return nil, err
+			}
+			value, err := datumJSONValue(entry.Value)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, map[string]any{"k": key, "v": value})
+		}
+		return map[string]any{"map": entries}, nil
+	}
+	return nil, fmt.Errorf("unexpected %T in datum", value)
+}
+
+func datumJSONList(items []any) ([]any, error) {
+	ret := make([]any, 0, len(items))
+	for _, item := range items {
+		value, err := datumJSONValue(item)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, value)
+	}
+	return ret, nil
+}
+
+// UnmarshalDatumJSON parses a datum in the "detailed schema" JSON into the
+// values returned by DecodeDatum
+func UnmarshalDatumJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var raw any
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to parse datum JSON: %s", err)
+	}
+	return datumFromJSONValue(raw)
+}
+
+func datumFromJSONValue(raw any) (any, error) {
+	object, ok := raw.(map[string]any)
+	if !ok {
+		return nil, errors.New("datum JSON values must be objects")
+	}
+	if constructor, ok := object["constructor"]; ok {
+		index, err := datumJSONInt(constructor)
+		if err != nil || !index.IsUint64() {
+			return nil, fmt.Errorf("invalid constructor %v", constructor)
+		}
+		fields, err := datumFromJSONList(object["fields"])
+		if err != nil {
+			return nil, err
+		}
+		return Constr{Index: index.Uint64(), Fields: fields}, nil
+	}
+	if value, ok := object["int"]; ok {
+		return datumJSONInt(value)
+	}
+	if value, ok := object["bytes"]; ok {
+		text, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid bytes %v", value)
+		}
+		ret, err := hex.DecodeString(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bytes %q: %s", text, err)
+		}
+		return ret, nil
+	}
+	if value, ok := object["list"]; ok {
+		return datumFromJSONList(value)
+	}
+	if value, ok := object["map"]; ok {
+		entries, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid map %v", value)
+		}
+		ret := make(PlutusMap, 0, len(entries))
+		for _, entry := range entries {
+			pair, ok := entry.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid map entry %v", entry)
+			}
+			key, err := datumFromJSONValue(pair["k"])
+			if err != nil {
+				return nil, err
+			}
+			value, err := datumFromJSONValue(pair["v"])
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, PlutusMapEntry{Key: key, Value: value})
+		}
+		return ret, nil
+	}
+	return nil, fmt.Errorf("unknown datum JSON object %v", object)
+}
+
+func datumFromJSONList(raw any) ([]any, error) {
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid list %v", raw)
+	}
+	ret := make([]any, 0, len(items))
+	for _, item := range items {
+		value, err := datumFromJSONValue(item)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, value)
+	}
+	return ret, nil
+}
+
+func datumJSONInt(raw any) (*big.Int, error) {
+	number, ok := raw.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %v", raw)
+	}
+	ret, ok := new(big.Int).SetString(number.String(), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %s", number)
+	}
+	return ret, nil
+}
+
+// EncodeDatum encodes a datum value, as returned by DecodeDatum, to CBOR as
+// cardano-node does: non-empty lists are indefinite-length and byte strings
+// are chunked every 64 bytes. Integers may also be given as int
+func EncodeDatum(value any) ([]byte, error) {
+	return appendDatum(nil, value)
+}
+
+func appendDatum(b []byte, value any) ([]byte, error) {
+	var err error
+	switch v := value.(type) {
+	case Constr:
+		switch {
+		case v.Index <= 6:
+			b = cbor.AppendTag(b, 121+v.Index)
+		case v.Index <= 127:
+			b = cbor.AppendTag(b, 1280+v.Index-7)
+		default:
+			b = cbor.AppendTag(b, 102)
+			b = cbor.AppendArrayHeader(b, 2)
+			b = cbor.AppendUint(b, v.Index)
+		}
+		return appendDatumList(b, v.Fields)
+	case *big.Int:
+		return appendDatumInt(b, v), nil
+	case int:
+		return cbor.AppendInt(b, int64(v)), nil
+	case []byte:
+		return appendDatumBytes(b, v), nil
+	case []any:
+		return appendDatumList(b, v)
+	case PlutusMap:
+		b = cbor.AppendMapHeader(b, len(v))
+		for _, entry := range v {
+			if b, err = appendDatum(b, entry.Key); err != nil {
+				return nil, err
+			}
+			if b, err = appendDatum(b, entry.Value); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("unexpected %T in datum", value)
+}
+
+func appendDatumList(b []byte, items []any) ([]byte, error) {
+	if len(items) == 0 {
+		return cbor.AppendArrayHeader(b, 0), nil
+	}
+	b = append(b, cbor.MajorTypeArray<<5|31)
+	var err error
+	for _, item := range items {
+		if b, err = appendDatum(b, item); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, 0xff), nil
+}
+
+func appendDatumInt(b []byte, n *big.Int) []byte {
+	if n.IsUint64() {
+		return cbor.AppendUint(b, n.Uint64())
+	}
+	if n.Sign() < 0 {
+		// Negative integers encode -1 - n
+		abs := new(big.Int).Neg(n)
+		abs.Sub(abs, big.NewInt(1))
+		if abs.IsUint64() {
+			return cbor.AppendHead(b, cbor.MajorTypeNegInt, abs.Uint64())
+		}
+		b = cbor.AppendTag(b, cbor.TagNegativeBignum)
+		return appendDatumBytes(b, abs.Bytes())
+	}
+	b = cbor.AppendTag(b, cbor.TagPositiveBignum)
+	return appendDatumBytes(b, n.Bytes())
+}
+
+func appendDatumBytes(b []byte, data []byte) []byte {
+	if len(data) <= datumChunkSize {
+		return cbor.AppendBytes(b, data)
+	}
+	b = append(b, cbor.MajorTypeBytes<<5|31)
+	for len(data) > 0 {
+		n := len(data)
+		if n > datumChunkSize {
+			n = datumChunkSize
+		}
+		b = cbor.AppendBytes(b, data[:n])
+		data = data[n:]
+	}
+	return append(b, 0xff)
+}
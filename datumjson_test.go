@@ -0,0 +1,99 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDatumJSON(t *testing.T) {
+	testDefs := []struct {
+		datum string
+		json  string
+	}{
+		{
+			// Constr 0 [1000000, h'abcd']
+			datum: "d8799f1a000f424042abcdff",
+			json:  `{"constructor":0,"fields":[{"int":1000000},{"bytes":"abcd"}]}`,
+		},
+		{
+			datum: "d87a80",
+			json:  `{"constructor":1,"fields":[]}`,
+		},
+		{
+			datum: "d905009f24ff",
+			json:  `{"constructor":7,"fields":[{"int":-5}]}`,
+		},
+		{
+			datum: "d8668218c880",
+			json:  `{"constructor":200,"fields":[]}`,
+		},
+		{
+			datum: "a141019f02ff",
+			json:  `{"map":[{"k":{"bytes":"01"},"v":{"list":[{"int":2}]}}]}`,
+		},
+		{
+			// 2^64
+			datum: "c249010000000000000000",
+			json:  `{"int":18446744073709551616}`,
+		},
+		{
+			// -2^64 - 2
+			datum: "c349010000000000000001",
+			json:  `{"int":-18446744073709551618}`,
+		},
+	}
+	for _, testDef := range testDefs {
+		data, err := DatumToJSON(testDef.datum)
+		if err != nil {
+			t.Fatalf("Expected no error converting %s, got %s", testDef.datum, err)
+		}
+		if string(data) != testDef.json {
+			t.Fatalf("Expected %s for %s, got %s", testDef.json, testDef.datum, data)
+		}
+		datum, err := DatumFromJSON([]byte(testDef.json))
+		if err != nil {
+			t.Fatalf("Expected no error converting %s, got %s", testDef.json, err)
+		}
+		if datum != testDef.datum {
+			t.Fatalf("Expected %s for %s, got %s", testDef.datum, testDef.json, datum)
+		}
+	}
+}
+
+func TestDatumJSONLongBytes(t *testing.T) {
+	long := strings.Repeat("ab", 65)
+	datum, err := DatumFromJSON([]byte(`{"bytes":"` + long + `"}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := "5f5840" + strings.Repeat("ab", 64) + "41abff"
+	if datum != expected {
+		t.Fatalf("Expected chunked bytes %s, got %s", expected, datum)
+	}
+	data, err := DatumToJSON(datum)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	var decoded map[string]string
+	if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(decoded, map[string]string{"bytes": long}) {
+		t.Fatalf("Unexpected JSON %s", data)
+	}
+}
+
+func TestDatumFromJSONInvalid(t *testing.T) {
+	for _, input := range []string{
+		`[]`,
+		`{"int":"1"}`,
+		`{"int":1.5}`,
+		`{"bytes":"zz"}`,
+		`{"constructor":-1,"fields":[]}`,
+		`{"constructor":0}`,
+		`{"other":1}`,
+	} {
+		if _, err := DatumFromJSON([]byte(input)); err == nil {
+			t.Fatalf("Expected error for %s", input)
+		}
+	}
+}
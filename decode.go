@@ -0,0 +1,361 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+)
+
+// errInvalidArray is returned when decoding matches from anything but a JSON
+// array
+var errInvalidArray = errors.New("invalid JSON array")
+
+// decodeJSON decodes a response body into v. The body is read into a pooled
+// buffer, which json.Decoder would otherwise allocate afresh to hold the whole
+// value, so only one copy of it is held and the buffer is reused across calls.
+// Decode failures are logged
+func (c *Client) decodeJSON(req *http.Request, body io.Reader, v any) error {
+	buf := getBuffer()
+	defer putBuffer(buf)
+	_, err := buf.ReadFrom(body)
+	if err == nil {
+		if c.jsonNumbers {
+			decoder := json.NewDecoder(bytes.NewReader(buf.Bytes()))
+			decoder.UseNumber()
+			err = decoder.Decode(v)
+		} else {
+			err = json.Unmarshal(buf.Bytes(), v)
+		}
+	}
+	if err != nil {
+		c.logDecodeFailure(req, err)
+		return err
+	}
+	return nil
+}
+
+// matchKey appears exactly once in each match of a response
+var matchKey = []byte(`"transaction_id"`)
+
+// decodeMatches decodes an array of matches with Matches.UnmarshalJSON
+func (c *Client) decodeMatches(req *http.Request, body io.Reader) (*Matches, error) {
+	if keep := matchFilter(req); keep != nil {
+		return c.decodeFilteredMatches(req, body, keep)
+	}
+	buf := getBuffer()
+	defer putBuffer(buf)
+	_, err := buf.ReadFrom(body)
+	if err != nil {
+		c.logDecodeFailure(req, err)
+		return nil, err
+	}
+	var matches Matches
+	if err := json.Unmarshal(buf.Bytes(), &matches); err != nil {
+		c.logDecodeFailure(req, err)
+		return nil, err
+	}
+	return &matches, nil
+}
+
+// UnmarshalJSON decodes an array of matches into a slice pre-sized from the
+// number of matches, avoiding repeated growth of large results. The strings
+// repeated across the matches are interned for the duration of the decode,
+// so that they share their memory
+func (m *Matches) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*m = nil
+		return nil
+	}
+	ret := (*m)[:0]
+	if size := bytes.Count(data, matchKey); cap(ret) < size {
+		ret = make(Matches, 0, size)
+	}
+	decoder := newMatchDecoder()
+	err := forEachElement(data, func(element []byte) error {
+		ret = append(ret, Match{})
+		return decoder.decode(element, &ret[len(ret)-1])
+	})
+	if err != nil {
+		return err
+	}
+	*m = ret
+	return nil
+}
+
+// UnmarshalJSON decodes a single match. Matches decoded together share the
+// strings repeated across them, see Matches.UnmarshalJSON
+func (m *Match) UnmarshalJSON(data []byte) error {
+	return (&matchDecoder{}).decode(data, m)
+}
+
+// wireMatch drops the methods of Match, avoiding recursion when decoding
+type wireMatch Match
+
+// matchDecoder decodes the matches of a single decode, interning the strings
+// repeated across them. Its state is reused from one match to the next
+type matchDecoder struct {
+	interner *stringInterner
+	wire     struct {
+		*wireMatch
+		// Shadows the value of the match, so that its assets are decoded
+		// with the interner
+		Value struct {
+			Coins  int           `json:"coins"`
+			Assets assetsDecoder `json:"assets"`
+		} `json:"value"`
+	}
+	// match is decoded into by UnmarshalJSON, for json.Decoder
+	match *Match
+}
+
+func newMatchDecoder() *matchDecoder {
+	return &matchDecoder{interner: newStringInterner()}
+}
+
+func (d *matchDecoder) UnmarshalJSON(data []byte) error {
+	return d.decode(data, d.match)
+}
+
+// decode decodes a match, interning its strings
+func (d *matchDecoder) decode(data []byte, m *Match) error {
+	d.wire.wireMatch = (*wireMatch)(m)
+	d.wire.Value.Coins = m.Value.Coins
+	d.wire.Value.Assets = assetsDecoder{assets: &m.Value.Assets, interner: d.interner}
+	if err := json.Unmarshal(data, &d.wire); err != nil {
+		return err
+	}
+	m.Value.Coins = d.wire.Value.Coins
+	m.Address = d.interner.internString(m.Address)
+	m.CreatedAt.HeaderHash = d.interner.internString(m.CreatedAt.HeaderHash)
+	if m.SpentAt != nil {
+		m.SpentAt.HeaderHash = d.interner.internString(m.SpentAt.HeaderHash)
+	}
+	if m.DatumType != nil && d.interner != nil {
+		datumType := d.interner.internString(*m.DatumType)
+		m.DatumType = &datumType
+	}
+	return nil
+}
+
+// assetsDecoder decodes assets with the interner of the surrounding decode
+type assetsDecoder struct {
+	assets   *Assets
+	interner *stringInterner
+}
+
+func (d assetsDecoder) UnmarshalJSON(data []byte) error {
+	return d.assets.decode(data, d.interner)
+}
+
+// forEachElement calls fn with each element of a JSON array. The elements are
+// only delimited here, and validated by fn as they are decoded
+func forEachElement(data []byte, fn func([]byte) error) error {
+	i := skipSpace(data, 0)
+	if i >= len(data) || data[i] != '[' {
+		return errInvalidArray
+	}
+	i = skipSpace(data, i+1)
+	if i < len(data) && data[i] == ']' {
+		if skipSpace(data, i+1) != len(data) {
+			return errInvalidArray
+		}
+		return nil
+	}
+	for {
+		end, ok := valueEnd(data, i)
+		if !ok {
+			return errInvalidArray
+		}
+		if err := fn(data[i:end]); err != nil {
+			return err
+		}
+		i = skipSpace(data, end)
+		if i >= len(data) {
+			return errInvalidArray
+		}
+		if data[i] == ']' {
+			break
+		}
+		if data[i] != ',' {
+			return errInvalidArray
+		}
+		i = skipSpace(data, i+1)
+	}
+	if skipSpace(data, i+1) != len(data) {
+		return errInvalidArray
+	}
+	return nil
+}
+
+// valueEnd returns the end of the JSON value starting at i, which is followed
+// by a comma or the end of the enclosing array
+func valueEnd(data []byte, i int) (int, bool) {
+	depth := 0
+	for ; i < len(data); i++ {
+		switch data[i] {
+		case '"':
+			// Skip over the string, including escaped quotes
+			for i++; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' {
+					i++
+				}
+			}
+		case '{', '[':
+			depth++
+		case '}', ']':
+			if depth == 0 {
+				return i, true
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				return i, true
+			}
+		}
+	}
+	return i, false
+}
+
+// UnmarshalJSON decodes assets into a map sized for the number of entries.
+// Outputs without assets are left with a nil map
+func (a *Assets) UnmarshalJSON(data []byte) error {
+	return a.decode(data, nil)
+}
+
+// decode decodes assets, interning the asset IDs with the interner
+func (a *Assets) decode(data []byte, interner *stringInterner) error {
+	if bytes.Equal(data, []byte("null")) {
+		*a = nil
+		return nil
+	}
+	if assets, ok := parseAssets(data, interner); ok {
+		if *a == nil {
+			*a = assets
+			return nil
+		}
+		for assetID, quantity := range assets {
+			(*a)[assetID] = quantity
+		}
+		return nil
+	}
+	// Anything unusual, such as escaped keys, goes through the standard
+	// decoding
+	var assets map[string]int
+	if err := json.Unmarshal(data, &assets); err != nil {
+		return err
+	}
+	if *a == nil && len(assets) > 0 {
+		*a = make(Assets, len(assets))
+	}
+	for assetID, quantity := range assets {
+		(*a)[interner.internString(assetID)] = quantity
+	}
+	return nil
+}
+
+// parseAssets parses a flat JSON object of integer quantities without
+// allocating its keys, returning false if data is not in that simple form.
+// Asset IDs are hex, so each colon separates a key from its quantity
+func parseAssets(data []byte, interner *stringInterner) (Assets, bool) {
+	i := skipSpace(data, 0)
+	if i >= len(data) || data[i] != '{' {
+		return nil, false
+	}
+	i = skipSpace(data, i+1)
+	if i < len(data) && data[i] == '}' {
+		return nil, skipSpace(data, i+1) == len(data)
+	}
+	ret := make(Assets, bytes.Count(data, []byte{':'}))
+	for {
+		if i >= len(data) || data[i] != '"' {
+			return nil, false
+		}
+		end := bytes.IndexByte(data[i+1:], '"')
+		if end < 0 {
+			return nil, false
+		}
+		key := data[i+1 : i+1+end]
+		if bytes.IndexByte(key, '\\') >= 0 {
+			return nil, false
+		}
+		i = skipSpace(data, i+2+end)
+		if i >= len(data) || data[i] != ':' {
+			return nil, false
+		}
+		i = skipSpace(data, i+1)
+		quantity, n, ok := parseInt(data[i:])
+		if !ok {
+			return nil, false
+		}
+		ret[interner.intern(key)] = quantity
+		i = skipSpace(data, i+n)
+		if i >= len(data) {
+			return nil, false
+		}
+		if data[i] == '}' {
+			break
+		}
+		if data[i] != ',' {
+			return nil, false
+		}
+		i = skipSpace(data, i+1)
+	}
+	return ret, skipSpace(data, i+1) == len(data)
+}
+
+func skipSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// parseInt parses the JSON integer at the start of data, returning its value
+// and length. Fractions, exponents and overflowing values are rejected
+func parseInt(data []byte) (int, int, bool) {
+	i := 0
+	negative := false
+	if i < len(data) && data[i] == '-' {
+		negative = true
+		i++
+	}
+	start := i
+	value := 0
+	for i < len(data) && data[i] >= '0' && data[i] <= '9' {
+		digit := int(data[i] - '0')
+		if value > (math.MaxInt-digit)/10 {
+			return 0, 0, false
+		}
+		value = value*10 + digit
+		i++
+	}
+	if i == start || (i < len(data) && (data[i] == '.' || data[i] == 'e' || data[i] == 'E')) {
+		return 0, 0, false
+	}
+	if negative {
+		value = -value
+	}
+	return value, i, true
+}
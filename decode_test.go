@@ -0,0 +1,247 @@
+package kupogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestDecodeMatchesPresized(t *testing.T) {
+	body := `[
+		{"transaction_id":"aa","output_index":0,"address":"addr1","value":{"coins":1,"assets":{"pp.01":1,"pp.02":2}},"created_at":{"slot_no":1}},
+		{"transaction_id":"bb","output_index":1,"address":"addr1","value":{"coins":2},"created_at":{"slot_no":2}}
+	]`
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/matches", nil)
+	matches, err := NewClient("").decodeMatches(req, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(*matches) != 2 || cap(*matches) != 2 {
+		t.Fatalf("Expected 2 matches with capacity 2, got %d/%d", len(*matches), cap(*matches))
+	}
+	expected := Assets{"pp.01": 1, "pp.02": 2}
+	if !reflect.DeepEqual((*matches)[0].Value.Assets, expected) {
+		t.Fatalf("Expected assets %v, got %v", expected, (*matches)[0].Value.Assets)
+	}
+	if (*matches)[1].Value.Assets != nil {
+		t.Fatalf("Expected no assets, got %v", (*matches)[1].Value.Assets)
+	}
+}
+
+func TestAssetsUnmarshalNull(t *testing.T) {
+	value := Value{Assets: Assets{"pp": 1}}
+	if err := json.Unmarshal([]byte(`{"coins":1,"assets":null}`), &value); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if value.Assets != nil {
+		t.Fatalf("Expected nil assets, got %v", value.Assets)
+	}
+}
+
+func TestAssetsUnmarshal(t *testing.T) {
+	testDefs := []struct {
+		data     string
+		expected Assets
+	}{
+		{`{}`, nil},
+		{` { } `, nil},
+		{`{"pp.01":1}`, Assets{"pp.01": 1}},
+		{`{ "pp.01" : 1 , "pp" : -2 }`, Assets{"pp.01": 1, "pp": -2}},
+		{`{"pp":3}`, Assets{"pp": 3}},
+	}
+	for _, testDef := range testDefs {
+		var assets Assets
+		if err := json.Unmarshal([]byte(testDef.data), &assets); err != nil {
+			t.Errorf("Expected no error for %s, got %s", testDef.data, err)
+			continue
+		}
+		if !reflect.DeepEqual(assets, testDef.expected) {
+			t.Errorf("Expected %#v for %s, got %#v", testDef.expected, testDef.data, assets)
+		}
+	}
+	for _, data := range []string{`{"pp":1.5}`, `{"pp":99999999999999999999}`, `[]`} {
+		var assets Assets
+		if err := json.Unmarshal([]byte(data), &assets); err == nil {
+			t.Errorf("Expected error for %s", data)
+		}
+	}
+}
+
+func TestMatchesInternStrings(t *testing.T) {
+	body := `[
+		{"transaction_id":"aa","output_index":0,"address":"addr_shared","value":{"coins":1,"assets":{"pp.01":1}},"created_at":{"slot_no":1,"header_hash":"hh"}},
+		{"transaction_id":"bb","output_index":1,"address":"addr_shared","value":{"coins":2,"assets":{"pp.01":2}},"created_at":{"slot_no":1,"header_hash":"hh"}}
+	]`
+	var matches Matches
+	if err := json.Unmarshal([]byte(body), &matches); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if unsafe.StringData(matches[0].Address) != unsafe.StringData(matches[1].Address) {
+		t.Errorf("Expected addresses to share memory")
+	}
+	if unsafe.StringData(matches[0].CreatedAt.HeaderHash) != unsafe.StringData(matches[1].CreatedAt.HeaderHash) {
+		t.Errorf("Expected header hashes to share memory")
+	}
+	var keys []string
+	for _, match := range matches {
+		for assetID := range match.Value.Assets {
+			keys = append(keys, assetID)
+		}
+	}
+	if unsafe.StringData(keys[0]) != unsafe.StringData(keys[1]) {
+		t.Errorf("Expected asset IDs to share memory")
+	}
+}
+
+func TestMatchesUnmarshalArray(t *testing.T) {
+	body := `[ {"transaction_id":"a]\",\\","address":"x,y","value":{"coins":1}} ,
+		{"transaction_id":"cc","datum_type":"hash","value":{"coins":2,"assets":{"pp":[1][0]}}} ]`
+	var matches Matches
+	err := json.Unmarshal([]byte(body), &matches)
+	if err == nil {
+		t.Fatalf("Expected an error for invalid assets")
+	}
+	body = strings.Replace(body, `[1][0]`, `3`, 1)
+	if err := json.Unmarshal([]byte(body), &matches); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(matches) != 2 || matches[0].TransactionID != `a]",\` || matches[0].Address != "x,y" ||
+		matches[1].Value.Assets["pp"] != 3 || *matches[1].DatumType != "hash" {
+		t.Fatalf("Unexpected matches: %+v", matches)
+	}
+	for _, data := range []string{`{}`, `[{},]`, `[{}`, `[{} {}]`, `[{}]x`, `[,]`} {
+		if err := matches.UnmarshalJSON([]byte(data)); err == nil {
+			t.Errorf("Expected error for %s", data)
+		}
+	}
+	if err := matches.UnmarshalJSON([]byte(` [ ] `)); err != nil || len(matches) != 0 {
+		t.Errorf("Expected no matches, got %v/%v", matches, err)
+	}
+}
+
+func TestMatchesInternScopedToDecode(t *testing.T) {
+	body := []byte(`[{"transaction_id":"aa","address":"addr_shared","value":{"coins":1}}]`)
+	var first, second Matches
+	if err := json.Unmarshal(body, &first); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := json.Unmarshal(body, &second); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if unsafe.StringData(first[0].Address) == unsafe.StringData(second[0].Address) {
+		t.Errorf("Expected separate decodes not to share strings")
+	}
+}
+
+// benchmarkMatchesBody returns the response to a wildcard pattern with n
+// matches, spread over a few addresses, blocks and policies
+func benchmarkMatchesBody(n int) []byte {
+	matches := make(Matches, 0, n)
+	for i := 0; i < n; i++ {
+		match := Match{
+			TransactionID: fmt.Sprintf("%064x", i),
+			OutputIndex:   i % 3,
+			Address:       fmt.Sprintf("addr1q%058x", i%50),
+			Value:         Value{Coins: 1000000 + i},
+			CreatedAt:     Point{SlotNo: 1000 + i/20, HeaderHash: fmt.Sprintf("%064x", i/20)},
+		}
+		// Most outputs carry no assets
+		if i%4 == 0 {
+			match.Value.Assets = Assets{
+				fmt.Sprintf("%056x.%x", i%10, "token"): i,
+				fmt.Sprintf("%056x", i%7):              1,
+			}
+		}
+		matches = append(matches, match)
+	}
+	body, _ := json.Marshal(matches)
+	return body
+}
+
+func BenchmarkDecodeMatches(b *testing.B) {
+	body := benchmarkMatchesBody(10000)
+	client := NewClient("")
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/matches/*", nil)
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.decodeMatches(req, bytes.NewReader(body)); err != nil {
+			b.Fatalf("Expected no error, got %s", err)
+		}
+	}
+}
+
+func BenchmarkDecodeMatchesParallel(b *testing.B) {
+	body := benchmarkMatchesBody(1000)
+	client := NewClient("")
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/matches/*", nil)
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := client.decodeMatches(req, bytes.NewReader(body)); err != nil {
+				b.Errorf("Expected no error, got %s", err)
+				return
+			}
+		}
+	})
+}
+
+func BenchmarkDecodeFilteredMatches(b *testing.B) {
+	body := benchmarkMatchesBody(10000)
+	client := NewClient("")
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/matches/*", nil)
+	req = req.WithContext(WithCallOptions(req.Context(), MatchFilter(func(match Match) bool {
+		return match.Value.Assets != nil
+	})))
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.decodeMatches(req, bytes.NewReader(body)); err != nil {
+			b.Fatalf("Expected no error, got %s", err)
+		}
+	}
+}
+
+func BenchmarkDecodeJSON(b *testing.B) {
+	checkpoints := make(Checkpoints, 0, 10000)
+	for i := 0; i < 10000; i++ {
+		checkpoints = append(checkpoints, Point{SlotNo: i, HeaderHash: fmt.Sprintf("%064x", i)})
+	}
+	body, _ := json.Marshal(checkpoints)
+	client := NewClient("")
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/checkpoints", nil)
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var decoded Checkpoints
+		if err := client.decodeJSON(req, bytes.NewReader(body), &decoded); err != nil {
+			b.Fatalf("Expected no error, got %s", err)
+		}
+	}
+}
+
+func BenchmarkAssetsUnmarshal(b *testing.B) {
+	assets := make(Assets, 100)
+	for i := 0; i < 100; i++ {
+		assets[fmt.Sprintf("%056x.%02x", i%5, i)] = i
+	}
+	data, _ := json.Marshal(assets)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var decoded Assets
+		if err := decoded.UnmarshalJSON(data); err != nil {
+			b.Fatalf("Expected no error, got %s", err)
+		}
+	}
+}
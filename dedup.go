@@ -0,0 +1,55 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+// Dedup returns the matches with duplicate outputs removed. Outputs are keyed
+// by transaction ID and output index, the first occurrence determines the
+// position in the result, and the most informative copy of each output is
+// kept (e.g. one carrying spent_at over one that does not)
+func (m Matches) Dedup() Matches {
+	ret := make(Matches, 0, len(m))
+	positions := make(map[OutputReference]int, len(m))
+	for _, match := range m {
+		ref := match.OutputReference()
+		if idx, ok := positions[ref]; ok {
+			if matchInformation(match) > matchInformation(ret[idx]) {
+				ret[idx] = match
+			}
+			continue
+		}
+		positions[ref] = len(ret)
+		ret = append(ret, match)
+	}
+	return ret
+}
+
+// matchInformation scores how much optional information a match carries
+func matchInformation(m Match) int {
+	score := 0
+	if m.SpentAt != nil {
+		// A spent copy supersedes anything else we might know about the output
+		score += 8
+	}
+	if m.DatumHash != nil {
+		score++
+	}
+	if m.DatumType != nil {
+		score++
+	}
+	if m.ScriptHash != nil {
+		score++
+	}
+	return score
+}
@@ -0,0 +1,31 @@
+package kupogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatches_Dedup(t *testing.T) {
+	datumHash := "34215ad90b1ade84f5b4fe3c0a16cb3afeae468210535e0305efd93931f35059"
+	spentAt := &Point{SlotNo: 200, HeaderHash: "bb"}
+	matches := Matches{
+		{TransactionID: "aa", OutputIndex: 0, CreatedAt: Point{SlotNo: 100}},
+		{TransactionID: "aa", OutputIndex: 1, CreatedAt: Point{SlotNo: 100}},
+		{TransactionID: "aa", OutputIndex: 0, CreatedAt: Point{SlotNo: 100}, SpentAt: spentAt},
+		{TransactionID: "bb", OutputIndex: 0, DatumHash: &datumHash},
+		{TransactionID: "bb", OutputIndex: 0},
+		{TransactionID: "aa", OutputIndex: 1, CreatedAt: Point{SlotNo: 100}},
+	}
+	expected := Matches{
+		{TransactionID: "aa", OutputIndex: 0, CreatedAt: Point{SlotNo: 100}, SpentAt: spentAt},
+		{TransactionID: "aa", OutputIndex: 1, CreatedAt: Point{SlotNo: 100}},
+		{TransactionID: "bb", OutputIndex: 0, DatumHash: &datumHash},
+	}
+	deduped := matches.Dedup()
+	if !reflect.DeepEqual(deduped, expected) {
+		t.Errorf("Expected matches %v, got %v", expected, deduped)
+	}
+	if len(matches) != 6 {
+		t.Errorf("Expected original matches to be left untouched")
+	}
+}
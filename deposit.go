@@ -0,0 +1,243 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Deposit is an output credited to a monitored address
+type Deposit struct {
+	Address         string
+	OutputReference OutputReference
+	Value           Value
+	CreatedAt       Point
+}
+
+// DepositMonitorConfig configures a DepositMonitor
+type DepositMonitorConfig struct {
+	// Pattern queried for new outputs, defaulting to "*". Querying all
+	// outputs is only efficient when Kupo indexes little besides the
+	// monitored addresses
+	Pattern string
+	// StartSlot is the initial cursor: only outputs created after it are
+	// reported
+	StartSlot int
+	// ChunkSize is the number of patterns registered per request, defaulting
+	// to 500
+	ChunkSize int
+	// RegisterPatterns adds a pattern for each monitored address, rolling
+	// back to RollbackTo
+	RegisterPatterns bool
+	RollbackTo       Point
+	RollbackLimit    RollbackLimit
+	// PollInterval defaults to 10 seconds
+	PollInterval time.Duration
+	// OnDeposit is called for each deposit found while running. Deposits are
+	// reported as soon as they are seen, so they may still be rolled back:
+	// wait for finality, such as with GetConfirmations, before acting on
+	// them irreversibly
+	OnDeposit func(Deposit)
+	// OnRetract is called with each reported deposit which was rolled back
+	// off the chain. The output is reported again if it is included anew
+	OnRetract func(Deposit)
+	OnError   func(error)
+	// RetractWindow is the number of slots during which reported deposits are
+	// tracked for rollbacks, defaulting to 129600, Kupo's safe zone on
+	// mainnet
+	RetractWindow int
+}
+
+const (
+	defaultDepositPattern   = "*"
+	defaultDepositChunkSize = 500
+	defaultRetractWindow    = 129600
+)
+
+// DepositMonitor reports credits to a large set of deposit addresses. Rather
+// than querying each address, it polls for outputs created since its cursor
+// and picks out those sent to monitored addresses. Before each poll it checks
+// that the block of its cursor is still on chain, retracting the deposits
+// reported past the divergence point after a rollback
+type DepositMonitor struct {
+	client    *Client
+	config    DepositMonitorConfig
+	mu        sync.Mutex
+	addresses map[string]bool
+	cursor    Point
+	reported  []Deposit
+}
+
+// NewDepositMonitor creates a deposit monitor
+func NewDepositMonitor(client *Client, config DepositMonitorConfig) *DepositMonitor {
+	if config.Pattern == "" {
+		config.Pattern = defaultDepositPattern
+	}
+	if config.ChunkSize <= 0 {
+		config.ChunkSize = defaultDepositChunkSize
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultWatchInterval
+	}
+	if config.RetractWindow <= 0 {
+		config.RetractWindow = defaultRetractWindow
+	}
+	return &DepositMonitor{
+		client:    client,
+		config:    config,
+		addresses: make(map[string]bool),
+		cursor:    Point{SlotNo: config.StartSlot},
+	}
+}
+
+// AddAddresses starts monitoring the addresses, registering their patterns in
+// chunks if configured to
+func (m *DepositMonitor) AddAddresses(addresses ...string) error {
+	if m.config.RegisterPatterns {
+		for start := 0; start < len(addresses); start += m.config.ChunkSize {
+			end := start + m.config.ChunkSize
+			if end > len(addresses) {
+				end = len(addresses)
+			}
+			_, err := m.client.AddPatterns(
+				addresses[start:end],
+				m.config.RollbackTo,
+				m.config.RollbackLimit,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to register deposit addresses: %s", err)
+			}
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, address := range addresses {
+		m.addresses[address] = true
+	}
+	return nil
+}
+
+// Cursor returns the slot up to which outputs have been processed
+func (m *DepositMonitor) Cursor() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.cursor.SlotNo
+}
+
+// Poll returns the deposits created since the previous poll and advances the
+// cursor. If the chain was rolled back past deposits reported earlier, they
+// are passed to OnRetract first and the cursor moves back to the divergence
+// point
+func (m *DepositMonitor) Poll() ([]Deposit, error) {
+	m.mu.Lock()
+	cursor := m.cursor
+	m.mu.Unlock()
+	// The starting cursor has no header hash to check
+	if cursor.HeaderHash != "" {
+		ancestor, err := m.client.CheckRollback(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if ancestor != nil {
+			m.rollback(*ancestor)
+			cursor = *ancestor
+		}
+	}
+	matches, err := m.client.GetMatchesWithOptions(
+		m.config.Pattern,
+		MatchOptions{
+			CreatedAfter: cursor.SlotNo,
+			Order:        MatchOrderOldestFirst,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var deposits []Deposit
+	for _, match := range *matches {
+		if match.CreatedAt.SlotNo > m.cursor.SlotNo {
+			m.cursor = match.CreatedAt
+		}
+		if !m.addresses[match.Address] {
+			continue
+		}
+		deposits = append(
+			deposits,
+			Deposit{
+				Address:         match.Address,
+				OutputReference: match.OutputReference(),
+				Value:           match.Value,
+				CreatedAt:       match.CreatedAt,
+			},
+		)
+	}
+	m.reported = append(m.reported, deposits...)
+	// Deposits beyond the window are final as far as the monitor is
+	// concerned
+	horizon := m.cursor.SlotNo - m.config.RetractWindow
+	keep := 0
+	for keep < len(m.reported) && m.reported[keep].CreatedAt.SlotNo < horizon {
+		keep++
+	}
+	m.reported = m.reported[keep:]
+	return deposits, nil
+}
+
+// rollback retracts the deposits reported after the divergence point and
+// moves the cursor back to it
+func (m *DepositMonitor) rollback(ancestor Point) {
+	m.mu.Lock()
+	keep := len(m.reported)
+	for keep > 0 && m.reported[keep-1].CreatedAt.SlotNo > ancestor.SlotNo {
+		keep--
+	}
+	retracted := append([]Deposit(nil), m.reported[keep:]...)
+	m.reported = m.reported[:keep]
+	m.cursor = ancestor
+	m.mu.Unlock()
+	if m.config.OnRetract != nil {
+		for i := len(retracted) - 1; i >= 0; i-- {
+			m.config.OnRetract(retracted[i])
+		}
+	}
+}
+
+// Run polls for deposits until the context is cancelled
+func (m *DepositMonitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		deposits, err := m.Poll()
+		if err != nil {
+			if m.config.OnError != nil {
+				m.config.OnError(err)
+			}
+		} else if m.config.OnDeposit != nil {
+			for _, deposit := range deposits {
+				m.config.OnDeposit(deposit)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDepositMonitor(t *testing.T) {
+	var registered [][]string
+	var queries []string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodPut && r.URL.Path == "/patterns":
+				var reqBody struct {
+					Patterns []string `json:"patterns"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&reqBody)
+				registered = append(registered, reqBody.Patterns)
+				_, _ = w.Write([]byte("[]"))
+			case r.URL.Path == "/matches/*":
+				queries = append(queries, r.URL.RawQuery)
+				matches := Matches{}
+				if r.URL.Query().Get("created_after") == "100" {
+					matches = Matches{
+						{TransactionID: "aa", Address: "addr2", Value: Value{Coins: 5}, CreatedAt: Point{SlotNo: 150}},
+						{TransactionID: "bb", Address: "other", Value: Value{Coins: 7}, CreatedAt: Point{SlotNo: 160}},
+					}
+				}
+				respBody, _ := json.Marshal(matches)
+				_, _ = w.Write(respBody)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer server.Close()
+
+	monitor := NewDepositMonitor(
+		&Client{KupoUrl: server.URL},
+		DepositMonitorConfig{
+			StartSlot:        100,
+			ChunkSize:        2,
+			RegisterPatterns: true,
+		},
+	)
+	var addresses []string
+	for i := 0; i < 5; i++ {
+		addresses = append(addresses, fmt.Sprintf("addr%d", i))
+	}
+	if err := monitor.AddAddresses(addresses...); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(registered) != 3 || len(registered[2]) != 1 {
+		t.Errorf("Expected patterns to be registered in 3 chunks, got %v", registered)
+	}
+	deposits, err := monitor.Poll()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(deposits) != 1 || deposits[0].Address != "addr2" || deposits[0].Value.Coins != 5 {
+		t.Errorf("Unexpected deposits: %v", deposits)
+	}
+	if monitor.Cursor() != 160 {
+		t.Errorf("Expected cursor to advance to 160, got %d", monitor.Cursor())
+	}
+	if _, err := monitor.Poll(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(queries) != 2 || queries[1] != "created_after=160&order=oldest_first" {
+		t.Errorf("Unexpected queries: %v", queries)
+	}
+}
+
+func TestDepositMonitorRollback(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/checkpoints/170" && r.URL.Query().Has("strict"):
+				// The block of the cursor was rolled back
+				_, _ = w.Write([]byte("null"))
+			case r.URL.Path == "/checkpoints/170":
+				_, _ = w.Write([]byte(`{"slot_no":160,"header_hash":"h160"}`))
+			case r.URL.Path == "/matches/*":
+				var matches Matches
+				switch r.URL.Query().Get("created_after") {
+				case "100":
+					matches = Matches{
+						{TransactionID: "aa", Address: "addr1", Value: Value{Coins: 5}, CreatedAt: Point{SlotNo: 150, HeaderHash: "h150"}},
+						{TransactionID: "bb", Address: "addr1", Value: Value{Coins: 7}, CreatedAt: Point{SlotNo: 170, HeaderHash: "h170"}},
+					}
+				case "160":
+					matches = Matches{
+						{TransactionID: "bb", Address: "addr1", Value: Value{Coins: 7}, CreatedAt: Point{SlotNo: 175, HeaderHash: "h175"}},
+					}
+				}
+				respBody, _ := json.Marshal(matches)
+				_, _ = w.Write(respBody)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer server.Close()
+
+	var retracted []Deposit
+	monitor := NewDepositMonitor(
+		&Client{KupoUrl: server.URL},
+		DepositMonitorConfig{
+			StartSlot: 100,
+			OnRetract: func(deposit Deposit) {
+				retracted = append(retracted, deposit)
+			},
+		},
+	)
+	if err := monitor.AddAddresses("addr1"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	deposits, err := monitor.Poll()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(deposits) != 2 {
+		t.Fatalf("Expected 2 deposits, got %v", deposits)
+	}
+	deposits, err = monitor.Poll()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(retracted) != 1 || retracted[0].OutputReference.TransactionID != "bb" {
+		t.Fatalf("Expected the deposit in the rolled back block to be retracted, got %v", retracted)
+	}
+	if len(deposits) != 1 || deposits[0].CreatedAt.SlotNo != 175 {
+		t.Fatalf("Expected the deposit to be reported again once included, got %v", deposits)
+	}
+	if monitor.Cursor() != 175 {
+		t.Errorf("Expected cursor to advance to 175, got %d", monitor.Cursor())
+	}
+}
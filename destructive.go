@@ -0,0 +1,133 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrNotConfirmed = errors.New("destructive operation not confirmed")
+
+// AuditDeleteMatches is recorded for DeleteMatches
+const AuditDeleteMatches AuditAction = "delete_matches"
+
+// ChangePlan describes what a destructive operation is about to change
+type ChangePlan struct {
+	Action AuditAction
+	// Patterns are the patterns deleted, or whose matches are deleted
+	Patterns []string
+	// Added are the patterns added by a replacement
+	Added []string
+	// Matches is the number of matches indexed for the deleted patterns, or
+	// the number of matches deleted by DeleteMatches
+	Matches int
+	// RollbackTo is the point Kupo rolls back to when a replacement adds
+	// patterns, and RescanSlots the number of slots it then indexes again
+	RollbackTo  *Point
+	RescanSlots int
+}
+
+// ConfirmFunc decides whether a destructive operation goes ahead, returning
+// an error to abort it
+type ConfirmFunc func(ctx context.Context, plan ChangePlan) error
+
+// WithConfirmation calls confirm with the plan of every DeletePattern,
+// DeleteMatches and applied EnsurePatterns before executing it. Planning
+// counts the affected matches, which costs extra requests. Operations confirm
+// rejects fail with ErrNotConfirmed
+func WithConfirmation(confirm ConfirmFunc) ClientOption {
+	return func(c *Client) {
+		c.confirm = confirm
+	}
+}
+
+// DryRun makes DeletePattern, DeleteMatches and EnsurePatterns report their
+// plan to report instead of changing anything. They then return zero counts,
+// or for EnsurePatterns the changes it would make
+func DryRun(report func(ChangePlan)) CallOption {
+	return func(o *callOptions) {
+		o.dryRun = report
+	}
+}
+
+type confirmedKey struct{}
+
+// guardDestructive plans a destructive operation if it is a dry run or needs
+// confirmation, and returns whether it should go ahead. The returned context
+// marks the operation as confirmed, so the operations it is made of are not
+// confirmed again
+func (c *Client) guardDestructive(
+	ctx context.Context,
+	plan func() (ChangePlan, error),
+) (context.Context, bool, error) {
+	opts := callOptionsFrom(ctx)
+	dryRun := opts != nil && opts.dryRun != nil
+	confirmed, _ := ctx.Value(confirmedKey{}).(bool)
+	if confirmed || (!dryRun && c.confirm == nil) {
+		return ctx, true, nil
+	}
+	p, err := plan()
+	if err != nil {
+		return ctx, false, fmt.Errorf("failed to plan change: %w", err)
+	}
+	if dryRun {
+		opts.dryRun(p)
+		return ctx, false, nil
+	}
+	if err := c.confirm(ctx, p); err != nil {
+		return ctx, false, fmt.Errorf("%w: %s", ErrNotConfirmed, err)
+	}
+	return context.WithValue(ctx, confirmedKey{}, true), true, nil
+}
+
+// planReplacement plans the changes of EnsurePatterns, resolving the point
+// Kupo rolls back to
+func (c *Client) planReplacement(
+	ctx context.Context,
+	changes *PatternChanges,
+	opts EnsurePatternsOptions,
+) (ChangePlan, error) {
+	plan := ChangePlan{
+		Action:   AuditReplacePatterns,
+		Patterns: patternStrings(changes.Removed),
+		Added:    patternStrings(changes.Added),
+	}
+	for _, pattern := range changes.Removed {
+		count, err := c.CountMatchesContext(ctx, string(pattern), MatchOptions{})
+		if err != nil {
+			return plan, err
+		}
+		plan.Matches += count
+	}
+	if len(changes.Added) == 0 {
+		return plan, nil
+	}
+	checkpoints, err := c.GetCheckpointsContext(ctx)
+	if err != nil {
+		return plan, err
+	}
+	if len(*checkpoints) == 0 {
+		return plan, errors.New("no checkpoint to roll back to")
+	}
+	tip := (*checkpoints)[0]
+	plan.RollbackTo = &tip
+	if opts.RollbackTo != nil {
+		plan.RollbackTo = opts.RollbackTo
+	}
+	plan.RescanSlots = tip.SlotNo - plan.RollbackTo.SlotNo
+	return plan, nil
+}
@@ -0,0 +1,96 @@
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestDestructiveOperations(t *testing.T) {
+	var mutations []string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/patterns":
+				_, _ = w.Write([]byte(`["*"]`))
+			case r.Method == http.MethodGet && r.URL.Path == "/checkpoints":
+				_, _ = w.Write([]byte(`[{"slot_no":100,"header_hash":"aa"}]`))
+			case r.Method == http.MethodGet && r.URL.Path == "/matches/*":
+				_, _ = w.Write([]byte(`[{"transaction_id":"t1"},{"transaction_id":"t2"}]`))
+			case r.Method == http.MethodPut:
+				mutations = append(mutations, r.Method+" "+r.URL.Path)
+				_, _ = w.Write([]byte(`[]`))
+			case r.Method == http.MethodDelete:
+				mutations = append(mutations, r.Method+" "+r.URL.Path)
+				_, _ = w.Write([]byte(`{"deleted":2}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer server.Close()
+	var plans []ChangePlan
+	approve := false
+	client := NewClient(server.URL, WithConfirmation(func(ctx context.Context, plan ChangePlan) error {
+		plans = append(plans, plan)
+		if !approve {
+			return errors.New("rejected")
+		}
+		return nil
+	}))
+
+	if _, err := client.DeletePattern("*"); !errors.Is(err, ErrNotConfirmed) {
+		t.Fatalf("Expected ErrNotConfirmed, got %v", err)
+	}
+	if len(mutations) != 0 {
+		t.Fatalf("Expected no mutations, got %v", mutations)
+	}
+	expected := ChangePlan{Action: AuditDeletePattern, Patterns: []string{"*"}, Matches: 2}
+	if len(plans) != 1 || !reflect.DeepEqual(plans[0], expected) {
+		t.Fatalf("Expected plan %+v, got %+v", expected, plans)
+	}
+
+	var dryRun []ChangePlan
+	ctx := WithCallOptions(context.Background(), DryRun(func(plan ChangePlan) {
+		dryRun = append(dryRun, plan)
+	}))
+	deleted, err := client.DeleteMatchesContext(ctx, "*")
+	if err != nil || deleted != 0 {
+		t.Fatalf("Expected no deletion in a dry run, got %d, %v", deleted, err)
+	}
+	if len(dryRun) != 1 || dryRun[0].Action != AuditDeleteMatches || dryRun[0].Matches != 2 {
+		t.Errorf("Unexpected dry run plan: %+v", dryRun)
+	}
+	if len(plans) != 1 || len(mutations) != 0 {
+		t.Errorf("Expected dry runs to skip confirmation and mutations")
+	}
+
+	approve = true
+	plans = nil
+	rollbackTo := Point{SlotNo: 40, HeaderHash: "bb"}
+	_, err = client.EnsurePatterns(
+		[]Pattern{MatchPolicy(testPolicyID)},
+		EnsurePatternsOptions{RollbackTo: &rollbackTo},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected = ChangePlan{
+		Action:      AuditReplacePatterns,
+		Patterns:    []string{"*"},
+		Added:       []string{string(MatchPolicy(testPolicyID))},
+		Matches:     2,
+		RollbackTo:  &rollbackTo,
+		RescanSlots: 60,
+	}
+	// The replacement is confirmed once, not for each pattern it changes
+	if len(plans) != 1 || !reflect.DeepEqual(plans[0], expected) {
+		t.Errorf("Expected plan %+v, got %+v", expected, plans)
+	}
+	if !reflect.DeepEqual(mutations, []string{"PUT /patterns", "DELETE /patterns/*"}) {
+		t.Errorf("Unexpected mutations: %v", mutations)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoEndpoints is returned when discovery found no Kupo instance to use
+var ErrNoEndpoints = errors.New("no kupo endpoints discovered")
+
+const defaultDiscoveryRefresh = 30 * time.Second
+
+// Discoverer returns the base URLs of the Kupo instances to send requests to,
+// in order of preference
+type Discoverer func(ctx context.Context) ([]string, error)
+
+// StaticEndpoints discovers a fixed set of Kupo instances
+func StaticEndpoints(urls ...string) Discoverer {
+	return func(context.Context) ([]string, error) {
+		return urls, nil
+	}
+}
+
+// SRVDiscoverer discovers Kupo instances from the SRV record
+// _service._proto.name, such as _http._tcp.kupo.default.svc.cluster.local,
+// using scheme ("http" if empty) for the resulting URLs. Targets are ordered
+// by priority and randomized by weight within a priority
+func SRVDiscoverer(service, proto, name, scheme string) Discoverer {
+	if scheme == "" {
+		scheme = "http"
+	}
+	return func(ctx context.Context) ([]string, error) {
+		_, records, err := net.DefaultResolver.LookupSRV(ctx, service, proto, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up SRV record: %s", err)
+		}
+		ret := make([]string, 0, len(records))
+		for _, record := range records {
+			host := strings.TrimSuffix(record.Target, ".")
+			ret = append(
+				ret,
+				scheme+"://"+net.JoinHostPort(host, strconv.Itoa(int(record.Port))),
+			)
+		}
+		return ret, nil
+	}
+}
+
+// DiscoveryConfig configures WithDiscovery
+type DiscoveryConfig struct {
+	// Discover returns the Kupo instances to use
+	Discover Discoverer
+	// Refresh is how long discovered instances are used before discovering
+	// them again, defaulting to 30 seconds
+	Refresh time.Duration
+	// RoundRobin spreads requests over all instances instead of sending them
+	// to the first one that is reachable
+	RoundRobin bool
+	// OnError, if not nil, is called when discovery fails. The previously
+	// discovered instances keep being used meanwhile
+	OnError func(error)
+}
+
+// WithDiscovery sends requests to the Kupo instances found by discovery
+// instead of the client's URL, trying the next instance when one cannot be
+// reached. Paths are kept relative to the client's URL, so it may be any
+// placeholder such as "http://kupo"
+func WithDiscovery(config DiscoveryConfig) ClientOption {
+	return func(c *Client) {
+		set := &endpointSet{config: config, client: c}
+		if set.config.Refresh <= 0 {
+			set.config.Refresh = defaultDiscoveryRefresh
+		}
+		c.middleware = append(c.middleware, set.middleware)
+	}
+}
+
+type endpointSet struct {
+	config     DiscoveryConfig
+	client     *Client
+	mu         sync.Mutex
+	endpoints  []*url.URL
+	discovered time.Time
+	next       int
+}
+
+func (s *endpointSet) middleware(next http.RoundTripper) http.RoundTripper {
+	return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		endpoints, err := s.get(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		// NoRetry requests and those with a body that cannot be replayed get a
+		// single attempt
+		attempts := len(endpoints)
+		if noRetry(req) ||
+			(req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			attempts = 1
+		}
+		var lastErr error
+		for i := 0; i < attempts; i++ {
+			attempt, err := s.rewrite(req, endpoints[i])
+			if err != nil {
+				return nil, err
+			}
+			resp, err := next.RoundTrip(attempt)
+			if err == nil {
+				return resp, nil
+			}
+			lastErr = err
+			if req.Context().Err() != nil {
+				break
+			}
+		}
+		return nil, lastErr
+	})
+}
+
+// get returns the discovered endpoints in the order to try them
+func (s *endpointSet) get(ctx context.Context) ([]*url.URL, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.endpoints == nil || time.Since(s.discovered) >= s.config.Refresh {
+		if err := s.discover(ctx); err != nil {
+			if s.endpoints == nil {
+				return nil, err
+			}
+			if s.config.OnError != nil {
+				s.config.OnError(err)
+			}
+		}
+	}
+	if len(s.endpoints) == 0 {
+		return nil, ErrNoEndpoints
+	}
+	ret := make([]*url.URL, len(s.endpoints))
+	start := 0
+	if s.config.RoundRobin {
+		start = s.next % len(s.endpoints)
+		s.next++
+	}
+	for i := range ret {
+		ret[i] = s.endpoints[(start+i)%len(s.endpoints)]
+	}
+	return ret, nil
+}
+
+func (s *endpointSet) discover(ctx context.Context) error {
+	urls, err := s.config.Discover(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to discover kupo endpoints: %s", err)
+	}
+	endpoints := make([]*url.URL, 0, len(urls))
+	for _, rawURL := range urls {
+		endpoint, err := url.Parse(rawURL)
+		if err != nil || endpoint.Host == "" {
+			return fmt.Errorf("invalid discovered endpoint %q", rawURL)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	s.endpoints = endpoints
+	s.discovered = time.Now()
+	return nil
+}
+
+// rewrite returns a copy of the request sent to the given endpoint
+func (s *endpointSet) rewrite(req *http.Request, endpoint *url.URL) (*http.Request, error) {
+	basePath := ""
+	if base, err := url.Parse(s.client.KupoUrl); err == nil {
+		basePath = strings.TrimSuffix(base.Path, "/")
+	}
+	ret := req.Clone(req.Context())
+	ret.URL.Scheme = endpoint.Scheme
+	ret.URL.Host = endpoint.Host
+	ret.URL.Path = strings.TrimSuffix(endpoint.Path, "/") +
+		strings.TrimPrefix(req.URL.Path, basePath)
+	if req.URL.RawPath != "" {
+		ret.URL.RawPath = strings.TrimSuffix(endpoint.EscapedPath(), "/") +
+			strings.TrimPrefix(req.URL.RawPath, basePath)
+	}
+	ret.Host = ""
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay request body: %s", err)
+		}
+		ret.Body = body
+	}
+	return ret, nil
+}
@@ -0,0 +1,82 @@
+package kupogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithDiscovery(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	down.Close()
+
+	var discoveries int
+	client := NewClient(
+		"http://kupo",
+		WithDiscovery(DiscoveryConfig{
+			Discover: func(context.Context) ([]string, error) {
+				discoveries++
+				return []string{down.URL, server.URL + "/kupo"}, nil
+			},
+		}),
+	)
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetMatches("*"); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	}
+	if discoveries != 1 {
+		t.Errorf("Expected a single discovery, got %d", discoveries)
+	}
+	if len(paths) != 2 || paths[0] != "/kupo/matches/*" {
+		t.Errorf("Unexpected paths: %v", paths)
+	}
+}
+
+func TestWithDiscoveryRoundRobin(t *testing.T) {
+	var hits [2]int
+	servers := make([]string, 2)
+	for i := range hits {
+		i := i
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits[i]++
+			_, _ = w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+		servers[i] = server.URL
+	}
+	client := NewClient(
+		"http://kupo",
+		WithDiscovery(DiscoveryConfig{
+			Discover:   StaticEndpoints(servers...),
+			RoundRobin: true,
+		}),
+	)
+	for i := 0; i < 4; i++ {
+		if _, err := client.GetMatches("*"); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	}
+	if hits != [2]int{2, 2} {
+		t.Errorf("Expected requests to be spread evenly, got %v", hits)
+	}
+}
+
+func TestWithDiscoveryNoEndpoints(t *testing.T) {
+	client := NewClient(
+		"http://kupo",
+		WithDiscovery(DiscoveryConfig{Discover: StaticEndpoints()}),
+	)
+	_, err := client.GetHealth()
+	if err == nil || !strings.Contains(err.Error(), ErrNoEndpoints.Error()) {
+		t.Fatalf("Expected no endpoints error, got %v", err)
+	}
+}
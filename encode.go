@@ -0,0 +1,66 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// The encoding counterparts of decode.go reproduce Kupo's wire format, so
+// decoded results can be cached, proxied or compared against Kupo output
+
+// MarshalJSON encodes assets as Kupo does, with an empty object rather than
+// null for outputs without assets
+func (a Assets) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(map[string]int(a))
+}
+
+type metadataItemJSON struct {
+	Hash   string          `json:"hash"`
+	Raw    string          `json:"raw"`
+	Schema json.RawMessage `json:"schema"`
+}
+
+// MarshalJSON encodes the item as Kupo does, with its CBOR as hex
+func (m MetadataItem) MarshalJSON() ([]byte, error) {
+	return json.Marshal(metadataItemJSON{
+		Hash:   m.Hash,
+		Raw:    m.RawHex(),
+		Schema: m.Schema,
+	})
+}
+
+// UnmarshalJSON decodes an item in Kupo's format
+func (m *MetadataItem) UnmarshalJSON(data []byte) error {
+	var item metadataItemJSON
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	raw, err := hex.DecodeString(item.Raw)
+	if err != nil {
+		return fmt.Errorf("failed to decode raw data: %s", err)
+	}
+	*m = MetadataItem{
+		Hash:   item.Hash,
+		Raw:    raw,
+		Schema: item.Schema,
+	}
+	return nil
+}
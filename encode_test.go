@@ -0,0 +1,61 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	testDefs := []struct {
+		name string
+		wire string
+		v    any
+	}{
+		{
+			name: "match",
+			wire: `{"transaction_index":1,"transaction_id":"aa","output_index":0,"address":"addr1","value":{"coins":5,"assets":{}},"datum_hash":null,"datum_type":null,"script_hash":null,"created_at":{"slot_no":10,"header_hash":"bb"},"spent_at":null}`,
+			v:    &Match{},
+		},
+		{
+			name: "match with assets",
+			wire: `{"transaction_index":0,"transaction_id":"aa","output_index":2,"address":"addr1","value":{"coins":5,"assets":{"cc.dd":1,"ee":2}},"datum_hash":"ff","datum_type":"inline","script_hash":null,"created_at":{"slot_no":10,"header_hash":"bb"},"spent_at":{"slot_no":11,"header_hash":"cc"}}`,
+			v:    &Match{},
+		},
+		{
+			name: "checkpoints",
+			wire: `[{"slot_no":10,"header_hash":"bb"}]`,
+			v:    &Checkpoints{},
+		},
+		{
+			name: "metadata",
+			wire: `[{"hash":"aa","raw":"a1016474657374","schema":{"1":{"string":"test"}}}]`,
+			v:    &Metadata{},
+		},
+	}
+	for _, testDef := range testDefs {
+		if err := json.Unmarshal([]byte(testDef.wire), testDef.v); err != nil {
+			t.Fatalf("%s: Expected no error, got %s", testDef.name, err)
+		}
+		data, err := json.Marshal(testDef.v)
+		if err != nil {
+			t.Fatalf("%s: Expected no error, got %s", testDef.name, err)
+		}
+		if string(data) != testDef.wire {
+			t.Errorf("%s: Expected:\n%s\ngot:\n%s", testDef.name, testDef.wire, data)
+		}
+	}
+}
+
+func TestMetadataItemMarshalRaw(t *testing.T) {
+	data, err := json.Marshal(MetadataItem{
+		Hash:   "aa",
+		Raw:    []byte{0xa0},
+		Schema: json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if string(data) != `{"hash":"aa","raw":"a0","schema":{}}` {
+		t.Fatalf("Unexpected encoding: %s", data)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"strings"
+)
+
+var knownEndpoints = map[string]bool{
+	"checkpoints": true,
+	"datums":      true,
+	"health":      true,
+	"matches":     true,
+	"metadata":    true,
+	"metrics":     true,
+	"patterns":    true,
+	"scripts":     true,
+}
+
+// EndpointName returns the Kupo endpoint a request path refers to, such as
+// "matches" for "/matches/addr1...", or "other" if it is not recognized. It
+// is meant for labelling metrics without the unbounded cardinality of full
+// paths
+func EndpointName(path string) string {
+	for _, segment := range strings.Split(path, "/") {
+		if knownEndpoints[segment] {
+			return segment
+		}
+	}
+	return "other"
+}
@@ -0,0 +1,19 @@
+package kupogo
+
+import (
+	"testing"
+)
+
+func TestEndpointName(t *testing.T) {
+	testDefs := map[string]string{
+		"/matches/addr1*":         "matches",
+		"/kupo/checkpoints/12345": "checkpoints",
+		"/patterns":               "patterns",
+		"/":                       "other",
+	}
+	for path, expected := range testDefs {
+		if got := EndpointName(path); got != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, path, got)
+		}
+	}
+}
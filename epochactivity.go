@@ -0,0 +1,116 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"sort"
+)
+
+// EpochActivity summarizes the matches created and spent in an epoch
+type EpochActivity struct {
+	Epoch int
+	// Created and Spent are the number of outputs created and spent
+	Created int
+	Spent   int
+	// Volume is the total value of the outputs created
+	Volume Value
+	// UniqueAddresses is the number of distinct addresses of the outputs
+	// created or spent
+	UniqueAddresses int
+}
+
+// EpochActivity buckets the creation and spending of the matches by epoch of
+// the network, oldest first. Epochs without activity are omitted
+func (m Matches) EpochActivity(network Network) []EpochActivity {
+	return matchesEpochActivity(m, network, func(int) bool { return true })
+}
+
+func matchesEpochActivity(matches Matches, network Network, include func(epoch int) bool) []EpochActivity {
+	activity := make(map[int]*EpochActivity)
+	addresses := make(map[int]map[string]struct{})
+	bucket := func(slotNo int, address string) *EpochActivity {
+		epoch, _ := network.SlotToEpoch(slotNo)
+		if !include(epoch) {
+			return nil
+		}
+		entry, ok := activity[epoch]
+		if !ok {
+			entry = &EpochActivity{Epoch: epoch}
+			activity[epoch] = entry
+			addresses[epoch] = make(map[string]struct{})
+		}
+		addresses[epoch][address] = struct{}{}
+		return entry
+	}
+	for _, match := range matches {
+		if entry := bucket(match.CreatedAt.SlotNo, match.Address); entry != nil {
+			entry.Created++
+			entry.Volume = entry.Volume.Add(match.Value)
+		}
+		if match.SpentAt != nil {
+			if entry := bucket(match.SpentAt.SlotNo, match.Address); entry != nil {
+				entry.Spent++
+			}
+		}
+	}
+	ret := make([]EpochActivity, 0, len(activity))
+	for epoch, entry := range activity {
+		entry.UniqueAddresses = len(addresses[epoch])
+		ret = append(ret, *entry)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Epoch < ret[j].Epoch
+	})
+	return ret
+}
+
+// GetEpochActivity aggregates the activity of a pattern in the epochs from
+// fromEpoch to toEpoch, inclusive, using the client's network. Outputs
+// created and spent within those epochs are found with slot-bounded queries
+func (c *Client) GetEpochActivity(pattern string, fromEpoch int, toEpoch int) ([]EpochActivity, error) {
+	return c.GetEpochActivityContext(context.Background(), pattern, fromEpoch, toEpoch)
+}
+
+// GetEpochActivityContext is like GetEpochActivity with a request context
+func (c *Client) GetEpochActivityContext(
+	ctx context.Context,
+	pattern string,
+	fromEpoch int,
+	toEpoch int,
+) ([]EpochActivity, error) {
+	network := c.Network()
+	fromSlot := network.EpochToSlot(fromEpoch)
+	toSlot := network.EpochToSlot(toEpoch+1) - 1
+	created, _, err := c.getMatches(ctx, pattern, MatchOptions{
+		CreatedAfter:  fromSlot - 1,
+		CreatedBefore: toSlot + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	spent, _, err := c.getMatches(ctx, pattern, MatchOptions{
+		Spent:       true,
+		SpentAfter:  fromSlot - 1,
+		SpentBefore: toSlot + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	matches := append(*created, *spent...).Dedup()
+	return matchesEpochActivity(matches, network, func(epoch int) bool {
+		return epoch >= fromEpoch && epoch <= toEpoch
+	}), nil
+}
@@ -0,0 +1,49 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetEpochActivity(t *testing.T) {
+	network := Network{ShelleyStartEpoch: 100, EpochLength: 10}
+	matches := Matches{
+		{TransactionID: "t1", Address: "a", Value: Value{Coins: 5}, CreatedAt: Point{SlotNo: 5}, SpentAt: &Point{SlotNo: 12}},
+		{TransactionID: "t2", Address: "b", Value: Value{Coins: 3}, CreatedAt: Point{SlotNo: 12}},
+		{TransactionID: "t3", Address: "a", Value: Value{Coins: 2}, CreatedAt: Point{SlotNo: 15}},
+	}
+	var queries []string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.RawQuery)
+			_ = json.NewEncoder(w).Encode(matches)
+		}),
+	)
+	defer server.Close()
+
+	activity, err := NewClient(server.URL, WithNetwork(network)).GetEpochActivity("*", 101, 101)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := []EpochActivity{
+		{Epoch: 101, Created: 2, Spent: 1, Volume: Value{Coins: 5}, UniqueAddresses: 2},
+	}
+	if !reflect.DeepEqual(activity, expected) {
+		t.Fatalf("Unexpected activity %+v", activity)
+	}
+	expectedQueries := []string{
+		"created_after=9&created_before=20",
+		"spent&spent_after=9&spent_before=20",
+	}
+	if !reflect.DeepEqual(queries, expectedQueries) {
+		t.Fatalf("Unexpected queries %v", queries)
+	}
+
+	all := matches.EpochActivity(network)
+	if len(all) != 2 || all[0].Epoch != 100 || all[0].Created != 1 || all[0].UniqueAddresses != 1 {
+		t.Fatalf("Unexpected activity of all matches %+v", all)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HasDatum reports whether Kupo knows the datum with the given hash, without
+// downloading it
+func (c *Client) HasDatum(datumHash string) (bool, error) {
+	return c.HasDatumContext(context.Background(), datumHash)
+}
+
+// HasDatumContext is like HasDatum with a request context
+func (c *Client) HasDatumContext(ctx context.Context, datumHash string) (bool, error) {
+	if c.contentCache != nil {
+		if _, ok := c.contentCache.Get("datums/" + datumHash); ok {
+			return true, nil
+		}
+	}
+	return c.hasContent(ctx, fmt.Sprintf("%s/datums/%s", c.KupoUrl, datumHash), "datum")
+}
+
+// HasScript reports whether Kupo knows the script with the given hash,
+// without downloading it
+func (c *Client) HasScript(scriptHash string) (bool, error) {
+	return c.HasScriptContext(context.Background(), scriptHash)
+}
+
+// HasScriptContext is like HasScript with a request context
+func (c *Client) HasScriptContext(ctx context.Context, scriptHash string) (bool, error) {
+	if c.contentCache != nil {
+		if _, ok := c.contentCache.Get("scripts/" + scriptHash); ok {
+			return true, nil
+		}
+	}
+	return c.hasContent(ctx, fmt.Sprintf("%s/scripts/%s", c.KupoUrl, scriptHash), "script")
+}
+
+// HasMatches reports whether any output matches the pattern and options. It
+// stops reading the response after the first match
+func (c *Client) HasMatches(pattern string, opts MatchOptions) (bool, error) {
+	return c.HasMatchesContext(context.Background(), pattern, opts)
+}
+
+// HasMatchesContext is like HasMatches with a request context
+func (c *Client) HasMatchesContext(
+	ctx context.Context,
+	pattern string,
+	opts MatchOptions,
+) (bool, error) {
+	// The response cache would read the whole body
+	ctx = WithCallOptions(ctx, NoCache())
+	return c.hasContent(ctx, c.matchesURL(pattern, opts), "matches")
+}
+
+// hasContent requests the URL and reports whether the response is something
+// other than null or an empty list, reading only its first tokens
+func (c *Client) hasContent(ctx context.Context, url string, what string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to check %s: %s", what, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf(
+			"failed to check %s: status code %d%s",
+			what,
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	reader := bufio.NewReaderSize(resp.Body, 64)
+	first, err := nextToken(reader)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %s", what, err)
+	}
+	switch first {
+	case 'n':
+		return false, nil
+	case '[':
+		next, err := nextToken(reader)
+		if err != nil {
+			return false, fmt.Errorf("failed to read %s: %s", what, err)
+		}
+		return next != ']', nil
+	default:
+		return true, nil
+	}
+}
+
+// nextToken returns the next byte which is not JSON whitespace
+func nextToken(reader io.ByteReader) (byte, error) {
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b, nil
+	}
+}
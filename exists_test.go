@@ -0,0 +1,52 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHasContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/datums/aa":
+			_, _ = w.Write([]byte(`{"datum":"d87980"}`))
+		case "/scripts/bb":
+			_, _ = w.Write([]byte(` {"language":"native","script":"8200581c"}`))
+		case "/matches/addr_full":
+			_, _ = w.Write([]byte("[\n{\"transaction_index\":0}"))
+		case "/matches/addr_empty":
+			_, _ = w.Write([]byte(" [ ] "))
+		case "/datums/cc", "/scripts/dd":
+			_, _ = w.Write([]byte(`null`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	testDefs := []struct {
+		check    func() (bool, error)
+		expected bool
+	}{
+		{func() (bool, error) { return client.HasDatum("aa") }, true},
+		{func() (bool, error) { return client.HasDatum("cc") }, false},
+		{func() (bool, error) { return client.HasScript("bb") }, true},
+		{func() (bool, error) { return client.HasScript("dd") }, false},
+		{func() (bool, error) { return client.HasMatches("addr_full", MatchOptions{}) }, true},
+		{func() (bool, error) { return client.HasMatches("addr_empty", MatchOptions{}) }, false},
+	}
+	for i, testDef := range testDefs {
+		ok, err := testDef.check()
+		if err != nil {
+			t.Fatalf("%d: Expected no error, got %s", i, err)
+		}
+		if ok != testDef.expected {
+			t.Errorf("%d: Expected %t, got %t", i, testDef.expected, ok)
+		}
+	}
+	if _, err := client.HasDatum("ee"); err == nil {
+		t.Fatalf("Expected an error for an error status")
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+
+package kupogo
+
+import (
+	"net/http"
+)
+
+// FetchOptions sets options of the browser Fetch API, which carries requests
+// when running under GOOS=js. Empty fields keep the browser defaults
+type FetchOptions struct {
+	// Mode is the request mode, such as "cors" or "same-origin"
+	Mode string
+	// Credentials controls whether cookies are sent: "omit", "same-origin" or
+	// "include"
+	Credentials string
+	// Redirect controls how redirects are handled: "follow", "error" or
+	// "manual"
+	Redirect string
+}
+
+// WithFetchOptions sets the Fetch API options of every request
+func WithFetchOptions(opts FetchOptions) ClientOption {
+	return WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			// These pseudo headers are read by the js/wasm transport and not
+			// sent to the server
+			if opts.Mode != "" {
+				req.Header.Set("js.fetch:mode", opts.Mode)
+			}
+			if opts.Credentials != "" {
+				req.Header.Set("js.fetch:credentials", opts.Credentials)
+			}
+			if opts.Redirect != "" {
+				req.Header.Set("js.fetch:redirect", opts.Redirect)
+			}
+			return next.RoundTrip(req)
+		})
+	})
+}
@@ -0,0 +1,43 @@
+//go:build js && wasm
+
+package kupogo
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestFetchOptionsWithCORSSafeRequests(t *testing.T) {
+	var received http.Header
+	httpClient := &http.Client{
+		Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			received = req.Header.Clone()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(bytes.NewReader([]byte(`[]`))),
+				Request:    req,
+			}, nil
+		}),
+	}
+	client := NewClient(
+		"http://localhost",
+		WithHTTPClient(httpClient),
+		WithFetchOptions(FetchOptions{Mode: "cors", Credentials: "omit", Redirect: "error"}),
+		WithCORSSafeRequests(),
+	)
+	if _, err := client.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	for name, value := range map[string]string{
+		"js.fetch:mode":        "cors",
+		"js.fetch:credentials": "omit",
+		"js.fetch:redirect":    "error",
+	} {
+		if received.Get(name) != value {
+			t.Fatalf("Expected %s to be %s, got %v", name, value, received)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// MatchFilter keeps only the matches for which keep returns true, applied to
+// each match as the response is decoded so rejected matches are never
+// accumulated. It expresses filters Kupo cannot, such as on address format,
+// datum presence or a minimum value. Several filters must all accept a match
+func MatchFilter(keep func(Match) bool) CallOption {
+	return func(o *callOptions) {
+		if o.filter == nil {
+			o.filter = keep
+			return
+		}
+		previous := o.filter
+		o.filter = func(match Match) bool {
+			return previous(match) && keep(match)
+		}
+	}
+}
+
+func matchFilter(req *http.Request) func(Match) bool {
+	opts := callOptionsFrom(req.Context())
+	if opts == nil {
+		return nil
+	}
+	return opts.filter
+}
+
+// decodeFilteredMatches decodes an array of matches one at a time, keeping
+// those accepted by keep
+func (c *Client) decodeFilteredMatches(
+	req *http.Request,
+	body io.Reader,
+	keep func(Match) bool,
+) (*Matches, error) {
+	matches := Matches{}
+	err := forEachMatch(body, func(match Match) {
+		if keep(match) {
+			matches = append(matches, match)
+		}
+	})
+	if err != nil {
+		c.logDecodeFailure(req, err)
+		return nil, err
+	}
+	return &matches, nil
+}
+
+// forEachMatch decodes an array of matches one at a time, calling fn with
+// each. The strings repeated across the matches are interned for the
+// duration of the decode
+func forEachMatch(body io.Reader, fn func(Match)) error {
+	decoder := json.NewDecoder(body)
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected JSON array, got %v", token)
+	}
+	target := newMatchDecoder()
+	for decoder.More() {
+		var match Match
+		target.match = &match
+		if err := decoder.Decode(target); err != nil {
+			return err
+		}
+		fn(match)
+	}
+	_, err = decoder.Token()
+	return err
+}
@@ -0,0 +1,51 @@
+package kupogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMatchFilter(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[
+				{"transaction_id":"t1","address":"addr1a","value":{"coins":5}},
+				{"transaction_id":"t2","address":"addr1b","value":{"coins":50},"datum_hash":"dd"},
+				{"transaction_id":"t3","address":"addr1a","value":{"coins":500},"datum_hash":"dd"}
+			]`))
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+	ctx := WithCallOptions(
+		context.Background(),
+		MatchFilter(func(match Match) bool {
+			return match.DatumHash != nil
+		}),
+		MatchFilter(func(match Match) bool {
+			return strings.HasSuffix(match.Address, "a")
+		}),
+	)
+
+	matches, err := client.GetMatchesContext(ctx, "*")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(*matches) != 1 || (*matches)[0].TransactionID != "t3" {
+		t.Errorf("Unexpected matches: %+v", *matches)
+	}
+
+	ctx = WithCallOptions(context.Background(), MatchFilter(func(match Match) bool {
+		return match.Value.Coins >= 50
+	}))
+	matches, err = client.GetMatchesProjectedContext(ctx, "*", MatchOptions{}, FieldOutputReference|FieldCoins)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(*matches) != 2 || (*matches)[0].TransactionID != "t2" {
+		t.Errorf("Unexpected projected matches: %+v", *matches)
+	}
+}
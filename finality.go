@@ -0,0 +1,46 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+// Security parameters k of the public networks, the maximum number of blocks
+// a rollback may span
+const (
+	SecurityParamMainnet = 2160
+	SecurityParamPreprod = 2160
+	SecurityParamPreview = 432
+)
+
+// activeSlotsInverse is the inverse of the active slot coefficient f = 0.05
+// shared by the public networks
+const activeSlotsInverse = 20
+
+// ImmutableSlots returns the number of slots after which a block can no
+// longer be rolled back for security parameter k, the stability window of
+// 3k/f slots
+func ImmutableSlots(k int) int {
+	return 3 * k * activeSlotsInverse
+}
+
+// IsImmutable returns whether a point is deep enough below tip that it can no
+// longer be rolled back for security parameter k
+func IsImmutable(point Point, tip Point, k int) bool {
+	return tip.SlotNo-point.SlotNo >= ImmutableSlots(k)
+}
+
+// IsImmutable returns whether the output of the match was created in a block
+// which can no longer be rolled back for security parameter k
+func (m Match) IsImmutable(tip Point, k int) bool {
+	return IsImmutable(m.CreatedAt, tip, k)
+}
@@ -0,0 +1,26 @@
+package kupogo
+
+import "testing"
+
+func TestIsImmutable(t *testing.T) {
+	if slots := ImmutableSlots(SecurityParamMainnet); slots != 129600 {
+		t.Fatalf("Expected 129600 slots, got %d", slots)
+	}
+	tip := Point{SlotNo: 200000}
+	testDefs := []struct {
+		slotNo   int
+		k        int
+		expected bool
+	}{
+		{70400, SecurityParamMainnet, true},
+		{70401, SecurityParamMainnet, false},
+		{174080, SecurityParamPreview, true},
+		{174081, SecurityParamPreview, false},
+	}
+	for _, testDef := range testDefs {
+		match := Match{CreatedAt: Point{SlotNo: testDef.slotNo}}
+		if immutable := match.IsImmutable(tip, testDef.k); immutable != testDef.expected {
+			t.Errorf("Expected immutable=%v for slot %d with k=%d, got %v", testDef.expected, testDef.slotNo, testDef.k, immutable)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"time"
+)
+
+// RollForward holds the changes to the unspent outputs of a followed pattern
+// up to a new tip
+type RollForward struct {
+	Created Matches
+	Spent   Matches
+	Tip     Point
+}
+
+// FollowerConfig configures a Follower
+type FollowerConfig struct {
+	Pattern string
+	// Interval between polls, defaulting to 10 seconds
+	Interval time.Duration
+	// CursorStore, if set, persists the point up to which changes have been
+	// delivered, so that a restarted follower resumes from it
+	CursorStore CursorStore
+	// CursorName identifies the follower in the cursor store, defaulting to
+	// the pattern
+	CursorName string
+	// OnRollBackward is called when the chain was rolled back past the
+	// cursor, with the point changes after which must be discarded. The zero
+	// point means no common point remains
+	OnRollBackward func(Point) error
+	// OnRollForward is called with the changes since the cursor, or since the
+	// rollback point if there was one
+	OnRollForward func(RollForward) error
+	// OnError is called when a step fails while running
+	OnError func(error)
+}
+
+// Follower follows the unspent outputs of a pattern along Kupo's chain tip,
+// handling rollbacks. The cursor only advances once the callbacks succeed, so
+// changes whose delivery failed are delivered again by the next step. A
+// rollback may therefore also be delivered more than once
+type Follower struct {
+	client *Client
+	config FollowerConfig
+	cursor *Point
+	loaded bool
+}
+
+// NewFollower creates a follower for the configured pattern
+func NewFollower(client *Client, config FollowerConfig) *Follower {
+	if config.Interval <= 0 {
+		config.Interval = defaultWatchInterval
+	}
+	if config.CursorName == "" {
+		config.CursorName = config.Pattern
+	}
+	return &Follower{
+		client: client,
+		config: config,
+	}
+}
+
+// Cursor returns the point up to which changes have been delivered, or nil
+// before the first successful step
+func (f *Follower) Cursor() *Point {
+	if f.cursor == nil {
+		return nil
+	}
+	cursor := *f.cursor
+	return &cursor
+}
+
+// Step syncs once, delivering any rollback and changes to the callbacks and
+// advancing the cursor
+func (f *Follower) Step() error {
+	if !f.loaded && f.config.CursorStore != nil {
+		cursor, err := f.config.CursorStore.LoadCursor(f.config.CursorName)
+		if err != nil {
+			return err
+		}
+		f.cursor = cursor
+	}
+	f.loaded = true
+	result, err := f.client.SyncSince(f.config.Pattern, f.cursor)
+	if err != nil {
+		return err
+	}
+	if result.RollbackTo != nil && f.config.OnRollBackward != nil {
+		if err := f.config.OnRollBackward(*result.RollbackTo); err != nil {
+			return err
+		}
+	}
+	changed := len(result.Created) > 0 || len(result.Spent) > 0
+	if changed && f.config.OnRollForward != nil {
+		err := f.config.OnRollForward(RollForward{
+			Created: result.Created,
+			Spent:   result.Spent,
+			Tip:     result.Cursor,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if f.config.CursorStore != nil && result.Cursor.SlotNo > 0 {
+		if err := f.config.CursorStore.SaveCursor(f.config.CursorName, result.Cursor); err != nil {
+			return err
+		}
+	}
+	f.cursor = &result.Cursor
+	return nil
+}
+
+// Run steps until the context is cancelled, reporting failed steps to the
+// configured error callback
+func (f *Follower) Run(ctx context.Context) error {
+	ticker := time.NewTicker(f.config.Interval)
+	defer ticker.Stop()
+	for {
+		if err := f.Step(); err != nil && f.config.OnError != nil {
+			f.config.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestFollower(t *testing.T) {
+	rolledBack := false
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var resp interface{}
+			switch r.URL.Path {
+			case "/checkpoints/200":
+				switch {
+				case rolledBack && r.URL.Query().Has("strict"):
+					resp = nil
+				case rolledBack:
+					resp = Point{SlotNo: 100, HeaderHash: "h100"}
+				default:
+					resp = Point{SlotNo: 200, HeaderHash: "h200"}
+				}
+			case "/checkpoints/210":
+				resp = Point{SlotNo: 210, HeaderHash: "h210"}
+			case "/matches/addr1":
+				switch r.URL.RawQuery {
+				case "unspent":
+					resp = Matches{{TransactionID: "aa", CreatedAt: Point{SlotNo: 100}}}
+					w.Header().Set("X-Most-Recent-Checkpoint", "200")
+				case "created_before=201&spent_after=200":
+					resp = Matches{}
+				case "created_after=100":
+					resp = Matches{{TransactionID: "bb", CreatedAt: Point{SlotNo: 205}}}
+					w.Header().Set("X-Most-Recent-Checkpoint", "210")
+				case "created_before=101&spent_after=100&spent_before=211":
+					resp = Matches{}
+				default:
+					t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			respBody, _ := json.Marshal(resp)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	store := NewFileCursorStore(filepath.Join(t.TempDir(), "cursors.json"))
+	var forwards []RollForward
+	var backwards []Point
+	failForward := false
+	config := FollowerConfig{
+		Pattern:     "addr1",
+		CursorStore: store,
+		OnRollBackward: func(point Point) error {
+			backwards = append(backwards, point)
+			return nil
+		},
+		OnRollForward: func(forward RollForward) error {
+			if failForward {
+				failForward = false
+				return errors.New("delivery failed")
+			}
+			forwards = append(forwards, forward)
+			return nil
+		},
+	}
+	follower := NewFollower(NewClient(server.URL), config)
+	if err := follower.Step(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(forwards) != 1 || forwards[0].Created[0].TransactionID != "aa" || forwards[0].Tip.SlotNo != 200 {
+		t.Fatalf("Unexpected roll forwards: %+v", forwards)
+	}
+	rolledBack = true
+	failForward = true
+	// A restarted follower resumes from the stored cursor
+	follower = NewFollower(NewClient(server.URL), config)
+	if err := follower.Step(); err == nil {
+		t.Fatalf("Expected delivery error")
+	}
+	if cursor, _ := store.LoadCursor("addr1"); cursor == nil || cursor.SlotNo != 200 {
+		t.Fatalf("Expected cursor to stay at 200, got %v", cursor)
+	}
+	if err := follower.Step(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(backwards) != 2 || backwards[1].SlotNo != 100 {
+		t.Fatalf("Expected redelivered rollback to 100, got %v", backwards)
+	}
+	if len(forwards) != 2 || forwards[1].Created[0].TransactionID != "bb" || forwards[1].Tip.SlotNo != 210 {
+		t.Fatalf("Unexpected roll forwards: %+v", forwards)
+	}
+	if cursor := follower.Cursor(); cursor == nil || cursor.SlotNo != 210 {
+		t.Fatalf("Expected cursor at 210, got %v", cursor)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LovelaceDecimals is the number of decimals of ADA
+const LovelaceDecimals = 6
+
+// AssetFormat describes how to display quantities of an asset
+type AssetFormat struct {
+	Ticker   string
+	Decimals int
+}
+
+// ValueFormatter renders values for display using known asset formats
+type ValueFormatter struct {
+	// Assets maps asset IDs to their formats. Other assets are shown by their
+	// asset name if it is printable text, or their asset ID, without decimals
+	Assets map[AssetID]AssetFormat
+}
+
+// FormatValue renders a value with ADA and any assets as raw quantities, such
+// as "12.345678 ADA + 15 4d494e"
+func FormatValue(v Value) string {
+	return ValueFormatter{}.Format(v)
+}
+
+// Format renders a value, such as "12.345678 ADA + 1.5 MIN". ADA comes first,
+// followed by assets ordered by name
+func (f ValueFormatter) Format(v Value) string {
+	parts := []string{FormatQuantity(v.Coins, LovelaceDecimals) + " ADA"}
+	type assetPart struct {
+		name string
+		text string
+	}
+	assetParts := make([]assetPart, 0, len(v.Assets))
+	for asset, quantity := range v.Assets {
+		name, format := f.assetFormat(AssetID(asset))
+		assetParts = append(assetParts, assetPart{
+			name: name,
+			text: FormatQuantity(quantity, format.Decimals) + " " + name,
+		})
+	}
+	sort.Slice(assetParts, func(i, j int) bool {
+		if assetParts[i].name != assetParts[j].name {
+			return assetParts[i].name < assetParts[j].name
+		}
+		return assetParts[i].text < assetParts[j].text
+	})
+	for _, part := range assetParts {
+		parts = append(parts, part.text)
+	}
+	return strings.Join(parts, " + ")
+}
+
+func (f ValueFormatter) assetFormat(assetID AssetID) (string, AssetFormat) {
+	format, ok := f.Assets[assetID]
+	if ok && format.Ticker != "" {
+		return format.Ticker, format
+	}
+	assetName := assetID.AssetName()
+	if _, name, ok := ParseCIP67Label(assetName); ok {
+		assetName = name
+	}
+	if name, ok := printableAssetName(assetName); ok {
+		return name, format
+	}
+	return assetID.String(), format
+}
+
+func printableAssetName(assetName string) (string, bool) {
+	if assetName == "" || len(assetName)%2 != 0 {
+		return "", false
+	}
+	name := make([]byte, 0, len(assetName)/2)
+	for i := 0; i < len(assetName); i += 2 {
+		b, err := strconv.ParseUint(assetName[i:i+2], 16, 8)
+		if err != nil {
+			return "", false
+		}
+		name = append(name, byte(b))
+	}
+	if !utf8.Valid(name) {
+		return "", false
+	}
+	for _, r := range string(name) {
+		if !unicode.IsPrint(r) || unicode.IsSpace(r) {
+			return "", false
+		}
+	}
+	return string(name), true
+}
+
+// FormatQuantity renders an integer quantity with the given number of
+// decimals, omitting trailing zeros, such as "1.5" for 1500000 with 6
+// decimals
+func FormatQuantity(quantity int, decimals int) string {
+	negative := quantity < 0
+	digits := strconv.FormatUint(absInt(quantity), 10)
+	if decimals > 0 {
+		if len(digits) <= decimals {
+			digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+		}
+		whole := digits[:len(digits)-decimals]
+		fraction := strings.TrimRight(digits[len(digits)-decimals:], "0")
+		digits = whole
+		if fraction != "" {
+			digits += "." + fraction
+		}
+	}
+	if negative {
+		return "-" + digits
+	}
+	return digits
+}
+
+func absInt(v int) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+	return uint64(v)
+}
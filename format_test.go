@@ -0,0 +1,45 @@
+package kupogo
+
+import "testing"
+
+func TestFormatQuantity(t *testing.T) {
+	testDefs := []struct {
+		quantity int
+		decimals int
+		expected string
+	}{
+		{12345678, 6, "12.345678"},
+		{1500000, 6, "1.5"},
+		{1000000, 6, "1"},
+		{5, 6, "0.000005"},
+		{0, 6, "0"},
+		{-250, 2, "-2.5"},
+		{42, 0, "42"},
+	}
+	for _, testDef := range testDefs {
+		if formatted := FormatQuantity(testDef.quantity, testDef.decimals); formatted != testDef.expected {
+			t.Errorf("Expected %s for %d with %d decimals, got %s", testDef.expected, testDef.quantity, testDef.decimals, formatted)
+		}
+	}
+}
+
+func TestValueFormatter(t *testing.T) {
+	policyID := "29d222ce763455e3d7a09a665ce554f00ac89d2e99a1a83d267170c6"
+	min := NewAssetID(policyID, "4d494e")
+	value := Value{
+		Coins: 12345678,
+		Assets: Assets{
+			string(min):                          1500000,
+			string(NewAssetID(policyID, "0102")): 3,
+			string(NewAssetID(policyID, CIP67LabelNFT.AssetName("4e4654"))): 1,
+		},
+	}
+	formatter := ValueFormatter{Assets: map[AssetID]AssetFormat{min: {Ticker: "MIN", Decimals: 6}}}
+	expected := "12.345678 ADA + 3 " + policyID + ".0102 + 1.5 MIN + 1 NFT"
+	if formatted := formatter.Format(value); formatted != expected {
+		t.Fatalf("Expected %s, got %s", expected, formatted)
+	}
+	if formatted := FormatValue(Value{Coins: 2000000}); formatted != "2 ADA" {
+		t.Fatalf("Expected 2 ADA, got %s", formatted)
+	}
+}
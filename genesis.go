@@ -0,0 +1,151 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// ShelleyGenesis is the subset of a Shelley genesis file describing slot
+// timing and finality
+type ShelleyGenesis struct {
+	SystemStart      time.Time `json:"systemStart"`
+	NetworkMagic     uint32    `json:"networkMagic"`
+	NetworkID        string    `json:"networkId"`
+	SecurityParam    int       `json:"securityParam"`
+	ActiveSlotsCoeff float64   `json:"activeSlotsCoeff"`
+	// SlotLength is in seconds
+	SlotLength  float64 `json:"slotLength"`
+	EpochLength int     `json:"epochLength"`
+}
+
+// LoadShelleyGenesis reads a Shelley genesis file
+func LoadShelleyGenesis(path string) (*ShelleyGenesis, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Shelley genesis: %s", err)
+	}
+	genesis := &ShelleyGenesis{}
+	if err := json.Unmarshal(data, genesis); err != nil {
+		return nil, fmt.Errorf("failed to decode Shelley genesis: %s", err)
+	}
+	if genesis.SlotLength <= 0 || genesis.EpochLength <= 0 {
+		return nil, fmt.Errorf("invalid Shelley genesis: missing slot or epoch length")
+	}
+	return genesis, nil
+}
+
+// Network returns the network described by the genesis, assuming it starts
+// in the Shelley era, as custom and private networks usually do
+func (g ShelleyGenesis) Network(name string) Network {
+	networkID := byte(0)
+	if g.NetworkID == "Mainnet" {
+		networkID = 1
+	}
+	return Network{
+		Name:             name,
+		Magic:            g.NetworkMagic,
+		NetworkID:        networkID,
+		SecurityParam:    g.SecurityParam,
+		ActiveSlotsCoeff: g.ActiveSlotsCoeff,
+		SlotConfig: SlotConfig{
+			ZeroTime:   g.SystemStart,
+			SlotLength: time.Duration(g.SlotLength * float64(time.Second)),
+		},
+		EpochLength: g.EpochLength,
+	}
+}
+
+// nodeConfig is the subset of a node configuration file locating the genesis
+// files and the Shelley hard fork
+type nodeConfig struct {
+	ByronGenesisFile           string `json:"ByronGenesisFile"`
+	ShelleyGenesisFile         string `json:"ShelleyGenesisFile"`
+	TestShelleyHardForkAtEpoch *int   `json:"TestShelleyHardForkAtEpoch"`
+}
+
+// byronGenesis is the subset of a Byron genesis file describing slot timing
+type byronGenesis struct {
+	BlockVersionData struct {
+		// SlotDuration is in milliseconds
+		SlotDuration string `json:"slotDuration"`
+	} `json:"blockVersionData"`
+	ProtocolConsts struct {
+		K int `json:"k"`
+	} `json:"protocolConsts"`
+}
+
+// LoadNodeConfig derives a network from a node configuration file and the
+// genesis files it references, resolved relative to it. When the
+// configuration sets TestShelleyHardForkAtEpoch, the Byron epochs before it
+// are accounted for using the Byron genesis
+func LoadNodeConfig(name string, path string) (Network, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Network{}, fmt.Errorf("failed to read node config: %s", err)
+	}
+	var config nodeConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Network{}, fmt.Errorf("failed to decode node config: %s", err)
+	}
+	if config.ShelleyGenesisFile == "" {
+		return Network{}, fmt.Errorf("node config does not reference a Shelley genesis")
+	}
+	dir := filepath.Dir(path)
+	genesis, err := LoadShelleyGenesis(resolveConfigPath(dir, config.ShelleyGenesisFile))
+	if err != nil {
+		return Network{}, err
+	}
+	network := genesis.Network(name)
+	if config.TestShelleyHardForkAtEpoch == nil || *config.TestShelleyHardForkAtEpoch == 0 {
+		return network, nil
+	}
+	if config.ByronGenesisFile == "" {
+		return Network{}, fmt.Errorf("node config does not reference a Byron genesis")
+	}
+	byronData, err := os.ReadFile(resolveConfigPath(dir, config.ByronGenesisFile))
+	if err != nil {
+		return Network{}, fmt.Errorf("failed to read Byron genesis: %s", err)
+	}
+	var byron byronGenesis
+	if err := json.Unmarshal(byronData, &byron); err != nil {
+		return Network{}, fmt.Errorf("failed to decode Byron genesis: %s", err)
+	}
+	slotDuration, err := strconv.Atoi(byron.BlockVersionData.SlotDuration)
+	if err != nil {
+		return Network{}, fmt.Errorf("invalid Byron slot duration: %s", err)
+	}
+	// Byron epochs are 10k slots long
+	hardForkEpoch := *config.TestShelleyHardForkAtEpoch
+	byronSlots := hardForkEpoch * 10 * byron.ProtocolConsts.K
+	network.ShelleyStartEpoch = hardForkEpoch
+	network.SlotConfig.ZeroSlot = byronSlots
+	network.SlotConfig.ZeroTime = genesis.SystemStart.Add(
+		time.Duration(byronSlots) * time.Duration(slotDuration) * time.Millisecond,
+	)
+	return network, nil
+}
+
+func resolveConfigPath(dir string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
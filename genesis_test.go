@@ -0,0 +1,76 @@
+package kupogo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeTestFile(t *testing.T, path string, content string) {
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+}
+
+func TestLoadShelleyGenesis(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shelley-genesis.json")
+	writeTestFile(t, path, `{
+		"activeSlotsCoeff": 0.1,
+		"epochLength": 500,
+		"networkId": "Testnet",
+		"networkMagic": 42,
+		"securityParam": 10,
+		"slotLength": 0.2,
+		"systemStart": "2024-01-01T00:00:00Z"
+	}`)
+	genesis, err := LoadShelleyGenesis(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	network := genesis.Network("devnet")
+	if network.Magic != 42 || network.NetworkID != 0 || network.SlotConfig.SlotLength != 200*time.Millisecond {
+		t.Fatalf("Unexpected network: %+v", network)
+	}
+	if slotNo := network.TimeToSlot(time.Date(2024, time.January, 1, 0, 1, 0, 0, time.UTC)); slotNo != 300 {
+		t.Fatalf("Expected slot 300, got %d", slotNo)
+	}
+	if epoch, _ := network.SlotToEpoch(1200); epoch != 2 {
+		t.Fatalf("Expected epoch 2, got %d", epoch)
+	}
+	if slots := network.ImmutableSlots(); slots != 300 {
+		t.Fatalf("Expected 300 immutable slots, got %d", slots)
+	}
+}
+
+func TestLoadNodeConfig(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "config.json"), `{
+		"ByronGenesisFile": "byron-genesis.json",
+		"ShelleyGenesisFile": "shelley-genesis.json",
+		"TestShelleyHardForkAtEpoch": 4
+	}`)
+	writeTestFile(t, filepath.Join(dir, "byron-genesis.json"), `{
+		"blockVersionData": {"slotDuration": "20000"},
+		"protocolConsts": {"k": 2160, "protocolMagic": 1}
+	}`)
+	writeTestFile(t, filepath.Join(dir, "shelley-genesis.json"), `{
+		"activeSlotsCoeff": 0.05,
+		"epochLength": 432000,
+		"networkId": "Testnet",
+		"networkMagic": 1,
+		"securityParam": 2160,
+		"slotLength": 1,
+		"systemStart": "2022-06-01T00:00:00Z"
+	}`)
+	network, err := LoadNodeConfig("preprod", filepath.Join(dir, "config.json"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if network.SlotConfig != NetworkPreprod.SlotConfig || network.ShelleyStartEpoch != 4 {
+		t.Fatalf("Expected preprod slot config, got %+v", network)
+	}
+	if network.ImmutableSlots() != NetworkPreprod.ImmutableSlots() {
+		t.Fatalf("Expected %d immutable slots, got %d", NetworkPreprod.ImmutableSlots(), network.ImmutableSlots())
+	}
+}
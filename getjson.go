@@ -0,0 +1,56 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GetJSON requests the Kupo endpoint at path, such as "/matches/*", with the
+// given query parameters and decodes the JSON response into out. It goes
+// through the same middleware, caching and decoding as the other methods, for
+// calling endpoints this package does not cover yet. A non-200 status is an
+// error
+func (c *Client) GetJSON(ctx context.Context, path string, query url.Values, out any) error {
+	u := strings.TrimSuffix(c.KupoUrl, "/") + "/" + strings.TrimPrefix(path, "/")
+	if encoded := query.Encode(); encoded != "" {
+		u += "?" + encoded
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %s", req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(
+			"failed to get %s: status code %d%s",
+			req.URL.Path,
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	if err := c.decodeJSON(req, resp.Body, out); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %s", req.URL.Path, err)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package kupogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestGetJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/kupo/experimental/stats" || r.URL.Query().Get("window") != "10" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"outputs":42}`))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL + "/kupo/")
+
+	var out struct {
+		Outputs int `json:"outputs"`
+	}
+	err := client.GetJSON(context.Background(), "/experimental/stats", url.Values{"window": {"10"}}, &out)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if out.Outputs != 42 {
+		t.Fatalf("Unexpected response: %+v", out)
+	}
+	if err := client.GetJSON(context.Background(), "unknown", nil, &out); err == nil {
+		t.Fatalf("Expected an error for an error status")
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hdwallet
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+const (
+	defaultGapLimit     = 20
+	defaultMaxSlotLag   = 120
+	defaultSyncInterval = 10 * time.Second
+)
+
+// Scanner discovers the used addresses of a CIP-1852 account by deriving
+// payment keys until a gap of unused addresses is found
+type Scanner struct {
+	Client    *kupogo.Client
+	XPub      *XPub
+	NetworkID byte
+	// GapLimit is the number of consecutive unused addresses after which
+	// scanning stops, defaulting to 20
+	GapLimit int
+	// RegisterPatterns adds Kupo patterns for the derived payment
+	// credentials before querying them, rolling back to RollbackTo. The
+	// credentials are registered a gap limit's worth at a time, and each
+	// registration waits for Kupo to catch up again, as it only returns the
+	// matches of newly added patterns once it has
+	RegisterPatterns bool
+	RollbackTo       kupogo.Point
+	RollbackLimit    kupogo.RollbackLimit
+	// MaxSlotLag is the number of slots Kupo may be behind its node tip to be
+	// considered caught up after registering patterns, defaulting to 120
+	MaxSlotLag int
+	// SyncInterval is how often Kupo is checked while catching up,
+	// defaulting to 10 seconds
+	SyncInterval time.Duration
+}
+
+// DiscoveredAddress describes a derived address and its outputs
+type DiscoveredAddress struct {
+	Role           uint32
+	Index          uint32
+	Address        string
+	PaymentKeyHash string
+	Pattern        string
+	Matches        kupogo.Matches
+	Balance        kupogo.Value
+}
+
+// ScanResult holds the used addresses of the account and their total balance
+type ScanResult struct {
+	Addresses []DiscoveredAddress
+	Balance   kupogo.Value
+}
+
+// Scan derives and queries external and internal addresses of the account
+func (s *Scanner) Scan() (*ScanResult, error) {
+	return s.ScanContext(context.Background())
+}
+
+// ScanContext is like Scan with a context, which also ends the wait for Kupo
+// to catch up after registering patterns
+func (s *Scanner) ScanContext(ctx context.Context) (*ScanResult, error) {
+	gapLimit := s.GapLimit
+	if gapLimit <= 0 {
+		gapLimit = defaultGapLimit
+	}
+	stakeKey, err := s.XPub.DerivePath(RoleStaking, 0)
+	if err != nil {
+		return nil, err
+	}
+	roles := []uint32{RoleExternal, RoleInternal}
+	// registered holds the number of addresses registered for each role
+	registered := make(map[uint32]uint32, len(roles))
+	if s.RegisterPatterns {
+		// The first gap of both roles is registered at once, which covers
+		// the scan of accounts not using more addresses
+		if err := s.register(ctx, roles, 0, uint32(gapLimit), stakeKey); err != nil {
+			return nil, err
+		}
+		for _, role := range roles {
+			registered[role] = uint32(gapLimit)
+		}
+	}
+	result := &ScanResult{}
+	for _, role := range roles {
+		unused := 0
+		for index := uint32(0); unused < gapLimit; index++ {
+			if s.RegisterPatterns && index >= registered[role] {
+				err := s.register(ctx, []uint32{role}, index, uint32(gapLimit), stakeKey)
+				if err != nil {
+					return nil, err
+				}
+				registered[role] = index + uint32(gapLimit)
+			}
+			discovered, err := s.scanAddress(ctx, role, index, stakeKey)
+			if err != nil {
+				return nil, err
+			}
+			if len(discovered.Matches) == 0 {
+				unused++
+				continue
+			}
+			unused = 0
+			result.Addresses = append(result.Addresses, *discovered)
+			result.Balance = result.Balance.Add(discovered.Balance)
+		}
+	}
+	return result, nil
+}
+
+// register adds the patterns of count addresses from the given index of each
+// role with a single request, and waits for Kupo to catch up
+func (s *Scanner) register(
+	ctx context.Context,
+	roles []uint32,
+	from uint32,
+	count uint32,
+	stakeKey *XPub,
+) error {
+	patterns := make([]string, 0, len(roles)*int(count))
+	for _, role := range roles {
+		derived, err := s.deriveRange(role, from, count, stakeKey)
+		if err != nil {
+			return err
+		}
+		for _, address := range derived {
+			patterns = append(patterns, address.Pattern)
+		}
+	}
+	_, err := s.Client.AddPatternsContext(ctx, patterns, s.RollbackTo, s.RollbackLimit)
+	if err != nil {
+		return fmt.Errorf("failed to register patterns: %s", err)
+	}
+	return s.awaitSync(ctx)
+}
+
+// awaitSync waits until Kupo has caught up with its node after a rollback
+func (s *Scanner) awaitSync(ctx context.Context) error {
+	maxSlotLag := s.MaxSlotLag
+	if maxSlotLag <= 0 {
+		maxSlotLag = defaultMaxSlotLag
+	}
+	interval := s.SyncInterval
+	if interval <= 0 {
+		interval = defaultSyncInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		err := s.Client.CheckReady(ctx, maxSlotLag)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, kupogo.ErrNotReady) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for kupo to catch up: %s", err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Derive returns the addresses of count payment keys of a role from the given
+// index, without querying Kupo, such as to register the patterns of a new
+// account
+func (s *Scanner) Derive(role uint32, from uint32, count uint32) ([]DiscoveredAddress, error) {
+	stakeKey, err := s.XPub.DerivePath(RoleStaking, 0)
+	if err != nil {
+		return nil, err
+	}
+	return s.deriveRange(role, from, count, stakeKey)
+}
+
+func (s *Scanner) deriveRange(
+	role uint32,
+	from uint32,
+	count uint32,
+	stakeKey *XPub,
+) ([]DiscoveredAddress, error) {
+	ret := make([]DiscoveredAddress, 0, count)
+	for index := from; index < from+count; index++ {
+		derived, err := s.derive(role, index, stakeKey)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, *derived)
+	}
+	return ret, nil
+}
+
+// derive returns the address, key hash and pattern of a payment key
+func (s *Scanner) derive(role uint32, index uint32, stakeKey *XPub) (*DiscoveredAddress, error) {
+	paymentKey, err := s.XPub.DerivePath(role, index)
+	if err != nil {
+		return nil, err
+	}
+	address, err := BaseAddress(s.NetworkID, paymentKey, stakeKey)
+	if err != nil {
+		return nil, err
+	}
+	keyHash := hex.EncodeToString(paymentKey.KeyHash())
+	return &DiscoveredAddress{
+		Role:           role,
+		Index:          index,
+		Address:        address,
+		PaymentKeyHash: keyHash,
+		// Match on the payment credential to also catch outputs using other
+		// (or no) stake credentials
+		Pattern: kupogo.MatchCredential(keyHash).String(),
+	}, nil
+}
+
+func (s *Scanner) scanAddress(
+	ctx context.Context,
+	role uint32,
+	index uint32,
+	stakeKey *XPub,
+) (*DiscoveredAddress, error) {
+	discovered, err := s.derive(role, index, stakeKey)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.Client.GetMatchesContext(ctx, discovered.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	discovered.Matches = *matches
+	discovered.Balance = matches.Balance()
+	return discovered, nil
+}
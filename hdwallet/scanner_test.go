@@ -0,0 +1,146 @@
+package hdwallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestScanner_Scan(t *testing.T) {
+	// Only external/1 and internal/0 have been used
+	used := map[string]kupogo.Value{
+		"f554692c2b112bc4d16536ed36aae8e4cda78ed06793980cf475fbd2": {Coins: 5000000},
+		"a7334728bf6e94e0f86fdbb34fa100f63bc89d8a9dc8ed6e11399d53": {Coins: 2000000},
+	}
+	queried := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queried++
+			keyHash := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/matches/"), "/*")
+			matches := kupogo.Matches{}
+			if value, ok := used[keyHash]; ok {
+				matches = append(matches, kupogo.Match{TransactionID: keyHash, Value: value})
+			}
+			respBody, _ := json.Marshal(matches)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	xpub, _ := ParseAccountXPub(testAccountXPub)
+	scanner := &Scanner{
+		Client:   kupogo.NewClient(server.URL),
+		XPub:     xpub,
+		GapLimit: 3,
+	}
+	result, err := scanner.Scan()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(result.Addresses) != 2 {
+		t.Fatalf("Expected 2 used addresses, got %d", len(result.Addresses))
+	}
+	if result.Addresses[0].Index != 1 || result.Addresses[1].Role != RoleInternal {
+		t.Errorf("Unexpected addresses discovered: %v", result.Addresses)
+	}
+	if result.Balance.Coins != 7000000 {
+		t.Errorf("Expected balance of 7000000, got %d", result.Balance.Coins)
+	}
+	// external 0-4 (gap after index 1) and internal 0-3
+	if queried != 9 {
+		t.Errorf("Expected 9 queries, got %d", queried)
+	}
+}
+
+func TestScanner_ScanRegisterPatterns(t *testing.T) {
+	// Only external/2 has been used, which extends the scan past the first
+	// gap of external addresses
+	var registered [][]string
+	healthPolls := 0
+	xpub, _ := ParseAccountXPub(testAccountXPub)
+	external2, err := (&Scanner{XPub: xpub}).derive(RoleExternal, 2, mustDerive(t, xpub, RoleStaking, 0))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	used := external2.PaymentKeyHash
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var respBody []byte
+			switch {
+			case r.Method == http.MethodPut && r.URL.Path == "/patterns":
+				var reqBody struct {
+					Patterns []string `json:"patterns"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&reqBody)
+				registered = append(registered, reqBody.Patterns)
+				respBody, _ = json.Marshal(reqBody.Patterns)
+			case r.URL.Path == "/health":
+				healthPolls++
+				// Kupo is still catching up on the first poll after each
+				// registration
+				checkpoint := 1000
+				if healthPolls%2 == 1 {
+					checkpoint = 0
+				}
+				respBody, _ = json.Marshal(map[string]any{
+					"connection_status":      "connected",
+					"most_recent_checkpoint": checkpoint,
+					"most_recent_node_tip":   1000,
+				})
+			case strings.HasPrefix(r.URL.Path, "/matches/"):
+				keyHash := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/matches/"), "/*")
+				matches := kupogo.Matches{}
+				if keyHash == used {
+					matches = append(matches, kupogo.Match{TransactionID: keyHash, Value: kupogo.Value{Coins: 1}})
+				}
+				respBody, _ = json.Marshal(matches)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	scanner := &Scanner{
+		Client:           kupogo.NewClient(server.URL),
+		XPub:             xpub,
+		GapLimit:         3,
+		RegisterPatterns: true,
+		SyncInterval:     time.Millisecond,
+	}
+	result, err := scanner.ScanContext(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(result.Addresses) != 1 || result.Addresses[0].Index != 2 {
+		t.Fatalf("Unexpected addresses discovered: %v", result.Addresses)
+	}
+	// The first gap of both roles, then external 3-5
+	if len(registered) != 2 || len(registered[0]) != 6 || len(registered[1]) != 3 {
+		t.Fatalf("Expected patterns to be registered in batches, got %v", registered)
+	}
+	if registered[0][2] != external2.Pattern {
+		t.Errorf("Expected the first batch to include external 2, got %v", registered[0])
+	}
+	if healthPolls != 4 {
+		t.Errorf("Expected to wait for Kupo after each registration, got %d health polls", healthPolls)
+	}
+}
+
+func mustDerive(t *testing.T, xpub *XPub, role uint32, index uint32) *XPub {
+	key, err := xpub.DerivePath(role, index)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	return key
+}
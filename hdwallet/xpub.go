@@ -0,0 +1,154 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hdwallet implements watch-only scanning of CIP-1852 HD wallets from
+// an account extended public key
+package hdwallet
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"filippo.io/edwards25519"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/blinklabs-io/kupogo/internal/bech32"
+)
+
+// Roles of the CIP-1852 derivation path
+const (
+	RoleExternal uint32 = 0
+	RoleInternal uint32 = 1
+	RoleStaking  uint32 = 2
+)
+
+const (
+	xpubLength       = 64
+	publicKeyLength  = 32
+	keyHashLength    = 28
+	hardenedIndex    = 0x80000000
+	accountXPubHRP   = "acct_xvk"
+	addressHRP       = "addr"
+	addressTestHRP   = "addr_test"
+	networkIDMainnet = 1
+)
+
+// XPub is an Ed25519-BIP32 extended public key
+type XPub struct {
+	PublicKey [publicKeyLength]byte
+	ChainCode [32]byte
+}
+
+// ParseAccountXPub parses an account extended public key, given either as hex
+// or as a bech32 acct_xvk string
+func ParseAccountXPub(s string) (*XPub, error) {
+	var data []byte
+	var err error
+	if strings.HasPrefix(s, accountXPubHRP+"1") {
+		_, data, err = bech32.Decode(s)
+	} else {
+		data, err = hex.DecodeString(s)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode xpub: %s", err)
+	}
+	if len(data) != xpubLength {
+		return nil, fmt.Errorf("invalid xpub length: %d", len(data))
+	}
+	xpub := &XPub{}
+	copy(xpub.PublicKey[:], data[:publicKeyLength])
+	copy(xpub.ChainCode[:], data[publicKeyLength:])
+	return xpub, nil
+}
+
+// Derive performs a soft (non-hardened) child key derivation
+func (x *XPub) Derive(index uint32) (*XPub, error) {
+	if index >= hardenedIndex {
+		return nil, errors.New("cannot derive hardened keys from a public key")
+	}
+	var indexBytes [4]byte
+	binary.LittleEndian.PutUint32(indexBytes[:], index)
+
+	zMac := hmac.New(sha512.New, x.ChainCode[:])
+	zMac.Write([]byte{0x02})
+	zMac.Write(x.PublicKey[:])
+	zMac.Write(indexBytes[:])
+	z := zMac.Sum(nil)
+
+	ccMac := hmac.New(sha512.New, x.ChainCode[:])
+	ccMac.Write([]byte{0x03})
+	ccMac.Write(x.PublicKey[:])
+	ccMac.Write(indexBytes[:])
+	cc := ccMac.Sum(nil)
+
+	// The child key is A + 8*ZL*B, with ZL the first 28 bytes of Z
+	var scalarBytes [32]byte
+	var carry byte
+	for i := 0; i < keyHashLength; i++ {
+		scalarBytes[i] = z[i]<<3 | carry
+		carry = z[i] >> 5
+	}
+	scalarBytes[keyHashLength] = carry
+	scalar, err := edwards25519.NewScalar().SetCanonicalBytes(scalarBytes[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %s", err)
+	}
+	parent, err := new(edwards25519.Point).SetBytes(x.PublicKey[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %s", err)
+	}
+	child := new(edwards25519.Point).ScalarBaseMult(scalar)
+	child.Add(child, parent)
+
+	ret := &XPub{}
+	copy(ret.PublicKey[:], child.Bytes())
+	copy(ret.ChainCode[:], cc[32:])
+	return ret, nil
+}
+
+// DerivePath derives the key at role/index below an account key
+func (x *XPub) DerivePath(role uint32, index uint32) (*XPub, error) {
+	roleKey, err := x.Derive(role)
+	if err != nil {
+		return nil, err
+	}
+	return roleKey.Derive(index)
+}
+
+// KeyHash returns the blake2b-224 hash of the public key
+func (x *XPub) KeyHash() []byte {
+	hash, _ := blake2b.New(keyHashLength, nil)
+	hash.Write(x.PublicKey[:])
+	return hash.Sum(nil)
+}
+
+// BaseAddress returns the bech32 base address with the given payment and
+// stake keys
+func BaseAddress(networkID byte, payment *XPub, stake *XPub) (string, error) {
+	data := make([]byte, 0, 1+2*keyHashLength)
+	// Header type 0 is a base address with key hash payment and stake parts
+	data = append(data, networkID&0x0f)
+	data = append(data, payment.KeyHash()...)
+	data = append(data, stake.KeyHash()...)
+	hrp := addressTestHRP
+	if networkID == networkIDMainnet {
+		hrp = addressHRP
+	}
+	return bech32.Encode(hrp, data)
+}
@@ -0,0 +1,63 @@
+package hdwallet
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+const testAccountXPub = "4c32058b53e4df920ac3b50f0859d7d8a4f5c92e92c65d1075f1d8a9a0e07b2591db495d11691045874102cbf1bb9f6c9c868ebfa5ce6d3056d976175b0064d4"
+
+func TestXPub_DerivePath(t *testing.T) {
+	xpub, err := ParseAccountXPub(testAccountXPub)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	stakeKey, err := xpub.DerivePath(RoleStaking, 0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	testDefs := []struct {
+		role    uint32
+		index   uint32
+		keyHash string
+		address string
+	}{
+		{
+			role:    RoleExternal,
+			index:   0,
+			keyHash: "9c8273304e495f2f0cad0bdbc6be34af0de6dbcc30836b27c8036e8e",
+			address: "addr_test1qzwgyuesfey47tcv459ah347xjhsmekmescgx6e8eqpkarh9zl04f0qd6vy7f4ckqce75dwasyjnhjl87cetfjasnsts6ful73",
+		},
+		{
+			role:    RoleExternal,
+			index:   1,
+			keyHash: "f554692c2b112bc4d16536ed36aae8e4cda78ed06793980cf475fbd2",
+			address: "addr_test1qr64g6fv9vgjh3x3v5mw6d42arjvmfuw6pne8xqv736lh5h9zl04f0qd6vy7f4ckqce75dwasyjnhjl87cetfjasnsts0dpvvf",
+		},
+		{
+			role:    RoleInternal,
+			index:   0,
+			keyHash: "a7334728bf6e94e0f86fdbb34fa100f63bc89d8a9dc8ed6e11399d53",
+			address: "addr_test1qznnx3eghahffc8cdldmxnapqrmrhjya32wu3mtwzyue65l9zl04f0qd6vy7f4ckqce75dwasyjnhjl87cetfjasnstspztjhg",
+		},
+	}
+	for _, testDef := range testDefs {
+		key, err := xpub.DerivePath(testDef.role, testDef.index)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if keyHash := hex.EncodeToString(key.KeyHash()); keyHash != testDef.keyHash {
+			t.Errorf("Expected key hash %s, got %s", testDef.keyHash, keyHash)
+		}
+		address, err := BaseAddress(0, key, stakeKey)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if address != testDef.address {
+			t.Errorf("Expected address %s, got %s", testDef.address, address)
+		}
+	}
+	if _, err := xpub.Derive(0x80000000); err == nil {
+		t.Errorf("Expected error deriving hardened key")
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Health is Kupo's health report
+type Health struct {
+	// ConnectionStatus is "connected" or "disconnected"
+	ConnectionStatus       string   `json:"connection_status"`
+	MostRecentCheckpoint   *int     `json:"most_recent_checkpoint"`
+	MostRecentNodeTip      *int     `json:"most_recent_node_tip"`
+	SecondsSinceLastBlock  *int     `json:"seconds_since_last_block"`
+	NetworkSynchronization *float64 `json:"network_synchronization"`
+	Configuration          struct {
+		Indexes string `json:"indexes"`
+	} `json:"configuration"`
+	Version string `json:"version"`
+}
+
+// IsConnected reports whether Kupo is connected to its node
+func (h Health) IsConnected() bool {
+	return h.ConnectionStatus == "connected"
+}
+
+// SyncLag returns the number of slots between the node tip and the most
+// recent checkpoint, if both are known
+func (h Health) SyncLag() (int, bool) {
+	if h.MostRecentCheckpoint == nil || h.MostRecentNodeTip == nil {
+		return 0, false
+	}
+	return *h.MostRecentNodeTip - *h.MostRecentCheckpoint, true
+}
+
+// GetHealth fetches Kupo's health report
+func (c *Client) GetHealth() (*Health, error) {
+	return c.GetHealthContext(context.Background())
+}
+
+// GetHealthContext is like GetHealth with a request context
+func (c *Client) GetHealthContext(ctx context.Context) (*Health, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/health", c.KupoUrl),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get health: %s", err)
+	}
+	defer resp.Body.Close()
+	// Kupo answers with 503 when it is not yet synchronized, still including
+	// the report
+	if resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusServiceUnavailable {
+		return nil, fmt.Errorf(
+			"failed to get health: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	health := &Health{}
+	if err := c.decodeJSON(req, resp.Body, health); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal health: %s", err)
+	}
+	return health, nil
+}
@@ -0,0 +1,36 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetHealth(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{
+				"connection_status": "connected",
+				"most_recent_checkpoint": 1000,
+				"most_recent_node_tip": 1250,
+				"seconds_since_last_block": 3,
+				"network_synchronization": 0.99,
+				"configuration": {"indexes": "installed"},
+				"version": "v2.8.0"
+			}`))
+		}),
+	)
+	defer server.Close()
+
+	health, err := NewClient(server.URL).GetHealth()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !health.IsConnected() || health.Version != "v2.8.0" || health.Configuration.Indexes != "installed" {
+		t.Errorf("Unexpected health: %+v", health)
+	}
+	if lag, ok := health.SyncLag(); !ok || lag != 250 {
+		t.Errorf("Expected sync lag of 250, got %d", lag)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// AddressActivity is the activity of an address in a transaction
+type AddressActivity struct {
+	// TransactionID is the transaction, empty for outputs spent in a block
+	// where the spending transaction cannot be identified
+	TransactionID string
+	Point         Point
+	// Received are the outputs created by the transaction
+	Received Matches
+	// Spent are the outputs consumed by the transaction
+	Spent Matches
+	// Delta is the net change of the address' value
+	Delta Value
+}
+
+// Activity reconstructs the history of the matches, such as those of an
+// address, as a chronological list of activity per transaction. Kupo only
+// reports the block in which an output was spent, so spent outputs are
+// attributed to the transaction that created outputs in the same block when
+// there is exactly one, and reported without a transaction otherwise
+func (m Matches) Activity() []AddressActivity {
+	var ret []*AddressActivity
+	byTransaction := make(map[string]*AddressActivity)
+	bySlot := make(map[int][]*AddressActivity)
+	for _, match := range m {
+		activity, ok := byTransaction[match.TransactionID]
+		if !ok {
+			activity = &AddressActivity{
+				TransactionID: match.TransactionID,
+				Point:         match.CreatedAt,
+			}
+			byTransaction[match.TransactionID] = activity
+			bySlot[match.CreatedAt.SlotNo] = append(bySlot[match.CreatedAt.SlotNo], activity)
+			ret = append(ret, activity)
+		}
+		activity.Received = append(activity.Received, match)
+		activity.Delta = activity.Delta.Add(match.Value)
+	}
+	unattributed := make(map[int]*AddressActivity)
+	for _, match := range m {
+		if match.SpentAt == nil {
+			continue
+		}
+		var activity *AddressActivity
+		if candidates := bySlot[match.SpentAt.SlotNo]; len(candidates) == 1 {
+			activity = candidates[0]
+		} else if activity = unattributed[match.SpentAt.SlotNo]; activity == nil {
+			activity = &AddressActivity{Point: *match.SpentAt}
+			unattributed[match.SpentAt.SlotNo] = activity
+			ret = append(ret, activity)
+		}
+		activity.Spent = append(activity.Spent, match)
+		activity.Delta = activity.Delta.Sub(match.Value)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Point.SlotNo != ret[j].Point.SlotNo {
+			return ret[i].Point.SlotNo < ret[j].Point.SlotNo
+		}
+		return activityTransactionIndex(ret[i]) < activityTransactionIndex(ret[j])
+	})
+	activities := make([]AddressActivity, 0, len(ret))
+	for _, activity := range ret {
+		sort.Slice(activity.Received, func(i, j int) bool {
+			return activity.Received[i].OutputIndex < activity.Received[j].OutputIndex
+		})
+		sort.Slice(activity.Spent, func(i, j int) bool {
+			return matchLess(activity.Spent[i], activity.Spent[j])
+		})
+		activities = append(activities, *activity)
+	}
+	return activities
+}
+
+// activityTransactionIndex orders activities within a block, placing those
+// without a known transaction last
+func activityTransactionIndex(activity *AddressActivity) int {
+	if len(activity.Received) == 0 {
+		return math.MaxInt
+	}
+	return activity.Received[0].TransactionIndex
+}
+
+// GetAddressHistory fetches the spent and unspent matches of an address and
+// returns its activity per transaction, oldest first
+func (c *Client) GetAddressHistory(address string) ([]AddressActivity, error) {
+	return c.GetAddressHistoryContext(context.Background(), address)
+}
+
+// GetAddressHistoryContext is like GetAddressHistory with a request context
+func (c *Client) GetAddressHistoryContext(ctx context.Context, address string) ([]AddressActivity, error) {
+	matches, _, err := c.getMatches(ctx, string(MatchAddress(address)), MatchOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return matches.Activity(), nil
+}
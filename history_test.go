@@ -0,0 +1,62 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetAddressHistory(t *testing.T) {
+	matches := Matches{
+		{TransactionID: "t1", OutputIndex: 0, Value: Value{Coins: 10}, CreatedAt: Point{SlotNo: 10}, SpentAt: &Point{SlotNo: 20}},
+		{TransactionID: "t1", OutputIndex: 1, Value: Value{Coins: 5}, CreatedAt: Point{SlotNo: 10}, SpentAt: &Point{SlotNo: 30}},
+		// Change of the transaction spending t1#0
+		{TransactionID: "t2", Value: Value{Coins: 3}, CreatedAt: Point{SlotNo: 20}},
+		// Two unrelated transactions in the block spending t1#1
+		{TransactionID: "t4", TransactionIndex: 2, Value: Value{Coins: 1}, CreatedAt: Point{SlotNo: 30}},
+		{TransactionID: "t3", TransactionIndex: 1, Value: Value{Coins: 2}, CreatedAt: Point{SlotNo: 30}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/matches/addr_test1" || r.URL.RawQuery != "" {
+				t.Errorf("Unexpected request %s", r.URL)
+			}
+			_ = json.NewEncoder(w).Encode(matches)
+		}),
+	)
+	defer server.Close()
+
+	history, err := NewClient(server.URL).GetAddressHistory("addr_test1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	type summary struct {
+		TransactionID string
+		SlotNo        int
+		Received      int
+		Spent         int
+		Delta         int
+	}
+	var got []summary
+	for _, activity := range history {
+		got = append(got, summary{
+			TransactionID: activity.TransactionID,
+			SlotNo:        activity.Point.SlotNo,
+			Received:      len(activity.Received),
+			Spent:         len(activity.Spent),
+			Delta:         activity.Delta.Coins,
+		})
+	}
+	expected := []summary{
+		{TransactionID: "t1", SlotNo: 10, Received: 2, Delta: 15},
+		{TransactionID: "t2", SlotNo: 20, Received: 1, Spent: 1, Delta: -7},
+		{TransactionID: "t3", SlotNo: 30, Received: 1, Delta: 2},
+		{TransactionID: "t4", SlotNo: 30, Received: 1, Delta: 1},
+		{SlotNo: 30, Spent: 1, Delta: -5},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Unexpected history %+v", got)
+	}
+}
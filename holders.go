@@ -0,0 +1,115 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"sort"
+
+	"github.com/blinklabs-io/kupogo/address"
+)
+
+// HoldersOptions configures a holders report
+type HoldersOptions struct {
+	// ByStake groups holdings by stake address, so that the addresses of a
+	// wallet count as one holder. Addresses without a stake credential are
+	// kept on their own
+	ByStake bool
+	// Limit is the maximum number of holders returned, 0 for all
+	Limit int
+}
+
+// Holder is the holding of an asset by an address or stake address
+type Holder struct {
+	Holder   string
+	Quantity int
+	// Outputs is the number of unspent outputs holding the asset
+	Outputs int
+	// Share is the fraction of the total quantity held
+	Share float64
+}
+
+// Holders ranks the holders of an asset among the unspent matches, largest
+// first
+func (m Matches) Holders(asset AssetID, opts HoldersOptions) []Holder {
+	holdings := make(map[string]*Holder)
+	total := 0
+	for _, match := range m {
+		quantity := match.Value.Assets[string(asset)]
+		if match.SpentAt != nil || quantity <= 0 {
+			continue
+		}
+		key := match.Address
+		if opts.ByStake {
+			key = stakeKey(match.Address)
+		}
+		holder, ok := holdings[key]
+		if !ok {
+			holder = &Holder{Holder: key}
+			holdings[key] = holder
+		}
+		holder.Quantity += quantity
+		holder.Outputs++
+		total += quantity
+	}
+	ret := make([]Holder, 0, len(holdings))
+	for _, holder := range holdings {
+		holder.Share = float64(holder.Quantity) / float64(total)
+		ret = append(ret, *holder)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Quantity != ret[j].Quantity {
+			return ret[i].Quantity > ret[j].Quantity
+		}
+		return ret[i].Holder < ret[j].Holder
+	})
+	if opts.Limit > 0 && len(ret) > opts.Limit {
+		ret = ret[:opts.Limit]
+	}
+	return ret
+}
+
+// stakeKey returns the stake address of an address, or the address itself if
+// it has no stake credential
+func stakeKey(addr string) string {
+	parsed, err := address.Parse(addr)
+	if err != nil {
+		return addr
+	}
+	stake, ok := parsed.StakeAddress()
+	if !ok {
+		return addr
+	}
+	return stake.String()
+}
+
+// GetTopHolders fetches the unspent outputs holding an asset and ranks its
+// holders, largest first
+func (c *Client) GetTopHolders(asset AssetID, opts HoldersOptions) ([]Holder, error) {
+	return c.GetTopHoldersContext(context.Background(), asset, opts)
+}
+
+// GetTopHoldersContext is like GetTopHolders with a request context
+func (c *Client) GetTopHoldersContext(
+	ctx context.Context,
+	asset AssetID,
+	opts HoldersOptions,
+) ([]Holder, error) {
+	matches, _, err := c.getMatches(ctx, string(MatchAsset(asset)), MatchOptions{Unspent: true})
+	if err != nil {
+		return nil, err
+	}
+	return matches.Holders(asset, opts), nil
+}
@@ -0,0 +1,56 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetTopHolders(t *testing.T) {
+	asset := AssetID(testPolicyID + ".01")
+	// Shares the stake credential of testBaseAddress
+	scriptBaseAddress := "addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh"
+	stakeAddress := "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"
+	matches := Matches{
+		{Address: testBaseAddress, Value: Value{Assets: Assets{string(asset): 30}}},
+		{Address: scriptBaseAddress, Value: Value{Assets: Assets{string(asset): 20}}},
+		{Address: testEnterpriseAddress, Value: Value{Assets: Assets{string(asset): 40}}},
+		{Address: testEnterpriseAddress, Value: Value{Assets: Assets{string(asset): 10}}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/matches/"+testPolicyID+".01" || r.URL.RawQuery != "unspent" {
+				t.Errorf("Unexpected request %s", r.URL)
+			}
+			_ = json.NewEncoder(w).Encode(matches)
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	holders, err := client.GetTopHolders(asset, HoldersOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := []Holder{
+		{Holder: testEnterpriseAddress, Quantity: 50, Outputs: 2, Share: 0.5},
+		{Holder: testBaseAddress, Quantity: 30, Outputs: 1, Share: 0.3},
+	}
+	if !reflect.DeepEqual(holders, expected) {
+		t.Fatalf("Unexpected holders %+v", holders)
+	}
+
+	holders, err = client.GetTopHolders(asset, HoldersOptions{ByStake: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected = []Holder{
+		{Holder: testEnterpriseAddress, Quantity: 50, Outputs: 2, Share: 0.5},
+		{Holder: stakeAddress, Quantity: 50, Outputs: 2, Share: 0.5},
+	}
+	if !reflect.DeepEqual(holders, expected) {
+		t.Fatalf("Unexpected holders by stake %+v", holders)
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpcache provides an http.RoundTripper which caches responses
+// following the freshness and validation rules of RFC 9111, so repeated
+// identical queries are served locally or revalidated cheaply with ETags
+package httpcache
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Store holds serialized responses keyed by request
+type Store interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte)
+	Delete(key string)
+}
+
+// Transport is a caching http.RoundTripper. Only GET requests with 200
+// responses are cached
+type Transport struct {
+	// Transport performs the actual requests, defaulting to
+	// http.DefaultTransport
+	Transport http.RoundTripper
+	Store     Store
+	now       func() time.Time
+}
+
+// NewTransport creates a caching transport backed by the given store
+func NewTransport(store Store) *Transport {
+	return &Transport{Store: store}
+}
+
+const storedAtHeader = "X-Httpcache-Stored-At"
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || hasDirective(req.Header, "no-store") {
+		return t.transport().RoundTrip(req)
+	}
+	key := cacheKey(req)
+	cached := t.load(key, req)
+	if cached != nil {
+		if !hasDirective(req.Header, "no-cache") &&
+			!hasDirective(cached.Header, "no-cache") &&
+			t.isFresh(cached) {
+			return cached, nil
+		}
+		etag := cached.Header.Get("ETag")
+		lastModified := cached.Header.Get("Last-Modified")
+		if etag != "" || lastModified != "" {
+			req = req.Clone(req.Context())
+			if etag != "" {
+				req.Header.Set("If-None-Match", etag)
+			}
+			if lastModified != "" {
+				req.Header.Set("If-Modified-Since", lastModified)
+			}
+		}
+	}
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil {
+		if cached != nil {
+			cached.Body.Close()
+		}
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+		for name, values := range resp.Header {
+			cached.Header[name] = values
+		}
+		cached.Header.Set(storedAtHeader, t.clock().UTC().Format(time.RFC3339Nano))
+		body, err := io.ReadAll(cached.Body)
+		cached.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		t.store(key, cached, body)
+		cached.Header.Del(storedAtHeader)
+		cached.Body = io.NopCloser(bytes.NewReader(body))
+		return cached, nil
+	}
+	if cached != nil {
+		cached.Body.Close()
+	}
+	if resp.StatusCode != http.StatusOK || hasDirective(resp.Header, "no-store") {
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Header.Set(storedAtHeader, t.clock().UTC().Format(time.RFC3339Nano))
+	t.store(key, resp, body)
+	resp.Header.Del(storedAtHeader)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func (t *Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+func (t *Transport) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+func (t *Transport) load(key string, req *http.Request) *http.Response {
+	data, ok := t.Store.Get(key)
+	if !ok {
+		return nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+	if err != nil {
+		t.Store.Delete(key)
+		return nil
+	}
+	return resp
+}
+
+func (t *Transport) store(key string, resp *http.Response, body []byte) {
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.TransferEncoding = nil
+	data, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return
+	}
+	t.Store.Set(key, data)
+}
+
+// isFresh reports whether a cached response is within its freshness lifetime,
+// taken from max-age or Expires
+func (t *Transport) isFresh(resp *http.Response) bool {
+	storedAt, err := time.Parse(time.RFC3339Nano, resp.Header.Get(storedAtHeader))
+	resp.Header.Del(storedAtHeader)
+	if err != nil {
+		return false
+	}
+	age := t.clock().Sub(storedAt)
+	if maxAge, ok := directiveValue(resp.Header, "max-age"); ok {
+		seconds, err := strconv.Atoi(maxAge)
+		if err != nil {
+			return false
+		}
+		return age < time.Duration(seconds)*time.Second
+	}
+	if expires := resp.Header.Get("Expires"); expires != "" {
+		expiresAt, err := http.ParseTime(expires)
+		if err != nil {
+			return false
+		}
+		date, err := http.ParseTime(resp.Header.Get("Date"))
+		if err != nil {
+			date = storedAt
+		}
+		return age < expiresAt.Sub(date)
+	}
+	return false
+}
+
+func cacheKey(req *http.Request) string {
+	return req.Header.Get("Accept") + " " + req.URL.String()
+}
+
+func cacheControl(header http.Header) map[string]string {
+	directives := make(map[string]string)
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			directives[strings.ToLower(name)] = strings.Trim(arg, `"`)
+		}
+	}
+	return directives
+}
+
+func hasDirective(header http.Header, name string) bool {
+	_, ok := cacheControl(header)[name]
+	return ok
+}
+
+func directiveValue(header http.Header, name string) (string, bool) {
+	value, ok := cacheControl(header)[name]
+	return value, ok
+}
+
+// MemoryStore is an in-memory Store
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string][]byte)}
+}
+
+func (s *MemoryStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.entries[key]
+	return value, ok
+}
+
+func (s *MemoryStore) Set(key string, value []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = value
+}
+
+func (s *MemoryStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+}
+
+// DiskStore is a Store keeping one file per entry in a directory, so cached
+// responses survive process restarts
+type DiskStore struct {
+	dir string
+}
+
+// NewDiskStore creates a disk store, creating the directory if needed
+func NewDiskStore(dir string) (*DiskStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %s", err)
+	}
+	return &DiskStore{dir: dir}, nil
+}
+
+func (s *DiskStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:]))
+}
+
+func (s *DiskStore) Get(key string) ([]byte, bool) {
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+func (s *DiskStore) Set(key string, value []byte) {
+	path := s.path(key)
+	f, err := os.CreateTemp(s.dir, filepath.Base(path)+".tmp")
+	if err != nil {
+		return
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(value)
+	if closeErr := f.Close(); err != nil || closeErr != nil {
+		return
+	}
+	_ = os.Rename(f.Name(), path)
+}
+
+func (s *DiskStore) Delete(key string) {
+	_ = os.Remove(s.path(key))
+}
@@ -0,0 +1,89 @@
+package httpcache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestTransport(t *testing.T) {
+	requests := 0
+	revalidations := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				revalidations++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("Cache-Control", "max-age=60")
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(`[{"transaction_id":"aa","value":{"coins":1}}]`))
+		}),
+	)
+	defer server.Close()
+
+	store, err := NewDiskStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	now := time.Now()
+	transport := NewTransport(store)
+	transport.now = func() time.Time { return now }
+	client := kupogo.NewClient(
+		server.URL,
+		kupogo.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	check := func() {
+		matches, err := client.GetMatches("addr1")
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if len(*matches) != 1 || (*matches)[0].TransactionID != "aa" {
+			t.Fatalf("Unexpected matches: %v", *matches)
+		}
+	}
+	check()
+	check()
+	if requests != 1 {
+		t.Errorf("Expected fresh response to be served from cache, got %d requests", requests)
+	}
+	now = now.Add(2 * time.Minute)
+	check()
+	if requests != 2 || revalidations != 1 {
+		t.Errorf("Expected stale response to be revalidated, got %d requests", requests)
+	}
+	// The revalidated response is fresh again
+	check()
+	if requests != 2 {
+		t.Errorf("Expected revalidated response to be fresh, got %d requests", requests)
+	}
+}
+
+func TestTransport_NoStore(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Cache-Control", "no-store")
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(NewMemoryStore())}
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		resp.Body.Close()
+	}
+	if requests != 2 {
+		t.Errorf("Expected no-store responses not to be cached, got %d requests", requests)
+	}
+}
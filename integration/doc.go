@@ -0,0 +1,23 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package integration runs Kupo in a Docker container for integration tests
+// against a real server. The harness is only built with the integration build
+// tag:
+//
+//	go test -tags integration ./...
+//
+// Kupo needs a chain source: either an Ogmios instance or a cardano-node
+// socket and configuration mounted into the container
+package integration
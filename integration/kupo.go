@@ -0,0 +1,190 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// DefaultImage is the Kupo image started when Config.Image is empty
+const DefaultImage = "cardanosolutions/kupo:v2.7.2"
+
+// Config configures a Kupo container
+type Config struct {
+	// Image defaults to DefaultImage
+	Image string
+	// OgmiosHost and OgmiosPort select Ogmios as the chain source. Use
+	// host.docker.internal for an Ogmios listening on the host
+	OgmiosHost string
+	OgmiosPort int
+	// NodeSocket and NodeConfig are host paths selecting a cardano-node as
+	// the chain source. Their directories are mounted into the container
+	NodeSocket string
+	NodeConfig string
+	// Since is the point to start synchronizing from, defaulting to "origin"
+	Since string
+	// Patterns are registered on start, defaulting to "*"
+	Patterns []string
+	// StartupTimeout bounds the wait for Kupo to answer, defaulting to 2
+	// minutes
+	StartupTimeout time.Duration
+}
+
+// Kupo is a running Kupo container
+type Kupo struct {
+	ContainerID string
+	URL         string
+	Client      *kupogo.Client
+}
+
+// Start launches a Kupo container and waits for it to answer health checks.
+// The container is removed when the test finishes. The test is skipped if
+// Docker is not available
+func Start(t testing.TB, config Config) *Kupo {
+	t.Helper()
+	if _, err := exec.LookPath("docker"); err != nil {
+		t.Skip("docker is not available")
+	}
+	args, err := runArgs(config)
+	if err != nil {
+		t.Fatalf("failed to configure container: %s", err)
+	}
+	containerID, err := docker(context.Background(), args...)
+	if err != nil {
+		t.Fatalf("failed to start container: %s", err)
+	}
+	t.Cleanup(func() {
+		_, _ = docker(context.Background(), "rm", "-f", containerID)
+	})
+	hostPort, err := docker(context.Background(), "port", containerID, "1442/tcp")
+	if err != nil {
+		t.Fatalf("failed to get container port: %s", err)
+	}
+	// docker port may list several bindings, one per line
+	hostPort, _, _ = strings.Cut(hostPort, "\n")
+	hostPort = strings.Replace(hostPort, "0.0.0.0", "127.0.0.1", 1)
+	kupo := &Kupo{
+		ContainerID: containerID,
+		URL:         "http://" + hostPort,
+	}
+	kupo.Client = kupogo.NewClient(kupo.URL)
+	timeout := config.StartupTimeout
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
+	if err := waitReady(kupo.URL, timeout); err != nil {
+		logs, _ := docker(context.Background(), "logs", "--tail", "50", containerID)
+		t.Fatalf("Kupo did not start: %s\n%s", err, logs)
+	}
+	return kupo
+}
+
+// runArgs builds the docker run arguments for a configuration
+func runArgs(config Config) ([]string, error) {
+	image := config.Image
+	if image == "" {
+		image = DefaultImage
+	}
+	since := config.Since
+	if since == "" {
+		since = "origin"
+	}
+	patterns := config.Patterns
+	if len(patterns) == 0 {
+		patterns = []string{"*"}
+	}
+	args := []string{"run", "-d", "-p", "127.0.0.1::1442"}
+	var kupoArgs []string
+	switch {
+	case config.OgmiosHost != "":
+		port := config.OgmiosPort
+		if port == 0 {
+			port = 1337
+		}
+		args = append(args, "--add-host", "host.docker.internal:host-gateway")
+		kupoArgs = append(
+			kupoArgs,
+			"--ogmios-host", config.OgmiosHost,
+			"--ogmios-port", fmt.Sprint(port),
+		)
+	case config.NodeSocket != "" && config.NodeConfig != "":
+		args = append(
+			args,
+			"-v", filepath.Dir(config.NodeSocket)+":/ipc",
+			"-v", filepath.Dir(config.NodeConfig)+":/config",
+		)
+		kupoArgs = append(
+			kupoArgs,
+			"--node-socket", "/ipc/"+filepath.Base(config.NodeSocket),
+			"--node-config", "/config/"+filepath.Base(config.NodeConfig),
+		)
+	default:
+		return nil, fmt.Errorf("no chain source configured")
+	}
+	kupoArgs = append(
+		kupoArgs,
+		"--host", "0.0.0.0",
+		"--since", since,
+		"--in-memory",
+	)
+	for _, pattern := range patterns {
+		kupoArgs = append(kupoArgs, "--match", pattern)
+	}
+	args = append(args, image)
+	return append(args, kupoArgs...), nil
+}
+
+func waitReady(kupoURL string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		resp, err := http.Get(kupoURL + "/health")
+		if err == nil {
+			resp.Body.Close()
+			// Kupo answers 503 while syncing, which is enough to run tests
+			if resp.StatusCode == http.StatusOK ||
+				resp.StatusCode == http.StatusServiceUnavailable {
+				return nil
+			}
+			err = fmt.Errorf("health status %d", resp.StatusCode)
+		}
+		if time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func docker(ctx context.Context, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("docker %s: %s: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
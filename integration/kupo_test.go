@@ -0,0 +1,78 @@
+//go:build integration
+
+package integration
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// testConfig selects the chain source from KUPO_TEST_OGMIOS_HOST and
+// KUPO_TEST_OGMIOS_PORT, or KUPO_TEST_NODE_SOCKET and KUPO_TEST_NODE_CONFIG
+func testConfig(t *testing.T) Config {
+	config := Config{
+		OgmiosHost: os.Getenv("KUPO_TEST_OGMIOS_HOST"),
+		NodeSocket: os.Getenv("KUPO_TEST_NODE_SOCKET"),
+		NodeConfig: os.Getenv("KUPO_TEST_NODE_CONFIG"),
+	}
+	if port := os.Getenv("KUPO_TEST_OGMIOS_PORT"); port != "" {
+		config.OgmiosPort, _ = strconv.Atoi(port)
+	}
+	if config.OgmiosHost == "" && config.NodeSocket == "" {
+		t.Skip("no chain source configured")
+	}
+	return config
+}
+
+func TestClient(t *testing.T) {
+	kupo := Start(t, testConfig(t))
+	health, err := kupo.Client.GetHealth()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !health.IsConnected() {
+		t.Fatalf("Expected Kupo to be connected, got %s", health.ConnectionStatus)
+	}
+	patterns, err := kupo.Client.GetAllPatterns()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(*patterns) != 1 || (*patterns)[0] != "*" {
+		t.Fatalf("Expected the default pattern, got %v", *patterns)
+	}
+	checkpoints, err := kupo.Client.GetCheckpoints()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(*checkpoints) > 0 {
+		if _, err := kupo.Client.AddPattern(
+			"*/*",
+			(*checkpoints)[0],
+			kupogo.RollbackLimitWithinSafeZone,
+		); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	}
+	if _, err := kupo.Client.GetMatchesWithOptions(
+		"*",
+		kupogo.MatchOptions{Unspent: true},
+	); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+}
+
+func TestRunArgs(t *testing.T) {
+	args, err := runArgs(Config{OgmiosHost: "host.docker.internal"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if args[len(args)-1] != "*" {
+		t.Fatalf("Expected the default pattern last, got %v", args)
+	}
+	if _, err := runArgs(Config{}); err == nil {
+		t.Fatalf("Expected error without a chain source")
+	}
+}
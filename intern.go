@@ -0,0 +1,66 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+// maxInternedStrings bounds an interner, which is cleared when full
+const maxInternedStrings = 1 << 16
+
+// stringInterner deduplicates strings repeated across the matches of a single
+// decode, such as the addresses and asset IDs of a wildcard pattern or the
+// header hash of outputs created in the same block. Each decode uses its own,
+// so concurrent decodes neither contend on it nor keep each other's strings
+// alive. A nil interner returns copies
+type stringInterner struct {
+	strings map[string]string
+}
+
+func newStringInterner() *stringInterner {
+	return &stringInterner{strings: make(map[string]string)}
+}
+
+// intern returns the shared copy of the string in data, only allocating the
+// first time it is seen
+func (i *stringInterner) intern(data []byte) string {
+	if i == nil {
+		return string(data)
+	}
+	// The conversion in a map index does not allocate
+	if s, ok := i.strings[string(data)]; ok {
+		return s
+	}
+	s := string(data)
+	i.add(s)
+	return s
+}
+
+// internString returns the shared copy of s, letting the garbage collector
+// reclaim s if it was already known
+func (i *stringInterner) internString(s string) string {
+	if i == nil || s == "" {
+		return s
+	}
+	if shared, ok := i.strings[s]; ok {
+		return shared
+	}
+	i.add(s)
+	return s
+}
+
+func (i *stringInterner) add(s string) {
+	if len(i.strings) >= maxInternedStrings {
+		clear(i.strings)
+	}
+	i.strings[s] = s
+}
@@ -0,0 +1,36 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestBase58(t *testing.T) {
+	testDefs := []struct {
+		hex     string
+		encoded string
+	}{
+		{"", ""},
+		{"61", "2g"},
+		{"626262", "a3gV"},
+		{"00000000000000000000", "1111111111"},
+		{"00eb15231dfceb60925886b67d065299925915aeb172c06647", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+	}
+	for _, testDef := range testDefs {
+		data, _ := hex.DecodeString(testDef.hex)
+		if encoded := Encode(data); encoded != testDef.encoded {
+			t.Errorf("Expected %s, got %s", testDef.encoded, encoded)
+		}
+		decoded, err := Decode(testDef.encoded)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if !bytes.Equal(decoded, data) {
+			t.Errorf("Expected %x, got %x", data, decoded)
+		}
+	}
+	if _, err := Decode("0OIl"); err == nil {
+		t.Fatalf("Expected error for invalid characters")
+	}
+}
@@ -0,0 +1,36 @@
+package bech32
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	// Base address from CIP-19
+	addr := "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x"
+	hrp, data, err := Decode(addr)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if hrp != "addr" || len(data) != 57 || data[0] != 0x01 {
+		t.Fatalf("Unexpected decode result: %s %x", hrp, data)
+	}
+	encoded, err := Encode(hrp, data)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if encoded != addr {
+		t.Errorf("Expected %s, got %s", addr, encoded)
+	}
+	// Corrupting a character must fail the checksum
+	if _, _, err := Decode(addr[:20] + "q" + addr[21:]); err == nil {
+		t.Errorf("Expected checksum error")
+	}
+	data, _ = hex.DecodeString("00")
+	encoded, _ = Encode("test", data)
+	_, decoded, err := Decode(encoded)
+	if err != nil || !bytes.Equal(decoded, data) {
+		t.Errorf("Expected round trip of %x, got %x (%v)", data, decoded, err)
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cbor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Tags with special handling
+const (
+	TagPositiveBignum uint64 = 2
+	TagNegativeBignum uint64 = 3
+	TagEncodedCBOR    uint64 = 24
+)
+
+const maxDepth = 256
+
+var errUnexpectedEnd = errors.New("unexpected end of CBOR data")
+
+// MapEntry is a key/value pair of a decoded map
+type MapEntry struct {
+	Key   any
+	Value any
+}
+
+// Map is a decoded map. Entries are kept in encoding order, as keys may be
+// of any type
+type Map []MapEntry
+
+// Get returns the value of the first entry whose key equals key, comparing
+// integers, strings and byte strings by value
+func (m Map) Get(key any) (any, bool) {
+	for _, entry := range m {
+		if keysEqual(entry.Key, key) {
+			return entry.Value, true
+		}
+	}
+	return nil, false
+}
+
+func keysEqual(a any, b any) bool {
+	switch av := a.(type) {
+	case []byte:
+		bv, ok := b.([]byte)
+		return ok && string(av) == string(bv)
+	case uint64:
+		switch bv := b.(type) {
+		case uint64:
+			return av == bv
+		case int:
+			return bv >= 0 && av == uint64(bv)
+		}
+		return false
+	case string, int64, bool:
+		return a == b
+	}
+	return false
+}
+
+// Tag is a decoded tagged item other than a bignum
+type Tag struct {
+	Number  uint64
+	Content any
+}
+
+// Decode decodes a single CBOR item which must span all of data.
+//
+// Unsigned integers decode to uint64 and negative integers to int64, or
+// *big.Int if they do not fit, as do bignums. Byte strings decode to []byte,
+// text strings to string, arrays to []any, maps to Map and other tags to Tag.
+// Indefinite-length items are supported
+func Decode(data []byte) (any, error) {
+	value, rest, err := DecodeFirst(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("%d trailing bytes after CBOR item", len(rest))
+	}
+	return value, nil
+}
+
+// DecodeFirst decodes the first CBOR item of data and returns the remaining
+// bytes
+func DecodeFirst(data []byte) (any, []byte, error) {
+	d := decoder{data: data}
+	value, err := d.decode(0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, d.data[d.pos:], nil
+}
+
+type decoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *decoder) readByte() (byte, error) {
+	if d.pos >= len(d.data) {
+		return 0, errUnexpectedEnd
+	}
+	b := d.data[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *decoder) read(n uint64) ([]byte, error) {
+	if n > uint64(len(d.data)-d.pos) {
+		return nil, errUnexpectedEnd
+	}
+	ret := d.data[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return ret, nil
+}
+
+// head reads an item head, returning the major type, the additional
+// information and the argument
+func (d *decoder) head() (byte, byte, uint64, error) {
+	b, err := d.readByte()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	majorType := b >> 5
+	info := b & 0x1f
+	switch {
+	case info <= additionalInfoMax:
+		return majorType, info, uint64(info), nil
+	case info <= 27:
+		arg, err := d.read(1 << (info - 24))
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		var value uint64
+		for _, b := range arg {
+			value = value<<8 | uint64(b)
+		}
+		return majorType, info, value, nil
+	case info == 31:
+		return majorType, info, 0, nil
+	default:
+		return 0, 0, 0, fmt.Errorf("invalid CBOR additional information %d", info)
+	}
+}
+
+func (d *decoder) isBreak() bool {
+	if d.pos < len(d.data) && d.data[d.pos] == 0xff {
+		d.pos++
+		return true
+	}
+	return false
+}
+
+func (d *decoder) decode(depth int) (any, error) {
+	if depth > maxDepth {
+		return nil, errors.New("CBOR nesting too deep")
+	}
+	majorType, info, arg, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	indefinite := info == 31
+	if indefinite && (majorType == MajorTypeUint || majorType == MajorTypeNegInt || majorType == MajorTypeTag) {
+		return nil, fmt.Errorf("invalid indefinite length for major type %d", majorType)
+	}
+	switch majorType {
+	case MajorTypeUint:
+		return arg, nil
+	case MajorTypeNegInt:
+		if arg <= math.MaxInt64 {
+			return -1 - int64(arg), nil
+		}
+		n := new(big.Int).SetUint64(arg)
+		return n.Neg(n).Sub(n, big.NewInt(1)), nil
+	case MajorTypeBytes, MajorTypeText:
+		var content []byte
+		if indefinite {
+			for !d.isBreak() {
+				chunkType, chunkInfo, chunkLength, err := d.head()
+				if err != nil {
+					return nil, err
+				}
+				if chunkType != majorType || chunkInfo == 31 {
+					return nil, errors.New("invalid chunk in indefinite-length string")
+				}
+				chunk, err := d.read(chunkLength)
+				if err != nil {
+					return nil, err
+				}
+				content = append(content, chunk...)
+			}
+			if content == nil {
+				content = []byte{}
+			}
+		} else {
+			chunk, err := d.read(arg)
+			if err != nil {
+				return nil, err
+			}
+			content = append([]byte{}, chunk...)
+		}
+		if majorType == MajorTypeText {
+			return string(content), nil
+		}
+		return content, nil
+	case MajorTypeArray:
+		ret := []any{}
+		for i := uint64(0); indefinite || i < arg; i++ {
+			if indefinite && d.isBreak() {
+				break
+			}
+			item, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, item)
+		}
+		return ret, nil
+	case MajorTypeMap:
+		ret := Map{}
+		for i := uint64(0); indefinite || i < arg; i++ {
+			if indefinite && d.isBreak() {
+				break
+			}
+			key, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			value, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, MapEntry{Key: key, Value: value})
+		}
+		return ret, nil
+	case MajorTypeTag:
+		content, err := d.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if arg == TagPositiveBignum || arg == TagNegativeBignum {
+			bytes, ok := content.([]byte)
+			if !ok {
+				return nil, errors.New("invalid bignum content")
+			}
+			n := new(big.Int).SetBytes(bytes)
+			if arg == TagNegativeBignum {
+				n.Neg(n).Sub(n, big.NewInt(1))
+			}
+			return n, nil
+		}
+		return Tag{Number: arg, Content: content}, nil
+	default:
+		return d.simple(info, arg)
+	}
+}
+
+func (d *decoder) simple(info byte, arg uint64) (any, error) {
+	switch info {
+	case 20:
+		return false, nil
+	case 21:
+		return true, nil
+	case 22, 23:
+		return nil, nil
+	case 25:
+		return float64(halfToFloat32(uint16(arg))), nil
+	case 26:
+		return float64(math.Float32frombits(uint32(arg))), nil
+	case 27:
+		return math.Float64frombits(arg), nil
+	case 31:
+		return nil, errors.New("unexpected CBOR break")
+	default:
+		return nil, fmt.Errorf("unsupported CBOR simple value %d", arg)
+	}
+}
+
+func halfToFloat32(h uint16) float32 {
+	sign := uint32(h>>15) << 31
+	exp := uint32(h>>10) & 0x1f
+	frac := uint32(h) & 0x3ff
+	switch exp {
+	case 0:
+		value := float32(frac) / (1 << 24)
+		if sign != 0 {
+			return -value
+		}
+		return value
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | frac<<13)
+	default:
+		return math.Float32frombits(sign | (exp+112)<<23 | frac<<13)
+	}
+}
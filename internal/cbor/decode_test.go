@@ -0,0 +1,64 @@
+package cbor
+
+import (
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	bignum, _ := new(big.Int).SetString("18446744073709551616", 10)
+	negBignum, _ := new(big.Int).SetString("-18446744073709551616", 10)
+	testDefs := []struct {
+		encoded  string
+		expected any
+	}{
+		{"00", uint64(0)},
+		{"1b000000e8d4a51000", uint64(1000000000000)},
+		{"3903e7", int64(-1000)},
+		{"3bffffffffffffffff", negBignum},
+		{"c249010000000000000000", bignum},
+		{"4401020304", []byte{1, 2, 3, 4}},
+		{"5f42010243030405ff", []byte{1, 2, 3, 4, 5}},
+		{"6449455446", "IETF"},
+		{"820102", []any{uint64(1), uint64(2)}},
+		{"9f0102ff", []any{uint64(1), uint64(2)}},
+		{"a201020304", Map{{uint64(1), uint64(2)}, {uint64(3), uint64(4)}}},
+		{"bf6161f5ff", Map{{"a", true}}},
+		{"d87980", Tag{Number: 121, Content: []any{}}},
+		{"f6", nil},
+		{"f93e00", 1.5},
+		{"fb3ff199999999999a", 1.1},
+	}
+	for _, testDef := range testDefs {
+		data, _ := hex.DecodeString(testDef.encoded)
+		value, err := Decode(data)
+		if err != nil {
+			t.Errorf("Expected no error decoding %s, got %s", testDef.encoded, err)
+			continue
+		}
+		if !reflect.DeepEqual(value, testDef.expected) {
+			t.Errorf("Expected %#v decoding %s, got %#v", testDef.expected, testDef.encoded, value)
+		}
+	}
+	for _, encoded := range []string{"", "8201", "0000", "5f01ff", "ff", "1c"} {
+		data, _ := hex.DecodeString(encoded)
+		if _, err := Decode(data); err == nil {
+			t.Errorf("Expected error decoding %q", encoded)
+		}
+	}
+}
+
+func TestMapGet(t *testing.T) {
+	m := Map{{[]byte("name"), "x"}, {uint64(1), "one"}}
+	if v, ok := m.Get([]byte("name")); !ok || v != "x" {
+		t.Fatalf("Expected bytes key lookup, got %v", v)
+	}
+	if v, ok := m.Get(1); !ok || v != "one" {
+		t.Fatalf("Expected int key lookup, got %v", v)
+	}
+	if _, ok := m.Get("name"); ok {
+		t.Fatalf("Expected text key not to match bytes key")
+	}
+}
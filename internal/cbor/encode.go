@@ -0,0 +1,87 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cbor implements the subset of CBOR (RFC 8949) needed to work with
+// Cardano ledger structures
+package cbor
+
+import "encoding/binary"
+
+// Major types
+const (
+	MajorTypeUint     byte = 0
+	MajorTypeNegInt   byte = 1
+	MajorTypeBytes    byte = 2
+	MajorTypeText     byte = 3
+	MajorTypeArray    byte = 4
+	MajorTypeMap      byte = 5
+	MajorTypeTag      byte = 6
+	MajorTypeSimple   byte = 7
+	additionalInfoMax byte = 23
+)
+
+// AppendHead appends an item head with the given major type and argument
+func AppendHead(b []byte, majorType byte, arg uint64) []byte {
+	mt := majorType << 5
+	switch {
+	case arg <= uint64(additionalInfoMax):
+		return append(b, mt|byte(arg))
+	case arg <= 0xff:
+		return append(b, mt|24, byte(arg))
+	case arg <= 0xffff:
+		return binary.BigEndian.AppendUint16(append(b, mt|25), uint16(arg))
+	case arg <= 0xffffffff:
+		return binary.BigEndian.AppendUint32(append(b, mt|26), uint32(arg))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, mt|27), arg)
+	}
+}
+
+// AppendUint appends an unsigned integer
+func AppendUint(b []byte, v uint64) []byte {
+	return AppendHead(b, MajorTypeUint, v)
+}
+
+// AppendInt appends a signed integer
+func AppendInt(b []byte, v int64) []byte {
+	if v < 0 {
+		return AppendHead(b, MajorTypeNegInt, uint64(-(v + 1)))
+	}
+	return AppendHead(b, MajorTypeUint, uint64(v))
+}
+
+// AppendBytes appends a byte string
+func AppendBytes(b []byte, data []byte) []byte {
+	return append(AppendHead(b, MajorTypeBytes, uint64(len(data))), data...)
+}
+
+// AppendText appends a text string
+func AppendText(b []byte, s string) []byte {
+	return append(AppendHead(b, MajorTypeText, uint64(len(s))), s...)
+}
+
+// AppendArrayHeader appends the head of a definite-length array
+func AppendArrayHeader(b []byte, length int) []byte {
+	return AppendHead(b, MajorTypeArray, uint64(length))
+}
+
+// AppendMapHeader appends the head of a definite-length map
+func AppendMapHeader(b []byte, length int) []byte {
+	return AppendHead(b, MajorTypeMap, uint64(length))
+}
+
+// AppendTag appends a tag head
+func AppendTag(b []byte, tag uint64) []byte {
+	return AppendHead(b, MajorTypeTag, tag)
+}
@@ -0,0 +1,32 @@
+package cbor
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	testDefs := []struct {
+		encoded  []byte
+		expected string
+	}{
+		{AppendUint(nil, 0), "00"},
+		{AppendUint(nil, 23), "17"},
+		{AppendUint(nil, 24), "1818"},
+		{AppendUint(nil, 1000), "1903e8"},
+		{AppendUint(nil, 1000000), "1a000f4240"},
+		{AppendUint(nil, 1000000000000), "1b000000e8d4a51000"},
+		{AppendInt(nil, -1), "20"},
+		{AppendInt(nil, -1000), "3903e7"},
+		{AppendBytes(nil, []byte{1, 2, 3, 4}), "4401020304"},
+		{AppendText(nil, "IETF"), "6449455446"},
+		{AppendUint(AppendUint(AppendArrayHeader(nil, 2), 1), 2), "820102"},
+		{AppendMapHeader(nil, 0), "a0"},
+		{AppendTag(nil, 24), "d818"},
+	}
+	for _, testDef := range testDefs {
+		if encoded := hex.EncodeToString(testDef.encoded); encoded != testDef.expected {
+			t.Errorf("Expected %s, got %s", testDef.expected, encoded)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ExportMatchesJSONL streams the matches of a pattern to w as JSON Lines, one
+// match per line, while the response downloads. Memory use is bounded by the
+// largest match rather than the result set. It returns the number of matches
+// written
+func (c *Client) ExportMatchesJSONL(
+	ctx context.Context,
+	w io.Writer,
+	pattern string,
+	opts MatchOptions,
+) (int, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.matchesURL(pattern, opts),
+		nil,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get matches: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf(
+			"failed to get matches: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	count, err := JSONArrayToJSONL(w, resp.Body)
+	if err != nil {
+		c.logDecodeFailure(req, err)
+		return count, err
+	}
+	return count, nil
+}
+
+// JSONArrayToJSONL converts a JSON array read from r into JSON Lines written
+// to w, one compacted element per line, returning the number of elements
+func JSONArrayToJSONL(w io.Writer, r io.Reader) (int, error) {
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read array: %s", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return 0, fmt.Errorf("expected JSON array, got %v", token)
+	}
+	bw := bufio.NewWriter(w)
+	// Elements already counted are written out even if a later one fails
+	defer bw.Flush()
+	var element json.RawMessage
+	var line bytes.Buffer
+	count := 0
+	for decoder.More() {
+		element = element[:0]
+		if err := decoder.Decode(&element); err != nil {
+			return count, fmt.Errorf("failed to decode element %d: %s", count, err)
+		}
+		line.Reset()
+		if err := json.Compact(&line, element); err != nil {
+			return count, fmt.Errorf("failed to compact element %d: %s", count, err)
+		}
+		line.WriteByte('\n')
+		if _, err := bw.Write(line.Bytes()); err != nil {
+			return count, fmt.Errorf("failed to write: %s", err)
+		}
+		count++
+	}
+	if _, err := decoder.Token(); err != nil {
+		return count, fmt.Errorf("failed to read array end: %s", err)
+	}
+	if err := bw.Flush(); err != nil {
+		return count, fmt.Errorf("failed to write: %s", err)
+	}
+	return count, nil
+}
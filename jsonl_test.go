@@ -0,0 +1,48 @@
+package kupogo
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestExportMatchesJSONL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"transaction_id": "aa", "output_index": 0},
+			{"transaction_id": "bb", "output_index": 1}
+		]`))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+	var out bytes.Buffer
+	count, err := client.ExportMatchesJSONL(context.Background(), &out, "*", MatchOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if count != 2 {
+		t.Fatalf("Expected 2 matches, got %d", count)
+	}
+	expected := `{"transaction_id":"aa","output_index":0}` + "\n" +
+		`{"transaction_id":"bb","output_index":1}` + "\n"
+	if out.String() != expected {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, out.String())
+	}
+}
+
+func TestJSONArrayToJSONLErrors(t *testing.T) {
+	var out bytes.Buffer
+	if _, err := JSONArrayToJSONL(&out, strings.NewReader(`{"a":1}`)); err == nil {
+		t.Fatalf("Expected error for non-array")
+	}
+	count, err := JSONArrayToJSONL(&out, strings.NewReader(`[{"a":1},{"b":`))
+	if err == nil {
+		t.Fatalf("Expected error for truncated array")
+	}
+	if count != 1 {
+		t.Fatalf("Expected 1 element before the error, got %d", count)
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package koios converts kupogo types into the JSON shapes of Koios'
+// address_info and UTxO endpoints. Fields Kupo does not index, such as block
+// height and time, are null
+package koios
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/address"
+)
+
+// Asset is an entry of a Koios asset_list
+type Asset struct {
+	PolicyID    string  `json:"policy_id"`
+	AssetName   *string `json:"asset_name"`
+	Fingerprint string  `json:"fingerprint"`
+	Decimals    int     `json:"decimals"`
+	Quantity    string  `json:"quantity"`
+}
+
+// InlineDatum is a Koios inline datum
+type InlineDatum struct {
+	Bytes string `json:"bytes"`
+	Value any    `json:"value"`
+}
+
+// ReferenceScript is a Koios reference script
+type ReferenceScript struct {
+	Hash  string `json:"hash"`
+	Size  int    `json:"size"`
+	Type  string `json:"type"`
+	Bytes string `json:"bytes"`
+	Value any    `json:"value"`
+}
+
+// UTxO is an output in the shape of Koios' address_utxos and utxo_info
+type UTxO struct {
+	TxHash          string           `json:"tx_hash"`
+	TxIndex         int              `json:"tx_index"`
+	Address         string           `json:"address"`
+	Value           string           `json:"value"`
+	StakeAddress    *string          `json:"stake_address"`
+	PaymentCred     *string          `json:"payment_cred"`
+	EpochNo         *int             `json:"epoch_no"`
+	BlockHeight     *int             `json:"block_height"`
+	BlockTime       *int             `json:"block_time"`
+	DatumHash       *string          `json:"datum_hash"`
+	InlineDatum     *InlineDatum     `json:"inline_datum"`
+	ReferenceScript *ReferenceScript `json:"reference_script"`
+	AssetList       []Asset          `json:"asset_list"`
+	IsSpent         bool             `json:"is_spent"`
+}
+
+// AddressUTxO is an entry of the utxo_set of Koios' address_info
+type AddressUTxO struct {
+	TxHash          string           `json:"tx_hash"`
+	TxIndex         int              `json:"tx_index"`
+	BlockHeight     *int             `json:"block_height"`
+	BlockTime       *int             `json:"block_time"`
+	Value           string           `json:"value"`
+	DatumHash       *string          `json:"datum_hash"`
+	InlineDatum     *InlineDatum     `json:"inline_datum"`
+	ReferenceScript *ReferenceScript `json:"reference_script"`
+	AssetList       []Asset          `json:"asset_list"`
+}
+
+// AddressInfo is an entry of Koios' address_info
+type AddressInfo struct {
+	Address       string        `json:"address"`
+	Balance       string        `json:"balance"`
+	StakeAddress  *string       `json:"stake_address"`
+	ScriptAddress bool          `json:"script_address"`
+	UTxOSet       []AddressUTxO `json:"utxo_set"`
+}
+
+// scriptTypes maps Kupo script languages to Koios script types
+var scriptTypes = map[string]string{
+	"native":    "timelock",
+	"plutus:v1": "plutusV1",
+	"plutus:v2": "plutusV2",
+	"plutus:v3": "plutusV3",
+}
+
+// AssetList converts the assets of a value into a Koios asset_list
+func AssetList(value kupogo.Value) []Asset {
+	ret := []Asset{}
+	for asset, quantity := range value.Assets {
+		assetID := kupogo.AssetID(asset)
+		assetName := assetID.AssetName()
+		fingerprint, _ := assetID.Fingerprint()
+		ret = append(ret, Asset{
+			PolicyID:    assetID.PolicyID(),
+			AssetName:   &assetName,
+			Fingerprint: fingerprint,
+			Quantity:    strconv.Itoa(quantity),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].PolicyID != ret[j].PolicyID {
+			return ret[i].PolicyID < ret[j].PolicyID
+		}
+		return *ret[i].AssetName < *ret[j].AssetName
+	})
+	return ret
+}
+
+// ToUTxO converts a match into Koios' UTxO shape. Inline datums and reference
+// scripts are left null, see Resolve
+func ToUTxO(match kupogo.Match) UTxO {
+	utxo := UTxO{
+		TxHash:    match.TransactionID,
+		TxIndex:   match.OutputIndex,
+		Address:   match.Address,
+		Value:     strconv.Itoa(match.Value.Coins),
+		DatumHash: match.DatumHash,
+		AssetList: AssetList(match.Value),
+		IsSpent:   match.SpentAt != nil,
+	}
+	if info, ok := decodeAddress(match.Address); ok {
+		utxo.PaymentCred = info.paymentCred
+		utxo.StakeAddress = info.stakeAddress
+	}
+	return utxo
+}
+
+// ToAddressInfo converts the unspent outputs of an address into Koios'
+// address_info shape. Spent outputs are ignored
+func ToAddressInfo(address string, matches kupogo.Matches) AddressInfo {
+	info := AddressInfo{
+		Address: address,
+		UTxOSet: []AddressUTxO{},
+	}
+	if decoded, ok := decodeAddress(address); ok {
+		info.StakeAddress = decoded.stakeAddress
+		info.ScriptAddress = decoded.script
+	}
+	balance := 0
+	for _, match := range matches {
+		if match.SpentAt != nil {
+			continue
+		}
+		balance += match.Value.Coins
+		info.UTxOSet = append(info.UTxOSet, AddressUTxO{
+			TxHash:    match.TransactionID,
+			TxIndex:   match.OutputIndex,
+			Value:     strconv.Itoa(match.Value.Coins),
+			DatumHash: match.DatumHash,
+			AssetList: AssetList(match.Value),
+		})
+	}
+	info.Balance = strconv.Itoa(balance)
+	return info
+}
+
+// Resolve fills in the inline datum and reference script of a converted
+// match from Kupo
+func Resolve(
+	ctx context.Context,
+	client kupogo.KupoClient,
+	match kupogo.Match,
+) (*InlineDatum, *ReferenceScript, error) {
+	var datum *InlineDatum
+	var script *ReferenceScript
+	if match.DatumHash != nil && match.DatumType != nil && *match.DatumType == "inline" {
+		resp, err := client.GetDatumByHashContext(ctx, *match.DatumHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp != nil {
+			datum = &InlineDatum{Bytes: resp.Datum}
+		}
+	}
+	if match.ScriptHash != nil {
+		resp, err := client.GetScriptByHashContext(ctx, *match.ScriptHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp != nil {
+			script = &ReferenceScript{
+				Hash:  *match.ScriptHash,
+				Size:  len(resp.Script) / 2,
+				Type:  scriptTypes[resp.Language],
+				Bytes: resp.Script,
+			}
+		}
+	}
+	return datum, script, nil
+}
+
+// GetAddressInfo fetches the Koios address_info of addresses from Kupo,
+// resolving inline datums and reference scripts
+func GetAddressInfo(
+	ctx context.Context,
+	client kupogo.KupoClient,
+	addresses ...string,
+) ([]AddressInfo, error) {
+	ret := make([]AddressInfo, 0, len(addresses))
+	for _, address := range addresses {
+		matches, err := client.GetMatchesWithOptionsContext(
+			ctx,
+			address,
+			kupogo.MatchOptions{Unspent: true, Order: kupogo.MatchOrderOldestFirst},
+		)
+		if err != nil {
+			return nil, err
+		}
+		info := ToAddressInfo(address, *matches)
+		for i, match := range *matches {
+			datum, script, err := Resolve(ctx, client, match)
+			if err != nil {
+				return nil, err
+			}
+			info.UTxOSet[i].InlineDatum = datum
+			info.UTxOSet[i].ReferenceScript = script
+		}
+		ret = append(ret, info)
+	}
+	return ret, nil
+}
+
+type addressInfo struct {
+	script       bool
+	paymentCred  *string
+	stakeAddress *string
+}
+
+// decodeAddress extracts the payment credential and stake address of a
+// Shelley address
+func decodeAddress(addr string) (addressInfo, bool) {
+	var info addressInfo
+	parsed, err := address.Parse(addr)
+	if err != nil || parsed.Payment == nil {
+		return info, false
+	}
+	info.script = parsed.Payment.Script
+	paymentCred := parsed.Payment.Hex()
+	info.paymentCred = &paymentCred
+	if stake, ok := parsed.StakeAddress(); ok {
+		stakeAddress := stake.String()
+		info.stakeAddress = &stakeAddress
+	}
+	return info, true
+}
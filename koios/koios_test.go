@@ -0,0 +1,81 @@
+package koios
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/kupogotest"
+)
+
+// Base address test vector from CIP-19
+const (
+	testAddress      = "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x"
+	testStakeAddress = "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"
+	testPaymentCred  = "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	testPolicy       = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+)
+
+func TestToUTxO(t *testing.T) {
+	utxo := ToUTxO(kupogo.Match{
+		TransactionID: "aa",
+		OutputIndex:   1,
+		Address:       testAddress,
+		Value: kupogo.Value{
+			Coins:  5000000,
+			Assets: kupogo.Assets{testPolicy: 7},
+		},
+		SpentAt: &kupogo.Point{SlotNo: 20},
+	})
+	if utxo.Value != "5000000" || !utxo.IsSpent {
+		t.Fatalf("Unexpected UTxO: %+v", utxo)
+	}
+	if utxo.PaymentCred == nil || *utxo.PaymentCred != testPaymentCred {
+		t.Fatalf("Unexpected payment credential: %v", utxo.PaymentCred)
+	}
+	if utxo.StakeAddress == nil || *utxo.StakeAddress != testStakeAddress {
+		t.Fatalf("Unexpected stake address: %v", utxo.StakeAddress)
+	}
+	if len(utxo.AssetList) != 1 ||
+		utxo.AssetList[0].Fingerprint != "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3" ||
+		utxo.AssetList[0].Quantity != "7" {
+		t.Fatalf("Unexpected asset list: %+v", utxo.AssetList)
+	}
+}
+
+func TestGetAddressInfo(t *testing.T) {
+	fake := kupogotest.NewFake()
+	datumHash := "dd"
+	inline := "inline"
+	fake.AddMatches(
+		kupogo.Match{
+			TransactionID: "aa",
+			Address:       testAddress,
+			Value:         kupogo.Value{Coins: 1000000},
+			DatumHash:     &datumHash,
+			DatumType:     &inline,
+			CreatedAt:     kupogo.Point{SlotNo: 1},
+		},
+		kupogo.Match{
+			TransactionID: "bb",
+			Address:       testAddress,
+			Value:         kupogo.Value{Coins: 2000000},
+			CreatedAt:     kupogo.Point{SlotNo: 2},
+		},
+	)
+	fake.AddDatum("dd", "d87980")
+	infos, err := GetAddressInfo(context.Background(), fake, testAddress)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(infos) != 1 {
+		t.Fatalf("Expected 1 address, got %d", len(infos))
+	}
+	info := infos[0]
+	if info.Balance != "3000000" || info.ScriptAddress || len(info.UTxOSet) != 2 {
+		t.Fatalf("Unexpected address info: %+v", info)
+	}
+	if info.UTxOSet[0].InlineDatum == nil || info.UTxOSet[0].InlineDatum.Bytes != "d87980" {
+		t.Fatalf("Expected inline datum, got %+v", info.UTxOSet[0].InlineDatum)
+	}
+}
@@ -0,0 +1,128 @@
+//go:build adder
+
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupoadder
+
+import (
+	"strings"
+	"time"
+
+	"github.com/blinklabs-io/adder/event"
+	"github.com/blinklabs-io/adder/plugin"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+const defaultKupoURL = "http://localhost:1442"
+
+var inputOptions struct {
+	url      string
+	patterns string
+	interval uint
+}
+
+var enrichOptions struct {
+	url string
+}
+
+func init() {
+	plugin.Register(
+		plugin.PluginEntry{
+			Type:               plugin.PluginTypeInput,
+			Name:               "kupo",
+			Description:        "emit events for the outputs created and spent under Kupo patterns",
+			NewFromOptionsFunc: newAdderMatchInput,
+			Options: []plugin.PluginOption{
+				{
+					Name:         "url",
+					Type:         plugin.PluginOptionTypeString,
+					Description:  "Kupo URL",
+					DefaultValue: defaultKupoURL,
+					Dest:         &(inputOptions.url),
+				},
+				{
+					Name:         "patterns",
+					Type:         plugin.PluginOptionTypeString,
+					Description:  "comma separated Kupo patterns",
+					DefaultValue: "*",
+					Dest:         &(inputOptions.patterns),
+				},
+				{
+					Name:         "interval",
+					Type:         plugin.PluginOptionTypeUint,
+					Description:  "polling interval in seconds",
+					DefaultValue: uint(10),
+					Dest:         &(inputOptions.interval),
+				},
+			},
+		},
+	)
+	plugin.Register(
+		plugin.PluginEntry{
+			Type:               plugin.PluginTypeFilter,
+			Name:               "kupoenrich",
+			Description:        "resolve the datums and scripts of Kupo match events",
+			NewFromOptionsFunc: newAdderEnrichFilter,
+			Options: []plugin.PluginOption{
+				{
+					Name:         "url",
+					Type:         plugin.PluginOptionTypeString,
+					Description:  "Kupo URL",
+					DefaultValue: defaultKupoURL,
+					Dest:         &(enrichOptions.url),
+				},
+			},
+		},
+	)
+}
+
+func newAdderMatchInput() plugin.Plugin {
+	var patterns []string
+	for _, pattern := range strings.Split(inputOptions.patterns, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	input := NewMatchInput(
+		kupogo.NewClient(inputOptions.url),
+		time.Duration(inputOptions.interval)*time.Second,
+		patterns...,
+	)
+	return NewBridge(input, toAdderEvent, fromAdderEvent)
+}
+
+func newAdderEnrichFilter() plugin.Plugin {
+	filter := NewEnrichFilter(kupogo.NewClient(enrichOptions.url), nil)
+	return NewBridge(filter, toAdderEvent, fromAdderEvent)
+}
+
+func toAdderEvent(e Event) event.Event {
+	return event.Event{
+		Type:      e.Type,
+		Timestamp: e.Timestamp,
+		Context:   e.Context,
+		Payload:   e.Payload,
+	}
+}
+
+func fromAdderEvent(e event.Event) Event {
+	return Event{
+		Type:      e.Type,
+		Timestamp: e.Timestamp,
+		Context:   e.Context,
+		Payload:   e.Payload,
+	}
+}
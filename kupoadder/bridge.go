@@ -0,0 +1,114 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupoadder
+
+// Plugin is the lifecycle of the plugins of this package, which is adder's
+// plugin.Plugin over Event
+type Plugin interface {
+	Start() error
+	Stop() error
+	ErrorChan() chan error
+	InputChan() chan<- Event
+	OutputChan() <-chan Event
+}
+
+// Bridge runs a plugin with its events converted to and from another event
+// type, such as adder's event.Event, so that it can be registered with a
+// pipeline using that type
+type Bridge[E any] struct {
+	plugin    Plugin
+	to        func(Event) E
+	from      func(E) Event
+	input     chan E
+	inputDone chan struct{}
+	output    chan E
+	done      chan struct{}
+	stop      chan struct{}
+}
+
+// NewBridge wraps a plugin, converting its events with to and the events
+// sent to it with from
+func NewBridge[E any](plugin Plugin, to func(Event) E, from func(E) Event) *Bridge[E] {
+	return &Bridge[E]{plugin: plugin, to: to, from: from}
+}
+
+// Start starts the plugin and the conversion of its events
+func (b *Bridge[E]) Start() error {
+	if err := b.plugin.Start(); err != nil {
+		return err
+	}
+	b.output = make(chan E, 100)
+	b.done = make(chan struct{})
+	b.stop = make(chan struct{})
+	go func() {
+		defer close(b.done)
+		defer close(b.output)
+		for event := range b.plugin.OutputChan() {
+			select {
+			case b.output <- b.to(event):
+			case <-b.stop:
+				// Nothing reads the output anymore, so it is drained for the
+				// plugin to stop
+			}
+		}
+	}()
+	if pluginInput := b.plugin.InputChan(); pluginInput != nil {
+		b.input = make(chan E, 100)
+		b.inputDone = make(chan struct{})
+		go func() {
+			defer close(b.inputDone)
+			for event := range b.input {
+				pluginInput <- b.from(event)
+			}
+		}()
+	}
+	return nil
+}
+
+// Stop passes on the events sent to InputChan, then stops the plugin and
+// closes the output channel
+func (b *Bridge[E]) Stop() error {
+	if b.stop == nil {
+		return nil
+	}
+	if b.input != nil {
+		close(b.input)
+		<-b.inputDone
+		b.input = nil
+	}
+	close(b.stop)
+	err := b.plugin.Stop()
+	<-b.done
+	b.stop = nil
+	return err
+}
+
+// ErrorChan returns the error channel of the plugin
+func (b *Bridge[E]) ErrorChan() chan error {
+	return b.plugin.ErrorChan()
+}
+
+// InputChan returns the channel events are sent to, nil for inputs
+func (b *Bridge[E]) InputChan() chan<- E {
+	if b.input == nil {
+		return nil
+	}
+	return b.input
+}
+
+// OutputChan returns the channel of converted events
+func (b *Bridge[E]) OutputChan() <-chan E {
+	return b.output
+}
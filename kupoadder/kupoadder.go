@@ -0,0 +1,294 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kupoadder provides plugins for Blink Labs' adder event pipeline:
+// an input emitting match events for Kupo patterns, and a filter enriching
+// events with datums and scripts resolved from Kupo.
+//
+// The plugins follow adder's plugin lifecycle (Start, Stop, ErrorChan,
+// InputChan, OutputChan) and its event shape, without depending on the adder
+// module. Building with the adder tag registers them with adder's plugin
+// registry, as the "kupo" input and the "kupoenrich" filter, through a Bridge
+// converting between Event and adder's event.Event. That build needs
+// github.com/blinklabs-io/adder added to go.mod
+package kupoadder
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+const (
+	EventTypeMatchCreated = "kupo.match.created"
+	EventTypeMatchSpent   = "kupo.match.spent"
+)
+
+// Event mirrors adder's event.Event
+type Event struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Context   any       `json:"context,omitempty"`
+	Payload   any       `json:"payload"`
+}
+
+// MatchContext is the context of match events
+type MatchContext struct {
+	Pattern string `json:"pattern"`
+	SlotNo  int    `json:"slotNumber"`
+}
+
+// MatchInput is an input plugin emitting an event for every output created
+// or spent under the configured patterns
+type MatchInput struct {
+	client   *kupogo.Client
+	patterns []string
+	interval time.Duration
+	output   chan Event
+	errors   chan error
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// NewMatchInput creates an input polling Kupo for the patterns every
+// interval, defaulting to 10 seconds
+func NewMatchInput(client *kupogo.Client, interval time.Duration, patterns ...string) *MatchInput {
+	return &MatchInput{
+		client:   client,
+		patterns: patterns,
+		interval: interval,
+	}
+}
+
+// Start begins polling
+func (m *MatchInput) Start() error {
+	m.output = make(chan Event, 100)
+	m.errors = make(chan error, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	for _, pattern := range m.patterns {
+		pattern := pattern
+		watcher := kupogo.NewWatcher(
+			m.client,
+			kupogo.WatcherConfig{
+				Pattern:  pattern,
+				Interval: m.interval,
+				OnEvent: func(event kupogo.WatchEvent) {
+					m.emit(ctx, pattern, event)
+				},
+				OnError: func(err error) {
+					select {
+					case m.errors <- err:
+					default:
+					}
+				},
+			},
+		)
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			_ = watcher.Run(ctx)
+		}()
+	}
+	return nil
+}
+
+func (m *MatchInput) emit(ctx context.Context, pattern string, event kupogo.WatchEvent) {
+	ret := Event{
+		Type:      EventTypeMatchCreated,
+		Timestamp: time.Now(),
+		Context: MatchContext{
+			Pattern: pattern,
+			SlotNo:  event.Match.CreatedAt.SlotNo,
+		},
+		Payload: event.Match,
+	}
+	if event.Type == kupogo.WatchEventSpent {
+		ret.Type = EventTypeMatchSpent
+		if event.Match.SpentAt != nil {
+			ret.Context = MatchContext{Pattern: pattern, SlotNo: event.Match.SpentAt.SlotNo}
+		}
+	}
+	select {
+	case m.output <- ret:
+	case <-ctx.Done():
+	}
+}
+
+// Stop ends polling and closes the output and error channels
+func (m *MatchInput) Stop() error {
+	if m.cancel != nil {
+		m.cancel()
+		m.wg.Wait()
+		close(m.output)
+		close(m.errors)
+		m.cancel = nil
+	}
+	return nil
+}
+
+// ErrorChan returns the channel of polling errors
+func (m *MatchInput) ErrorChan() chan error {
+	return m.errors
+}
+
+// InputChan returns nil, as inputs do not consume events
+func (m *MatchInput) InputChan() chan<- Event {
+	return nil
+}
+
+// OutputChan returns the channel of match events
+func (m *MatchInput) OutputChan() <-chan Event {
+	return m.output
+}
+
+// Enriched is the payload of an enriched event
+type Enriched struct {
+	Payload any `json:"payload"`
+	// Datums maps datum hashes to hex encoded CBOR
+	Datums map[string]string `json:"datums,omitempty"`
+	// Scripts maps script hashes to scripts
+	Scripts map[string]kupogo.ScriptResponse `json:"scripts,omitempty"`
+}
+
+// References returns the datum and script hashes referenced by an event
+type References func(event Event) (datumHashes []string, scriptHashes []string)
+
+// EnrichFilter is a filter plugin resolving the datums and scripts
+// referenced by events from Kupo. Events are passed on with an Enriched
+// payload, or unchanged if they reference nothing
+type EnrichFilter struct {
+	client     kupogo.KupoClient
+	references References
+	input      chan Event
+	output     chan Event
+	errors     chan error
+	done       chan struct{}
+}
+
+// NewEnrichFilter creates a filter. references extracts the hashes to
+// resolve from an event payload, such as the datum hashes of a transaction's
+// outputs. Match events of MatchInput are handled when it is nil
+func NewEnrichFilter(client kupogo.KupoClient, references References) *EnrichFilter {
+	if references == nil {
+		references = MatchReferences
+	}
+	return &EnrichFilter{
+		client:     client,
+		references: references,
+	}
+}
+
+// MatchReferences returns the datum and script hashes of match events
+func MatchReferences(event Event) ([]string, []string) {
+	match, ok := event.Payload.(kupogo.Match)
+	if !ok {
+		return nil, nil
+	}
+	var datumHashes, scriptHashes []string
+	if match.DatumHash != nil {
+		datumHashes = append(datumHashes, *match.DatumHash)
+	}
+	if match.ScriptHash != nil {
+		scriptHashes = append(scriptHashes, *match.ScriptHash)
+	}
+	return datumHashes, scriptHashes
+}
+
+// Start begins processing events sent to InputChan
+func (f *EnrichFilter) Start() error {
+	f.input = make(chan Event, 100)
+	f.output = make(chan Event, 100)
+	f.errors = make(chan error, 10)
+	f.done = make(chan struct{})
+	go func() {
+		defer close(f.done)
+		defer close(f.output)
+		for event := range f.input {
+			f.output <- f.enrich(event)
+		}
+	}()
+	return nil
+}
+
+func (f *EnrichFilter) enrich(event Event) Event {
+	datumHashes, scriptHashes := f.references(event)
+	if len(datumHashes) == 0 && len(scriptHashes) == 0 {
+		return event
+	}
+	enriched := Enriched{Payload: event.Payload}
+	for _, hash := range datumHashes {
+		datum, err := f.client.GetDatumByHash(hash)
+		if err != nil {
+			f.reportError(err)
+			continue
+		}
+		if datum != nil {
+			if enriched.Datums == nil {
+				enriched.Datums = make(map[string]string)
+			}
+			enriched.Datums[hash] = datum.Datum
+		}
+	}
+	for _, hash := range scriptHashes {
+		script, err := f.client.GetScriptByHash(hash)
+		if err != nil {
+			f.reportError(err)
+			continue
+		}
+		if script != nil {
+			if enriched.Scripts == nil {
+				enriched.Scripts = make(map[string]kupogo.ScriptResponse)
+			}
+			enriched.Scripts[hash] = *script
+		}
+	}
+	event.Payload = enriched
+	return event
+}
+
+func (f *EnrichFilter) reportError(err error) {
+	select {
+	case f.errors <- err:
+	default:
+	}
+}
+
+// Stop closes the input channel and waits for queued events to be processed
+func (f *EnrichFilter) Stop() error {
+	if f.input != nil {
+		close(f.input)
+		<-f.done
+		close(f.errors)
+		f.input = nil
+	}
+	return nil
+}
+
+// ErrorChan returns the channel of resolution errors
+func (f *EnrichFilter) ErrorChan() chan error {
+	return f.errors
+}
+
+// InputChan returns the channel events are sent to
+func (f *EnrichFilter) InputChan() chan<- Event {
+	return f.input
+}
+
+// OutputChan returns the channel of enriched events
+func (f *EnrichFilter) OutputChan() <-chan Event {
+	return f.output
+}
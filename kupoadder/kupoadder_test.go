@@ -0,0 +1,158 @@
+package kupoadder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/kupogotest"
+)
+
+func TestMatchInput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"transaction_id":"aa","output_index":0,"address":"addr1",` +
+			`"value":{"coins":1},"created_at":{"slot_no":10,"header_hash":"hh"}}]`))
+	}))
+	defer server.Close()
+	input := NewMatchInput(kupogo.NewClient(server.URL), time.Hour, "addr1")
+	if err := input.Start(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	select {
+	case event := <-input.OutputChan():
+		if event.Type != EventTypeMatchCreated {
+			t.Fatalf("Unexpected event type: %s", event.Type)
+		}
+		if event.Context.(MatchContext).SlotNo != 10 {
+			t.Fatalf("Unexpected context: %+v", event.Context)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for event")
+	}
+	if err := input.Stop(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+}
+
+func TestMatchInputPatterns(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		address := strings.TrimPrefix(r.URL.Path, "/matches/")
+		_, _ = w.Write([]byte(`[{"transaction_id":"` + address + `","output_index":0,"address":"` + address +
+			`","value":{"coins":1},"created_at":{"slot_no":10,"header_hash":"hh"}}]`))
+	}))
+	defer server.Close()
+	input := NewMatchInput(kupogo.NewClient(server.URL), time.Hour, "a", "b")
+	if err := input.Start(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-input.OutputChan():
+			match := event.Payload.(kupogo.Match)
+			if pattern := event.Context.(MatchContext).Pattern; pattern != match.Address {
+				t.Fatalf("Expected pattern %s for the match of %s, got %s", match.Address, match.Address, pattern)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for event")
+		}
+	}
+	if err := input.Stop(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+}
+
+func TestEnrichFilter(t *testing.T) {
+	fake := kupogotest.NewFake()
+	fake.AddDatum("dd", "d87980")
+	filter := NewEnrichFilter(fake, nil)
+	if err := filter.Start(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	datumHash := "dd"
+	match := kupogo.Match{TransactionID: "aa", DatumHash: &datumHash}
+	filter.InputChan() <- Event{Type: EventTypeMatchCreated, Payload: match}
+	filter.InputChan() <- Event{Type: "other", Payload: "unchanged"}
+	enriched := (<-filter.OutputChan()).Payload.(Enriched)
+	if enriched.Datums["dd"] != "d87980" {
+		t.Fatalf("Unexpected enrichment: %+v", enriched)
+	}
+	if payload := (<-filter.OutputChan()).Payload; payload != "unchanged" {
+		t.Fatalf("Expected unchanged payload, got %v", payload)
+	}
+	if err := filter.Stop(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+}
+
+// pipelineEvent stands in for the event type of a pipeline, such as adder's
+type pipelineEvent struct {
+	Kind    string
+	Payload any
+}
+
+func TestBridge(t *testing.T) {
+	fake := kupogotest.NewFake()
+	fake.AddDatum("dd", "d87980")
+	bridge := NewBridge(
+		NewEnrichFilter(fake, nil),
+		func(e Event) pipelineEvent {
+			return pipelineEvent{Kind: e.Type, Payload: e.Payload}
+		},
+		func(e pipelineEvent) Event {
+			return Event{Type: e.Kind, Payload: e.Payload}
+		},
+	)
+	if err := bridge.Start(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	datumHash := "dd"
+	bridge.InputChan() <- pipelineEvent{Kind: EventTypeMatchCreated, Payload: kupogo.Match{DatumHash: &datumHash}}
+	output := <-bridge.OutputChan()
+	if output.Kind != EventTypeMatchCreated || output.Payload.(Enriched).Datums["dd"] != "d87980" {
+		t.Fatalf("Unexpected event: %+v", output)
+	}
+	// Events nobody reads do not keep the bridge from stopping
+	for i := 0; i < 300; i++ {
+		bridge.InputChan() <- pipelineEvent{Kind: "other"}
+	}
+	if err := bridge.Stop(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+}
+
+func TestBridgeInput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"transaction_id":"aa","output_index":0,"address":"addr1",` +
+			`"value":{"coins":1},"created_at":{"slot_no":10,"header_hash":"hh"}}]`))
+	}))
+	defer server.Close()
+	bridge := NewBridge(
+		NewMatchInput(kupogo.NewClient(server.URL), time.Hour, "addr1"),
+		func(e Event) pipelineEvent {
+			return pipelineEvent{Kind: e.Type, Payload: e.Payload}
+		},
+		func(e pipelineEvent) Event {
+			return Event{Type: e.Kind, Payload: e.Payload}
+		},
+	)
+	if err := bridge.Start(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if bridge.InputChan() != nil {
+		t.Fatalf("Expected inputs not to consume events")
+	}
+	select {
+	case event := <-bridge.OutputChan():
+		if event.Kind != EventTypeMatchCreated || event.Payload.(kupogo.Match).TransactionID != "aa" {
+			t.Fatalf("Unexpected event: %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for event")
+	}
+	if err := bridge.Stop(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+)
+
+// KupoClient is the set of Kupo API calls implemented by Client. Depend on it
+// instead of *Client to substitute a fake, such as kupogotest.Fake, in tests
+type KupoClient interface {
+	GetAllMatches() (*Matches, error)
+	GetAllMatchesContext(ctx context.Context) (*Matches, error)
+	GetMatches(pattern string) (*Matches, error)
+	GetMatchesContext(ctx context.Context, pattern string) (*Matches, error)
+	GetMatchesWithOptions(pattern string, opts MatchOptions) (*Matches, error)
+	GetMatchesWithOptionsContext(
+		ctx context.Context,
+		pattern string,
+		opts MatchOptions,
+	) (*Matches, error)
+	GetMetadata(slotNo int, txId string) (*Metadata, error)
+	GetMetadataContext(ctx context.Context, slotNo int, txId string) (*Metadata, error)
+	GetAllPatterns() (*Patterns, error)
+	GetAllPatternsContext(ctx context.Context) (*Patterns, error)
+	GetPattern(pattern string) (*Patterns, error)
+	GetPatternContext(ctx context.Context, pattern string) (*Patterns, error)
+	AddPattern(pattern string, rollbackTo Point, limit RollbackLimit) (*Patterns, error)
+	AddPatternContext(
+		ctx context.Context,
+		pattern string,
+		rollbackTo Point,
+		limit RollbackLimit,
+	) (*Patterns, error)
+	AddPatterns(patterns []string, rollbackTo Point, limit RollbackLimit) (*Patterns, error)
+	AddPatternsContext(
+		ctx context.Context,
+		patterns []string,
+		rollbackTo Point,
+		limit RollbackLimit,
+	) (*Patterns, error)
+	GetScriptByHash(scriptHash string) (*ScriptResponse, error)
+	GetScriptByHashContext(ctx context.Context, scriptHash string) (*ScriptResponse, error)
+	GetDatumByHash(datumHash string) (*DatumResponse, error)
+	GetDatumByHashContext(ctx context.Context, datumHash string) (*DatumResponse, error)
+	GetCheckpoints() (*Checkpoints, error)
+	GetCheckpointsContext(ctx context.Context) (*Checkpoints, error)
+	GetCheckpointBySlot(slotNo int, strict bool) (*Point, error)
+	GetCheckpointBySlotContext(ctx context.Context, slotNo int, strict bool) (*Point, error)
+	GetHealth() (*Health, error)
+	GetHealthContext(ctx context.Context) (*Health, error)
+}
+
+var _ KupoClient = (*Client)(nil)
@@ -15,14 +15,15 @@
 package kupogo
 
 import (
+	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"io"
+	"log/slog"
 	"net/http"
+	"strconv"
 	"time"
-
-	"github.com/go-playground/validator/v10"
 )
 
 type Matches []Match
@@ -40,6 +41,25 @@ type Match struct {
 	SpentAt          *Point  `json:"spent_at"`
 }
 
+// OutputReference uniquely identifies a transaction output
+type OutputReference struct {
+	TransactionID string `json:"transaction_id"`
+	OutputIndex   int    `json:"output_index"`
+}
+
+// String returns the reference in Kupo's output-reference pattern form
+func (r OutputReference) String() string {
+	return fmt.Sprintf("%d@%s", r.OutputIndex, r.TransactionID)
+}
+
+// OutputReference returns the reference of the output described by the match
+func (m Match) OutputReference() OutputReference {
+	return OutputReference{
+		TransactionID: m.TransactionID,
+		OutputIndex:   m.OutputIndex,
+	}
+}
+
 type Assets map[string]int
 
 type Value struct {
@@ -52,14 +72,82 @@ type Point struct {
 	HeaderHash string `json:"header_hash"`
 }
 
+// Checkpoints are ordered from most recent to oldest
+type Checkpoints []Point
+
 type Client struct {
-	KupoUrl string
+	KupoUrl        string
+	httpClient     *http.Client
+	transport      *TransportConfig
+	middleware     []Middleware
+	slowRequests   *slowRequests
+	stats          clientStats
+	requestIDs     func() string
+	logger         *slog.Logger
+	logLevels      LogLevels
+	cache          Cache
+	contentCache   Cache
+	staleFallback  *staleFallback
+	lenient        *lenientDecoding
+	network        *Network
+	datumDecoders  *DatumDecoders
+	contentMemo    contentMemo
+	versionCheck   *versionCheck
+	redirectPolicy *RedirectPolicy
+	timeout        time.Duration
+	// baseHTTPClient is the HTTP client before the transport, middleware and
+	// redirect policy were applied
+	baseHTTPClient *http.Client
+	lastResponse   lastResponse
+	jsonNumbers    bool
+	auditSink      AuditSink
+	confirm        ConfirmFunc
+	// cacheScope separates the entries of this client in a cache shared
+	// with clients sending other headers
+	cacheScope string
 }
 
 type MetadataItem struct {
-	Hash   string          `json:"hash" validate:"required"`
-	Raw    []byte          `json:"-" validate:"required"`
-	Schema json.RawMessage `json:"schema" validate:"required"`
+	Hash string `json:"hash"`
+	// Raw is the CBOR encoded metadata. GetMetadata called with the
+	// LazyMetadata call option leaves it unset, use RawBytes then
+	Raw    []byte          `json:"-"`
+	Schema json.RawMessage `json:"schema"`
+	rawHex []byte
+}
+
+// RawHex returns the hex encoded CBOR of the metadata
+func (m MetadataItem) RawHex() string {
+	if m.Raw != nil || m.rawHex == nil {
+		return hex.EncodeToString(m.Raw)
+	}
+	return string(m.rawHex)
+}
+
+// RawBytes returns the CBOR encoded metadata, hex decoding the payload
+// received from Kupo if Raw is unset
+func (m MetadataItem) RawBytes() ([]byte, error) {
+	if m.Raw != nil || m.rawHex == nil {
+		return m.Raw, nil
+	}
+	ret := make([]byte, hex.DecodedLen(len(m.rawHex)))
+	if _, err := hex.Decode(ret, m.rawHex); err != nil {
+		return nil, fmt.Errorf("failed to decode raw data: %s", err)
+	}
+	return ret, nil
+}
+
+// validateHex checks that data is valid hex without decoding it
+func validateHex(data []byte) error {
+	if len(data)%2 != 0 {
+		return hex.ErrLength
+	}
+	for _, c := range data {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return hex.InvalidByteError(c)
+		}
+	}
+	return nil
 }
 
 type Metadata []MetadataItem
@@ -67,32 +155,124 @@ type Metadata []MetadataItem
 type Pattern string
 type Patterns []Pattern
 
+// RollbackLimit controls how far back Kupo may roll back when adding a pattern
+type RollbackLimit string
+
+const (
+	RollbackLimitWithinSafeZone            RollbackLimit = "within_safe_zone"
+	RollbackLimitUnsafeAllowBeyondSafeZone RollbackLimit = "unsafe_allow_beyond_safe_zone"
+)
+
+type rollbackPoint struct {
+	SlotNo     int    `json:"slot_no"`
+	HeaderHash string `json:"header_hash,omitempty"`
+}
+
+type addPatternRequest struct {
+	RollbackTo rollbackPoint `json:"rollback_to"`
+	Limit      RollbackLimit `json:"limit,omitempty"`
+}
+
+type addPatternsRequest struct {
+	Patterns []string `json:"patterns"`
+	addPatternRequest
+}
+
 type ScriptResponse struct {
-	Language string `json:"language" validate:"required"`
-	Script   string `json:"script"   validate:"required"`
+	Language string `json:"language"`
+	Script   string `json:"script"`
 }
 
 type DatumResponse struct {
-	Datum string `json:"datum" validate:"required"`
+	Datum string `json:"datum"`
 }
 
-func NewClient(url string) *Client {
-	return &Client{KupoUrl: url}
+// mostRecentCheckpointHeader holds the slot of the most recent checkpoint when
+// Kupo answered a query
+const mostRecentCheckpointHeader = "X-Most-Recent-Checkpoint"
+
+var defaultHTTPClient = &http.Client{Timeout: 5 * time.Minute}
+
+func NewClient(url string, opts ...ClientOption) *Client {
+	c := &Client{KupoUrl: url}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.baseHTTPClient = c.httpClient
+	c.applyTransport()
+	c.applyMiddleware(c.middleware)
+	c.applyRedirectPolicy()
+	c.applyTimeout()
+	return c
 }
 
 func (c *Client) Do(req *http.Request) (*http.Response, error) {
-	client := http.DefaultClient
-	client.Timeout = 5 * time.Minute
-	req.Header.Set("Accept", "application/json")
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json")
+	}
+	if err := c.checkVersionBeforeRequest(req); err != nil {
+		return nil, err
+	}
+	req, cancel := applyCallOptions(req)
+	c.setRequestID(req)
+	start := time.Now()
+	c.logRequest(req)
+	var resp *http.Response
+	var err error
+	if c.cache != nil && req.Method == http.MethodGet && !noCache(req) {
+		resp, err = c.doCached(req)
+	} else {
+		resp, err = c.doRequest(req)
+	}
+	c.logResponse(req, resp, err, time.Since(start))
+	endpoint := c.stats.record(req, resp, err, time.Since(start))
+	if err == nil {
+		c.recordResponse(req, resp, time.Since(start))
+		resp.Body = &observedBody{
+			ReadCloser: resp.Body,
+			done: func(n int) {
+				c.stats.addBytes(endpoint, n)
+			},
+		}
+		if c.slowRequests != nil {
+			resp.Body = c.slowRequests.wrap(c, req, resp, start)
+		}
+		if opts := callOptionsFrom(req.Context()); opts != nil && opts.progress != nil {
+			resp.Body = newProgressBody(
+				resp.Body,
+				opts.progress,
+				resp.ContentLength,
+				start,
+				endpoint == "matches",
+			)
+		}
+		resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	} else {
+		cancel()
+	}
+	return resp, err
+}
+
+func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
+	client := c.httpClient
+	if client == nil {
+		client = defaultHTTPClient
+	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed do: %s", err)
+		return nil, fmt.Errorf("failed do: %w%s", err, requestIDSuffix(req))
 	}
 	return resp, nil
 }
 
 func (c *Client) GetAllMatches() (*Matches, error) {
-	req, err := http.NewRequest(
+	return c.GetAllMatchesContext(context.Background())
+}
+
+// GetAllMatchesContext is like GetAllMatches with a request context
+func (c *Client) GetAllMatchesContext(ctx context.Context) (*Matches, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("%s/matches", c.KupoUrl),
 		nil,
@@ -108,20 +288,16 @@ func (c *Client) GetAllMatches() (*Matches, error) {
 				err,
 			)
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		return nil,
 			fmt.Errorf(
-				"failed getting all matches: %d",
+				"failed getting all matches: %d%s",
 				resp.StatusCode,
+				requestIDSuffix(req),
 			)
 	}
-	respBodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed getting body bytes: %s", err)
-	}
-	defer resp.Body.Close()
-	matches := &Matches{}
-	err = json.Unmarshal(respBodyBytes, &matches)
+	matches, err := c.decodeMatches(req, resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed unmarshal: %s", err)
 	}
@@ -129,49 +305,147 @@ func (c *Client) GetAllMatches() (*Matches, error) {
 }
 
 func (c *Client) GetMatches(pattern string) (*Matches, error) {
-	req, err := http.NewRequest(
+	return c.GetMatchesWithOptions(pattern, MatchOptions{})
+}
+
+// GetMatchesContext is like GetMatches with a request context
+func (c *Client) GetMatchesContext(ctx context.Context, pattern string) (*Matches, error) {
+	return c.GetMatchesWithOptionsContext(ctx, pattern, MatchOptions{})
+}
+
+func (c *Client) GetMatchesWithOptions(
+	pattern string,
+	opts MatchOptions,
+) (*Matches, error) {
+	return c.GetMatchesWithOptionsContext(context.Background(), pattern, opts)
+}
+
+// GetMatchesWithOptionsContext is like GetMatchesWithOptions with a request context
+func (c *Client) GetMatchesWithOptionsContext(
+	ctx context.Context,
+	pattern string,
+	opts MatchOptions,
+) (*Matches, error) {
+	matches, _, err := c.getMatches(ctx, pattern, opts)
+	return matches, err
+}
+
+// getMatches also returns the slot of Kupo's most recent checkpoint when the
+// query was answered, or -1 if the response did not include it
+func (c *Client) getMatches(
+	ctx context.Context,
+	pattern string,
+	opts MatchOptions,
+) (*Matches, int, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodGet,
-		fmt.Sprintf("%s/matches/%s", c.KupoUrl, pattern),
+		c.matchesURL(pattern, opts),
 		nil,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed req: %s", err)
+		return nil, -1, fmt.Errorf("failed req: %s", err)
 	}
 	resp, err := c.Do(req)
 	if err != nil {
-		return nil,
+		return nil, -1,
 			fmt.Errorf(
 				"failed getting all matches: %s",
 				err,
 			)
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return nil,
+		return nil, -1,
 			fmt.Errorf(
-				"failed getting all matches: %d",
+				"failed getting all matches: %d%s",
 				resp.StatusCode,
+				requestIDSuffix(req),
+			)
+	}
+	if c.lenient != nil {
+		// Lenient decoding works on the whole body to skip bad elements. The
+		// decoded matches do not refer to the buffer
+		buf := getBuffer()
+		defer putBuffer(buf)
+		if _, err := buf.ReadFrom(resp.Body); err != nil {
+			return nil, -1, err
+		}
+		matches, report, err := DecodeMatchesLenient(buf.Bytes())
+		if err != nil {
+			c.logDecodeFailure(req, err)
+			return nil, -1, fmt.Errorf("fail unmarshal: %s", err)
+		}
+		if len(report.Skipped) > 0 {
+			report.URL = req.URL.String()
+			c.logDecodeFailure(
+				req,
+				fmt.Errorf(
+					"skipped %d of %d matches, first %s",
+					len(report.Skipped),
+					report.Total,
+					report.Skipped[0],
+				),
 			)
+			if c.lenient.onSkipped != nil {
+				c.lenient.onSkipped(report)
+			}
+		}
+		if keep := matchFilter(req); keep != nil {
+			kept := matches[:0]
+			for _, match := range matches {
+				if keep(match) {
+					kept = append(kept, match)
+				}
+			}
+			matches = kept
+		}
+		return &matches, mostRecentCheckpoint(resp), nil
 	}
-	respBodyBytes, err := io.ReadAll(resp.Body)
+	matches, err := c.decodeMatches(req, resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, -1, fmt.Errorf("fail unmarshal: %s", err)
 	}
-	defer resp.Body.Close()
-	matches := &Matches{}
-	err = json.Unmarshal(respBodyBytes, &matches)
+	return matches, mostRecentCheckpoint(resp), nil
+}
+
+func (c *Client) matchesURL(pattern string, opts MatchOptions) string {
+	url := fmt.Sprintf("%s/matches/%s", c.KupoUrl, pattern)
+	if opts.HasTimeBounds() {
+		opts = opts.WithSlotBounds(c.Network())
+	}
+	if query := opts.queryString(); query != "" {
+		url += "?" + query
+	}
+	return url
+}
+
+// mostRecentCheckpoint returns the slot from Kupo's most recent checkpoint
+// header, or -1 if absent
+func mostRecentCheckpoint(resp *http.Response) int {
+	slotNo, err := strconv.Atoi(resp.Header.Get(mostRecentCheckpointHeader))
 	if err != nil {
-		return nil, fmt.Errorf("fail unmarshal: %s", err)
+		return -1
 	}
-	return matches, nil
+	return slotNo
 }
 
 func (c *Client) GetMetadata(slotNo int, txId string) (*Metadata, error) {
+	return c.GetMetadataContext(context.Background(), slotNo, txId)
+}
+
+// GetMetadataContext is like GetMetadata with a request context
+func (c *Client) GetMetadataContext(
+	ctx context.Context,
+	slotNo int,
+	txId string,
+) (*Metadata, error) {
 	url := fmt.Sprintf("%s/metadata/%d", c.KupoUrl, slotNo)
 	if txId != "" {
 		url += fmt.Sprintf("?transaction_id=%s", txId)
 	}
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %s", err)
 	}
@@ -186,39 +460,45 @@ func (c *Client) GetMetadata(slotNo int, txId string) (*Metadata, error) {
 		return nil, fmt.Errorf("metadata not modified since last request")
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get metadata: status code %d", resp.StatusCode)
-	}
-
-	respBodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get metadata: status code %d%s", resp.StatusCode, requestIDSuffix(req))
 	}
 
+	// The raw payload is kept as the undecoded JSON string, sparing a string
+	// copy, and with LazyMetadata its hex decoding until RawBytes is called
 	var responses []struct {
 		Hash   string          `json:"hash"`
-		RawHex string          `json:"raw"`
+		RawHex json.RawMessage `json:"raw"`
 		Schema json.RawMessage `json:"schema"`
 	}
-	err = json.Unmarshal(respBodyBytes, &responses)
-	if err != nil {
+	if err := c.decodeJSON(req, resp.Body, &responses); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal metadata: %s", err)
 	}
 
-	validate := validator.New()
+	lazy := lazyMetadata(req)
 	metadata := &Metadata{}
 	for _, response := range responses {
-		rawBytes, err := hex.DecodeString(response.RawHex)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decode raw data: %s", err)
+		rawHex := response.RawHex
+		if len(rawHex) < 3 || rawHex[0] != '"' || rawHex[len(rawHex)-1] != '"' {
+			return nil, fmt.Errorf("failed to validate metadata item: missing raw data")
 		}
+		rawHex = rawHex[1 : len(rawHex)-1]
 		metadataItem := MetadataItem{
 			Hash:   response.Hash,
-			Raw:    rawBytes,
 			Schema: response.Schema,
 		}
+		if lazy {
+			if err := validateHex(rawHex); err != nil {
+				return nil, fmt.Errorf("failed to decode raw data: %s", err)
+			}
+			metadataItem.rawHex = rawHex
+		} else {
+			metadataItem.Raw = make([]byte, hex.DecodedLen(len(rawHex)))
+			if _, err := hex.Decode(metadataItem.Raw, rawHex); err != nil {
+				return nil, fmt.Errorf("failed to decode raw data: %s", err)
+			}
+		}
 
-		err = validate.Struct(metadataItem)
-		if err != nil {
+		if err := metadataItem.validate(); err != nil {
 			return nil, fmt.Errorf("failed to validate metadata item: %s", err)
 		}
 
@@ -229,7 +509,13 @@ func (c *Client) GetMetadata(slotNo int, txId string) (*Metadata, error) {
 }
 
 func (c *Client) GetAllPatterns() (*Patterns, error) {
-	req, err := http.NewRequest(
+	return c.GetAllPatternsContext(context.Background())
+}
+
+// GetAllPatternsContext is like GetAllPatterns with a request context
+func (c *Client) GetAllPatternsContext(ctx context.Context) (*Patterns, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("%s/patterns", c.KupoUrl),
 		nil,
@@ -244,24 +530,29 @@ func (c *Client) GetAllPatterns() (*Patterns, error) {
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf(
-			"failed to get patterns: status code %d",
+			"failed to get patterns: status code %d%s",
 			resp.StatusCode,
+			requestIDSuffix(req),
 		)
 	}
-	respBodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
 	patterns := &Patterns{}
-	err = json.Unmarshal(respBodyBytes, &patterns)
-	if err != nil {
+	if err := c.decodeJSON(req, resp.Body, patterns); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal patterns: %s", err)
 	}
 	return patterns, nil
 }
 
 func (c *Client) GetPattern(pattern string) (*Patterns, error) {
-	req, err := http.NewRequest(
+	return c.GetPatternContext(context.Background(), pattern)
+}
+
+// GetPatternContext is like GetPattern with a request context
+func (c *Client) GetPatternContext(
+	ctx context.Context,
+	pattern string,
+) (*Patterns, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("%s/patterns/%s", c.KupoUrl, pattern),
 		nil,
@@ -276,24 +567,279 @@ func (c *Client) GetPattern(pattern string) (*Patterns, error) {
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf(
-			"failed to get pattern: status code %d",
+			"failed to get pattern: status code %d%s",
 			resp.StatusCode,
+			requestIDSuffix(req),
 		)
 	}
-	respBodyBytes, err := io.ReadAll(resp.Body)
+	patterns := &Patterns{}
+	if err := c.decodeJSON(req, resp.Body, patterns); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pattern: %s", err)
+	}
+	return patterns, nil
+}
+
+func (c *Client) AddPattern(
+	pattern string,
+	rollbackTo Point,
+	limit RollbackLimit,
+) (*Patterns, error) {
+	return c.AddPatternContext(context.Background(), pattern, rollbackTo, limit)
+}
+
+// AddPatternContext is like AddPattern with a request context
+func (c *Client) AddPatternContext(
+	ctx context.Context,
+	pattern string,
+	rollbackTo Point,
+	limit RollbackLimit,
+) (ret *Patterns, err error) {
+	defer func() {
+		c.audit(ctx, AuditEntry{
+			Action:     AuditAddPattern,
+			Patterns:   []string{pattern},
+			RollbackTo: &rollbackTo,
+			Limit:      limit,
+		}, err)
+	}()
+	reqBody := addPatternRequest{
+		RollbackTo: rollbackPoint{
+			SlotNo:     rollbackTo.SlotNo,
+			HeaderHash: rollbackTo.HeaderHash,
+		},
+		Limit: limit,
+	}
+	reqBodyBytes, err := json.Marshal(reqBody)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to marshal request: %s", err)
 	}
-	patterns := &Patterns{}
-	err = json.Unmarshal(respBodyBytes, &patterns)
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s/patterns/%s", c.KupoUrl, pattern),
+		bytes.NewReader(reqBodyBytes),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal pattern: %s", err)
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add pattern: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"failed to add pattern: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	patterns := &Patterns{}
+	if err := c.decodeJSON(req, resp.Body, patterns); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal patterns: %s", err)
 	}
 	return patterns, nil
 }
 
+// AddPatterns adds several patterns at once, rolling back to the given point
+func (c *Client) AddPatterns(
+	patterns []string,
+	rollbackTo Point,
+	limit RollbackLimit,
+) (*Patterns, error) {
+	return c.AddPatternsContext(context.Background(), patterns, rollbackTo, limit)
+}
+
+// AddPatternsContext is like AddPatterns with a request context
+func (c *Client) AddPatternsContext(
+	ctx context.Context,
+	patterns []string,
+	rollbackTo Point,
+	limit RollbackLimit,
+) (ret *Patterns, err error) {
+	defer func() {
+		c.audit(ctx, AuditEntry{
+			Action:     AuditAddPatterns,
+			Patterns:   patterns,
+			RollbackTo: &rollbackTo,
+			Limit:      limit,
+		}, err)
+	}()
+	reqBody := addPatternsRequest{
+		Patterns: patterns,
+		addPatternRequest: addPatternRequest{
+			RollbackTo: rollbackPoint{
+				SlotNo:     rollbackTo.SlotNo,
+				HeaderHash: rollbackTo.HeaderHash,
+			},
+			Limit: limit,
+		},
+	}
+	reqBodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %s", err)
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("%s/patterns", c.KupoUrl),
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add patterns: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"failed to add patterns: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	ret = &Patterns{}
+	if err := c.decodeJSON(req, resp.Body, ret); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal patterns: %s", err)
+	}
+	return ret, nil
+}
+
+type deletePatternResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+// DeletePattern removes a pattern from Kupo and returns the number of
+// patterns deleted. Matches already indexed for the pattern are kept
+func (c *Client) DeletePattern(pattern string) (int, error) {
+	return c.DeletePatternContext(context.Background(), pattern)
+}
+
+// DeletePatternContext is like DeletePattern with a request context
+func (c *Client) DeletePatternContext(ctx context.Context, pattern string) (deleted int, err error) {
+	ctx, proceed, err := c.guardDestructive(ctx, func() (ChangePlan, error) {
+		count, err := c.CountMatchesContext(ctx, pattern, MatchOptions{})
+		return ChangePlan{
+			Action:   AuditDeletePattern,
+			Patterns: []string{pattern},
+			Matches:  count,
+		}, err
+	})
+	if !proceed {
+		return 0, err
+	}
+	defer func() {
+		c.audit(ctx, AuditEntry{
+			Action:   AuditDeletePattern,
+			Patterns: []string{pattern},
+		}, err)
+	}()
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s/patterns/%s", c.KupoUrl, pattern),
+		nil,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete pattern: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf(
+			"failed to delete pattern: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	var ret deletePatternResponse
+	if err := c.decodeJSON(req, resp.Body, &ret); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal delete response: %s", err)
+	}
+	return ret.Deleted, nil
+}
+
+// DeleteMatches removes the matches of a pattern from Kupo's index and returns
+// the number of matches deleted. Kupo refuses to delete the matches of a
+// pattern which is still registered
+func (c *Client) DeleteMatches(pattern string) (int, error) {
+	return c.DeleteMatchesContext(context.Background(), pattern)
+}
+
+// DeleteMatchesContext is like DeleteMatches with a request context
+func (c *Client) DeleteMatchesContext(ctx context.Context, pattern string) (deleted int, err error) {
+	ctx, proceed, err := c.guardDestructive(ctx, func() (ChangePlan, error) {
+		count, err := c.CountMatchesContext(ctx, pattern, MatchOptions{})
+		return ChangePlan{
+			Action:   AuditDeleteMatches,
+			Patterns: []string{pattern},
+			Matches:  count,
+		}, err
+	})
+	if !proceed {
+		return 0, err
+	}
+	defer func() {
+		c.audit(ctx, AuditEntry{
+			Action:   AuditDeleteMatches,
+			Patterns: []string{pattern},
+		}, err)
+	}()
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("%s/matches/%s", c.KupoUrl, pattern),
+		nil,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete matches: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf(
+			"failed to delete matches: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	var ret deletePatternResponse
+	if err := c.decodeJSON(req, resp.Body, &ret); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal delete response: %s", err)
+	}
+	return ret.Deleted, nil
+}
+
 func (c *Client) GetScriptByHash(scriptHash string) (*ScriptResponse, error) {
-	req, err := http.NewRequest(
+	return c.GetScriptByHashContext(context.Background(), scriptHash)
+}
+
+// GetScriptByHashContext is like GetScriptByHash with a request context
+func (c *Client) GetScriptByHashContext(
+	ctx context.Context,
+	scriptHash string,
+) (*ScriptResponse, error) {
+	cacheKey := "scripts/" + scriptHash
+	if c.contentCache != nil {
+		if cached, ok := c.contentCache.Get(cacheKey); ok {
+			scriptResponse := &ScriptResponse{}
+			if err := json.Unmarshal(cached, &scriptResponse); err == nil {
+				return scriptResponse, nil
+			}
+		}
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("%s/scripts/%s", c.KupoUrl, scriptHash),
 		nil,
@@ -312,33 +858,50 @@ func (c *Client) GetScriptByHash(scriptHash string) (*ScriptResponse, error) {
 	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf(
-			"failed to get script: status code %d",
+			"failed to get script: status code %d%s",
 			resp.StatusCode,
+			requestIDSuffix(req),
 		)
 	}
-	respBodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+	// Kupo answers with null for unknown scripts, which leaves the pointer nil
+	var scriptResponse *ScriptResponse
+	if err := c.decodeJSON(req, resp.Body, &scriptResponse); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal script response: %s", err)
 	}
-	// Check for empty response
-	if string(respBodyBytes) == "null" {
+	if scriptResponse == nil {
 		return nil, nil
 	}
-	scriptResponse := &ScriptResponse{}
-	err = json.Unmarshal(respBodyBytes, &scriptResponse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal script response: %s", err)
-	}
-	validate := validator.New()
-	err = validate.Struct(scriptResponse)
-	if err != nil {
+	if err := scriptResponse.validate(); err != nil {
 		return nil, fmt.Errorf("failed to validate script response: %s", err)
 	}
+	if c.contentCache != nil {
+		if data, err := json.Marshal(scriptResponse); err == nil {
+			c.contentCache.Set(cacheKey, data)
+		}
+	}
 	return scriptResponse, nil
 }
 
 func (c *Client) GetDatumByHash(datumHash string) (*DatumResponse, error) {
-	req, err := http.NewRequest(
+	return c.GetDatumByHashContext(context.Background(), datumHash)
+}
+
+// GetDatumByHashContext is like GetDatumByHash with a request context
+func (c *Client) GetDatumByHashContext(
+	ctx context.Context,
+	datumHash string,
+) (*DatumResponse, error) {
+	cacheKey := "datums/" + datumHash
+	if c.contentCache != nil {
+		if cached, ok := c.contentCache.Get(cacheKey); ok {
+			datumResponse := &DatumResponse{}
+			if err := json.Unmarshal(cached, &datumResponse); err == nil {
+				return datumResponse, nil
+			}
+		}
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("%s/datums/%s", c.KupoUrl, datumHash),
 		nil,
@@ -357,27 +920,102 @@ func (c *Client) GetDatumByHash(datumHash string) (*DatumResponse, error) {
 	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf(
-			"failed to get datum: status code %d",
+			"failed to get datum: status code %d%s",
 			resp.StatusCode,
+			requestIDSuffix(req),
 		)
 	}
-	respBodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+	// Kupo answers with null for unknown datums, which leaves the pointer nil
+	var datumResponse *DatumResponse
+	if err := c.decodeJSON(req, resp.Body, &datumResponse); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal datum: %s", err)
 	}
-	// Check for empty response
-	if string(respBodyBytes) == "null" {
+	if datumResponse == nil {
 		return nil, nil
 	}
-	datumResponse := &DatumResponse{}
-	err = json.Unmarshal(respBodyBytes, &datumResponse)
+	if err := datumResponse.validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate datum response: %s", err)
+	}
+	if c.contentCache != nil {
+		if data, err := json.Marshal(datumResponse); err == nil {
+			c.contentCache.Set(cacheKey, data)
+		}
+	}
+	return datumResponse, nil
+}
+
+func (c *Client) GetCheckpoints() (*Checkpoints, error) {
+	return c.GetCheckpointsContext(context.Background())
+}
+
+// GetCheckpointsContext is like GetCheckpoints with a request context
+func (c *Client) GetCheckpointsContext(ctx context.Context) (*Checkpoints, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/checkpoints", c.KupoUrl),
+		nil,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal datum: %s", err)
+		return nil, fmt.Errorf("failed to create request: %s", err)
 	}
-	validate := validator.New()
-	err = validate.Struct(datumResponse)
+	resp, err := c.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to validate datum response: %s", err)
+		return nil, fmt.Errorf("failed to get checkpoints: %s", err)
 	}
-	return datumResponse, nil
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"failed to get checkpoints: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	checkpoints := &Checkpoints{}
+	if err := c.decodeJSON(req, resp.Body, checkpoints); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal checkpoints: %s", err)
+	}
+	return checkpoints, nil
+}
+
+// GetCheckpointBySlot returns the checkpoint at the given slot or, unless
+// strict is set, the closest one before it. A nil checkpoint is returned if
+// there is none
+func (c *Client) GetCheckpointBySlot(slotNo int, strict bool) (*Point, error) {
+	return c.GetCheckpointBySlotContext(context.Background(), slotNo, strict)
+}
+
+// GetCheckpointBySlotContext is like GetCheckpointBySlot with a request context
+func (c *Client) GetCheckpointBySlotContext(
+	ctx context.Context,
+	slotNo int,
+	strict bool,
+) (*Point, error) {
+	url := fmt.Sprintf("%s/checkpoints/%d", c.KupoUrl, slotNo)
+	if strict {
+		url += "?strict"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoint: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"failed to get checkpoint: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	// Kupo answers with null when there is no checkpoint, which leaves the
+	// pointer nil
+	var checkpoint *Point
+	if err := c.decodeJSON(req, resp.Body, &checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal checkpoint: %s", err)
+	}
+	return checkpoint, nil
 }
@@ -426,3 +426,46 @@ func TestClient_GetMetadata(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_AddPattern(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/patterns/addr1/*" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			var reqBody map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			expectedBody := map[string]any{
+				"rollback_to": map[string]any{"slot_no": float64(1234)},
+				"limit":       "within_safe_zone",
+			}
+			if !reflect.DeepEqual(reqBody, expectedBody) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`["*", "addr1/*"]`))
+		}),
+	)
+	defer server.Close()
+
+	client := &Client{KupoUrl: server.URL}
+	patterns, err := client.AddPattern(
+		"addr1/*",
+		Point{SlotNo: 1234},
+		RollbackLimitWithinSafeZone,
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expectedPatterns := &Patterns{"*", "addr1/*"}
+	if !reflect.DeepEqual(patterns, expectedPatterns) {
+		t.Errorf("Expected patterns %v, got %v", expectedPatterns, patterns)
+	}
+}
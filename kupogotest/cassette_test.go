@@ -0,0 +1,50 @@
+package kupogotest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestRecorderRoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Most-Recent-Checkpoint", "42")
+		_, _ = w.Write([]byte(`{"datum":"d87980"}`))
+	}))
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	client := kupogo.NewClient(server.URL, kupogo.WithHTTPClient(recorder.Client()))
+	if _, err := client.GetDatumByHash("abcd"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	server.Close()
+
+	replayer, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	client = kupogo.NewClient("http://kupo.invalid", kupogo.WithHTTPClient(replayer.Client()))
+	datum, err := client.GetDatumByHash("abcd")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if datum == nil || datum.Datum != "d87980" {
+		t.Fatalf("Unexpected datum: %v", datum)
+	}
+	// Each interaction is only replayed once
+	_, err = client.GetDatumByHash("abcd")
+	if err == nil || !strings.Contains(err.Error(), ErrInteractionNotFound.Error()) {
+		t.Fatalf("Expected ErrInteractionNotFound, got %v", err)
+	}
+}
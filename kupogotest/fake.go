@@ -0,0 +1,409 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kupogotest provides an in-memory fake of the Kupo API for testing
+// code which depends on kupogo.KupoClient
+package kupogotest
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// Fake is an in-memory kupogo.KupoClient seeded with test data
+type Fake struct {
+	// MatchPattern decides whether a match satisfies a pattern. The default
+	// supports "*", addresses, "policy.*", "policy.asset", "index@txid" and
+	// "*@txid"
+	MatchPattern func(pattern string, match kupogo.Match) bool
+	// Health is returned by GetHealth
+	Health kupogo.Health
+
+	mu          sync.Mutex
+	matches     kupogo.Matches
+	patterns    []string
+	datums      map[string]kupogo.DatumResponse
+	scripts     map[string]kupogo.ScriptResponse
+	metadata    map[int]kupogo.Metadata
+	checkpoints kupogo.Checkpoints
+}
+
+var _ kupogo.KupoClient = (*Fake)(nil)
+
+// NewFake creates an empty fake
+func NewFake() *Fake {
+	return &Fake{
+		MatchPattern: DefaultMatchPattern,
+		Health:       kupogo.Health{ConnectionStatus: "connected"},
+		datums:       make(map[string]kupogo.DatumResponse),
+		scripts:      make(map[string]kupogo.ScriptResponse),
+		metadata:     make(map[int]kupogo.Metadata),
+	}
+}
+
+// AddMatches seeds matches
+func (f *Fake) AddMatches(matches ...kupogo.Match) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.matches = append(f.matches, matches...)
+}
+
+// AddDatum seeds a datum, given as hex encoded CBOR
+func (f *Fake) AddDatum(hash string, datum string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.datums[hash] = kupogo.DatumResponse{Datum: datum}
+}
+
+// AddScript seeds a script
+func (f *Fake) AddScript(hash string, language string, script string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.scripts[hash] = kupogo.ScriptResponse{Language: language, Script: script}
+}
+
+// AddMetadata seeds the metadata of a block
+func (f *Fake) AddMetadata(slotNo int, metadata ...kupogo.MetadataItem) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.metadata[slotNo] = append(f.metadata[slotNo], metadata...)
+}
+
+// AddCheckpoints seeds checkpoints, in any order
+func (f *Fake) AddCheckpoints(points ...kupogo.Point) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.checkpoints = append(f.checkpoints, points...)
+	sort.Slice(f.checkpoints, func(i, j int) bool {
+		return f.checkpoints[i].SlotNo > f.checkpoints[j].SlotNo
+	})
+}
+
+// DefaultMatchPattern matches outputs using the same pattern semantics as
+// Kupo. Patterns are also compared literally against the address, transaction
+// ID and asset IDs of the match, so tests may use placeholder values
+func DefaultMatchPattern(pattern string, match kupogo.Match) bool {
+	if pattern == "*" || pattern == "*/*" || pattern == match.Address {
+		return true
+	}
+	if index, txID, ok := strings.Cut(pattern, "@"); ok && txID == match.TransactionID {
+		if index == "*" || index == strconv.Itoa(match.OutputIndex) {
+			return true
+		}
+	}
+	if policyID, assetName, ok := strings.Cut(pattern, "."); ok {
+		for asset := range match.Value.Assets {
+			assetID := kupogo.AssetID(asset)
+			if assetID.PolicyID() != policyID {
+				continue
+			}
+			if assetName == "*" || assetID.AssetName() == assetName {
+				return true
+			}
+		}
+	}
+	return kupogo.Pattern(pattern).Matches(match)
+}
+
+func (f *Fake) GetAllMatches() (*kupogo.Matches, error) {
+	return f.GetMatches("*")
+}
+
+func (f *Fake) GetAllMatchesContext(ctx context.Context) (*kupogo.Matches, error) {
+	return f.GetMatches("*")
+}
+
+func (f *Fake) GetMatches(pattern string) (*kupogo.Matches, error) {
+	return f.GetMatchesWithOptions(pattern, kupogo.MatchOptions{})
+}
+
+func (f *Fake) GetMatchesContext(ctx context.Context, pattern string) (*kupogo.Matches, error) {
+	return f.GetMatches(pattern)
+}
+
+func (f *Fake) GetMatchesWithOptions(
+	pattern string,
+	opts kupogo.MatchOptions,
+) (*kupogo.Matches, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ret := kupogo.Matches{}
+	for _, match := range f.matches {
+		if f.MatchPattern(pattern, match) && matchesOptions(match, opts) {
+			ret = append(ret, match)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if opts.Order == kupogo.MatchOrderOldestFirst {
+			return ret[i].CreatedAt.SlotNo < ret[j].CreatedAt.SlotNo
+		}
+		return ret[i].CreatedAt.SlotNo > ret[j].CreatedAt.SlotNo
+	})
+	return &ret, nil
+}
+
+func (f *Fake) GetMatchesWithOptionsContext(
+	ctx context.Context,
+	pattern string,
+	opts kupogo.MatchOptions,
+) (*kupogo.Matches, error) {
+	return f.GetMatchesWithOptions(pattern, opts)
+}
+
+// matchesOptions applies the query filters, with exclusive slot bounds
+func matchesOptions(match kupogo.Match, opts kupogo.MatchOptions) bool {
+	// Time bounds use mainnet, the default network of clients
+	if opts.HasTimeBounds() {
+		opts = opts.WithSlotBounds(kupogo.NetworkMainnet)
+	}
+	if opts.Spent && match.SpentAt == nil {
+		return false
+	}
+	if opts.Unspent && match.SpentAt != nil {
+		return false
+	}
+	created := match.CreatedAt.SlotNo
+	if opts.CreatedAfter > 0 && created <= opts.CreatedAfter {
+		return false
+	}
+	if opts.CreatedBefore > 0 && created >= opts.CreatedBefore {
+		return false
+	}
+	if opts.SpentAfter > 0 || opts.SpentBefore > 0 {
+		if match.SpentAt == nil {
+			return false
+		}
+		spent := match.SpentAt.SlotNo
+		if opts.SpentAfter > 0 && spent <= opts.SpentAfter {
+			return false
+		}
+		if opts.SpentBefore > 0 && spent >= opts.SpentBefore {
+			return false
+		}
+	}
+	if opts.TransactionID != "" && match.TransactionID != opts.TransactionID {
+		return false
+	}
+	if opts.PolicyID != "" {
+		found := false
+		for asset := range match.Value.Assets {
+			assetID := kupogo.AssetID(asset)
+			if assetID.PolicyID() == opts.PolicyID &&
+				(opts.AssetName == "" || assetID.AssetName() == opts.AssetName) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *Fake) GetMetadata(slotNo int, txId string) (*kupogo.Metadata, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ret := kupogo.Metadata{}
+	ret = append(ret, f.metadata[slotNo]...)
+	return &ret, nil
+}
+
+func (f *Fake) GetMetadataContext(
+	ctx context.Context,
+	slotNo int,
+	txId string,
+) (*kupogo.Metadata, error) {
+	return f.GetMetadata(slotNo, txId)
+}
+
+func (f *Fake) GetAllPatterns() (*kupogo.Patterns, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ret := kupogo.Patterns{}
+	for _, pattern := range f.patterns {
+		ret = append(ret, kupogo.Pattern(pattern))
+	}
+	return &ret, nil
+}
+
+func (f *Fake) GetAllPatternsContext(ctx context.Context) (*kupogo.Patterns, error) {
+	return f.GetAllPatterns()
+}
+
+func (f *Fake) GetPattern(pattern string) (*kupogo.Patterns, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ret := kupogo.Patterns{}
+	for _, p := range f.patterns {
+		if p == pattern {
+			ret = append(ret, kupogo.Pattern(p))
+		}
+	}
+	return &ret, nil
+}
+
+func (f *Fake) GetPatternContext(ctx context.Context, pattern string) (*kupogo.Patterns, error) {
+	return f.GetPattern(pattern)
+}
+
+func (f *Fake) AddPattern(
+	pattern string,
+	rollbackTo kupogo.Point,
+	limit kupogo.RollbackLimit,
+) (*kupogo.Patterns, error) {
+	return f.AddPatterns([]string{pattern}, rollbackTo, limit)
+}
+
+func (f *Fake) AddPatternContext(
+	ctx context.Context,
+	pattern string,
+	rollbackTo kupogo.Point,
+	limit kupogo.RollbackLimit,
+) (*kupogo.Patterns, error) {
+	return f.AddPattern(pattern, rollbackTo, limit)
+}
+
+func (f *Fake) AddPatterns(
+	patterns []string,
+	rollbackTo kupogo.Point,
+	limit kupogo.RollbackLimit,
+) (*kupogo.Patterns, error) {
+	f.mu.Lock()
+	existing := make(map[string]bool, len(f.patterns))
+	for _, pattern := range f.patterns {
+		existing[pattern] = true
+	}
+	for _, pattern := range patterns {
+		if !existing[pattern] {
+			existing[pattern] = true
+			f.patterns = append(f.patterns, pattern)
+		}
+	}
+	f.mu.Unlock()
+	return f.GetAllPatterns()
+}
+
+func (f *Fake) AddPatternsContext(
+	ctx context.Context,
+	patterns []string,
+	rollbackTo kupogo.Point,
+	limit kupogo.RollbackLimit,
+) (*kupogo.Patterns, error) {
+	return f.AddPatterns(patterns, rollbackTo, limit)
+}
+
+// DeletePattern removes a registered pattern, like Client.DeletePattern
+func (f *Fake) DeletePattern(pattern string) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	kept := f.patterns[:0]
+	deleted := 0
+	for _, p := range f.patterns {
+		if p == pattern {
+			deleted++
+			continue
+		}
+		kept = append(kept, p)
+	}
+	f.patterns = kept
+	return deleted, nil
+}
+
+func (f *Fake) DeletePatternContext(ctx context.Context, pattern string) (int, error) {
+	return f.DeletePattern(pattern)
+}
+
+func (f *Fake) GetScriptByHash(scriptHash string) (*kupogo.ScriptResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	script, ok := f.scripts[scriptHash]
+	if !ok {
+		return nil, nil
+	}
+	return &script, nil
+}
+
+func (f *Fake) GetScriptByHashContext(
+	ctx context.Context,
+	scriptHash string,
+) (*kupogo.ScriptResponse, error) {
+	return f.GetScriptByHash(scriptHash)
+}
+
+func (f *Fake) GetDatumByHash(datumHash string) (*kupogo.DatumResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	datum, ok := f.datums[datumHash]
+	if !ok {
+		return nil, nil
+	}
+	return &datum, nil
+}
+
+func (f *Fake) GetDatumByHashContext(
+	ctx context.Context,
+	datumHash string,
+) (*kupogo.DatumResponse, error) {
+	return f.GetDatumByHash(datumHash)
+}
+
+func (f *Fake) GetCheckpoints() (*kupogo.Checkpoints, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ret := kupogo.Checkpoints{}
+	ret = append(ret, f.checkpoints...)
+	return &ret, nil
+}
+
+func (f *Fake) GetCheckpointsContext(ctx context.Context) (*kupogo.Checkpoints, error) {
+	return f.GetCheckpoints()
+}
+
+func (f *Fake) GetCheckpointBySlot(slotNo int, strict bool) (*kupogo.Point, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	// Checkpoints are ordered most recent first
+	for _, point := range f.checkpoints {
+		if point.SlotNo == slotNo || (!strict && point.SlotNo < slotNo) {
+			ret := point
+			return &ret, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *Fake) GetCheckpointBySlotContext(
+	ctx context.Context,
+	slotNo int,
+	strict bool,
+) (*kupogo.Point, error) {
+	return f.GetCheckpointBySlot(slotNo, strict)
+}
+
+func (f *Fake) GetHealth() (*kupogo.Health, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	health := f.Health
+	return &health, nil
+}
+
+func (f *Fake) GetHealthContext(ctx context.Context) (*kupogo.Health, error) {
+	return f.GetHealth()
+}
@@ -0,0 +1,167 @@
+package kupogotest
+
+import (
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+const (
+	testPolicy = "00000000000000000000000000000000000000000000000000000000"
+	testTxA    = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testTxB    = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func testFake() *Fake {
+	fake := NewFake()
+	fake.AddMatches(
+		kupogo.Match{
+			TransactionID: testTxA,
+			OutputIndex:   0,
+			Address:       "addr_test1a",
+			Value:         kupogo.Value{Coins: 1000000},
+			CreatedAt:     kupogo.Point{SlotNo: 10},
+		},
+		kupogo.Match{
+			TransactionID: testTxB,
+			OutputIndex:   1,
+			Address:       "addr_test1b",
+			Value: kupogo.Value{
+				Coins:  2000000,
+				Assets: kupogo.Assets{testPolicy + ".746f6b656e": 1},
+			},
+			CreatedAt: kupogo.Point{SlotNo: 20},
+			SpentAt:   &kupogo.Point{SlotNo: 30},
+		},
+	)
+	return fake
+}
+
+func TestFakeGetMatches(t *testing.T) {
+	fake := testFake()
+	testDefs := []struct {
+		pattern  string
+		opts     kupogo.MatchOptions
+		expected []string
+	}{
+		{pattern: "*", expected: []string{testTxB, testTxA}},
+		{
+			pattern:  "*",
+			opts:     kupogo.MatchOptions{Order: kupogo.MatchOrderOldestFirst},
+			expected: []string{testTxA, testTxB},
+		},
+		{pattern: "addr_test1a", expected: []string{testTxA}},
+		{pattern: testPolicy + ".*", expected: []string{testTxB}},
+		{pattern: testPolicy + ".746f6b656e", expected: []string{testTxB}},
+		{pattern: "1@" + testTxB, expected: []string{testTxB}},
+		{pattern: "0@" + testTxB, expected: []string{}},
+		{pattern: "*@" + testTxA, expected: []string{testTxA}},
+		{pattern: "*", opts: kupogo.MatchOptions{Unspent: true}, expected: []string{testTxA}},
+		{pattern: "*", opts: kupogo.MatchOptions{Spent: true}, expected: []string{testTxB}},
+		{
+			pattern:  "*",
+			opts:     kupogo.MatchOptions{CreatedAfter: 10},
+			expected: []string{testTxB},
+		},
+	}
+	for _, testDef := range testDefs {
+		matches, err := fake.GetMatchesWithOptions(testDef.pattern, testDef.opts)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if len(*matches) != len(testDef.expected) {
+			t.Fatalf(
+				"Pattern %s: expected %d matches, got %d",
+				testDef.pattern,
+				len(testDef.expected),
+				len(*matches),
+			)
+		}
+		for i, match := range *matches {
+			if match.TransactionID != testDef.expected[i] {
+				t.Fatalf(
+					"Pattern %s: expected %s at %d, got %s",
+					testDef.pattern,
+					testDef.expected[i],
+					i,
+					match.TransactionID,
+				)
+			}
+		}
+	}
+}
+
+func TestFakeContent(t *testing.T) {
+	fake := testFake()
+	fake.AddDatum("datumhash", "d87980")
+	fake.AddScript("scripthash", "plutus:v2", "4e4d01000033222220051200120011")
+	datum, err := fake.GetDatumByHash("datumhash")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if datum == nil || datum.Datum != "d87980" {
+		t.Fatalf("Unexpected datum: %v", datum)
+	}
+	missing, err := fake.GetDatumByHash("missing")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if missing != nil {
+		t.Fatalf("Expected nil datum, got %v", missing)
+	}
+	script, err := fake.GetScriptByHash("scripthash")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if script == nil || script.Language != "plutus:v2" {
+		t.Fatalf("Unexpected script: %v", script)
+	}
+}
+
+func TestFakeCheckpoints(t *testing.T) {
+	fake := NewFake()
+	fake.AddCheckpoints(
+		kupogo.Point{SlotNo: 10, HeaderHash: "a"},
+		kupogo.Point{SlotNo: 30, HeaderHash: "c"},
+		kupogo.Point{SlotNo: 20, HeaderHash: "b"},
+	)
+	point, err := fake.GetCheckpointBySlot(25, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if point == nil || point.HeaderHash != "b" {
+		t.Fatalf("Expected closest ancestor b, got %v", point)
+	}
+	point, err = fake.GetCheckpointBySlot(25, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if point != nil {
+		t.Fatalf("Expected no strict checkpoint, got %v", point)
+	}
+}
+
+func TestFakePatterns(t *testing.T) {
+	fake := NewFake()
+	var client kupogo.KupoClient = fake
+	if _, err := client.AddPatterns(
+		[]string{"*@" + testTxA, "*@" + testTxA, "*"},
+		kupogo.Point{},
+		kupogo.RollbackLimitWithinSafeZone,
+	); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	patterns, err := client.GetAllPatterns()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(*patterns) != 2 {
+		t.Fatalf("Expected 2 patterns, got %d", len(*patterns))
+	}
+	if deleted, err := fake.DeletePattern("*"); err != nil || deleted != 1 {
+		t.Fatalf("Expected 1 deleted pattern, got %d (%v)", deleted, err)
+	}
+	if patterns, _ := client.GetAllPatterns(); len(*patterns) != 1 {
+		t.Fatalf("Expected 1 pattern, got %d", len(*patterns))
+	}
+}
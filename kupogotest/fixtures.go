@@ -0,0 +1,265 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogotest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// FixtureConfig selects the responses captured by GenerateFixtures. Choose
+// patterns, datums, scripts and metadata slots covering every era of interest
+type FixtureConfig struct {
+	// Patterns are queried with /matches, one fixture per pattern
+	Patterns []string
+	// DatumHashes are fetched from /datums
+	DatumHashes []string
+	// ScriptHashes are fetched from /scripts
+	ScriptHashes []string
+	// MetadataSlots are fetched from /metadata
+	MetadataSlots []int
+	// HTTPClient is used for requests, defaulting to http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// fixtureKinds maps fixture file name prefixes to the type they decode into
+var fixtureKinds = map[string]func() any{
+	"health":      func() any { return &kupogo.Health{} },
+	"checkpoints": func() any { return &kupogo.Checkpoints{} },
+	"patterns":    func() any { return &kupogo.Patterns{} },
+	"matches":     func() any { return &kupogo.Matches{} },
+	"datum":       func() any { return &kupogo.DatumResponse{} },
+	"script":      func() any { return &kupogo.ScriptResponse{} },
+	"metadata":    func() any { return &kupogo.Metadata{} },
+}
+
+// GenerateFixtures captures responses from a live Kupo as raw JSON files
+// under dir/<kupo version>, returning that directory. Decoding the files with
+// DecodeFixtures catches schema changes between Kupo releases
+func GenerateFixtures(
+	ctx context.Context,
+	kupoURL string,
+	dir string,
+	config FixtureConfig,
+) (string, error) {
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	kupoURL = strings.TrimSuffix(kupoURL, "/")
+	health, err := fetchFixture(ctx, config.HTTPClient, kupoURL+"/health")
+	if err != nil {
+		return "", err
+	}
+	var h kupogo.Health
+	if err := json.Unmarshal(health, &h); err != nil {
+		return "", fmt.Errorf("failed to decode health: %s", err)
+	}
+	version := h.Version
+	if version == "" {
+		version = "unknown"
+	}
+	versionDir := filepath.Join(dir, version)
+	if err := os.MkdirAll(versionDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create fixture directory: %s", err)
+	}
+	fixtures := map[string]string{
+		"health.json":      "/health",
+		"checkpoints.json": "/checkpoints",
+		"patterns.json":    "/patterns",
+	}
+	for i, pattern := range config.Patterns {
+		name := fmt.Sprintf("matches-%02d.json", i)
+		fixtures[name] = "/matches/" + url.PathEscape(pattern)
+	}
+	for _, hash := range config.DatumHashes {
+		fixtures["datum-"+hash+".json"] = "/datums/" + hash
+	}
+	for _, hash := range config.ScriptHashes {
+		fixtures["script-"+hash+".json"] = "/scripts/" + hash
+	}
+	for _, slot := range config.MetadataSlots {
+		fixtures["metadata-"+strconv.Itoa(slot)+".json"] = "/metadata/" + strconv.Itoa(slot)
+	}
+	for name, path := range fixtures {
+		data, err := fetchFixture(ctx, config.HTTPClient, kupoURL+path)
+		if err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(filepath.Join(versionDir, name), data, 0o644); err != nil {
+			return "", fmt.Errorf("failed to write fixture: %s", err)
+		}
+	}
+	return versionDir, nil
+}
+
+func fetchFixture(ctx context.Context, client *http.Client, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed do: %s", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %s", err)
+	}
+	// Kupo reports 503 from /health while syncing, with a valid body
+	if resp.StatusCode != http.StatusOK &&
+		!(resp.StatusCode == http.StatusServiceUnavailable && strings.HasSuffix(u, "/health")) {
+		return nil, fmt.Errorf("%s: HTTP response %d", u, resp.StatusCode)
+	}
+	return data, nil
+}
+
+// wireFields lists the JSON fields of types whose custom decoding reads
+// fields their struct tags do not declare
+var wireFields = map[reflect.Type][]string{
+	reflect.TypeOf(kupogo.MetadataItem{}): {"raw"},
+}
+
+// DecodeFixtures decodes every fixture under dir, recursively, returning the
+// paths of the files decoded. When strict, unknown fields are an error so that
+// fields added by a Kupo release are noticed. Fields are checked against the
+// struct tags of the decoded types rather than by the decoder, as types with
+// custom decoding such as Match bypass DisallowUnknownFields
+func DecodeFixtures(dir string, strict bool) ([]string, error) {
+	var decoded []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+		kind, _, _ := strings.Cut(strings.TrimSuffix(d.Name(), ".json"), "-")
+		newValue, ok := fixtureKinds[kind]
+		if !ok {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read fixture: %s", err)
+		}
+		value := newValue()
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(value); err != nil {
+			return fmt.Errorf("failed to decode fixture %s: %s", path, err)
+		}
+		if strict {
+			var doc any
+			if err := json.Unmarshal(data, &doc); err != nil {
+				return fmt.Errorf("failed to decode fixture %s: %s", path, err)
+			}
+			if err := checkFields(doc, reflect.TypeOf(value), "$"); err != nil {
+				return fmt.Errorf("failed to decode fixture %s: %s", path, err)
+			}
+		}
+		decoded = append(decoded, path)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(decoded)
+	return decoded, nil
+}
+
+// checkFields reports the first field of a decoded JSON document which the
+// struct tags of t do not declare
+func checkFields(doc any, t reflect.Type, path string) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch doc := doc.(type) {
+	case map[string]any:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := make(map[string]reflect.Type)
+			collectFields(t, fields)
+			for _, name := range wireFields[t] {
+				if _, ok := fields[name]; !ok {
+					fields[name] = nil
+				}
+			}
+			for key, value := range doc {
+				fieldType, ok := fields[key]
+				if !ok {
+					return fmt.Errorf("unknown field %s.%s in %s", path, key, t)
+				}
+				if fieldType == nil {
+					continue
+				}
+				if err := checkFields(value, fieldType, path+"."+key); err != nil {
+					return err
+				}
+			}
+		case reflect.Map:
+			for key, value := range doc {
+				if err := checkFields(value, t.Elem(), path+"."+key); err != nil {
+					return err
+				}
+			}
+		}
+	case []any:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for i, value := range doc {
+				if err := checkFields(value, t.Elem(), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// collectFields gathers the JSON field names of a struct and their types,
+// descending into embedded structs
+func collectFields(t reflect.Type, fields map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			collectFields(field.Type, fields)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+}
@@ -0,0 +1,107 @@
+package kupogotest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateFixtures(t *testing.T) {
+	responses := map[string]string{
+		"/health":      `{"connection_status":"connected","most_recent_checkpoint":10,"most_recent_node_tip":12,"seconds_since_last_block":1,"network_synchronization":0.99999,"configuration":{"indexes":"installed"},"version":"v2.7.2"}`,
+		"/checkpoints": `[{"slot_no":10,"header_hash":"abcd"}]`,
+		"/patterns":    `["*"]`,
+		"/matches/*":   `[{"transaction_index":0,"transaction_id":"aa","output_index":0,"address":"addr_test1","value":{"coins":1,"assets":{}},"datum_hash":null,"datum_type":null,"script_hash":null,"created_at":{"slot_no":10,"header_hash":"abcd"},"spent_at":null}]`,
+		"/datums/ff":   `{"datum":"d87980"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+	dir, err := GenerateFixtures(
+		context.Background(),
+		server.URL,
+		t.TempDir(),
+		FixtureConfig{
+			Patterns:    []string{"*"},
+			DatumHashes: []string{"ff"},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if filepath.Base(dir) != "v2.7.2" {
+		t.Fatalf("Expected fixtures versioned as v2.7.2, got %s", dir)
+	}
+	decoded, err := DecodeFixtures(dir, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(decoded) != 5 {
+		t.Fatalf("Expected 5 fixtures, got %d", len(decoded))
+	}
+	// A field added in a later release is caught in strict mode only
+	if err := os.WriteFile(
+		filepath.Join(dir, "datum-ff.json"),
+		[]byte(`{"datum":"d87980","datum_type":"inline"}`),
+		0o644,
+	); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if _, err := DecodeFixtures(dir, true); err == nil {
+		t.Fatalf("Expected error for unknown field")
+	}
+	if _, err := DecodeFixtures(dir, false); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	// Matches decode through a custom UnmarshalJSON, which must not hide new
+	// fields either
+	if err := os.WriteFile(
+		filepath.Join(dir, "datum-ff.json"),
+		[]byte(`{"datum":"d87980"}`),
+		0o644,
+	); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := os.WriteFile(
+		filepath.Join(dir, "matches-00.json"),
+		[]byte(`[{"transaction_id":"aa","value":{"coins":1},"created_at":{"slot_no":10,"header_hash":"abcd","transaction_id":"aa"}}]`),
+		0o644,
+	); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if _, err := DecodeFixtures(dir, true); err == nil {
+		t.Fatalf("Expected error for unknown match field")
+	}
+}
+
+// TestLiveFixtures refreshes testdata/fixtures from the Kupo instance in
+// KUPO_FIXTURE_URL, then checks every stored fixture still decodes without
+// unknown fields
+func TestLiveFixtures(t *testing.T) {
+	if kupoURL := os.Getenv("KUPO_FIXTURE_URL"); kupoURL != "" {
+		if _, err := GenerateFixtures(
+			context.Background(),
+			kupoURL,
+			filepath.Join("testdata", "fixtures"),
+			FixtureConfig{Patterns: []string{"*"}},
+		); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	}
+	decoded, err := DecodeFixtures(filepath.Join("testdata", "fixtures"), true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(decoded) == 0 {
+		t.Fatalf("Expected fixtures in testdata/fixtures")
+	}
+}
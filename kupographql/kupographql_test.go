@@ -0,0 +1,108 @@
+package kupographql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+type testSource struct {
+	opts kupogo.MatchOptions
+}
+
+func (s *testSource) Matches(
+	ctx context.Context,
+	pattern string,
+	opts kupogo.MatchOptions,
+) (kupogo.Matches, error) {
+	s.opts = opts
+	datumHash := "dd"
+	return kupogo.Matches{
+		{
+			TransactionID: "t1",
+			OutputIndex:   1,
+			Address:       pattern,
+			Value: kupogo.Value{
+				Coins:  45000000000000,
+				Assets: kupogo.Assets{"aa.01": 2, "aa": 1},
+			},
+			DatumHash: &datumHash,
+			CreatedAt: kupogo.Point{SlotNo: 10, HeaderHash: "h1"},
+		},
+		{TransactionID: "t2", Address: pattern},
+	}, nil
+}
+
+func (s *testSource) Datum(ctx context.Context, datumHash string) (*kupogo.DatumResponse, error) {
+	return &kupogo.DatumResponse{Datum: "d87980"}, nil
+}
+
+func (s *testSource) Script(ctx context.Context, scriptHash string) (*kupogo.ScriptResponse, error) {
+	return nil, nil
+}
+
+func (s *testSource) Metadata(ctx context.Context, slotNo int, txId string) (kupogo.Metadata, error) {
+	return kupogo.Metadata{}, nil
+}
+
+func TestHandler(t *testing.T) {
+	source := &testSource{}
+	handler, err := NewHandler(source)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	query := `query($pattern: String!) {
+		matches(pattern: $pattern, status: UNSPENT, policyId: "aa", order: OLDEST_FIRST, limit: 1) {
+			transactionId
+			value { coins assets { policyId assetName quantity } }
+			createdAt { slotNo }
+			spentAt { slotNo }
+			datum { hash value }
+		}
+	}`
+	body, _ := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": map[string]interface{}{"pattern": "addr1"},
+	})
+	resp, err := http.Post(server.URL, "application/json", strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	defer resp.Body.Close()
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	got, _ := json.Marshal(result)
+	expected := `{"data":{"matches":[{"createdAt":{"slotNo":10},"datum":{"hash":"dd","value":"d87980"},` +
+		`"spentAt":null,"transactionId":"t1","value":{"assets":[{"assetName":"","policyId":"aa","quantity":1},` +
+		`{"assetName":"01","policyId":"aa","quantity":2}],"coins":45000000000000}}]}}`
+	if string(got) != expected {
+		t.Errorf("Unexpected result:\n%s\nexpected:\n%s", got, expected)
+	}
+	if !source.opts.Unspent || source.opts.PolicyID != "aa" || source.opts.Order != kupogo.MatchOrderOldestFirst {
+		t.Errorf("Unexpected match options: %+v", source.opts)
+	}
+
+	resp, err = http.Get(server.URL + "?query=" + url.QueryEscape(`{ matches(pattern: "*", assetName: "01") { transactionId } }`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	defer resp.Body.Close()
+	result = nil
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if result["errors"] == nil {
+		t.Errorf("Expected an error for assetName without policyId, got %v", result)
+	}
+}
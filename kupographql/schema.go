@@ -0,0 +1,474 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kupographql exposes kupogo data as a GraphQL schema, for frontends
+// which prefer GraphQL over Kupo's REST API. Matches, their values, datums,
+// scripts and transaction metadata can be queried, backed either by Kupo
+// directly or by a local mirror
+package kupographql
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// quantityType carries lovelace and asset quantities, which overflow
+// GraphQL's 32-bit Int
+var quantityType = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Quantity",
+	Description: "An amount of lovelace or of a native asset, as a JSON number",
+	Serialize: func(value interface{}) interface{} {
+		return value
+	},
+	ParseValue: func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case int:
+			return value
+		case float64:
+			return int(value)
+		case string:
+			ret, err := strconv.Atoi(value)
+			if err != nil {
+				return nil
+			}
+			return ret
+		}
+		return nil
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		if value, ok := valueAST.(*ast.IntValue); ok {
+			ret, err := strconv.Atoi(value.Value)
+			if err != nil {
+				return nil
+			}
+			return ret
+		}
+		return nil
+	},
+})
+
+var pointType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Point",
+	Fields: graphql.Fields{
+		"slotNo": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(kupogo.Point).SlotNo, nil
+			},
+		},
+		"headerHash": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(kupogo.Point).HeaderHash, nil
+			},
+		},
+	},
+})
+
+// asset is a single entry of a value's assets
+type asset struct {
+	id       kupogo.AssetID
+	quantity int
+}
+
+var assetType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Asset",
+	Fields: graphql.Fields{
+		"assetId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(asset).id.String(), nil
+			},
+		},
+		"policyId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(asset).id.PolicyID(), nil
+			},
+		},
+		"assetName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(asset).id.AssetName(), nil
+			},
+		},
+		"quantity": &graphql.Field{
+			Type: graphql.NewNonNull(quantityType),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(asset).quantity, nil
+			},
+		},
+	},
+})
+
+var valueType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Value",
+	Fields: graphql.Fields{
+		"coins": &graphql.Field{
+			Type: graphql.NewNonNull(quantityType),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(kupogo.Value).Coins, nil
+			},
+		},
+		"assets": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(assetType))),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				value := p.Source.(kupogo.Value)
+				ret := make([]asset, 0, len(value.Assets))
+				for id, quantity := range value.Assets {
+					ret = append(ret, asset{id: kupogo.AssetID(id), quantity: quantity})
+				}
+				sort.Slice(ret, func(i, j int) bool {
+					return ret[i].id < ret[j].id
+				})
+				return ret, nil
+			},
+		},
+	},
+})
+
+// datum is a datum along with the hash it was looked up by
+type datum struct {
+	hash  string
+	value string
+}
+
+var datumType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Datum",
+	Fields: graphql.Fields{
+		"hash": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(datum).hash, nil
+			},
+		},
+		"value": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "The CBOR encoded datum, as hex",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(datum).value, nil
+			},
+		},
+	},
+})
+
+// script is a script along with the hash it was looked up by
+type script struct {
+	hash string
+	kupogo.ScriptResponse
+}
+
+var scriptType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Script",
+	Fields: graphql.Fields{
+		"hash": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(script).hash, nil
+			},
+		},
+		"language": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(script).Language, nil
+			},
+		},
+		"script": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "The serialized script, as hex",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(script).Script, nil
+			},
+		},
+	},
+})
+
+var metadataItemType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "MetadataItem",
+	Fields: graphql.Fields{
+		"hash": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(kupogo.MetadataItem).Hash, nil
+			},
+		},
+		"raw": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "The CBOR encoded metadata, as hex",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(kupogo.MetadataItem).RawHex(), nil
+			},
+		},
+		"json": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "The metadata decoded to JSON, keyed by label",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				decoded, err := p.Source.(kupogo.MetadataItem).JSON()
+				if err != nil {
+					return nil, err
+				}
+				ret, err := json.Marshal(decoded)
+				if err != nil {
+					return nil, err
+				}
+				return string(ret), nil
+			},
+		},
+	},
+})
+
+var statusType = graphql.NewEnum(graphql.EnumConfig{
+	Name: "MatchStatus",
+	Values: graphql.EnumValueConfigMap{
+		"ALL":     &graphql.EnumValueConfig{Value: "all"},
+		"SPENT":   &graphql.EnumValueConfig{Value: "spent"},
+		"UNSPENT": &graphql.EnumValueConfig{Value: "unspent"},
+	},
+})
+
+var orderType = graphql.NewEnum(graphql.EnumConfig{
+	Name: "MatchOrder",
+	Values: graphql.EnumValueConfigMap{
+		"MOST_RECENT_FIRST": &graphql.EnumValueConfig{Value: string(kupogo.MatchOrderMostRecentFirst)},
+		"OLDEST_FIRST":      &graphql.EnumValueConfig{Value: string(kupogo.MatchOrderOldestFirst)},
+	},
+})
+
+// NewSchema builds the GraphQL schema served from a source
+func NewSchema(source Source) (graphql.Schema, error) {
+	matchType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Match",
+		Fields: graphql.Fields{
+			"transactionId": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(kupogo.Match).TransactionID, nil
+				},
+			},
+			"transactionIndex": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(kupogo.Match).TransactionIndex, nil
+				},
+			},
+			"outputIndex": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(kupogo.Match).OutputIndex, nil
+				},
+			},
+			"address": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(kupogo.Match).Address, nil
+				},
+			},
+			"value": &graphql.Field{
+				Type: graphql.NewNonNull(valueType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(kupogo.Match).Value, nil
+				},
+			},
+			"datumHash": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(kupogo.Match).DatumHash, nil
+				},
+			},
+			"datumType": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(kupogo.Match).DatumType, nil
+				},
+			},
+			"scriptHash": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(kupogo.Match).ScriptHash, nil
+				},
+			},
+			"createdAt": &graphql.Field{
+				Type: graphql.NewNonNull(pointType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(kupogo.Match).CreatedAt, nil
+				},
+			},
+			"spentAt": &graphql.Field{
+				Type: pointType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					spentAt := p.Source.(kupogo.Match).SpentAt
+					if spentAt == nil {
+						return nil, nil
+					}
+					return *spentAt, nil
+				},
+			},
+			"datum": &graphql.Field{
+				Type:        datumType,
+				Description: "The datum of the output, fetched on demand",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					datumHash := p.Source.(kupogo.Match).DatumHash
+					if datumHash == nil {
+						return nil, nil
+					}
+					return resolveDatum(p, source, *datumHash)
+				},
+			},
+			"script": &graphql.Field{
+				Type:        scriptType,
+				Description: "The reference script of the output, fetched on demand",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					scriptHash := p.Source.(kupogo.Match).ScriptHash
+					if scriptHash == nil {
+						return nil, nil
+					}
+					return resolveScript(p, source, *scriptHash)
+				},
+			},
+		},
+	})
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"matches": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(matchType))),
+				Args: graphql.FieldConfigArgument{
+					"pattern":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"status":        &graphql.ArgumentConfig{Type: statusType, DefaultValue: "all"},
+					"createdAfter":  &graphql.ArgumentConfig{Type: graphql.Int},
+					"createdBefore": &graphql.ArgumentConfig{Type: graphql.Int},
+					"spentAfter":    &graphql.ArgumentConfig{Type: graphql.Int},
+					"spentBefore":   &graphql.ArgumentConfig{Type: graphql.Int},
+					"policyId":      &graphql.ArgumentConfig{Type: graphql.String},
+					"assetName":     &graphql.ArgumentConfig{Type: graphql.String},
+					"transactionId": &graphql.ArgumentConfig{Type: graphql.String},
+					"order":         &graphql.ArgumentConfig{Type: orderType},
+					"limit": &graphql.ArgumentConfig{
+						Type:        graphql.Int,
+						Description: "The maximum number of matches to return",
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					opts, err := matchOptions(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					matches, err := source.Matches(p.Context, p.Args["pattern"].(string), opts)
+					if err != nil {
+						return nil, err
+					}
+					if limit, ok := p.Args["limit"].(int); ok && limit >= 0 && limit < len(matches) {
+						matches = matches[:limit]
+					}
+					return []kupogo.Match(matches), nil
+				},
+			},
+			"datum": &graphql.Field{
+				Type: datumType,
+				Args: graphql.FieldConfigArgument{
+					"hash": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return resolveDatum(p, source, p.Args["hash"].(string))
+				},
+			},
+			"script": &graphql.Field{
+				Type: scriptType,
+				Args: graphql.FieldConfigArgument{
+					"hash": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return resolveScript(p, source, p.Args["hash"].(string))
+				},
+			},
+			"metadata": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(metadataItemType))),
+				Args: graphql.FieldConfigArgument{
+					"slotNo":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+					"transactionId": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					txId, _ := p.Args["transactionId"].(string)
+					metadata, err := source.Metadata(p.Context, p.Args["slotNo"].(int), txId)
+					if err != nil {
+						return nil, err
+					}
+					return []kupogo.MetadataItem(metadata), nil
+				},
+			},
+		},
+	})
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// matchOptions converts the arguments of a matches query to match options
+func matchOptions(args map[string]interface{}) (kupogo.MatchOptions, error) {
+	var opts kupogo.MatchOptions
+	switch args["status"] {
+	case "spent":
+		opts.Spent = true
+	case "unspent":
+		opts.Unspent = true
+	}
+	if opts.Unspent {
+		if _, ok := args["spentAfter"]; ok {
+			return opts, fmt.Errorf("spentAfter cannot be used with unspent matches")
+		}
+		if _, ok := args["spentBefore"]; ok {
+			return opts, fmt.Errorf("spentBefore cannot be used with unspent matches")
+		}
+	}
+	opts.CreatedAfter, _ = args["createdAfter"].(int)
+	opts.CreatedBefore, _ = args["createdBefore"].(int)
+	opts.SpentAfter, _ = args["spentAfter"].(int)
+	opts.SpentBefore, _ = args["spentBefore"].(int)
+	opts.PolicyID, _ = args["policyId"].(string)
+	opts.AssetName, _ = args["assetName"].(string)
+	opts.TransactionID, _ = args["transactionId"].(string)
+	if order, ok := args["order"].(string); ok {
+		opts.Order = kupogo.MatchOrder(order)
+	}
+	if opts.AssetName != "" && opts.PolicyID == "" {
+		return opts, fmt.Errorf("assetName requires policyId")
+	}
+	return opts, nil
+}
+
+func resolveDatum(p graphql.ResolveParams, source Source, datumHash string) (interface{}, error) {
+	resp, err := source.Datum(p.Context, datumHash)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	return datum{hash: datumHash, value: resp.Datum}, nil
+}
+
+func resolveScript(p graphql.ResolveParams, source Source, scriptHash string) (interface{}, error) {
+	resp, err := source.Script(p.Context, scriptHash)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	return script{hash: scriptHash, ScriptResponse: *resp}, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupographql
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/mirror"
+)
+
+// Source provides the data served by the GraphQL schema
+type Source interface {
+	Matches(ctx context.Context, pattern string, opts kupogo.MatchOptions) (kupogo.Matches, error)
+	Datum(ctx context.Context, datumHash string) (*kupogo.DatumResponse, error)
+	Script(ctx context.Context, scriptHash string) (*kupogo.ScriptResponse, error)
+	Metadata(ctx context.Context, slotNo int, txId string) (kupogo.Metadata, error)
+}
+
+// ClientSource returns a Source answering every query from Kupo
+func ClientSource(client *kupogo.Client) Source {
+	return &clientSource{client: client}
+}
+
+type clientSource struct {
+	client *kupogo.Client
+}
+
+func (s *clientSource) Matches(
+	ctx context.Context,
+	pattern string,
+	opts kupogo.MatchOptions,
+) (kupogo.Matches, error) {
+	matches, err := s.client.GetMatchesWithOptionsContext(ctx, pattern, opts)
+	if err != nil {
+		return nil, err
+	}
+	return *matches, nil
+}
+
+func (s *clientSource) Datum(ctx context.Context, datumHash string) (*kupogo.DatumResponse, error) {
+	return s.client.GetDatumByHashContext(ctx, datumHash)
+}
+
+func (s *clientSource) Script(ctx context.Context, scriptHash string) (*kupogo.ScriptResponse, error) {
+	return s.client.GetScriptByHashContext(ctx, scriptHash)
+}
+
+func (s *clientSource) Metadata(ctx context.Context, slotNo int, txId string) (kupogo.Metadata, error) {
+	metadata, err := s.client.GetMetadataContext(ctx, slotNo, txId)
+	if err != nil {
+		return nil, err
+	}
+	return *metadata, nil
+}
+
+// MirrorSource returns a Source answering unspent match queries for mirrored
+// patterns from a local mirror, and everything else from a fallback source
+func MirrorSource(m *mirror.Mirror, fallback Source) Source {
+	return &mirrorSource{mirror: m, fallback: fallback}
+}
+
+type mirrorSource struct {
+	mirror   *mirror.Mirror
+	fallback Source
+}
+
+func (s *mirrorSource) Matches(
+	ctx context.Context,
+	pattern string,
+	opts kupogo.MatchOptions,
+) (kupogo.Matches, error) {
+	// The mirror only holds the current UTxO set
+	if !opts.Unspent || opts.CreatedAfter != 0 || opts.CreatedBefore != 0 ||
+		opts.SpentAfter != 0 || opts.SpentBefore != 0 || opts.HasTimeBounds() {
+		return s.fallback.Matches(ctx, pattern, opts)
+	}
+	checkpoint, err := s.mirror.Checkpoint(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint == nil {
+		return s.fallback.Matches(ctx, pattern, opts)
+	}
+	utxos, err := s.mirror.UTxOs(pattern)
+	if err != nil {
+		return nil, err
+	}
+	ret := kupogo.Matches{}
+	for _, match := range utxos {
+		if opts.TransactionID != "" && match.TransactionID != opts.TransactionID {
+			continue
+		}
+		if opts.PolicyID != "" && !holdsPolicy(match, opts.PolicyID, opts.AssetName) {
+			continue
+		}
+		ret = append(ret, match)
+	}
+	// Kupo returns the most recent matches first unless asked otherwise
+	oldestFirst := opts.Order == kupogo.MatchOrderOldestFirst
+	sort.SliceStable(ret, func(i, j int) bool {
+		a, b := ret[i].CreatedAt.SlotNo, ret[j].CreatedAt.SlotNo
+		if oldestFirst {
+			return a < b
+		}
+		return a > b
+	})
+	return ret, nil
+}
+
+func (s *mirrorSource) Datum(ctx context.Context, datumHash string) (*kupogo.DatumResponse, error) {
+	return s.fallback.Datum(ctx, datumHash)
+}
+
+func (s *mirrorSource) Script(ctx context.Context, scriptHash string) (*kupogo.ScriptResponse, error) {
+	return s.fallback.Script(ctx, scriptHash)
+}
+
+func (s *mirrorSource) Metadata(ctx context.Context, slotNo int, txId string) (kupogo.Metadata, error) {
+	return s.fallback.Metadata(ctx, slotNo, txId)
+}
+
+func holdsPolicy(match kupogo.Match, policyID string, assetName string) bool {
+	for asset := range match.Value.Assets {
+		assetID := kupogo.AssetID(asset)
+		if !strings.EqualFold(assetID.PolicyID(), policyID) {
+			continue
+		}
+		if assetName == "" || strings.EqualFold(assetID.AssetName(), assetName) {
+			return true
+		}
+	}
+	return false
+}
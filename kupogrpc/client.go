@@ -0,0 +1,117 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/blinklabs-io/kupogo"
+	"google.golang.org/grpc"
+)
+
+// Client calls the Kupo service of a gateway
+type Client struct {
+	conn grpc.ClientConnInterface
+}
+
+// NewClient creates a client using a gRPC connection
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{conn: conn}
+}
+
+func (c *Client) invoke(ctx context.Context, method string, req message, resp message) error {
+	return c.conn.Invoke(
+		ctx,
+		"/"+serviceName+"/"+method,
+		req,
+		resp,
+		grpc.ForceCodec(codec{}),
+	)
+}
+
+// GetMatches receives the streamed matches of a pattern, calling onMatch for
+// each as it arrives
+func (c *Client) GetMatches(
+	ctx context.Context,
+	req *GetMatchesRequest,
+	onMatch func(kupogo.Match) error,
+) error {
+	stream, err := c.conn.NewStream(
+		ctx,
+		&serviceDesc.Streams[0],
+		"/"+serviceName+"/GetMatches",
+		grpc.ForceCodec(codec{}),
+	)
+	if err != nil {
+		return err
+	}
+	if err := stream.SendMsg(req); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	for {
+		match := &Match{}
+		if err := stream.RecvMsg(match); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := onMatch(match.ToMatch()); err != nil {
+			return err
+		}
+	}
+}
+
+// GetDatum looks up a datum by hash
+func (c *Client) GetDatum(ctx context.Context, datumHash string) (*Datum, error) {
+	resp := &Datum{}
+	if err := c.invoke(ctx, "GetDatum", &GetDatumRequest{DatumHash: datumHash}, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetScript looks up a script by hash
+func (c *Client) GetScript(ctx context.Context, scriptHash string) (*Script, error) {
+	resp := &Script{}
+	if err := c.invoke(ctx, "GetScript", &GetScriptRequest{ScriptHash: scriptHash}, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetPatterns lists the patterns overlapping a pattern, or all patterns if it
+// is empty
+func (c *Client) GetPatterns(ctx context.Context, pattern string) ([]string, error) {
+	resp := &Patterns{}
+	if err := c.invoke(ctx, "GetPatterns", &GetPatternsRequest{Pattern: pattern}, resp); err != nil {
+		return nil, err
+	}
+	return resp.Patterns, nil
+}
+
+// AddPatterns registers patterns
+func (c *Client) AddPatterns(ctx context.Context, req *AddPatternsRequest) ([]string, error) {
+	resp := &Patterns{}
+	if err := c.invoke(ctx, "AddPatterns", req, resp); err != nil {
+		return nil, err
+	}
+	return resp.Patterns, nil
+}
@@ -0,0 +1,142 @@
+package kupogrpc
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/kupogotest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func testClient(t *testing.T, fake *kupogotest.Fake) *Client {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(ServerOption())
+	NewServer(fake).Register(server)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewClient(conn)
+}
+
+func TestGetMatches(t *testing.T) {
+	fake := kupogotest.NewFake()
+	datumHash := "dd"
+	expected := []kupogo.Match{
+		{
+			TransactionID: "aa",
+			OutputIndex:   1,
+			Address:       "addr1",
+			Value: kupogo.Value{
+				Coins:  1000000,
+				Assets: kupogo.Assets{"pp.746f6b656e": 3},
+			},
+			DatumHash: &datumHash,
+			CreatedAt: kupogo.Point{SlotNo: 10, HeaderHash: "h10"},
+			SpentAt:   &kupogo.Point{SlotNo: 20, HeaderHash: "h20"},
+		},
+		{
+			TransactionID: "bb",
+			Address:       "addr1",
+			Value:         kupogo.Value{Coins: 2000000, Assets: kupogo.Assets{}},
+			CreatedAt:     kupogo.Point{SlotNo: 5, HeaderHash: "h5"},
+		},
+	}
+	fake.AddMatches(expected...)
+	client := testClient(t, fake)
+	var matches []kupogo.Match
+	err := client.GetMatches(
+		context.Background(),
+		&GetMatchesRequest{Pattern: "addr1"},
+		func(match kupogo.Match) error {
+			matches = append(matches, match)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(matches, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, matches)
+	}
+	matches = nil
+	err = client.GetMatches(
+		context.Background(),
+		&GetMatchesRequest{Pattern: "addr1", Unspent: true},
+		func(match kupogo.Match) error {
+			matches = append(matches, match)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(matches) != 1 || matches[0].TransactionID != "bb" {
+		t.Fatalf("Unexpected unspent matches: %+v", matches)
+	}
+}
+
+func TestUnary(t *testing.T) {
+	fake := kupogotest.NewFake()
+	fake.AddDatum("dd", "d87980")
+	fake.AddScript("ss", "native", "8200581c")
+	client := testClient(t, fake)
+	ctx := context.Background()
+	datum, err := client.GetDatum(ctx, "dd")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !datum.Found || datum.Datum != "d87980" {
+		t.Fatalf("Unexpected datum: %+v", datum)
+	}
+	datum, err = client.GetDatum(ctx, "missing")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if datum.Found {
+		t.Fatalf("Expected datum not found")
+	}
+	script, err := client.GetScript(ctx, "ss")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !script.Found || script.Language != "native" {
+		t.Fatalf("Unexpected script: %+v", script)
+	}
+	patterns, err := client.AddPatterns(ctx, &AddPatternsRequest{
+		Patterns:   []string{"addr1", "addr2"},
+		RollbackTo: &Point{SlotNo: 10},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(patterns, []string{"addr1", "addr2"}) {
+		t.Fatalf("Unexpected patterns: %v", patterns)
+	}
+	if _, err := client.AddPatterns(ctx, &AddPatternsRequest{}); err == nil {
+		t.Fatalf("Expected error without patterns")
+	}
+	patterns, err = client.GetPatterns(ctx, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(patterns) != 2 {
+		t.Fatalf("Expected 2 patterns, got %v", patterns)
+	}
+}
@@ -0,0 +1,442 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogrpc
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The messages of kupo.proto, encoded by hand with protowire so that no
+// generated code or protoc is needed. The wire format is standard protobuf,
+// so clients generated from kupo.proto in any language interoperate
+
+type message interface {
+	marshal() []byte
+	unmarshal(data []byte) error
+}
+
+type Point struct {
+	SlotNo     uint64
+	HeaderHash string
+}
+
+func (m *Point) marshal() []byte {
+	var b []byte
+	b = appendUint(b, 1, m.SlotNo)
+	b = appendString(b, 2, m.HeaderHash)
+	return b
+}
+
+func (m *Point) unmarshal(data []byte) error {
+	return decodeFields(data, func(num protowire.Number, n uint64, value []byte) error {
+		switch num {
+		case 1:
+			m.SlotNo = n
+		case 2:
+			m.HeaderHash = string(value)
+		}
+		return nil
+	})
+}
+
+type Match struct {
+	TransactionIndex uint32
+	TransactionID    string
+	OutputIndex      uint32
+	Address          string
+	Coins            uint64
+	Assets           map[string]uint64
+	DatumHash        string
+	DatumType        string
+	ScriptHash       string
+	CreatedAt        *Point
+	SpentAt          *Point
+}
+
+func (m *Match) marshal() []byte {
+	var b []byte
+	b = appendUint(b, 1, uint64(m.TransactionIndex))
+	b = appendString(b, 2, m.TransactionID)
+	b = appendUint(b, 3, uint64(m.OutputIndex))
+	b = appendString(b, 4, m.Address)
+	b = appendUint(b, 5, m.Coins)
+	// Map entries are sorted for a deterministic encoding
+	assets := make([]string, 0, len(m.Assets))
+	for asset := range m.Assets {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	for _, asset := range assets {
+		var entry []byte
+		entry = appendString(entry, 1, asset)
+		entry = appendUint(entry, 2, m.Assets[asset])
+		b = appendMessage(b, 6, entry)
+	}
+	b = appendString(b, 7, m.DatumHash)
+	b = appendString(b, 8, m.DatumType)
+	b = appendString(b, 9, m.ScriptHash)
+	if m.CreatedAt != nil {
+		b = appendMessage(b, 10, m.CreatedAt.marshal())
+	}
+	if m.SpentAt != nil {
+		b = appendMessage(b, 11, m.SpentAt.marshal())
+	}
+	return b
+}
+
+func (m *Match) unmarshal(data []byte) error {
+	return decodeFields(data, func(num protowire.Number, n uint64, value []byte) error {
+		switch num {
+		case 1:
+			m.TransactionIndex = uint32(n)
+		case 2:
+			m.TransactionID = string(value)
+		case 3:
+			m.OutputIndex = uint32(n)
+		case 4:
+			m.Address = string(value)
+		case 5:
+			m.Coins = n
+		case 6:
+			var asset string
+			var quantity uint64
+			err := decodeFields(value, func(num protowire.Number, n uint64, value []byte) error {
+				switch num {
+				case 1:
+					asset = string(value)
+				case 2:
+					quantity = n
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if m.Assets == nil {
+				m.Assets = make(map[string]uint64)
+			}
+			m.Assets[asset] = quantity
+		case 7:
+			m.DatumHash = string(value)
+		case 8:
+			m.DatumType = string(value)
+		case 9:
+			m.ScriptHash = string(value)
+		case 10:
+			m.CreatedAt = &Point{}
+			return m.CreatedAt.unmarshal(value)
+		case 11:
+			m.SpentAt = &Point{}
+			return m.SpentAt.unmarshal(value)
+		}
+		return nil
+	})
+}
+
+type GetMatchesRequest struct {
+	Pattern       string
+	Spent         bool
+	Unspent       bool
+	CreatedAfter  uint64
+	CreatedBefore uint64
+	SpentAfter    uint64
+	SpentBefore   uint64
+	PolicyID      string
+	AssetName     string
+	TransactionID string
+	// Order is "most_recent_first" or "oldest_first"
+	Order string
+}
+
+func (m *GetMatchesRequest) marshal() []byte {
+	var b []byte
+	b = appendString(b, 1, m.Pattern)
+	b = appendBool(b, 2, m.Spent)
+	b = appendBool(b, 3, m.Unspent)
+	b = appendUint(b, 4, m.CreatedAfter)
+	b = appendUint(b, 5, m.CreatedBefore)
+	b = appendUint(b, 6, m.SpentAfter)
+	b = appendUint(b, 7, m.SpentBefore)
+	b = appendString(b, 8, m.PolicyID)
+	b = appendString(b, 9, m.AssetName)
+	b = appendString(b, 10, m.TransactionID)
+	b = appendString(b, 11, m.Order)
+	return b
+}
+
+func (m *GetMatchesRequest) unmarshal(data []byte) error {
+	return decodeFields(data, func(num protowire.Number, n uint64, value []byte) error {
+		switch num {
+		case 1:
+			m.Pattern = string(value)
+		case 2:
+			m.Spent = n != 0
+		case 3:
+			m.Unspent = n != 0
+		case 4:
+			m.CreatedAfter = n
+		case 5:
+			m.CreatedBefore = n
+		case 6:
+			m.SpentAfter = n
+		case 7:
+			m.SpentBefore = n
+		case 8:
+			m.PolicyID = string(value)
+		case 9:
+			m.AssetName = string(value)
+		case 10:
+			m.TransactionID = string(value)
+		case 11:
+			m.Order = string(value)
+		}
+		return nil
+	})
+}
+
+type GetDatumRequest struct {
+	DatumHash string
+}
+
+func (m *GetDatumRequest) marshal() []byte {
+	return appendString(nil, 1, m.DatumHash)
+}
+
+func (m *GetDatumRequest) unmarshal(data []byte) error {
+	return decodeFields(data, func(num protowire.Number, n uint64, value []byte) error {
+		if num == 1 {
+			m.DatumHash = string(value)
+		}
+		return nil
+	})
+}
+
+type Datum struct {
+	Found bool
+	Datum string
+}
+
+func (m *Datum) marshal() []byte {
+	var b []byte
+	b = appendBool(b, 1, m.Found)
+	b = appendString(b, 2, m.Datum)
+	return b
+}
+
+func (m *Datum) unmarshal(data []byte) error {
+	return decodeFields(data, func(num protowire.Number, n uint64, value []byte) error {
+		switch num {
+		case 1:
+			m.Found = n != 0
+		case 2:
+			m.Datum = string(value)
+		}
+		return nil
+	})
+}
+
+type GetScriptRequest struct {
+	ScriptHash string
+}
+
+func (m *GetScriptRequest) marshal() []byte {
+	return appendString(nil, 1, m.ScriptHash)
+}
+
+func (m *GetScriptRequest) unmarshal(data []byte) error {
+	return decodeFields(data, func(num protowire.Number, n uint64, value []byte) error {
+		if num == 1 {
+			m.ScriptHash = string(value)
+		}
+		return nil
+	})
+}
+
+type Script struct {
+	Found    bool
+	Language string
+	Script   string
+}
+
+func (m *Script) marshal() []byte {
+	var b []byte
+	b = appendBool(b, 1, m.Found)
+	b = appendString(b, 2, m.Language)
+	b = appendString(b, 3, m.Script)
+	return b
+}
+
+func (m *Script) unmarshal(data []byte) error {
+	return decodeFields(data, func(num protowire.Number, n uint64, value []byte) error {
+		switch num {
+		case 1:
+			m.Found = n != 0
+		case 2:
+			m.Language = string(value)
+		case 3:
+			m.Script = string(value)
+		}
+		return nil
+	})
+}
+
+type GetPatternsRequest struct {
+	Pattern string
+}
+
+func (m *GetPatternsRequest) marshal() []byte {
+	return appendString(nil, 1, m.Pattern)
+}
+
+func (m *GetPatternsRequest) unmarshal(data []byte) error {
+	return decodeFields(data, func(num protowire.Number, n uint64, value []byte) error {
+		if num == 1 {
+			m.Pattern = string(value)
+		}
+		return nil
+	})
+}
+
+type AddPatternsRequest struct {
+	Patterns   []string
+	RollbackTo *Point
+	// Limit is "within_safe_zone" or "unsafe_allow_beyond_safe_zone"
+	Limit string
+}
+
+func (m *AddPatternsRequest) marshal() []byte {
+	var b []byte
+	for _, pattern := range m.Patterns {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, pattern)
+	}
+	if m.RollbackTo != nil {
+		b = appendMessage(b, 2, m.RollbackTo.marshal())
+	}
+	b = appendString(b, 3, m.Limit)
+	return b
+}
+
+func (m *AddPatternsRequest) unmarshal(data []byte) error {
+	return decodeFields(data, func(num protowire.Number, n uint64, value []byte) error {
+		switch num {
+		case 1:
+			m.Patterns = append(m.Patterns, string(value))
+		case 2:
+			m.RollbackTo = &Point{}
+			return m.RollbackTo.unmarshal(value)
+		case 3:
+			m.Limit = string(value)
+		}
+		return nil
+	})
+}
+
+type Patterns struct {
+	Patterns []string
+}
+
+func (m *Patterns) marshal() []byte {
+	var b []byte
+	for _, pattern := range m.Patterns {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, pattern)
+	}
+	return b
+}
+
+func (m *Patterns) unmarshal(data []byte) error {
+	return decodeFields(data, func(num protowire.Number, n uint64, value []byte) error {
+		if num == 1 {
+			m.Patterns = append(m.Patterns, string(value))
+		}
+		return nil
+	})
+}
+
+// appendString appends a string field, omitting the proto3 default
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+// appendUint appends a varint field, omitting the proto3 default
+func appendUint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendBool(b []byte, num protowire.Number, v bool) []byte {
+	if !v {
+		return b
+	}
+	return appendUint(b, num, 1)
+}
+
+func appendMessage(b []byte, num protowire.Number, m []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, m)
+}
+
+// decodeFields calls field for each varint or length-delimited field, with
+// the varint value or the bytes. Other wire types are skipped
+func decodeFields(
+	data []byte,
+	field func(num protowire.Number, n uint64, value []byte) error,
+) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return fmt.Errorf("invalid tag: %s", protowire.ParseError(n))
+		}
+		data = data[n:]
+		switch typ {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(data)
+			if n < 0 {
+				return fmt.Errorf("invalid field %d: %s", num, protowire.ParseError(n))
+			}
+			data = data[n:]
+			if err := field(num, v, nil); err != nil {
+				return err
+			}
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return fmt.Errorf("invalid field %d: %s", num, protowire.ParseError(n))
+			}
+			data = data[n:]
+			if err := field(num, 0, v); err != nil {
+				return err
+			}
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return fmt.Errorf("invalid field %d: %s", num, protowire.ParseError(n))
+			}
+			data = data[n:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,313 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kupogrpc exposes Kupo through a gRPC gateway, defined by kupo.proto,
+// so services in other languages get typed access to matches, datums, scripts
+// and patterns. Matches are streamed to the caller
+package kupogrpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blinklabs-io/kupogo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const serviceName = "kupogo.v1.Kupo"
+
+// codec marshals the hand-encoded messages of this package. It is named
+// "proto" since the wire format is protobuf
+type codec struct{}
+
+func (codec) Marshal(v any) ([]byte, error) {
+	m, ok := v.(message)
+	if !ok {
+		return nil, fmt.Errorf("unsupported message type %T", v)
+	}
+	return m.marshal(), nil
+}
+
+func (codec) Unmarshal(data []byte, v any) error {
+	m, ok := v.(message)
+	if !ok {
+		return fmt.Errorf("unsupported message type %T", v)
+	}
+	return m.unmarshal(data)
+}
+
+func (codec) Name() string {
+	return "proto"
+}
+
+// ServerOption must be passed to grpc.NewServer for the server which
+// registers the service, to encode its messages
+func ServerOption() grpc.ServerOption {
+	return grpc.ForceServerCodec(codec{})
+}
+
+// Server implements the Kupo service with a Kupo client
+type Server struct {
+	client kupogo.KupoClient
+}
+
+// NewServer creates the service backed by a Kupo client
+func NewServer(client kupogo.KupoClient) *Server {
+	return &Server{client: client}
+}
+
+// Register registers the service with a gRPC server created with
+// ServerOption
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	registrar.RegisterService(&serviceDesc, s)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDatum",
+			Handler:    unaryHandler("GetDatum", (*Server).GetDatum),
+		},
+		{
+			MethodName: "GetScript",
+			Handler:    unaryHandler("GetScript", (*Server).GetScript),
+		},
+		{
+			MethodName: "GetPatterns",
+			Handler:    unaryHandler("GetPatterns", (*Server).GetPatterns),
+		},
+		{
+			MethodName: "AddPatterns",
+			Handler:    unaryHandler("AddPatterns", (*Server).AddPatterns),
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetMatches",
+			Handler:       getMatchesHandler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "kupo.proto",
+}
+
+// unaryHandler adapts a method of Server to a gRPC unary handler
+func unaryHandler[Req any, Resp any, PReq interface {
+	*Req
+	message
+}](
+	method string,
+	call func(*Server, context.Context, PReq) (Resp, error),
+) func(any, context.Context, func(any) error, grpc.UnaryServerInterceptor) (any, error) {
+	return func(
+		srv any,
+		ctx context.Context,
+		dec func(any) error,
+		interceptor grpc.UnaryServerInterceptor,
+	) (any, error) {
+		req := PReq(new(Req))
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+		server := srv.(*Server)
+		if interceptor == nil {
+			return call(server, ctx, req)
+		}
+		info := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: "/" + serviceName + "/" + method,
+		}
+		return interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return call(server, ctx, req.(PReq))
+		})
+	}
+}
+
+func getMatchesHandler(srv any, stream grpc.ServerStream) error {
+	req := &GetMatchesRequest{}
+	if err := stream.RecvMsg(req); err != nil {
+		return err
+	}
+	return srv.(*Server).GetMatches(req, stream)
+}
+
+// GetMatches streams the matches of a pattern
+func (s *Server) GetMatches(req *GetMatchesRequest, stream grpc.ServerStream) error {
+	opts := kupogo.MatchOptions{
+		Spent:         req.Spent,
+		Unspent:       req.Unspent,
+		CreatedAfter:  int(req.CreatedAfter),
+		CreatedBefore: int(req.CreatedBefore),
+		SpentAfter:    int(req.SpentAfter),
+		SpentBefore:   int(req.SpentBefore),
+		PolicyID:      req.PolicyID,
+		AssetName:     req.AssetName,
+		TransactionID: req.TransactionID,
+		Order:         kupogo.MatchOrder(req.Order),
+	}
+	matches, err := s.client.GetMatchesWithOptionsContext(stream.Context(), req.Pattern, opts)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	for _, match := range *matches {
+		if err := stream.SendMsg(FromMatch(match)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetDatum looks up a datum by hash
+func (s *Server) GetDatum(ctx context.Context, req *GetDatumRequest) (*Datum, error) {
+	datum, err := s.client.GetDatumByHashContext(ctx, req.DatumHash)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if datum == nil {
+		return &Datum{}, nil
+	}
+	return &Datum{Found: true, Datum: datum.Datum}, nil
+}
+
+// GetScript looks up a script by hash
+func (s *Server) GetScript(ctx context.Context, req *GetScriptRequest) (*Script, error) {
+	script, err := s.client.GetScriptByHashContext(ctx, req.ScriptHash)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if script == nil {
+		return &Script{}, nil
+	}
+	return &Script{Found: true, Language: script.Language, Script: script.Script}, nil
+}
+
+// GetPatterns lists the patterns overlapping a pattern, or all patterns
+func (s *Server) GetPatterns(ctx context.Context, req *GetPatternsRequest) (*Patterns, error) {
+	var patterns *kupogo.Patterns
+	var err error
+	if req.Pattern == "" {
+		patterns, err = s.client.GetAllPatternsContext(ctx)
+	} else {
+		patterns, err = s.client.GetPatternContext(ctx, req.Pattern)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return fromPatterns(patterns), nil
+}
+
+// AddPatterns registers patterns, rolling back to the given point
+func (s *Server) AddPatterns(ctx context.Context, req *AddPatternsRequest) (*Patterns, error) {
+	if len(req.Patterns) == 0 || req.RollbackTo == nil {
+		return nil, status.Error(codes.InvalidArgument, "patterns and rollback_to are required")
+	}
+	patterns, err := s.client.AddPatternsContext(
+		ctx,
+		req.Patterns,
+		req.RollbackTo.toPoint(),
+		kupogo.RollbackLimit(req.Limit),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return fromPatterns(patterns), nil
+}
+
+func fromPatterns(patterns *kupogo.Patterns) *Patterns {
+	ret := &Patterns{Patterns: make([]string, 0, len(*patterns))}
+	for _, pattern := range *patterns {
+		ret.Patterns = append(ret.Patterns, string(pattern))
+	}
+	return ret
+}
+
+func fromPoint(point kupogo.Point) *Point {
+	return &Point{SlotNo: uint64(point.SlotNo), HeaderHash: point.HeaderHash}
+}
+
+func (m *Point) toPoint() kupogo.Point {
+	return kupogo.Point{SlotNo: int(m.SlotNo), HeaderHash: m.HeaderHash}
+}
+
+// FromMatch converts a match into its message
+func FromMatch(match kupogo.Match) *Match {
+	ret := &Match{
+		TransactionIndex: uint32(match.TransactionIndex),
+		TransactionID:    match.TransactionID,
+		OutputIndex:      uint32(match.OutputIndex),
+		Address:          match.Address,
+		Coins:            uint64(match.Value.Coins),
+		CreatedAt:        fromPoint(match.CreatedAt),
+	}
+	if len(match.Value.Assets) > 0 {
+		ret.Assets = make(map[string]uint64, len(match.Value.Assets))
+		for asset, quantity := range match.Value.Assets {
+			ret.Assets[asset] = uint64(quantity)
+		}
+	}
+	if match.DatumHash != nil {
+		ret.DatumHash = *match.DatumHash
+	}
+	if match.DatumType != nil {
+		ret.DatumType = *match.DatumType
+	}
+	if match.ScriptHash != nil {
+		ret.ScriptHash = *match.ScriptHash
+	}
+	if match.SpentAt != nil {
+		ret.SpentAt = fromPoint(*match.SpentAt)
+	}
+	return ret
+}
+
+// ToMatch converts a message into a match
+func (m *Match) ToMatch() kupogo.Match {
+	ret := kupogo.Match{
+		TransactionIndex: int(m.TransactionIndex),
+		TransactionID:    m.TransactionID,
+		OutputIndex:      int(m.OutputIndex),
+		Address:          m.Address,
+		Value: kupogo.Value{
+			Coins:  int(m.Coins),
+			Assets: kupogo.Assets{},
+		},
+	}
+	for asset, quantity := range m.Assets {
+		ret.Value.Assets[asset] = int(quantity)
+	}
+	if m.DatumHash != "" {
+		datumHash := m.DatumHash
+		ret.DatumHash = &datumHash
+	}
+	if m.DatumType != "" {
+		datumType := m.DatumType
+		ret.DatumType = &datumType
+	}
+	if m.ScriptHash != "" {
+		scriptHash := m.ScriptHash
+		ret.ScriptHash = &scriptHash
+	}
+	if m.CreatedAt != nil {
+		ret.CreatedAt = m.CreatedAt.toPoint()
+	}
+	if m.SpentAt != nil {
+		spentAt := m.SpentAt.toPoint()
+		ret.SpentAt = &spentAt
+	}
+	return ret
+}
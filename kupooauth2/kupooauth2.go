@@ -0,0 +1,51 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kupooauth2 authenticates Kupo clients with OAuth2 access tokens
+package kupooauth2
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/blinklabs-io/kupogo"
+	"golang.org/x/oauth2"
+)
+
+// WithTokenSource authenticates requests with tokens from the given source,
+// which is wrapped with oauth2.ReuseTokenSource so tokens are only fetched
+// again once they expire
+func WithTokenSource(source oauth2.TokenSource) kupogo.ClientOption {
+	return kupogo.WithMiddleware(Middleware(source))
+}
+
+// Middleware sets the Authorization header of requests from tokens of the
+// given source
+func Middleware(source oauth2.TokenSource) kupogo.Middleware {
+	source = oauth2.ReuseTokenSource(nil, source)
+	return func(next http.RoundTripper) http.RoundTripper {
+		return kupogo.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if kupogo.IsCrossHostRedirect(req) {
+				return next.RoundTrip(req)
+			}
+			token, err := source.Token()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get access token: %s", err)
+			}
+			req = req.Clone(req.Context())
+			token.SetAuthHeader(req)
+			return next.RoundTrip(req)
+		})
+	}
+}
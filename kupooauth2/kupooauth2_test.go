@@ -0,0 +1,48 @@
+package kupooauth2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+	"golang.org/x/oauth2"
+)
+
+type countingSource struct {
+	calls int
+}
+
+func (s *countingSource) Token() (*oauth2.Token, error) {
+	s.calls++
+	return &oauth2.Token{
+		AccessToken: "secret",
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Hour),
+	}, nil
+}
+
+func TestWithTokenSource(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+
+	source := &countingSource{}
+	client := kupogo.NewClient(server.URL, WithTokenSource(source))
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetMatches("*"); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	}
+	if source.calls != 1 {
+		t.Fatalf("Expected the token to be reused, got %d token requests", source.calls)
+	}
+}
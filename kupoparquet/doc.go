@@ -0,0 +1,20 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kupoparquet writes matches and metadata as Parquet files for
+// analytics tools such as Spark and DuckDB. It is only built with the parquet
+// build tag, keeping the Parquet dependency out of default builds:
+//
+//	go build -tags parquet
+package kupoparquet
@@ -0,0 +1,149 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build parquet
+
+package kupoparquet
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/parquet-go/parquet-go"
+)
+
+// MatchRow is the Parquet row of a match
+type MatchRow struct {
+	TransactionID     string           `parquet:"transaction_id,dict"`
+	TransactionIndex  int64            `parquet:"transaction_index"`
+	OutputIndex       int64            `parquet:"output_index"`
+	Address           string           `parquet:"address,dict"`
+	Coins             int64            `parquet:"coins"`
+	Assets            map[string]int64 `parquet:"assets"`
+	DatumHash         *string          `parquet:"datum_hash,optional"`
+	DatumType         *string          `parquet:"datum_type,optional,dict"`
+	ScriptHash        *string          `parquet:"script_hash,optional"`
+	CreatedSlot       int64            `parquet:"created_slot"`
+	CreatedHeaderHash string           `parquet:"created_header_hash"`
+	SpentSlot         *int64           `parquet:"spent_slot,optional"`
+	SpentHeaderHash   *string          `parquet:"spent_header_hash,optional"`
+}
+
+// NewMatchRow converts a match to its Parquet row
+func NewMatchRow(match kupogo.Match) MatchRow {
+	row := MatchRow{
+		TransactionID:     match.TransactionID,
+		TransactionIndex:  int64(match.TransactionIndex),
+		OutputIndex:       int64(match.OutputIndex),
+		Address:           match.Address,
+		Coins:             int64(match.Value.Coins),
+		DatumHash:         match.DatumHash,
+		DatumType:         match.DatumType,
+		ScriptHash:        match.ScriptHash,
+		CreatedSlot:       int64(match.CreatedAt.SlotNo),
+		CreatedHeaderHash: match.CreatedAt.HeaderHash,
+	}
+	if len(match.Value.Assets) > 0 {
+		row.Assets = make(map[string]int64, len(match.Value.Assets))
+		for asset, quantity := range match.Value.Assets {
+			row.Assets[asset] = int64(quantity)
+		}
+	}
+	if match.SpentAt != nil {
+		spentSlot := int64(match.SpentAt.SlotNo)
+		spentHeaderHash := match.SpentAt.HeaderHash
+		row.SpentSlot = &spentSlot
+		row.SpentHeaderHash = &spentHeaderHash
+	}
+	return row
+}
+
+// MetadataRow is the Parquet row of a transaction metadata item
+type MetadataRow struct {
+	SlotNo int64  `parquet:"slot_no"`
+	Hash   string `parquet:"hash"`
+	Raw    []byte `parquet:"raw"`
+	// Schema is Kupo's detailed schema JSON
+	Schema string `parquet:"schema"`
+}
+
+// MatchWriter writes matches to a Parquet file
+type MatchWriter struct {
+	writer *parquet.GenericWriter[MatchRow]
+	rows   []MatchRow
+}
+
+// NewMatchWriter creates a writer of matches to w. Close must be called to
+// write the file footer
+func NewMatchWriter(w io.Writer) *MatchWriter {
+	return &MatchWriter{
+		writer: parquet.NewGenericWriter[MatchRow](w),
+	}
+}
+
+// Write appends matches to the file
+func (w *MatchWriter) Write(matches ...kupogo.Match) error {
+	w.rows = w.rows[:0]
+	for _, match := range matches {
+		w.rows = append(w.rows, NewMatchRow(match))
+	}
+	if _, err := w.writer.Write(w.rows); err != nil {
+		return fmt.Errorf("failed to write matches: %s", err)
+	}
+	return nil
+}
+
+// Close flushes buffered rows and writes the file footer
+func (w *MatchWriter) Close() error {
+	if err := w.writer.Close(); err != nil {
+		return fmt.Errorf("failed to close parquet writer: %s", err)
+	}
+	return nil
+}
+
+// WriteMatches writes matches to w as a complete Parquet file
+func WriteMatches(w io.Writer, matches kupogo.Matches) error {
+	writer := NewMatchWriter(w)
+	if err := writer.Write(matches...); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// WriteMetadata writes the metadata of the block at slotNo to w as a complete
+// Parquet file
+func WriteMetadata(w io.Writer, slotNo int, metadata kupogo.Metadata) error {
+	rows := make([]MetadataRow, 0, len(metadata))
+	for _, item := range metadata {
+		raw, err := item.RawBytes()
+		if err != nil {
+			return err
+		}
+		rows = append(rows, MetadataRow{
+			SlotNo: int64(slotNo),
+			Hash:   item.Hash,
+			Raw:    raw,
+			Schema: string(item.Schema),
+		})
+	}
+	writer := parquet.NewGenericWriter[MetadataRow](w)
+	if _, err := writer.Write(rows); err != nil {
+		return fmt.Errorf("failed to write metadata: %s", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to close parquet writer: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+//go:build parquet
+
+package kupoparquet
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/parquet-go/parquet-go"
+)
+
+func TestWriteMatches(t *testing.T) {
+	datumHash := "abcd"
+	matches := kupogo.Matches{
+		{
+			TransactionID: "aa",
+			OutputIndex:   1,
+			Address:       "addr1",
+			Value: kupogo.Value{
+				Coins:  1000000,
+				Assets: kupogo.Assets{"policy.asset": 5},
+			},
+			DatumHash: &datumHash,
+			CreatedAt: kupogo.Point{SlotNo: 10, HeaderHash: "h10"},
+			SpentAt:   &kupogo.Point{SlotNo: 20, HeaderHash: "h20"},
+		},
+		{
+			TransactionID: "bb",
+			Address:       "addr2",
+			Value:         kupogo.Value{Coins: 2000000},
+			CreatedAt:     kupogo.Point{SlotNo: 11, HeaderHash: "h11"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteMatches(&buf, matches); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	rows, err := parquet.Read[MatchRow](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(rows))
+	}
+	expected := NewMatchRow(matches[0])
+	if !reflect.DeepEqual(rows[0], expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, rows[0])
+	}
+	if rows[1].SpentSlot != nil || rows[1].DatumHash != nil {
+		t.Fatalf("Expected null spent slot and datum hash, got %+v", rows[1])
+	}
+}
+
+func TestWriteMetadata(t *testing.T) {
+	metadata := kupogo.Metadata{
+		{Hash: "abcd", Raw: []byte{0xa0}, Schema: []byte(`{}`)},
+	}
+	var buf bytes.Buffer
+	if err := WriteMetadata(&buf, 42, metadata); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	rows, err := parquet.Read[MetadataRow](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(rows) != 1 || rows[0].SlotNo != 42 || rows[0].Schema != "{}" {
+		t.Fatalf("Unexpected rows: %+v", rows)
+	}
+}
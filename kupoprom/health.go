@@ -0,0 +1,117 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupoprom
+
+import (
+	"context"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	healthUpDesc = prometheus.NewDesc(
+		"kupo_health_up",
+		"Whether the last Kupo health check succeeded",
+		nil,
+		nil,
+	)
+	healthConnectedDesc = prometheus.NewDesc(
+		"kupo_health_connected",
+		"Whether Kupo is connected to its node",
+		nil,
+		nil,
+	)
+	healthSyncLagDesc = prometheus.NewDesc(
+		"kupo_health_sync_lag_slots",
+		"Slots between the node tip and Kupo's most recent checkpoint",
+		nil,
+		nil,
+	)
+	healthStalenessDesc = prometheus.NewDesc(
+		"kupo_health_seconds_since_last_block",
+		"Seconds since Kupo last indexed a block",
+		nil,
+		nil,
+	)
+	healthSyncDesc = prometheus.NewDesc(
+		"kupo_health_network_synchronization",
+		"Kupo's network synchronization progress, from 0 to 1",
+		nil,
+		nil,
+	)
+)
+
+// HealthCollector is a prometheus.Collector which queries Kupo's health on
+// every scrape and exports it as gauges
+type HealthCollector struct {
+	client  *kupogo.Client
+	timeout time.Duration
+}
+
+// NewHealthCollector creates a health collector. Each scrape queries Kupo with
+// the given timeout
+func NewHealthCollector(client *kupogo.Client, timeout time.Duration) *HealthCollector {
+	return &HealthCollector{
+		client:  client,
+		timeout: timeout,
+	}
+}
+
+func (c *HealthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- healthUpDesc
+	ch <- healthConnectedDesc
+	ch <- healthSyncLagDesc
+	ch <- healthStalenessDesc
+	ch <- healthSyncDesc
+}
+
+func (c *HealthCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	health, err := c.client.GetHealthContext(ctx)
+	if err != nil {
+		ch <- prometheus.MustNewConstMetric(healthUpDesc, prometheus.GaugeValue, 0)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(healthUpDesc, prometheus.GaugeValue, 1)
+	connected := 0.0
+	if health.IsConnected() {
+		connected = 1
+	}
+	ch <- prometheus.MustNewConstMetric(healthConnectedDesc, prometheus.GaugeValue, connected)
+	if lag, ok := health.SyncLag(); ok {
+		ch <- prometheus.MustNewConstMetric(healthSyncLagDesc, prometheus.GaugeValue, float64(lag))
+	}
+	if health.SecondsSinceLastBlock != nil {
+		ch <- prometheus.MustNewConstMetric(
+			healthStalenessDesc,
+			prometheus.GaugeValue,
+			float64(*health.SecondsSinceLastBlock),
+		)
+	}
+	if health.NetworkSynchronization != nil {
+		ch <- prometheus.MustNewConstMetric(
+			healthSyncDesc,
+			prometheus.GaugeValue,
+			*health.NetworkSynchronization,
+		)
+	}
+}
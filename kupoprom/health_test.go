@@ -0,0 +1,54 @@
+package kupoprom
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestHealthCollector(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{
+				"connection_status": "connected",
+				"most_recent_checkpoint": 1000,
+				"most_recent_node_tip": 1020,
+				"seconds_since_last_block": 7,
+				"network_synchronization": 1
+			}`))
+		}),
+	)
+	defer server.Close()
+
+	collector := NewHealthCollector(kupogo.NewClient(server.URL), time.Second)
+	expected := `
+# HELP kupo_health_connected Whether Kupo is connected to its node
+# TYPE kupo_health_connected gauge
+kupo_health_connected 1
+# HELP kupo_health_seconds_since_last_block Seconds since Kupo last indexed a block
+# TYPE kupo_health_seconds_since_last_block gauge
+kupo_health_seconds_since_last_block 7
+# HELP kupo_health_sync_lag_slots Slots between the node tip and Kupo's most recent checkpoint
+# TYPE kupo_health_sync_lag_slots gauge
+kupo_health_sync_lag_slots 20
+# HELP kupo_health_up Whether the last Kupo health check succeeded
+# TYPE kupo_health_up gauge
+kupo_health_up 1
+`
+	err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(expected),
+		"kupo_health_connected",
+		"kupo_health_seconds_since_last_block",
+		"kupo_health_sync_lag_slots",
+		"kupo_health_up",
+	)
+	if err != nil {
+		t.Errorf("Unexpected metrics: %s", err)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kupoprom instruments Kupo clients with Prometheus metrics
+package kupoprom
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics holds the client metrics, labelled by Kupo endpoint
+type Metrics struct {
+	requests      *prometheus.CounterVec
+	errors        *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	responseBytes *prometheus.HistogramVec
+}
+
+// NewMetrics creates the client metrics and registers them with the
+// registerer, if not nil
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "kupogo_requests_total",
+				Help: "Requests made to Kupo, by endpoint and status code",
+			},
+			[]string{"endpoint", "code"},
+		),
+		errors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "kupogo_request_errors_total",
+				Help: "Failed requests to Kupo, by endpoint and error class",
+			},
+			[]string{"endpoint", "class"},
+		),
+		duration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "kupogo_request_duration_seconds",
+				Help:    "Time until Kupo response headers were received",
+				Buckets: prometheus.ExponentialBuckets(0.005, 2, 14),
+			},
+			[]string{"endpoint"},
+		),
+		responseBytes: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "kupogo_response_size_bytes",
+				Help:    "Size of Kupo response bodies",
+				Buckets: prometheus.ExponentialBuckets(256, 4, 12),
+			},
+			[]string{"endpoint"},
+		),
+	}
+	if reg != nil {
+		reg.MustRegister(m.requests, m.errors, m.duration, m.responseBytes)
+	}
+	return m
+}
+
+// Collectors returns the metrics, for registering them manually
+func (m *Metrics) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.requests, m.errors, m.duration, m.responseBytes}
+}
+
+// Transport wraps an http.RoundTripper, defaulting to http.DefaultTransport,
+// to record metrics for every request. Use it with kupogo.WithHTTPClient, or
+// pass the method value to kupogo.WithMiddleware
+func (m *Metrics) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &transport{metrics: m, next: next}
+}
+
+type transport struct {
+	metrics *Metrics
+	next    http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := kupogo.EndpointName(req.URL.Path)
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	t.metrics.duration.WithLabelValues(endpoint).Observe(time.Since(start).Seconds())
+	if err != nil {
+		t.metrics.requests.WithLabelValues(endpoint, "error").Inc()
+		t.metrics.errors.WithLabelValues(endpoint, errorClass(err)).Inc()
+		return nil, err
+	}
+	t.metrics.requests.WithLabelValues(endpoint, strconv.Itoa(resp.StatusCode)).Inc()
+	switch {
+	case resp.StatusCode >= 500:
+		t.metrics.errors.WithLabelValues(endpoint, "server").Inc()
+	case resp.StatusCode >= 400:
+		t.metrics.errors.WithLabelValues(endpoint, "client").Inc()
+	}
+	resp.Body = &countingBody{
+		ReadCloser: resp.Body,
+		observe: func(n int) {
+			t.metrics.responseBytes.WithLabelValues(endpoint).Observe(float64(n))
+		},
+	}
+	return resp, nil
+}
+
+// errorClass groups transport errors into a few label values
+func errorClass(err error) string {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	default:
+		return "network"
+	}
+}
+
+// countingBody reports the number of bytes read from a response body once it
+// is closed
+type countingBody struct {
+	io.ReadCloser
+	n        int
+	observe  func(int)
+	observed bool
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += n
+	return n, err
+}
+
+func (b *countingBody) Close() error {
+	if !b.observed {
+		b.observed = true
+		b.observe(b.n)
+	}
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,50 @@
+package kupoprom
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, "/datums/") {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+
+	reg := prometheus.NewRegistry()
+	metrics := NewMetrics(reg)
+	client := kupogo.NewClient(
+		server.URL,
+		kupogo.WithHTTPClient(&http.Client{Transport: metrics.Transport(nil)}),
+	)
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetMatches("addr1"); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	}
+	if _, err := client.GetDatumByHash("abcd"); err == nil {
+		t.Fatalf("Expected error")
+	}
+	if got := testutil.ToFloat64(metrics.requests.WithLabelValues("matches", "200")); got != 2 {
+		t.Errorf("Expected 2 matches requests, got %f", got)
+	}
+	if got := testutil.ToFloat64(metrics.errors.WithLabelValues("datums", "server")); got != 1 {
+		t.Errorf("Expected 1 datums server error, got %f", got)
+	}
+	count, err := testutil.GatherAndCount(reg, "kupogo_response_size_bytes")
+	if err != nil || count != 2 {
+		t.Errorf("Expected response sizes for 2 endpoints, got %d, %v", count, err)
+	}
+}
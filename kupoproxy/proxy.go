@@ -0,0 +1,242 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kupoproxy is a reverse proxy fronting Kupo, for safely sharing one
+// Kupo with many consumers. It caches immutable resources, limits request
+// rates per client, authenticates clients and rejects writes by default
+package kupoproxy
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// DefaultImmutableDepth is the number of slots after which a block can no
+// longer be rolled back on mainnet (3k/f)
+const DefaultImmutableDepth = 129600
+
+const (
+	mostRecentCheckpointHeader = "X-Most-Recent-Checkpoint"
+	cacheHeader                = "X-Kupoproxy-Cache"
+)
+
+// Config configures a Proxy
+type Config struct {
+	// Upstream is the URL of Kupo
+	Upstream string
+	// Cache stores immutable responses, defaulting to an LRU cache of 256MB
+	Cache kupogo.Cache
+	// ImmutableDepth is the number of slots behind the most recent checkpoint
+	// after which spent matches are cached, defaulting to
+	// DefaultImmutableDepth
+	ImmutableDepth int
+	// Authorize identifies the client of a request, returning false to reject
+	// it. All requests are allowed as one client if nil
+	Authorize func(r *http.Request) (client string, ok bool)
+	// RateLimit is the sustained number of requests per second allowed per
+	// client, with bursts of up to Burst requests. 0 disables rate limiting
+	RateLimit float64
+	Burst     int
+	// AllowWrites allows requests which modify patterns or matches
+	AllowWrites bool
+	// Transport is used for upstream requests, defaulting to
+	// http.DefaultTransport
+	Transport http.RoundTripper
+}
+
+// Proxy is an http.Handler forwarding requests to Kupo
+type Proxy struct {
+	config     Config
+	proxy      *httputil.ReverseProxy
+	checkpoint atomic.Int64
+	limiter    *limiter
+}
+
+// New creates a proxy
+func New(config Config) (*Proxy, error) {
+	upstream, err := url.Parse(config.Upstream)
+	if err != nil {
+		return nil, fmt.Errorf("invalid upstream URL: %s", err)
+	}
+	if config.Cache == nil {
+		config.Cache = kupogo.NewLRUCache(0, 256<<20)
+	}
+	if config.ImmutableDepth <= 0 {
+		config.ImmutableDepth = DefaultImmutableDepth
+	}
+	if config.Burst <= 0 {
+		config.Burst = 1
+	}
+	p := &Proxy{config: config}
+	p.checkpoint.Store(-1)
+	if config.RateLimit > 0 {
+		p.limiter = newLimiter(config.RateLimit, config.Burst)
+	}
+	p.proxy = httputil.NewSingleHostReverseProxy(upstream)
+	p.proxy.Transport = config.Transport
+	p.proxy.ModifyResponse = p.modifyResponse
+	return p, nil
+}
+
+// BearerTokens authorizes requests with an "Authorization: Bearer" token,
+// identifying clients by the name the token maps to
+func BearerTokens(tokens map[string]string) func(r *http.Request) (string, bool) {
+	return func(r *http.Request) (string, bool) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			return "", false
+		}
+		client, ok := tokens[token]
+		return client, ok
+	}
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	client := ""
+	if p.config.Authorize != nil {
+		var ok bool
+		client, ok = p.config.Authorize(r)
+		if !ok {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+	if p.limiter != nil && !p.limiter.allow(client) {
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead && !p.config.AllowWrites {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	// Credentials for the proxy are not forwarded to Kupo
+	r.Header.Del("Authorization")
+	if r.Method == http.MethodGet && p.cacheable(r) {
+		if body, ok := p.config.Cache.Get(cacheKey(r)); ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set(cacheHeader, "hit")
+			_, _ = w.Write(body)
+			return
+		}
+	}
+	p.proxy.ServeHTTP(w, r)
+}
+
+// modifyResponse tracks the most recent checkpoint and stores cacheable
+// responses
+func (p *Proxy) modifyResponse(resp *http.Response) error {
+	if slotNo, err := strconv.Atoi(resp.Header.Get(mostRecentCheckpointHeader)); err == nil {
+		p.checkpoint.Store(int64(slotNo))
+	}
+	req := resp.Request
+	if req.Method != http.MethodGet || resp.StatusCode != http.StatusOK || !p.cacheable(req) {
+		return nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	// A missing datum or script may still appear later
+	if !bytes.Equal(bytes.TrimSpace(body), []byte("null")) {
+		p.config.Cache.Set(cacheKey(req), body)
+	}
+	resp.Header.Set(cacheHeader, "miss")
+	return nil
+}
+
+// cacheable reports whether the response to a request can never change:
+// datums and scripts by hash, and spent matches when they were all spent
+// deeper than the immutable depth
+func (p *Proxy) cacheable(r *http.Request) bool {
+	path := r.URL.Path
+	switch {
+	case strings.HasPrefix(path, "/datums/"), strings.HasPrefix(path, "/scripts/"):
+		return true
+	case strings.HasPrefix(path, "/matches"):
+		query := r.URL.Query()
+		if !query.Has("spent") {
+			return false
+		}
+		spentBefore, err := strconv.Atoi(query.Get("spent_before"))
+		if err != nil {
+			return false
+		}
+		checkpoint := p.checkpoint.Load()
+		return checkpoint >= 0 &&
+			int64(spentBefore) <= checkpoint-int64(p.config.ImmutableDepth)
+	}
+	return false
+}
+
+func cacheKey(r *http.Request) string {
+	return r.URL.Path + "?" + r.URL.RawQuery
+}
+
+// limiter is a token bucket per client
+type limiter struct {
+	rate    float64
+	burst   float64
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(rate float64, burst int) *limiter {
+	return &limiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (l *limiter) allow(client string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[client] = b
+	}
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
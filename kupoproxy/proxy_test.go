@@ -0,0 +1,138 @@
+package kupoproxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testUpstream(requests *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("Authorization") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("X-Most-Recent-Checkpoint", "200000")
+		switch r.URL.Path {
+		case "/datums/missing":
+			_, _ = w.Write([]byte(`null`))
+		case "/datums/dd":
+			_, _ = w.Write([]byte(`{"datum":"d87980"}`))
+		default:
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+}
+
+func get(t *testing.T, url string, token string) *http.Response {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	resp.Body.Close()
+	return resp
+}
+
+func TestProxyCache(t *testing.T) {
+	var requests atomic.Int32
+	upstream := testUpstream(&requests)
+	defer upstream.Close()
+	proxy, err := New(Config{Upstream: upstream.URL})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	server := httptest.NewServer(proxy)
+	defer server.Close()
+	testDefs := []struct {
+		path      string
+		upstreams int32
+	}{
+		// Datums are cached once found
+		{path: "/datums/dd", upstreams: 1},
+		{path: "/datums/dd", upstreams: 1},
+		{path: "/datums/missing", upstreams: 2},
+		{path: "/datums/missing", upstreams: 3},
+		// Unspent matches may change
+		{path: "/matches/*?unspent", upstreams: 4},
+		{path: "/matches/*?unspent", upstreams: 5},
+		// Spent long enough ago to be immutable
+		{path: "/matches/*?spent&spent_before=1000", upstreams: 6},
+		{path: "/matches/*?spent&spent_before=1000", upstreams: 6},
+		// Spent recently, within the rollback window
+		{path: "/matches/*?spent&spent_before=190000", upstreams: 7},
+		{path: "/matches/*?spent&spent_before=190000", upstreams: 8},
+	}
+	for _, testDef := range testDefs {
+		resp := get(t, server.URL+testDef.path, "")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: expected status 200, got %d", testDef.path, resp.StatusCode)
+		}
+		if requests.Load() != testDef.upstreams {
+			t.Fatalf(
+				"%s: expected %d upstream requests, got %d",
+				testDef.path,
+				testDef.upstreams,
+				requests.Load(),
+			)
+		}
+	}
+}
+
+func TestProxyAuthAndWrites(t *testing.T) {
+	var requests atomic.Int32
+	upstream := testUpstream(&requests)
+	defer upstream.Close()
+	proxy, err := New(Config{
+		Upstream:  upstream.URL,
+		Authorize: BearerTokens(map[string]string{"secret": "team-a"}),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	server := httptest.NewServer(proxy)
+	defer server.Close()
+	if resp := get(t, server.URL+"/health", ""); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Expected status 401, got %d", resp.StatusCode)
+	}
+	// The token must not reach Kupo
+	if resp := get(t, server.URL+"/health", "secret"); resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	req, _ := http.NewRequest(http.MethodPut, server.URL+"/patterns/*", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("Expected status 405, got %d", resp.StatusCode)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newLimiter(1, 2)
+	l.now = func() time.Time { return now }
+	for i, expected := range []bool{true, true, false} {
+		if l.allow("a") != expected {
+			t.Fatalf("Request %d: expected allowed %t", i, expected)
+		}
+	}
+	// Clients have separate buckets
+	if !l.allow("b") {
+		t.Fatalf("Expected other client to be allowed")
+	}
+	now = now.Add(time.Second)
+	if !l.allow("a") {
+		t.Fatalf("Expected request after refill to be allowed")
+	}
+}
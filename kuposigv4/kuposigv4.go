@@ -0,0 +1,115 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kuposigv4 signs requests to Kupo with AWS Signature Version 4, for
+// deployments fronted by API Gateway or another service using IAM auth
+package kuposigv4
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/blinklabs-io/kupogo"
+)
+
+// DefaultService is the signing name of API Gateway
+const DefaultService = "execute-api"
+
+// Config configures request signing
+type Config struct {
+	// Region is the AWS region of the service, such as "us-east-1"
+	Region string
+	// Service is the signing name of the service, defaulting to
+	// DefaultService
+	Service string
+	// Credentials provides the credentials requests are signed with, such as
+	// the Credentials of an aws.Config loaded from the environment
+	Credentials aws.CredentialsProvider
+	// Now returns the signing time, defaulting to time.Now
+	Now func() time.Time
+}
+
+// WithSigning signs every request of the client. It should come after other
+// middleware modifying requests, so the headers they set are signed
+func WithSigning(config Config) kupogo.ClientOption {
+	return kupogo.WithMiddleware(Middleware(config))
+}
+
+// Middleware signs requests with the configured credentials
+func Middleware(config Config) kupogo.Middleware {
+	if config.Service == "" {
+		config.Service = DefaultService
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	signer := v4.NewSigner()
+	return func(next http.RoundTripper) http.RoundTripper {
+		return kupogo.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if kupogo.IsCrossHostRedirect(req) {
+				return next.RoundTrip(req)
+			}
+			if config.Credentials == nil {
+				return nil, errors.New("failed to sign request: no credentials provider")
+			}
+			credentials, err := config.Credentials.Retrieve(req.Context())
+			if err != nil {
+				return nil, fmt.Errorf("failed to retrieve AWS credentials: %s", err)
+			}
+			req = req.Clone(req.Context())
+			payloadHash, err := hashBody(req)
+			if err != nil {
+				return nil, err
+			}
+			err = signer.SignHTTP(
+				req.Context(),
+				credentials,
+				req,
+				payloadHash,
+				config.Service,
+				config.Region,
+				config.Now(),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to sign request: %s", err)
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// hashBody returns the hex encoded SHA-256 of the request body, replacing the
+// body so it can still be sent
+func hashBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		sum := sha256.Sum256(nil)
+		return hex.EncodeToString(sum[:]), nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to read request body: %s", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:]), nil
+}
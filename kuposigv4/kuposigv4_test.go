@@ -0,0 +1,64 @@
+package kuposigv4
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestWithSigning(t *testing.T) {
+	var authorization, date, body string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+			date = r.Header.Get("X-Amz-Date")
+			data, _ := io.ReadAll(r.Body)
+			body = string(data)
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+
+	client := kupogo.NewClient(
+		server.URL,
+		WithSigning(Config{
+			Region: "eu-west-1",
+			Credentials: aws.CredentialsProviderFunc(
+				func(ctx context.Context) (aws.Credentials, error) {
+					return aws.Credentials{
+						AccessKeyID:     "AKIDEXAMPLE",
+						SecretAccessKey: "secret",
+					}, nil
+				},
+			),
+			Now: func() time.Time {
+				return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+			},
+		}),
+	)
+	if _, err := client.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !strings.HasPrefix(
+		authorization,
+		"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20240102/eu-west-1/execute-api/aws4_request",
+	) {
+		t.Fatalf("Unexpected authorization header: %s", authorization)
+	}
+	if date != "20240102T030405Z" {
+		t.Fatalf("Unexpected date header: %s", date)
+	}
+	if _, err := client.AddPattern("*", kupogo.Point{SlotNo: 10}, kupogo.RollbackLimitWithinSafeZone); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if body == "" {
+		t.Fatalf("Expected the request body to be sent after signing")
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kupotel traces Kupo client requests with OpenTelemetry
+package kupotel
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/blinklabs-io/kupogo/kupotel"
+
+// Config configures the tracing transport. Zero values use the global
+// OpenTelemetry tracer provider and propagator
+type Config struct {
+	TracerProvider trace.TracerProvider
+	Propagator     propagation.TextMapPropagator
+}
+
+// Transport wraps an http.RoundTripper, defaulting to http.DefaultTransport,
+// to create a span for every request and propagate the trace context to
+// Kupo. Use it with kupogo.WithHTTPClient and the client's ...Context
+// methods so spans join the caller's trace
+func Transport(next http.RoundTripper, config Config) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if config.TracerProvider == nil {
+		config.TracerProvider = otel.GetTracerProvider()
+	}
+	if config.Propagator == nil {
+		config.Propagator = otel.GetTextMapPropagator()
+	}
+	return &transport{
+		next:       next,
+		tracer:     config.TracerProvider.Tracer(instrumentationName),
+		propagator: config.Propagator,
+	}
+}
+
+// Middleware returns the tracing transport as a kupogo.Middleware, for use
+// with kupogo.WithMiddleware
+func Middleware(config Config) kupogo.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return Transport(next, config)
+	}
+}
+
+type transport struct {
+	next       http.RoundTripper
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := kupogo.EndpointName(req.URL.Path)
+	attrs := []attribute.KeyValue{
+		attribute.String("http.method", req.Method),
+		attribute.String("kupo.endpoint", endpoint),
+	}
+	if pattern := patternFromPath(req.URL.Path, endpoint); pattern != "" {
+		attrs = append(attrs, attribute.String("kupo.pattern", pattern))
+	}
+	ctx, span := t.tracer.Start(
+		req.Context(),
+		"kupo "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	req = req.Clone(ctx)
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+	if checkpoint, err := strconv.Atoi(resp.Header.Get("X-Most-Recent-Checkpoint")); err == nil {
+		span.SetAttributes(attribute.Int("kupo.checkpoint", checkpoint))
+	}
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, fmt.Sprintf("status code %d", resp.StatusCode))
+	}
+	// The span ends once the body is consumed, so it covers the transfer
+	resp.Body = &spanBody{ReadCloser: resp.Body, span: span}
+	return resp, nil
+}
+
+// patternFromPath returns the pattern or hash following the endpoint in a
+// request path
+func patternFromPath(path string, endpoint string) string {
+	idx := strings.Index(path, "/"+endpoint+"/")
+	if idx < 0 {
+		return ""
+	}
+	return path[idx+len(endpoint)+2:]
+}
+
+// spanBody ends a span with the response size once the body is closed
+type spanBody struct {
+	io.ReadCloser
+	span  trace.Span
+	n     int
+	ended bool
+}
+
+func (b *spanBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += n
+	return n, err
+}
+
+func (b *spanBody) Close() error {
+	if !b.ended {
+		b.ended = true
+		b.span.SetAttributes(attribute.Int("kupo.response_bytes", b.n))
+		b.span.End()
+	}
+	return b.ReadCloser.Close()
+}
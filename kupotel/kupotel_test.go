@@ -0,0 +1,73 @@
+package kupotel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTransport(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			traceparent = r.Header.Get("traceparent")
+			w.Header().Set("X-Most-Recent-Checkpoint", "1234")
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client := kupogo.NewClient(
+		server.URL,
+		kupogo.WithHTTPClient(
+			&http.Client{
+				Transport: Transport(
+					nil,
+					Config{
+						TracerProvider: provider,
+						Propagator:     propagation.TraceContext{},
+					},
+				),
+			},
+		),
+	)
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	if _, err := client.GetMatchesContext(ctx, "addr1"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "kupo matches" {
+		t.Errorf("Unexpected span name: %s", span.Name())
+	}
+	if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("Expected request span to be a child of the caller's span")
+	}
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, attr := range span.Attributes() {
+		attrs[attr.Key] = attr.Value
+	}
+	if attrs["kupo.pattern"].AsString() != "addr1" ||
+		attrs["kupo.checkpoint"].AsInt64() != 1234 ||
+		attrs["kupo.response_bytes"].AsInt64() != 2 ||
+		attrs["http.status_code"].AsInt64() != 200 {
+		t.Errorf("Unexpected span attributes: %v", span.Attributes())
+	}
+	if traceparent == "" {
+		t.Errorf("Expected trace context to be propagated")
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DecodeError describes an element of a response array which failed to decode
+type DecodeError struct {
+	Index int
+	// Raw is the undecoded JSON of the element
+	Raw json.RawMessage
+	Err error
+}
+
+func (e DecodeError) Error() string {
+	return fmt.Sprintf("element %d: %s", e.Index, e.Err)
+}
+
+// DecodeReport lists the elements skipped while leniently decoding a response
+type DecodeReport struct {
+	URL string
+	// Total is the number of elements in the response
+	Total   int
+	Skipped []DecodeError
+}
+
+// WithLenientDecoding makes match queries skip elements which fail to decode,
+// such as ones with a new field shape, instead of failing the whole call.
+// onSkipped, if not nil, is called with the report of every response where
+// elements were skipped
+func WithLenientDecoding(onSkipped func(DecodeReport)) ClientOption {
+	return func(c *Client) {
+		c.lenient = &lenientDecoding{onSkipped: onSkipped}
+	}
+}
+
+type lenientDecoding struct {
+	onSkipped func(DecodeReport)
+}
+
+// DecodeMatchesLenient decodes a JSON array of matches, skipping and reporting
+// the elements which fail to decode. Only a malformed array is an error
+func DecodeMatchesLenient(data []byte) (Matches, DecodeReport, error) {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return nil, DecodeReport{}, err
+	}
+	report := DecodeReport{Total: len(elements)}
+	matches := make(Matches, 0, len(elements))
+	for i, element := range elements {
+		var match Match
+		if err := json.Unmarshal(element, &match); err != nil {
+			report.Skipped = append(report.Skipped, DecodeError{
+				Index: i,
+				Raw:   element,
+				Err:   err,
+			})
+			continue
+		}
+		matches = append(matches, match)
+	}
+	return matches, report, nil
+}
@@ -0,0 +1,76 @@
+package kupogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const lenientMatchesJSON = `[
+	{"transaction_id":"aa","output_index":0,"address":"addr1","value":{"coins":1},"created_at":{"slot_no":1,"header_hash":"ab"}},
+	{"transaction_id":"bb","output_index":"zero","address":"addr1","value":{"coins":2},"created_at":{"slot_no":2,"header_hash":"ab"}},
+	{"transaction_id":"cc","output_index":1,"address":"addr1","value":{"coins":3},"created_at":{"slot_no":3,"header_hash":"ab"}}
+]`
+
+func TestDecodeMatchesLenient(t *testing.T) {
+	matches, report, err := DecodeMatchesLenient([]byte(lenientMatchesJSON))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(matches) != 2 || matches[1].TransactionID != "cc" {
+		t.Fatalf("Unexpected matches: %v", matches)
+	}
+	if report.Total != 3 || len(report.Skipped) != 1 || report.Skipped[0].Index != 1 {
+		t.Fatalf("Unexpected report: %v", report)
+	}
+	if _, _, err := DecodeMatchesLenient([]byte(`{"not":"an array"}`)); err == nil {
+		t.Fatalf("Expected error for malformed array")
+	}
+}
+
+func TestClientLenientDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(lenientMatchesJSON))
+	}))
+	defer server.Close()
+	strict := NewClient(server.URL)
+	if _, err := strict.GetMatches("*"); err == nil {
+		t.Fatalf("Expected error without lenient decoding")
+	}
+	var reports []DecodeReport
+	client := NewClient(
+		server.URL,
+		WithLenientDecoding(func(report DecodeReport) {
+			reports = append(reports, report)
+		}),
+	)
+	matches, err := client.GetMatches("*")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(*matches) != 2 {
+		t.Fatalf("Expected 2 matches, got %d", len(*matches))
+	}
+	if len(reports) != 1 || reports[0].URL != server.URL+"/matches/*" {
+		t.Fatalf("Unexpected reports: %v", reports)
+	}
+}
+
+func TestClientLenientDecodingFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(lenientMatchesJSON))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, WithLenientDecoding(nil))
+	ctx := WithCallOptions(context.Background(), MatchFilter(func(match Match) bool {
+		return match.Value.Coins > 1
+	}))
+	matches, err := client.GetMatchesContext(ctx, "*")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(*matches) != 1 || (*matches)[0].TransactionID != "cc" {
+		t.Fatalf("Expected the filter to apply to leniently decoded matches, got %v", *matches)
+	}
+}
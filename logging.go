@@ -0,0 +1,126 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// LogLevels sets the levels used for client log messages
+type LogLevels struct {
+	// Request is used for request start and finish, defaulting to debug
+	Request slog.Level
+	// Error is used for failed requests and decode failures, defaulting to
+	// warn
+	Error slog.Level
+}
+
+var defaultLogLevels = LogLevels{
+	Request: slog.LevelDebug,
+	Error:   slog.LevelWarn,
+}
+
+// WithLogger logs requests, failures and decode errors to the given logger
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+		if c.logLevels == (LogLevels{}) {
+			c.logLevels = defaultLogLevels
+		}
+	}
+}
+
+// WithLogLevels overrides the levels used by WithLogger
+func WithLogLevels(levels LogLevels) ClientOption {
+	return func(c *Client) {
+		c.logLevels = levels
+	}
+}
+
+func (c *Client) logRequest(req *http.Request) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.Log(
+		requestContext(req),
+		c.logLevels.Request,
+		"kupo request started",
+		"method", req.Method,
+		"url", req.URL.String(),
+		"request_id", req.Header.Get(RequestIDHeader),
+	)
+}
+
+func (c *Client) logResponse(
+	req *http.Request,
+	resp *http.Response,
+	err error,
+	duration time.Duration,
+) {
+	if c.logger == nil {
+		return
+	}
+	if err != nil {
+		c.logger.Log(
+			requestContext(req),
+			c.logLevels.Error,
+			"kupo request failed",
+			"method", req.Method,
+			"url", req.URL.String(),
+			"duration", duration,
+			"request_id", req.Header.Get(RequestIDHeader),
+			"error", err,
+		)
+		return
+	}
+	level := c.logLevels.Request
+	if resp.StatusCode >= http.StatusBadRequest {
+		level = c.logLevels.Error
+	}
+	c.logger.Log(
+		requestContext(req),
+		level,
+		"kupo request finished",
+		"method", req.Method,
+		"url", req.URL.String(),
+		"status", resp.StatusCode,
+		"duration", duration,
+		"request_id", req.Header.Get(RequestIDHeader),
+	)
+}
+
+func (c *Client) logDecodeFailure(req *http.Request, err error) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.Log(
+		requestContext(req),
+		c.logLevels.Error,
+		"failed to decode kupo response",
+		"url", req.URL.String(),
+		"request_id", req.Header.Get(RequestIDHeader),
+		"error", err,
+	)
+}
+
+func requestContext(req *http.Request) context.Context {
+	if ctx := req.Context(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
@@ -0,0 +1,38 @@
+package kupogo
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_WithLogger(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`not json`))
+		}),
+	)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(
+		slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	client := NewClient(server.URL, WithLogger(logger))
+	if _, err := client.GetMatches("addr1"); err == nil {
+		t.Fatalf("Expected decode error")
+	}
+	output := buf.String()
+	for _, expected := range []string{
+		`level=DEBUG msg="kupo request started"`,
+		`level=DEBUG msg="kupo request finished"`,
+		`level=WARN msg="failed to decode kupo response"`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected log output to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LRUCache is an in-memory cache bounded by entry count and total value size,
+// evicting the least recently used entries first
+type LRUCache struct {
+	maxEntries int
+	maxBytes   int
+	mu         sync.Mutex
+	size       int
+	order      *list.List
+	entries    map[string]*list.Element
+}
+
+type lruCacheEntry struct {
+	key   string
+	value []byte
+}
+
+// NewLRUCache creates an LRU cache. A limit of 0 means unbounded
+func NewLRUCache(maxEntries int, maxBytes int) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the cached value for a key and marks it as recently used
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruCacheEntry).value, true
+}
+
+// Set caches a value, evicting old entries as needed. Values larger than the
+// size limit are not cached
+func (c *LRUCache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.maxBytes > 0 && len(value) > c.maxBytes {
+		return
+	}
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*lruCacheEntry)
+		c.size += len(value) - len(entry.value)
+		entry.value = value
+		c.order.MoveToFront(elem)
+	} else {
+		c.entries[key] = c.order.PushFront(&lruCacheEntry{key: key, value: value})
+		c.size += len(value)
+	}
+	for (c.maxEntries > 0 && c.order.Len() > c.maxEntries) ||
+		(c.maxBytes > 0 && c.size > c.maxBytes) {
+		oldest := c.order.Back()
+		entry := oldest.Value.(*lruCacheEntry)
+		c.order.Remove(oldest)
+		delete(c.entries, entry.key)
+		c.size -= len(entry.value)
+	}
+}
+
+// Len returns the number of cached entries
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
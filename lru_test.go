@@ -0,0 +1,57 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2, 10)
+	cache.Set("a", []byte("1234"))
+	cache.Set("b", []byte("1234"))
+	// Mark "a" as recently used so "b" is evicted first
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatalf("Expected entry a to be cached")
+	}
+	cache.Set("c", []byte("12"))
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("Expected entry b to be evicted by entry limit")
+	}
+	cache.Set("d", []byte("123456"))
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("Expected entry a to be evicted by size limit")
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Expected 2 entries, got %d", cache.Len())
+	}
+	cache.Set("e", []byte("12345678901"))
+	if _, ok := cache.Get("e"); ok {
+		t.Errorf("Expected oversized entry not to be cached")
+	}
+}
+
+func TestClient_WithContentCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			_, _ = w.Write([]byte(`{"datum":"d87980"}`))
+		}),
+	)
+	defer server.Close()
+
+	client := NewClient(server.URL, WithContentCache(NewLRUCache(100, 0)))
+	for i := 0; i < 3; i++ {
+		datum, err := client.GetDatumByHash("abcd")
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if datum.Datum != "d87980" {
+			t.Fatalf("Unexpected datum: %s", datum.Datum)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
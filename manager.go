@@ -0,0 +1,144 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	ErrUnknownNetwork   = errors.New("unknown network")
+	ErrAmbiguousNetwork = errors.New("address matches several networks")
+)
+
+// NetworkConfig configures the client of one network in a Manager
+type NetworkConfig struct {
+	Network Network
+	KupoUrl string
+	// Options are applied to the network's client. WithNetwork is added
+	// automatically
+	Options []ClientOption
+}
+
+// Manager holds one client per Cardano network, for services reading from
+// several networks in one process. It is safe for concurrent use
+type Manager struct {
+	mu      sync.RWMutex
+	clients map[string]*Client
+}
+
+// NewManager returns a manager with a client for each network
+func NewManager(configs ...NetworkConfig) (*Manager, error) {
+	m := &Manager{clients: make(map[string]*Client)}
+	for _, config := range configs {
+		if err := m.Add(config); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Add creates the client of a network. It fails if the network already has
+// one
+func (m *Manager) Add(config NetworkConfig) error {
+	if config.Network.Name == "" {
+		return fmt.Errorf("network name is required")
+	}
+	if config.KupoUrl == "" {
+		return fmt.Errorf("kupo URL is required for network %s", config.Network.Name)
+	}
+	opts := append([]ClientOption{}, config.Options...)
+	opts = append(opts, WithNetwork(config.Network))
+	client := NewClient(config.KupoUrl, opts...)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.clients[config.Network.Name]; ok {
+		return fmt.Errorf("network %s is already configured", config.Network.Name)
+	}
+	m.clients[config.Network.Name] = client
+	return nil
+}
+
+// Remove drops the client of a network
+func (m *Manager) Remove(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.clients, name)
+}
+
+// Client returns the client of a network by name
+func (m *Manager) Client(name string) (*Client, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	client, ok := m.clients[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownNetwork, name)
+	}
+	return client, nil
+}
+
+// ClientForAddress returns the client of the network an address belongs to.
+// Testnet Shelley addresses don't say which testnet they are from, so they
+// can only be resolved if a single testnet is configured
+func (m *Manager) ClientForAddress(addr string) (*Client, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var found *Client
+	for _, client := range m.clients {
+		if !client.Network().ValidAddress(addr) {
+			continue
+		}
+		if found != nil {
+			return nil, ErrAmbiguousNetwork
+		}
+		found = client
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w: no network for address %s", ErrUnknownNetwork, addr)
+	}
+	return found, nil
+}
+
+// Networks returns the names of the configured networks, sorted
+func (m *Manager) Networks() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]string, 0, len(m.clients))
+	for name := range m.clients {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Each calls fn with the client of every network, in name order, and returns
+// the errors joined together
+func (m *Manager) Each(fn func(name string, client *Client) error) error {
+	var errs []error
+	for _, name := range m.Networks() {
+		client, err := m.Client(name)
+		if err != nil {
+			// Removed concurrently
+			continue
+		}
+		if err := fn(name, client); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
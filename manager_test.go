@@ -0,0 +1,59 @@
+package kupogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestManager(t *testing.T) {
+	manager, err := NewManager(
+		NetworkConfig{Network: NetworkMainnet, KupoUrl: "http://mainnet:1442"},
+		NetworkConfig{Network: NetworkPreprod, KupoUrl: "http://preprod:1442"},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := manager.Add(NetworkConfig{Network: NetworkMainnet, KupoUrl: "http://other:1442"}); err == nil {
+		t.Errorf("Expected an error adding a network twice")
+	}
+	if networks := manager.Networks(); !reflect.DeepEqual(networks, []string{"mainnet", "preprod"}) {
+		t.Errorf("Unexpected networks: %v", networks)
+	}
+	client, err := manager.Client("preprod")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if client.KupoUrl != "http://preprod:1442" || client.Network().Name != "preprod" {
+		t.Errorf("Unexpected client: %s %s", client.KupoUrl, client.Network().Name)
+	}
+	if _, err := manager.Client("preview"); !errors.Is(err, ErrUnknownNetwork) {
+		t.Errorf("Expected ErrUnknownNetwork, got %v", err)
+	}
+	client, err = manager.ClientForAddress("addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if client.Network().Name != "mainnet" {
+		t.Errorf("Expected mainnet client, got %s", client.Network().Name)
+	}
+
+	var visited []string
+	err = manager.Each(func(name string, client *Client) error {
+		visited = append(visited, name)
+		if name == "preprod" {
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+	if err == nil || err.Error() != "preprod: unavailable" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(visited, []string{"mainnet", "preprod"}) {
+		t.Errorf("Unexpected visited networks: %v", visited)
+	}
+	manager.Remove("preprod")
+	if networks := manager.Networks(); !reflect.DeepEqual(networks, []string{"mainnet"}) {
+		t.Errorf("Unexpected networks after removal: %v", networks)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo/address"
+)
+
+// ParseAddress parses the address of the match, which may be a Byron address
+// for old outputs
+func (m Match) ParseAddress() (*address.Address, error) {
+	return address.Parse(m.Address)
+}
+
+// AddressKind returns the type of the match's address and whether its
+// payment part is a script
+func (m Match) AddressKind() (address.Kind, bool, error) {
+	return address.Classify(m.Address)
+}
+
+// ErrScriptHashMismatch is returned by CheckScriptHash when the reference
+// script of an output is not the script locking it
+var ErrScriptHashMismatch = errors.New("script hash does not match address")
+
+// IsScriptLocked returns whether the output of the match is locked by a
+// script, that is its address has a script payment credential
+func (m Match) IsScriptLocked() bool {
+	_, ok := m.PaymentScriptHash()
+	return ok
+}
+
+// PaymentScriptHash returns the hash of the script locking the output of the
+// match, derived from its address, or false if it is not script-locked
+func (m Match) PaymentScriptHash() (string, bool) {
+	parsed, err := m.ParseAddress()
+	if err != nil || parsed.Payment == nil || !parsed.Payment.Script {
+		return "", false
+	}
+	return parsed.Payment.Hex(), true
+}
+
+// CheckScriptHash cross-checks the script hash derived from the match's
+// address against the hash of the reference script Kupo reports for the
+// output. It returns ErrScriptHashMismatch if the output is script-locked and
+// carries a different reference script. Outputs may legitimately carry
+// another script, so this only indicates an inconsistency for outputs
+// expected to carry their own validator, such as script deployments
+func (m Match) CheckScriptHash() error {
+	hash, ok := m.PaymentScriptHash()
+	if !ok || m.ScriptHash == nil {
+		return nil
+	}
+	if !strings.EqualFold(hash, *m.ScriptHash) {
+		return fmt.Errorf("%w: address script %s, reference script %s", ErrScriptHashMismatch, hash, *m.ScriptHash)
+	}
+	return nil
+}
+
+// ScriptLocked returns the matches whose outputs are locked by a script
+func (m Matches) ScriptLocked() Matches {
+	var ret Matches
+	for _, match := range m {
+		if match.IsScriptLocked() {
+			ret = append(ret, match)
+		}
+	}
+	return ret
+}
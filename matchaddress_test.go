@@ -0,0 +1,49 @@
+package kupogo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo/address"
+)
+
+func TestMatchAddressKind(t *testing.T) {
+	match := Match{Address: "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx"}
+	kind, script, err := match.AddressKind()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if kind != address.KindEnterprise || !script {
+		t.Fatalf("Expected script enterprise address, got %s (script=%v)", kind, script)
+	}
+	if _, _, err := (Match{Address: "bogus"}).AddressKind(); err == nil {
+		t.Fatalf("Expected error for invalid address")
+	}
+}
+
+func TestMatchScriptHash(t *testing.T) {
+	scriptHash := "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+	otherHash := "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	scriptLocked := Match{Address: "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx"}
+	keyLocked := Match{Address: "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8", ScriptHash: &otherHash}
+	if hash, ok := scriptLocked.PaymentScriptHash(); !ok || hash != scriptHash {
+		t.Fatalf("Expected script hash %s, got %s", scriptHash, hash)
+	}
+	if keyLocked.IsScriptLocked() {
+		t.Fatalf("Expected key-locked output")
+	}
+	if locked := (Matches{scriptLocked, keyLocked}).ScriptLocked(); len(locked) != 1 {
+		t.Fatalf("Expected one script-locked output, got %d", len(locked))
+	}
+	if err := keyLocked.CheckScriptHash(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	scriptLocked.ScriptHash = &scriptHash
+	if err := scriptLocked.CheckScriptHash(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	scriptLocked.ScriptHash = &otherHash
+	if err := scriptLocked.CheckScriptHash(); !errors.Is(err, ErrScriptHashMismatch) {
+		t.Fatalf("Expected ErrScriptHashMismatch, got %v", err)
+	}
+}
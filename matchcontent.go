@@ -0,0 +1,85 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// Maximum number of datums and scripts memoized for Match.Datum and
+// Match.Script
+const maxMemoizedContent = 1024
+
+// contentMemo memoizes the datums and scripts fetched for matches, which are
+// immutable
+type contentMemo struct {
+	once  sync.Once
+	cache *LRUCache
+}
+
+func (m *contentMemo) get() *LRUCache {
+	m.once.Do(func() {
+		m.cache = NewLRUCache(maxMemoizedContent, 0)
+	})
+	return m.cache
+}
+
+// Datum fetches the datum of the match's output using the client, or returns
+// nil if it has none or Kupo does not know it. Datums are memoized by the
+// client, so repeated calls do not hit Kupo
+func (m Match) Datum(ctx context.Context, c *Client) (*DatumResponse, error) {
+	if m.DatumHash == nil {
+		return nil, nil
+	}
+	memo := c.contentMemo.get()
+	key := "datums/" + *m.DatumHash
+	if cached, ok := memo.Get(key); ok {
+		return &DatumResponse{Datum: string(cached)}, nil
+	}
+	// Inline datums are also served by hash
+	datum, err := c.GetDatumByHashContext(ctx, *m.DatumHash)
+	if err != nil || datum == nil {
+		return nil, err
+	}
+	memo.Set(key, []byte(datum.Datum))
+	return datum, nil
+}
+
+// Script fetches the reference script carried by the match's output using the
+// client, or returns nil if it has none or Kupo does not know it. Scripts are
+// memoized by the client, so repeated calls do not hit Kupo
+func (m Match) Script(ctx context.Context, c *Client) (*ScriptResponse, error) {
+	if m.ScriptHash == nil {
+		return nil, nil
+	}
+	memo := c.contentMemo.get()
+	key := "scripts/" + *m.ScriptHash
+	if cached, ok := memo.Get(key); ok {
+		script := &ScriptResponse{}
+		if err := json.Unmarshal(cached, script); err == nil {
+			return script, nil
+		}
+	}
+	script, err := c.GetScriptByHashContext(ctx, *m.ScriptHash)
+	if err != nil || script == nil {
+		return nil, err
+	}
+	if data, err := json.Marshal(script); err == nil {
+		memo.Set(key, data)
+	}
+	return script, nil
+}
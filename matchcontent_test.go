@@ -0,0 +1,58 @@
+package kupogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMatchDatumAndScript(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			switch {
+			case r.URL.Path == "/datums/aa":
+				_, _ = w.Write([]byte(`{"datum":"d87980"}`))
+			case r.URL.Path == "/scripts/bb":
+				_, _ = w.Write([]byte(`{"language":"plutus:v2","script":"4e4d01000033222220051200120011"}`))
+			case strings.HasPrefix(r.URL.Path, "/datums/"), strings.HasPrefix(r.URL.Path, "/scripts/"):
+				_, _ = w.Write([]byte(`null`))
+			}
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+	ctx := context.Background()
+	datumHash, scriptHash, unknown := "aa", "bb", "cc"
+	match := Match{DatumHash: &datumHash, ScriptHash: &scriptHash}
+
+	for i := 0; i < 2; i++ {
+		datum, err := match.Datum(ctx, client)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if datum == nil || datum.Datum != "d87980" {
+			t.Fatalf("Unexpected datum %+v", datum)
+		}
+		script, err := match.Script(ctx, client)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if script == nil || script.Language != ScriptLanguagePlutusV2 {
+			t.Fatalf("Unexpected script %+v", script)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("Expected datum and script to be fetched once, got %d requests", requests)
+	}
+
+	if datum, err := (Match{}).Datum(ctx, client); err != nil || datum != nil {
+		t.Fatalf("Expected no datum for output without one, got %+v, %v", datum, err)
+	}
+	if script, err := (Match{ScriptHash: &unknown}).Script(ctx, client); err != nil || script != nil {
+		t.Fatalf("Expected no script for unknown hash, got %+v, %v", script, err)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MatchOrder controls the ordering of matches returned by Kupo
+type MatchOrder string
+
+const (
+	MatchOrderMostRecentFirst MatchOrder = "most_recent_first"
+	MatchOrderOldestFirst     MatchOrder = "oldest_first"
+)
+
+// MatchOptions narrows down the matches returned by Kupo. Zero values leave
+// the corresponding filter unset. Slot bounds are exclusive, as in Kupo
+type MatchOptions struct {
+	Spent         bool
+	Unspent       bool
+	CreatedAfter  int
+	CreatedBefore int
+	SpentAfter    int
+	SpentBefore   int
+	PolicyID      string
+	AssetName     string
+	TransactionID string
+	Order         MatchOrder
+	// Time bounds are exclusive too, and converted to slots with the
+	// client's network. When both a slot and a time bound are set, the
+	// stricter one applies
+	CreatedAfterTime  time.Time
+	CreatedBeforeTime time.Time
+	SpentAfterTime    time.Time
+	SpentBeforeTime   time.Time
+}
+
+// HasTimeBounds returns whether any time bound is set
+func (o MatchOptions) HasTimeBounds() bool {
+	return !o.CreatedAfterTime.IsZero() || !o.CreatedBeforeTime.IsZero() ||
+		!o.SpentAfterTime.IsZero() || !o.SpentBeforeTime.IsZero()
+}
+
+// WithSlotBounds returns the options with their time bounds converted to
+// slot bounds of a network
+func (o MatchOptions) WithSlotBounds(network Network) MatchOptions {
+	o.CreatedAfter = afterSlot(o.CreatedAfter, o.CreatedAfterTime, network)
+	o.CreatedBefore = beforeSlot(o.CreatedBefore, o.CreatedBeforeTime, network)
+	o.SpentAfter = afterSlot(o.SpentAfter, o.SpentAfterTime, network)
+	o.SpentBefore = beforeSlot(o.SpentBefore, o.SpentBeforeTime, network)
+	o.CreatedAfterTime = time.Time{}
+	o.CreatedBeforeTime = time.Time{}
+	o.SpentAfterTime = time.Time{}
+	o.SpentBeforeTime = time.Time{}
+	return o
+}
+
+// afterSlot returns the stricter of a slot bound and the slot bound excluding
+// blocks up to a time, which are those of the slot in progress at the time
+func afterSlot(slotNo int, t time.Time, network Network) int {
+	if t.IsZero() {
+		return slotNo
+	}
+	return max(slotNo, network.TimeToSlot(t))
+}
+
+// beforeSlot returns the stricter of a slot bound and the slot bound
+// excluding blocks from a time on, which are those of slots starting at or
+// after the time
+func beforeSlot(slotNo int, t time.Time, network Network) int {
+	if t.IsZero() {
+		return slotNo
+	}
+	ret := network.TimeToSlot(t)
+	if network.SlotToTime(ret).Before(t) {
+		ret++
+	}
+	// Bounds are only sent if positive
+	ret = max(ret, 1)
+	if slotNo > 0 {
+		return min(slotNo, ret)
+	}
+	return ret
+}
+
+// queryString encodes the options as a URL query string
+func (o MatchOptions) queryString() string {
+	var flags []string
+	// Kupo expects these as bare flags without a value
+	if o.Spent {
+		flags = append(flags, "spent")
+	}
+	if o.Unspent {
+		flags = append(flags, "unspent")
+	}
+	query := url.Values{}
+	slotFilters := []struct {
+		name   string
+		slotNo int
+	}{
+		{"created_after", o.CreatedAfter},
+		{"created_before", o.CreatedBefore},
+		{"spent_after", o.SpentAfter},
+		{"spent_before", o.SpentBefore},
+	}
+	for _, filter := range slotFilters {
+		if filter.slotNo > 0 {
+			query.Set(filter.name, strconv.Itoa(filter.slotNo))
+		}
+	}
+	if o.PolicyID != "" {
+		query.Set("policy_id", o.PolicyID)
+	}
+	if o.AssetName != "" {
+		query.Set("asset_name", o.AssetName)
+	}
+	if o.TransactionID != "" {
+		query.Set("transaction_id", o.TransactionID)
+	}
+	if o.Order != "" {
+		query.Set("order", string(o.Order))
+	}
+	if encoded := query.Encode(); encoded != "" {
+		flags = append(flags, encoded)
+	}
+	return strings.Join(flags, "&")
+}
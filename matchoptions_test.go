@@ -0,0 +1,38 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMatchOptions_TimeBounds(t *testing.T) {
+	var query string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.RawQuery
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL, WithNetwork(NetworkPreprod))
+	zero := NetworkPreprod.SlotConfig.ZeroTime
+	opts := MatchOptions{
+		// Half way through slot 86500
+		CreatedAfterTime: zero.Add(100*time.Second + 500*time.Millisecond),
+		// At the start of slot 86600, which is excluded
+		CreatedBeforeTime: zero.Add(200 * time.Second),
+		// Stricter than the time bound
+		CreatedBefore: 86550,
+		// Half way through slot 86700, which is included
+		SpentBeforeTime: zero.Add(300*time.Second + 500*time.Millisecond),
+	}
+	if _, err := client.GetMatchesWithOptions("*", opts); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := "created_after=86500&created_before=86550&spent_before=86701"
+	if query != expected {
+		t.Errorf("Expected query %s, got %s", expected, query)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const matchSnapshotVersion = 1
+
+// matchSnapshot is the on-disk format written by SaveMatches
+type matchSnapshot struct {
+	Version    int
+	Checkpoint Point
+	Matches    Matches
+}
+
+// SaveMatches writes matches along with the checkpoint they were read at in a
+// compact binary (gob) format
+func SaveMatches(w io.Writer, checkpoint Point, matches Matches) error {
+	err := gob.NewEncoder(w).Encode(
+		matchSnapshot{
+			Version:    matchSnapshotVersion,
+			Checkpoint: checkpoint,
+			Matches:    matches,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to encode matches: %s", err)
+	}
+	return nil
+}
+
+// LoadMatches reads matches and their checkpoint written by SaveMatches
+func LoadMatches(r io.Reader) (Point, Matches, error) {
+	var snapshot matchSnapshot
+	if err := gob.NewDecoder(r).Decode(&snapshot); err != nil {
+		return Point{}, nil, fmt.Errorf("failed to decode matches: %s", err)
+	}
+	if snapshot.Version != matchSnapshotVersion {
+		return Point{}, nil, fmt.Errorf(
+			"unsupported match snapshot version %d",
+			snapshot.Version,
+		)
+	}
+	return snapshot.Checkpoint, snapshot.Matches, nil
+}
+
+// SaveMatchesFile writes matches to a file, replacing it atomically so a crash
+// never leaves a partially written snapshot behind
+func SaveMatchesFile(path string, checkpoint Point, matches Matches) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %s", err)
+	}
+	defer os.Remove(f.Name())
+	if err := SaveMatches(f, checkpoint, matches); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to sync snapshot file: %s", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close snapshot file: %s", err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("failed to rename snapshot file: %s", err)
+	}
+	return nil
+}
+
+// LoadMatchesFile reads matches from a file written by SaveMatchesFile
+func LoadMatchesFile(path string) (Point, Matches, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Point{}, nil, fmt.Errorf("failed to open snapshot file: %s", err)
+	}
+	defer f.Close()
+	return LoadMatches(f)
+}
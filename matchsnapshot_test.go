@@ -0,0 +1,37 @@
+package kupogo
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSaveLoadMatchesFile(t *testing.T) {
+	datumHash := "abcd"
+	checkpoint := Point{SlotNo: 1234, HeaderHash: "ff"}
+	matches := Matches{
+		{
+			TransactionID: "aa",
+			OutputIndex:   1,
+			Address:       "addr1",
+			Value:         Value{Coins: 10, Assets: Assets{"bb.cc": 2}},
+			DatumHash:     &datumHash,
+			CreatedAt:     Point{SlotNo: 1000, HeaderHash: "ee"},
+			SpentAt:       &Point{SlotNo: 1200, HeaderHash: "dd"},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "matches.snapshot")
+	if err := SaveMatchesFile(path, checkpoint, matches); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	loadedCheckpoint, loadedMatches, err := LoadMatchesFile(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if loadedCheckpoint != checkpoint {
+		t.Errorf("Expected checkpoint %v, got %v", checkpoint, loadedCheckpoint)
+	}
+	if !reflect.DeepEqual(loadedMatches, matches) {
+		t.Errorf("Expected matches %v, got %v", matches, loadedMatches)
+	}
+}
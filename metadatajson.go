@@ -0,0 +1,104 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// JSON converts the metadata from Kupo's detailed schema into plain JSON
+// values keyed by label. Integers are json.Number to keep their precision,
+// byte strings are "0x" prefixed hex and map keys which are not strings are
+// their JSON encoding
+func (m MetadataItem) JSON() (map[string]any, error) {
+	var labels map[string]json.RawMessage
+	if err := json.Unmarshal(m.Schema, &labels); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata schema: %s", err)
+	}
+	ret := make(map[string]any, len(labels))
+	for label, schema := range labels {
+		value, err := DetailedSchemaToJSON(schema)
+		if err != nil {
+			return nil, fmt.Errorf("label %s: %s", label, err)
+		}
+		ret[label] = value
+	}
+	return ret, nil
+}
+
+type detailedSchema struct {
+	Int    *json.Number      `json:"int"`
+	String *string           `json:"string"`
+	Bytes  *string           `json:"bytes"`
+	List   []json.RawMessage `json:"list"`
+	Map    []struct {
+		K json.RawMessage `json:"k"`
+		V json.RawMessage `json:"v"`
+	} `json:"map"`
+}
+
+// DetailedSchemaToJSON converts one metadatum in the detailed schema, such as
+// {"map":[{"k":{"string":"a"},"v":{"int":1}}]}, into a plain JSON value
+func DetailedSchemaToJSON(data json.RawMessage) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var schema detailedSchema
+	if err := decoder.Decode(&schema); err != nil {
+		return nil, fmt.Errorf("failed to decode metadatum: %s", err)
+	}
+	switch {
+	case schema.Int != nil:
+		return *schema.Int, nil
+	case schema.String != nil:
+		return *schema.String, nil
+	case schema.Bytes != nil:
+		return "0x" + *schema.Bytes, nil
+	case schema.List != nil:
+		ret := make([]any, 0, len(schema.List))
+		for _, item := range schema.List {
+			value, err := DetailedSchemaToJSON(item)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, value)
+		}
+		return ret, nil
+	case schema.Map != nil:
+		ret := make(map[string]any, len(schema.Map))
+		for _, entry := range schema.Map {
+			key, err := DetailedSchemaToJSON(entry.K)
+			if err != nil {
+				return nil, err
+			}
+			value, err := DetailedSchemaToJSON(entry.V)
+			if err != nil {
+				return nil, err
+			}
+			keyString, ok := key.(string)
+			if !ok {
+				keyJSON, err := json.Marshal(key)
+				if err != nil {
+					return nil, err
+				}
+				keyString = string(keyJSON)
+			}
+			ret[keyString] = value
+		}
+		return ret, nil
+	}
+	return nil, fmt.Errorf("unknown metadatum: %s", data)
+}
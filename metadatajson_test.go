@@ -0,0 +1,34 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMetadataItemJSON(t *testing.T) {
+	item := MetadataItem{
+		Schema: json.RawMessage(`{
+			"674": {"map": [
+				{"k": {"string": "msg"}, "v": {"list": [{"string": "hello"}, {"bytes": "beef"}]}},
+				{"k": {"int": 1}, "v": {"int": 18446744073709551616}}
+			]}
+		}`),
+	}
+	value, err := item.JSON()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := map[string]any{
+		"674": map[string]any{
+			"msg": []any{"hello", "0xbeef"},
+			"1":   json.Number("18446744073709551616"),
+		},
+	}
+	if !reflect.DeepEqual(value, expected) {
+		t.Fatalf("Expected %v, got %v", expected, value)
+	}
+	if _, err := DetailedSchemaToJSON(json.RawMessage(`{"float": 1.5}`)); err == nil {
+		t.Fatalf("Expected error for unknown metadatum")
+	}
+}
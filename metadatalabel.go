@@ -0,0 +1,119 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Well known transaction metadata labels
+const (
+	// MetadataLabelMessage is the CIP-20 transaction message label
+	MetadataLabelMessage = 674
+	// MetadataLabelNFT is the CIP-25 NFT metadata label
+	MetadataLabelNFT = 721
+	// MetadataLabelNutLinkOracle is the nut.link oracle data label
+	MetadataLabelNutLinkOracle = 1967
+)
+
+// Labels returns the labels present in the metadata, in ascending order
+func (m MetadataItem) Labels() ([]int, error) {
+	var labels map[string]json.RawMessage
+	if err := json.Unmarshal(m.Schema, &labels); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata schema: %s", err)
+	}
+	ret := make([]int, 0, len(labels))
+	for label := range labels {
+		labelNo, err := strconv.Atoi(label)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metadata label: %s", label)
+		}
+		ret = append(ret, labelNo)
+	}
+	sort.Ints(ret)
+	return ret, nil
+}
+
+// Label returns the value under a label converted to plain JSON values as
+// described for MetadataItem.JSON. The boolean is false if the label is absent
+func (m MetadataItem) Label(label int) (any, bool, error) {
+	var labels map[string]json.RawMessage
+	if err := json.Unmarshal(m.Schema, &labels); err != nil {
+		return nil, false, fmt.Errorf("failed to decode metadata schema: %s", err)
+	}
+	schema, ok := labels[strconv.Itoa(label)]
+	if !ok {
+		return nil, false, nil
+	}
+	value, err := DetailedSchemaToJSON(schema)
+	if err != nil {
+		return nil, false, fmt.Errorf("label %d: %s", label, err)
+	}
+	return value, true, nil
+}
+
+// DecodeLabel decodes the value under a label into v, which is populated as
+// if from the plain JSON form returned by Label. The boolean is false if the
+// label is absent
+func (m MetadataItem) DecodeLabel(label int, v any) (bool, error) {
+	value, ok, err := m.Label(label)
+	if err != nil || !ok {
+		return ok, err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode label %d: %s", label, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("failed to decode label %d: %s", label, err)
+	}
+	return true, nil
+}
+
+// Label returns the values under a label across all metadata items,
+// skipping those without it
+func (m Metadata) Label(label int) ([]any, error) {
+	var ret []any
+	for _, item := range m {
+		value, ok, err := item.Label(label)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			ret = append(ret, value)
+		}
+	}
+	return ret, nil
+}
+
+// DecodeMetadataLabel decodes the values under a label across all metadata
+// items into T, skipping those without it
+func DecodeMetadataLabel[T any](metadata Metadata, label int) ([]T, error) {
+	var ret []T
+	for _, item := range metadata {
+		var value T
+		ok, err := item.DecodeLabel(label, &value)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			ret = append(ret, value)
+		}
+	}
+	return ret, nil
+}
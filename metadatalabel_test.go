@@ -0,0 +1,43 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMetadataLabels(t *testing.T) {
+	metadata := Metadata{
+		{Schema: json.RawMessage(`{"1967": {"int": 5}, "674": {"map": [{"k": {"string": "msg"}, "v": {"list": [{"string": "hi"}]}}]}}`)},
+		{Schema: json.RawMessage(`{"721": {"map": []}}`)},
+		{Schema: json.RawMessage(`{"674": {"map": [{"k": {"string": "msg"}, "v": {"list": [{"string": "bye"}]}}]}}`)},
+	}
+	labels, err := metadata[0].Labels()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(labels, []int{MetadataLabelMessage, MetadataLabelNutLinkOracle}) {
+		t.Fatalf("Unexpected labels: %v", labels)
+	}
+	values, err := metadata.Label(MetadataLabelNutLinkOracle)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(values, []any{json.Number("5")}) {
+		t.Fatalf("Unexpected values: %v", values)
+	}
+	type message struct {
+		Msg []string `json:"msg"`
+	}
+	messages, err := DecodeMetadataLabel[message](metadata, MetadataLabelMessage)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := []message{{Msg: []string{"hi"}}, {Msg: []string{"bye"}}}
+	if !reflect.DeepEqual(messages, expected) {
+		t.Fatalf("Expected %v, got %v", expected, messages)
+	}
+	if _, err := DecodeMetadataLabel[int](metadata, MetadataLabelMessage); err == nil {
+		t.Fatalf("Expected error decoding into the wrong type")
+	}
+}
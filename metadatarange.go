@@ -0,0 +1,122 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+const defaultMetadataConcurrency = 8
+
+// TransactionMetadata is the metadata of a single transaction
+type TransactionMetadata struct {
+	TransactionID string
+	SlotNo        int
+	Metadata      Metadata
+}
+
+// GetMetadataRange fetches the metadata of the transactions which created
+// outputs matching pattern between fromSlot and toSlot, inclusive. An empty
+// pattern matches every output indexed by Kupo. Up to concurrency requests
+// are made at once, defaulting to 8. Transactions without metadata are
+// omitted, and results are ordered by slot
+func (c *Client) GetMetadataRange(
+	pattern string,
+	fromSlot int,
+	toSlot int,
+	concurrency int,
+) ([]TransactionMetadata, error) {
+	return c.GetMetadataRangeContext(context.Background(), pattern, fromSlot, toSlot, concurrency)
+}
+
+// GetMetadataRangeContext is like GetMetadataRange with a request context
+func (c *Client) GetMetadataRangeContext(
+	ctx context.Context,
+	pattern string,
+	fromSlot int,
+	toSlot int,
+	concurrency int,
+) ([]TransactionMetadata, error) {
+	if pattern == "" {
+		pattern = "*"
+	}
+	if concurrency <= 0 {
+		concurrency = defaultMetadataConcurrency
+	}
+	opts := MatchOptions{
+		CreatedBefore: toSlot + 1,
+		Order:         MatchOrderOldestFirst,
+	}
+	if fromSlot > 0 {
+		opts.CreatedAfter = fromSlot - 1
+	}
+	matches, _, err := c.getMatches(ctx, pattern, opts)
+	if err != nil {
+		return nil, err
+	}
+	// Several outputs of a transaction may match, but its metadata is only
+	// fetched once
+	var txs []TransactionMetadata
+	seen := make(map[string]bool)
+	for _, match := range *matches {
+		if seen[match.TransactionID] {
+			continue
+		}
+		seen[match.TransactionID] = true
+		txs = append(txs, TransactionMetadata{
+			TransactionID: match.TransactionID,
+			SlotNo:        match.CreatedAt.SlotNo,
+		})
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var firstErr error
+	sem := make(chan struct{}, concurrency)
+	for i := range txs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(tx *TransactionMetadata) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			metadata, err := c.GetMetadataContext(ctx, tx.SlotNo, tx.TransactionID)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			tx.Metadata = *metadata
+		}(&txs[i])
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	ret := make([]TransactionMetadata, 0, len(txs))
+	for _, tx := range txs {
+		if len(tx.Metadata) > 0 {
+			ret = append(ret, tx)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].SlotNo < ret[j].SlotNo
+	})
+	return ret, nil
+}
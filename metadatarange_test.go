@@ -0,0 +1,42 @@
+package kupogo
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetMetadataRange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/matches/*":
+			if r.URL.Query().Get("created_after") != "9" || r.URL.Query().Get("created_before") != "21" {
+				t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`[
+				{"transaction_id":"aa","output_index":0,"address":"addr1","value":{"coins":1},"created_at":{"slot_no":10,"header_hash":"h"}},
+				{"transaction_id":"aa","output_index":1,"address":"addr1","value":{"coins":1},"created_at":{"slot_no":10,"header_hash":"h"}},
+				{"transaction_id":"bb","output_index":0,"address":"addr1","value":{"coins":1},"created_at":{"slot_no":20,"header_hash":"h"}}
+			]`))
+		case "/metadata/10":
+			if r.URL.Query().Get("transaction_id") != "aa" {
+				t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `[{"hash":"mh","raw":"a0","schema":{"674":{"string":"hi"}}}]`)
+		case "/metadata/20":
+			fmt.Fprint(w, `[]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+	txs, err := client.GetMetadataRange("", 10, 20, 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(txs) != 1 || txs[0].TransactionID != "aa" || txs[0].SlotNo != 10 || txs[0].Metadata[0].Hash != "mh" {
+		t.Fatalf("Unexpected result: %+v", txs)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrTransactionNotFound is returned when the slot of a transaction cannot be
+// located because none of its outputs match a pattern indexed by Kupo
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// GetMetadataForTransaction returns the metadata of a transaction and the slot
+// it was found in. The slot is located from an output created by the
+// transaction, which must match a pattern indexed by Kupo
+func (c *Client) GetMetadataForTransaction(txId string) (*Metadata, int, error) {
+	return c.GetMetadataForTransactionContext(context.Background(), txId)
+}
+
+// GetMetadataForTransactionContext is like GetMetadataForTransaction with a
+// request context
+func (c *Client) GetMetadataForTransactionContext(
+	ctx context.Context,
+	txId string,
+) (*Metadata, int, error) {
+	matches, _, err := c.getMatches(ctx, MatchTransaction(txId).String(), MatchOptions{})
+	if err != nil {
+		return nil, -1, err
+	}
+	if len(*matches) == 0 {
+		return nil, -1, ErrTransactionNotFound
+	}
+	slotNo := (*matches)[0].CreatedAt.SlotNo
+	metadata, err := c.GetMetadataContext(ctx, slotNo, txId)
+	if err != nil {
+		return nil, -1, err
+	}
+	return metadata, slotNo, nil
+}
@@ -0,0 +1,39 @@
+package kupogo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetMetadataForTransaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/matches/*@aa":
+			fmt.Fprint(w, `[{"transaction_id":"aa","output_index":0,"address":"addr1","value":{"coins":1},"created_at":{"slot_no":42,"header_hash":"h"}}]`)
+		case "/matches/*@bb":
+			fmt.Fprint(w, `[]`)
+		case "/metadata/42":
+			if r.URL.Query().Get("transaction_id") != "aa" {
+				t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `[{"hash":"mh","raw":"a0","schema":{"674":{"string":"hi"}}}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+	metadata, slotNo, err := client.GetMetadataForTransaction("aa")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if slotNo != 42 || len(*metadata) != 1 || (*metadata)[0].Hash != "mh" {
+		t.Fatalf("Unexpected result: %d %v", slotNo, metadata)
+	}
+	if _, _, err := client.GetMetadataForTransaction("bb"); !errors.Is(err, ErrTransactionNotFound) {
+		t.Fatalf("Expected ErrTransactionNotFound, got %v", err)
+	}
+}
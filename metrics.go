@@ -0,0 +1,131 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Metrics holds Kupo's operational metrics, as exposed in Prometheus text
+// format by its health endpoint
+type Metrics struct {
+	// ConnectionStatus is 1 when Kupo is connected to its node
+	ConnectionStatus float64
+	// MostRecentCheckpoint is the slot of the most recent indexed block
+	MostRecentCheckpoint float64
+	// MostRecentNodeTip is the slot of the node's tip
+	MostRecentNodeTip float64
+	// NetworkSynchronization is the sync progress, from 0 to 1
+	NetworkSynchronization float64
+	// ConfigurationIndexes is 1 when database indexes are installed
+	ConfigurationIndexes float64
+	// All holds every sample by metric name, including labels if any, e.g.
+	// `name{label="value"}`
+	All map[string]float64
+}
+
+// GetMetrics fetches and parses Kupo's Prometheus metrics
+func (c *Client) GetMetrics() (*Metrics, error) {
+	return c.GetMetricsContext(context.Background())
+}
+
+// GetMetricsContext is like GetMetrics with a request context
+func (c *Client) GetMetricsContext(ctx context.Context) (*Metrics, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/health", c.KupoUrl),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	req.Header.Set("Accept", "text/plain")
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metrics: %s", err)
+	}
+	defer resp.Body.Close()
+	// Kupo answers with 503 when it is not yet synchronized, still including
+	// the metrics
+	if resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusServiceUnavailable {
+		return nil, fmt.Errorf(
+			"failed to get metrics: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	metrics, err := ParseMetrics(resp.Body)
+	if err != nil {
+		c.logDecodeFailure(req, err)
+		return nil, err
+	}
+	return metrics, nil
+}
+
+// ParseMetrics parses metrics in Prometheus text exposition format
+func ParseMetrics(r io.Reader) (*Metrics, error) {
+	metrics := &Metrics{All: make(map[string]float64)}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// Split the value from the name, which may include labels with spaces
+		nameEnd := strings.LastIndex(line, "}")
+		if nameEnd < 0 {
+			nameEnd = strings.IndexAny(line, " \t")
+		} else {
+			nameEnd++
+		}
+		if nameEnd <= 0 || nameEnd >= len(line) {
+			return nil, fmt.Errorf("failed to parse metric line: %q", line)
+		}
+		name := line[:nameEnd]
+		fields := strings.Fields(line[nameEnd:])
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("failed to parse metric line: %q", line)
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse metric value: %s", err)
+		}
+		metrics.All[name] = value
+		switch name {
+		case "kupo_connection_status":
+			metrics.ConnectionStatus = value
+		case "kupo_most_recent_checkpoint":
+			metrics.MostRecentCheckpoint = value
+		case "kupo_most_recent_node_tip":
+			metrics.MostRecentNodeTip = value
+		case "kupo_network_synchronization":
+			metrics.NetworkSynchronization = value
+		case "kupo_configuration_indexes":
+			metrics.ConfigurationIndexes = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read metrics: %s", err)
+	}
+	return metrics, nil
+}
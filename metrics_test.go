@@ -0,0 +1,49 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testMetrics = `# TYPE kupo_connection_status gauge
+kupo_connection_status  1.0
+# TYPE kupo_most_recent_checkpoint counter
+kupo_most_recent_checkpoint  104366000
+# TYPE kupo_most_recent_node_tip counter
+kupo_most_recent_node_tip  104366020
+# TYPE kupo_network_synchronization gauge
+kupo_network_synchronization  0.99999
+# TYPE kupo_configuration_indexes gauge
+kupo_configuration_indexes  1.0
+kupo_custom{kind="a b"} 3 1700000000000
+`
+
+func TestClient_GetMetrics(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/health" || r.Header.Get("Accept") != "text/plain" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte(testMetrics))
+		}),
+	)
+	defer server.Close()
+
+	metrics, err := NewClient(server.URL).GetMetrics()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if metrics.ConnectionStatus != 1 ||
+		metrics.MostRecentCheckpoint != 104366000 ||
+		metrics.MostRecentNodeTip != 104366020 ||
+		metrics.NetworkSynchronization != 0.99999 ||
+		metrics.ConfigurationIndexes != 1 {
+		t.Errorf("Unexpected metrics: %+v", metrics)
+	}
+	if metrics.All[`kupo_custom{kind="a b"}`] != 3 {
+		t.Errorf("Expected labelled metric to be parsed, got %v", metrics.All)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"net/http"
+)
+
+// Middleware wraps the transport used for requests, to add behavior such as
+// authentication, metrics, caching or fault injection
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a function to an http.RoundTripper
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// WithMiddleware wraps the client's transport with the given middleware. The
+// first middleware is the outermost, seeing each request first and each
+// response last
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, middleware...)
+	}
+}
+
+// applyMiddleware replaces the HTTP client with a copy whose transport is
+// wrapped by the given middleware
+func (c *Client) applyMiddleware(middleware []Middleware) {
+	if len(middleware) == 0 {
+		return
+	}
+	base := c.httpClient
+	if base == nil {
+		base = defaultHTTPClient
+	}
+	httpClient := *base
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	for i := len(middleware) - 1; i >= 0; i-- {
+		transport = middleware[i](transport)
+	}
+	httpClient.Transport = transport
+	c.httpClient = &httpClient
+}
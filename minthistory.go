@@ -0,0 +1,132 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// MintActivity is the quantity of an asset minted and burned within a window
+// of slots
+type MintActivity struct {
+	Asset AssetID
+	// FromSlot and ToSlot bound the window, inclusive
+	FromSlot int
+	ToSlot   int
+	// Epoch is the epoch of the window when windows are epochs
+	Epoch  int
+	Minted int
+	Burned int
+}
+
+// MintHistoryOptions configures GetMintHistory
+type MintHistoryOptions struct {
+	// FromSlot and ToSlot bound the history, inclusive. ToSlot defaults to
+	// Kupo's most recent checkpoint
+	FromSlot int
+	ToSlot   int
+	// Window is the number of slots per reported window. Windows are the
+	// epochs of the client's network if zero
+	Window int
+}
+
+// GetMintHistory reports the quantities of the assets of a policy minted and
+// burned per window, oldest first. Quantities are derived from the net change
+// of each asset's quantity in each block, found with slot-bounded queries for
+// the outputs created and spent within the history
+func (c *Client) GetMintHistory(policyID string, opts MintHistoryOptions) ([]MintActivity, error) {
+	return c.GetMintHistoryContext(context.Background(), policyID, opts)
+}
+
+// GetMintHistoryContext is like GetMintHistory with a request context
+func (c *Client) GetMintHistoryContext(
+	ctx context.Context,
+	policyID string,
+	opts MintHistoryOptions,
+) ([]MintActivity, error) {
+	if opts.ToSlot <= 0 {
+		checkpoints, err := c.GetCheckpointsContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(*checkpoints) == 0 {
+			return nil, errors.New("no checkpoint to end history at")
+		}
+		opts.ToSlot = (*checkpoints)[0].SlotNo
+	}
+	pattern := MatchPolicy(policyID)
+	created, _, err := c.getMatches(ctx, string(pattern), MatchOptions{
+		CreatedAfter:  opts.FromSlot - 1,
+		CreatedBefore: opts.ToSlot + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	spent, _, err := c.getMatches(ctx, string(pattern), MatchOptions{
+		Spent:       true,
+		SpentAfter:  opts.FromSlot - 1,
+		SpentBefore: opts.ToSlot + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	matches := append(*created, *spent...).Dedup()
+	network := c.Network()
+	type windowKey struct {
+		asset AssetID
+		start int
+	}
+	windows := make(map[windowKey]*MintActivity)
+	for asset, deltas := range assetDeltas(matches, pattern.MatchesAsset) {
+		for slotNo, delta := range deltas {
+			if slotNo < opts.FromSlot || slotNo > opts.ToSlot || delta == 0 {
+				continue
+			}
+			activity := MintActivity{Asset: asset}
+			if opts.Window > 0 {
+				activity.FromSlot = opts.FromSlot + (slotNo-opts.FromSlot)/opts.Window*opts.Window
+				activity.ToSlot = activity.FromSlot + opts.Window - 1
+			} else {
+				activity.Epoch, _ = network.SlotToEpoch(slotNo)
+				activity.FromSlot = network.EpochToSlot(activity.Epoch)
+				activity.ToSlot = network.EpochToSlot(activity.Epoch+1) - 1
+			}
+			key := windowKey{asset: asset, start: activity.FromSlot}
+			window, ok := windows[key]
+			if !ok {
+				window = &activity
+				windows[key] = window
+			}
+			if delta > 0 {
+				window.Minted += delta
+			} else {
+				window.Burned -= delta
+			}
+		}
+	}
+	ret := make([]MintActivity, 0, len(windows))
+	for _, window := range windows {
+		ret = append(ret, *window)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].FromSlot != ret[j].FromSlot {
+			return ret[i].FromSlot < ret[j].FromSlot
+		}
+		return ret[i].Asset < ret[j].Asset
+	})
+	return ret, nil
+}
@@ -0,0 +1,77 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestGetMintHistory(t *testing.T) {
+	asset := testPolicyID + ".01"
+	matches := Matches{
+		// Minted in slot 5, before the history
+		{TransactionID: "t0", Value: Value{Assets: Assets{asset: 10}}, CreatedAt: Point{SlotNo: 5}, SpentAt: &Point{SlotNo: 150}},
+		// 10 more minted in slot 150 alongside a transfer
+		{TransactionID: "t1", Value: Value{Assets: Assets{asset: 20}}, CreatedAt: Point{SlotNo: 150}, SpentAt: &Point{SlotNo: 250}},
+		// 5 burned in slot 250
+		{TransactionID: "t2", Value: Value{Assets: Assets{asset: 15}}, CreatedAt: Point{SlotNo: 250}},
+		// Minted after the history
+		{TransactionID: "t3", Value: Value{Assets: Assets{asset: 1}}, CreatedAt: Point{SlotNo: 400}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			bound := func(name string, fallback int) int {
+				if value, err := strconv.Atoi(query.Get(name)); err == nil {
+					return value
+				}
+				return fallback
+			}
+			ret := Matches{}
+			for _, match := range matches {
+				if query.Has("spent") {
+					if match.SpentAt != nil && match.SpentAt.SlotNo > bound("spent_after", -1) &&
+						match.SpentAt.SlotNo < bound("spent_before", 1<<30) {
+						ret = append(ret, match)
+					}
+				} else if match.CreatedAt.SlotNo > bound("created_after", -1) &&
+					match.CreatedAt.SlotNo < bound("created_before", 1<<30) {
+					ret = append(ret, match)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(ret)
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	history, err := client.GetMintHistory(testPolicyID, MintHistoryOptions{FromSlot: 100, ToSlot: 299, Window: 100})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := []MintActivity{
+		{Asset: AssetID(asset), FromSlot: 100, ToSlot: 199, Minted: 10},
+		{Asset: AssetID(asset), FromSlot: 200, ToSlot: 299, Burned: 5},
+	}
+	if !reflect.DeepEqual(history, expected) {
+		t.Fatalf("Unexpected history %+v", history)
+	}
+
+	network := Network{SlotConfig: SlotConfig{ZeroSlot: 0}, ShelleyStartEpoch: 10, EpochLength: 200}
+	client = NewClient(server.URL, WithNetwork(network))
+	history, err = client.GetMintHistory(testPolicyID, MintHistoryOptions{ToSlot: 500})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected = []MintActivity{
+		{Asset: AssetID(asset), FromSlot: 0, ToSlot: 199, Epoch: 10, Minted: 20},
+		{Asset: AssetID(asset), FromSlot: 200, ToSlot: 399, Epoch: 11, Burned: 5},
+		{Asset: AssetID(asset), FromSlot: 400, ToSlot: 599, Epoch: 12, Minted: 1},
+	}
+	if !reflect.DeepEqual(history, expected) {
+		t.Fatalf("Unexpected epoch history %+v", history)
+	}
+}
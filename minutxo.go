@@ -0,0 +1,151 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import "sort"
+
+// OutputShape describes the parts of a transaction output which determine its
+// serialized size, and therefore its minimum lovelace
+type OutputShape struct {
+	// AddressLength is the length of the address in bytes, defaulting to the
+	// 57 bytes of a base address
+	AddressLength int
+	Assets        Assets
+	// DatumHash is set if the output carries a datum hash
+	DatumHash bool
+	// InlineDatumSize is the length in bytes of an inline datum, if any
+	InlineDatumSize int
+	// ScriptRefSize is the length in bytes of a reference script, if any
+	ScriptRefSize int
+}
+
+const (
+	// minUTxOOverhead is the constant overhead in bytes added to the size of
+	// an output in the Babbage minimum lovelace calculation
+	minUTxOOverhead      = 160
+	defaultAddressLength = 57
+	policyIDLength       = 28
+	datumHashLength      = 32
+	cborTagEmbeddedCBOR  = 24
+)
+
+// MinLovelace returns the minimum lovelace required for an output at a base
+// address carrying the given assets, as per the Babbage ledger rules
+func MinLovelace(coinsPerUTxOByte int, assets Assets) int {
+	return MinLovelaceForOutput(coinsPerUTxOByte, OutputShape{Assets: assets})
+}
+
+// MinLovelaceForOutput returns the minimum lovelace required for an output of
+// the given shape, as per the Babbage ledger rules
+func MinLovelaceForOutput(coinsPerUTxOByte int, shape OutputShape) int {
+	// The size of the lovelace amount itself depends on the result, so we
+	// iterate until the amount no longer grows
+	coins := 0
+	for i := 0; i < 5; i++ {
+		required := (minUTxOOverhead + shape.size(coins)) * coinsPerUTxOByte
+		if required <= coins {
+			break
+		}
+		coins = required
+	}
+	return coins
+}
+
+// size returns the CBOR serialized size of the output. Outputs without an
+// inline datum or reference script use the more compact legacy array format
+func (s OutputShape) size(coins int) int {
+	addressLength := s.AddressLength
+	if addressLength == 0 {
+		addressLength = defaultAddressLength
+	}
+	valueSize := cborHeadSize(uint64(coins))
+	if len(s.Assets) > 0 {
+		valueSize += 1 + s.multiAssetSize()
+	}
+	if s.InlineDatumSize == 0 && s.ScriptRefSize == 0 {
+		size := 1 + cborBytesSize(addressLength) + valueSize
+		if s.DatumHash {
+			size += cborBytesSize(datumHashLength)
+		}
+		return size
+	}
+	// Post-Alonzo map format, always with the address and value keys
+	size := 1 + cborBytesSize(addressLength) + 1 + valueSize
+	keys := 2
+	if s.InlineDatumSize > 0 {
+		keys++
+		// [1, #6.24(bytes)]
+		size += 1 + 1 + 1 + cborHeadSize(cborTagEmbeddedCBOR) + cborBytesSize(s.InlineDatumSize)
+	} else if s.DatumHash {
+		keys++
+		// [0, hash]
+		size += 1 + 1 + 1 + cborBytesSize(datumHashLength)
+	}
+	if s.ScriptRefSize > 0 {
+		keys++
+		// #6.24(bytes)
+		size += 1 + cborHeadSize(cborTagEmbeddedCBOR) + cborBytesSize(s.ScriptRefSize)
+	}
+	return cborHeadSize(uint64(keys)) + size
+}
+
+func (s OutputShape) multiAssetSize() int {
+	policies := make(map[string][]string)
+	for asset := range s.Assets {
+		assetID := AssetID(asset)
+		policies[assetID.PolicyID()] = append(
+			policies[assetID.PolicyID()],
+			assetID.AssetName(),
+		)
+	}
+	size := cborHeadSize(uint64(len(policies)))
+	policyIDs := make([]string, 0, len(policies))
+	for policyID := range policies {
+		policyIDs = append(policyIDs, policyID)
+	}
+	sort.Strings(policyIDs)
+	for _, policyID := range policyIDs {
+		assetNames := policies[policyID]
+		size += cborBytesSize(policyIDLength)
+		size += cborHeadSize(uint64(len(assetNames)))
+		for _, assetName := range assetNames {
+			size += cborBytesSize(len(assetName) / 2)
+			quantity := s.Assets[string(NewAssetID(policyID, assetName))]
+			size += cborHeadSize(uint64(quantity))
+		}
+	}
+	return size
+}
+
+// cborHeadSize returns the size of a CBOR item head carrying the argument
+func cborHeadSize(arg uint64) int {
+	switch {
+	case arg < 24:
+		return 1
+	case arg <= 0xff:
+		return 2
+	case arg <= 0xffff:
+		return 3
+	case arg <= 0xffffffff:
+		return 5
+	default:
+		return 9
+	}
+}
+
+// cborBytesSize returns the size of a CBOR byte string of the given length
+func cborBytesSize(length int) int {
+	return cborHeadSize(uint64(length)) + length
+}
@@ -0,0 +1,46 @@
+package kupogo
+
+import "testing"
+
+func TestMinLovelace(t *testing.T) {
+	const coinsPerUTxOByte = 4310
+	testDefs := []struct {
+		name     string
+		shape    OutputShape
+		expected int
+	}{
+		{
+			name:     "pure ADA",
+			shape:    OutputShape{},
+			expected: 969750,
+		},
+		{
+			name: "single asset",
+			shape: OutputShape{
+				Assets: Assets{
+					"4fc6bb0c93780ad706425d9f7dc1d3c5e3ddbf29ba8486dce904a5fc.4d794e4654": 1,
+				},
+			},
+			expected: 1142150,
+		},
+		{
+			name:     "inline datum",
+			shape:    OutputShape{InlineDatumSize: 3},
+			expected: 1017160,
+		},
+	}
+	for _, testDef := range testDefs {
+		minLovelace := MinLovelaceForOutput(coinsPerUTxOByte, testDef.shape)
+		if minLovelace != testDef.expected {
+			t.Errorf(
+				"%s: expected min lovelace %d, got %d",
+				testDef.name,
+				testDef.expected,
+				minLovelace,
+			)
+		}
+	}
+	if MinLovelace(coinsPerUTxOByte, nil) != 969750 {
+		t.Errorf("Expected MinLovelace to assume a base address")
+	}
+}
@@ -0,0 +1,525 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mirror keeps a local copy of the unspent outputs matching a set of
+// patterns in a SQL database, indexed by address, policy, asset and datum
+// hash, so balance and UTxO queries can be answered without calling Kupo.
+//
+// Like sqlitecache, the package does not import a database driver: open the
+// database with the SQLite driver of your choice and pass the *sql.DB to New
+package mirror
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+var ErrNoCheckpoint = errors.New("no checkpoints available")
+
+const schema = `
+CREATE TABLE IF NOT EXISTS mirror_patterns (
+	pattern TEXT PRIMARY KEY,
+	slot_no INTEGER NOT NULL,
+	header_hash TEXT NOT NULL,
+	synced_at INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS mirror_members (
+	pattern TEXT NOT NULL,
+	transaction_id TEXT NOT NULL,
+	output_index INTEGER NOT NULL,
+	PRIMARY KEY (pattern, transaction_id, output_index)
+);
+CREATE INDEX IF NOT EXISTS mirror_members_output
+	ON mirror_members (transaction_id, output_index);
+CREATE TABLE IF NOT EXISTS mirror_outputs (
+	transaction_id TEXT NOT NULL,
+	output_index INTEGER NOT NULL,
+	address TEXT NOT NULL,
+	coins INTEGER NOT NULL,
+	datum_hash TEXT,
+	data BLOB NOT NULL,
+	PRIMARY KEY (transaction_id, output_index)
+);
+CREATE INDEX IF NOT EXISTS mirror_outputs_address ON mirror_outputs (address);
+CREATE INDEX IF NOT EXISTS mirror_outputs_datum_hash ON mirror_outputs (datum_hash);
+CREATE TABLE IF NOT EXISTS mirror_assets (
+	transaction_id TEXT NOT NULL,
+	output_index INTEGER NOT NULL,
+	policy_id TEXT NOT NULL,
+	asset_id TEXT NOT NULL,
+	quantity INTEGER NOT NULL,
+	PRIMARY KEY (transaction_id, output_index, asset_id)
+);
+CREATE INDEX IF NOT EXISTS mirror_assets_policy_id ON mirror_assets (policy_id);
+CREATE INDEX IF NOT EXISTS mirror_assets_asset_id ON mirror_assets (asset_id);
+`
+
+// Config controls which patterns a Mirror follows and how often it syncs
+type Config struct {
+	// Patterns to mirror. Outputs matching more than one pattern are stored
+	// once
+	Patterns []string
+	// Interval between syncs when running. Defaults to 10 seconds
+	Interval time.Duration
+	// OnSync is called after every successful sync with the checkpoint the
+	// mirror caught up to
+	OnSync func(kupogo.Point)
+	// OnError is called with sync errors when running
+	OnError func(error)
+}
+
+// Mirror is a local, indexed copy of the UTxO set of a list of patterns
+type Mirror struct {
+	db     *sql.DB
+	client *kupogo.Client
+	config Config
+	// Syncs are serialized so concurrent callers don't fetch the same changes
+	syncMu sync.Mutex
+}
+
+// New creates the mirror tables in the database if needed
+func New(db *sql.DB, client *kupogo.Client, config Config) (*Mirror, error) {
+	if config.Interval <= 0 {
+		config.Interval = 10 * time.Second
+	}
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create mirror tables: %s", err)
+	}
+	return &Mirror{
+		db:     db,
+		client: client,
+		config: config,
+	}, nil
+}
+
+// Run syncs the mirror every interval until the context is done
+func (m *Mirror) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+	for {
+		if err := m.Sync(ctx); err != nil && m.config.OnError != nil {
+			m.config.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync brings every pattern up to Kupo's most recent checkpoint. Only changes
+// since a pattern's stored checkpoint are fetched, unless that checkpoint has
+// been rolled back, in which case the pattern is rebuilt from scratch
+func (m *Mirror) Sync(ctx context.Context) error {
+	m.syncMu.Lock()
+	defer m.syncMu.Unlock()
+	checkpoints, err := m.client.GetCheckpointsContext(ctx)
+	if err != nil {
+		return err
+	}
+	if len(*checkpoints) == 0 {
+		return ErrNoCheckpoint
+	}
+	tip := (*checkpoints)[0]
+	for _, pattern := range m.config.Patterns {
+		if err := m.syncPattern(ctx, pattern, tip); err != nil {
+			return fmt.Errorf("failed to sync %s: %w", pattern, err)
+		}
+	}
+	if m.config.OnSync != nil {
+		m.config.OnSync(tip)
+	}
+	return nil
+}
+
+func (m *Mirror) syncPattern(ctx context.Context, pattern string, tip kupogo.Point) error {
+	from, err := m.Checkpoint(pattern)
+	if err != nil {
+		return err
+	}
+	if from != nil && *from == tip {
+		return m.touch(pattern)
+	}
+	if from != nil {
+		point, err := m.client.GetCheckpointBySlotContext(ctx, from.SlotNo, true)
+		if err != nil {
+			return err
+		}
+		if point == nil || point.HeaderHash != from.HeaderHash {
+			from = nil
+		}
+	}
+	var created, spent kupogo.Matches
+	if from == nil {
+		created, err = m.utxosAt(ctx, pattern, tip.SlotNo)
+		if err != nil {
+			return err
+		}
+	} else {
+		created, spent, err = m.changesSince(ctx, pattern, *from, tip)
+		if err != nil {
+			return err
+		}
+	}
+	return m.apply(pattern, tip, from == nil, created, spent)
+}
+
+// utxosAt returns the outputs matching a pattern which were unspent at a slot
+func (m *Mirror) utxosAt(ctx context.Context, pattern string, slotNo int) (kupogo.Matches, error) {
+	unspent, err := m.client.GetMatchesWithOptionsContext(
+		ctx,
+		pattern,
+		kupogo.MatchOptions{
+			Unspent:       true,
+			CreatedBefore: slotNo + 1,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	spentLater, err := m.client.GetMatchesWithOptionsContext(
+		ctx,
+		pattern,
+		kupogo.MatchOptions{
+			CreatedBefore: slotNo + 1,
+			SpentAfter:    slotNo,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	ret := append(*unspent, *spentLater...)
+	for i := range ret {
+		ret[i].SpentAt = nil
+	}
+	return ret, nil
+}
+
+// changesSince returns the outputs created and spent between two checkpoints.
+// Outputs created and spent within the range are left out entirely
+func (m *Mirror) changesSince(
+	ctx context.Context,
+	pattern string,
+	from kupogo.Point,
+	tip kupogo.Point,
+) (kupogo.Matches, kupogo.Matches, error) {
+	created, err := m.client.GetMatchesWithOptionsContext(
+		ctx,
+		pattern,
+		kupogo.MatchOptions{
+			CreatedAfter:  from.SlotNo,
+			CreatedBefore: tip.SlotNo + 1,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	spent, err := m.client.GetMatchesWithOptionsContext(
+		ctx,
+		pattern,
+		kupogo.MatchOptions{
+			SpentAfter:  from.SlotNo,
+			SpentBefore: tip.SlotNo + 1,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	var unspent kupogo.Matches
+	for _, match := range *created {
+		// Outputs spent after the tip were still unspent at it
+		if match.SpentAt == nil || match.SpentAt.SlotNo > tip.SlotNo {
+			match.SpentAt = nil
+			unspent = append(unspent, match)
+		}
+	}
+	return unspent, *spent, nil
+}
+
+// apply stores the changes to a pattern's UTxO set in a single transaction,
+// replacing the set entirely if reset is true
+func (m *Mirror) apply(
+	pattern string,
+	tip kupogo.Point,
+	reset bool,
+	created kupogo.Matches,
+	spent kupogo.Matches,
+) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %s", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	if reset {
+		if _, err := tx.Exec(
+			"DELETE FROM mirror_members WHERE pattern = ?",
+			pattern,
+		); err != nil {
+			return fmt.Errorf("failed to clear outputs: %s", err)
+		}
+	}
+	for _, match := range spent {
+		if _, err := tx.Exec(
+			"DELETE FROM mirror_members WHERE pattern = ? AND transaction_id = ? AND output_index = ?",
+			pattern,
+			match.TransactionID,
+			match.OutputIndex,
+		); err != nil {
+			return fmt.Errorf("failed to delete output: %s", err)
+		}
+	}
+	for _, match := range created {
+		if err := insertOutput(tx, pattern, match); err != nil {
+			return err
+		}
+	}
+	// Drop outputs no pattern refers to anymore
+	if _, err := tx.Exec(
+		`DELETE FROM mirror_outputs WHERE NOT EXISTS (
+			SELECT 1 FROM mirror_members m
+			WHERE m.transaction_id = mirror_outputs.transaction_id
+			AND m.output_index = mirror_outputs.output_index
+		)`,
+	); err != nil {
+		return fmt.Errorf("failed to prune outputs: %s", err)
+	}
+	if _, err := tx.Exec(
+		`DELETE FROM mirror_assets WHERE NOT EXISTS (
+			SELECT 1 FROM mirror_outputs o
+			WHERE o.transaction_id = mirror_assets.transaction_id
+			AND o.output_index = mirror_assets.output_index
+		)`,
+	); err != nil {
+		return fmt.Errorf("failed to prune assets: %s", err)
+	}
+	if _, err := tx.Exec(
+		"INSERT OR REPLACE INTO mirror_patterns (pattern, slot_no, header_hash, synced_at) VALUES (?, ?, ?, ?)",
+		pattern,
+		tip.SlotNo,
+		tip.HeaderHash,
+		time.Now().UnixNano(),
+	); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %s", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %s", err)
+	}
+	return nil
+}
+
+func insertOutput(tx *sql.Tx, pattern string, match kupogo.Match) error {
+	data, err := json.Marshal(match)
+	if err != nil {
+		return fmt.Errorf("failed to marshal match: %s", err)
+	}
+	if _, err := tx.Exec(
+		"INSERT OR REPLACE INTO mirror_members (pattern, transaction_id, output_index) VALUES (?, ?, ?)",
+		pattern,
+		match.TransactionID,
+		match.OutputIndex,
+	); err != nil {
+		return fmt.Errorf("failed to save output: %s", err)
+	}
+	if _, err := tx.Exec(
+		`INSERT OR REPLACE INTO mirror_outputs
+			(transaction_id, output_index, address, coins, datum_hash, data)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+		match.TransactionID,
+		match.OutputIndex,
+		match.Address,
+		match.Value.Coins,
+		match.DatumHash,
+		data,
+	); err != nil {
+		return fmt.Errorf("failed to save output: %s", err)
+	}
+	for asset, quantity := range match.Value.Assets {
+		if _, err := tx.Exec(
+			`INSERT OR REPLACE INTO mirror_assets
+				(transaction_id, output_index, policy_id, asset_id, quantity)
+				VALUES (?, ?, ?, ?, ?)`,
+			match.TransactionID,
+			match.OutputIndex,
+			kupogo.AssetID(asset).PolicyID(),
+			asset,
+			quantity,
+		); err != nil {
+			return fmt.Errorf("failed to save asset: %s", err)
+		}
+	}
+	return nil
+}
+
+// Checkpoint returns the point a pattern was last synced to, or nil if it has
+// never been synced
+func (m *Mirror) Checkpoint(pattern string) (*kupogo.Point, error) {
+	point := &kupogo.Point{}
+	err := m.db.QueryRow(
+		"SELECT slot_no, header_hash FROM mirror_patterns WHERE pattern = ?",
+		pattern,
+	).Scan(&point.SlotNo, &point.HeaderHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load checkpoint: %s", err)
+	}
+	return point, nil
+}
+
+// LastSync returns when a pattern was last confirmed to be up to date with
+// Kupo, or the zero time if it has never been synced
+func (m *Mirror) LastSync(pattern string) (time.Time, error) {
+	var syncedAt int64
+	err := m.db.QueryRow(
+		"SELECT synced_at FROM mirror_patterns WHERE pattern = ?",
+		pattern,
+	).Scan(&syncedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to load sync time: %s", err)
+	}
+	return time.Unix(0, syncedAt), nil
+}
+
+// touch records that a pattern is up to date without changing its outputs
+func (m *Mirror) touch(pattern string) error {
+	_, err := m.db.Exec(
+		"UPDATE mirror_patterns SET synced_at = ? WHERE pattern = ?",
+		time.Now().UnixNano(),
+		pattern,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save sync time: %s", err)
+	}
+	return nil
+}
+
+// UTxOs returns the mirrored outputs matching a pattern
+func (m *Mirror) UTxOs(pattern string) (kupogo.Matches, error) {
+	return m.query(
+		`SELECT o.data FROM mirror_outputs o
+			JOIN mirror_members m
+			ON m.transaction_id = o.transaction_id AND m.output_index = o.output_index
+			WHERE m.pattern = ?`,
+		pattern,
+	)
+}
+
+// UTxOsByAddress returns the mirrored outputs locked at an address
+func (m *Mirror) UTxOsByAddress(address string) (kupogo.Matches, error) {
+	return m.query(
+		"SELECT o.data FROM mirror_outputs o WHERE o.address = ?",
+		address,
+	)
+}
+
+// UTxOsByDatumHash returns the mirrored outputs carrying a datum hash
+func (m *Mirror) UTxOsByDatumHash(datumHash string) (kupogo.Matches, error) {
+	return m.query(
+		"SELECT o.data FROM mirror_outputs o WHERE o.datum_hash = ?",
+		datumHash,
+	)
+}
+
+// UTxOsByPolicy returns the mirrored outputs holding any asset of a policy
+func (m *Mirror) UTxOsByPolicy(policyID string) (kupogo.Matches, error) {
+	return m.query(
+		`SELECT o.data FROM mirror_outputs o WHERE EXISTS (
+			SELECT 1 FROM mirror_assets a
+			WHERE a.transaction_id = o.transaction_id
+			AND a.output_index = o.output_index
+			AND a.policy_id = ?
+		)`,
+		strings.ToLower(policyID),
+	)
+}
+
+// UTxOsByAsset returns the mirrored outputs holding an asset
+func (m *Mirror) UTxOsByAsset(asset kupogo.AssetID) (kupogo.Matches, error) {
+	return m.query(
+		`SELECT o.data FROM mirror_outputs o WHERE EXISTS (
+			SELECT 1 FROM mirror_assets a
+			WHERE a.transaction_id = o.transaction_id
+			AND a.output_index = o.output_index
+			AND a.asset_id = ?
+		)`,
+		strings.ToLower(string(asset)),
+	)
+}
+
+// Balance returns the total value of the mirrored outputs locked at an
+// address
+func (m *Mirror) Balance(address string) (*kupogo.Value, error) {
+	utxos, err := m.UTxOsByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	var balance kupogo.Value
+	for _, match := range utxos {
+		balance = balance.Add(match.Value)
+	}
+	return &balance, nil
+}
+
+// AssetQuantity returns the total quantity of an asset across all mirrored
+// outputs
+func (m *Mirror) AssetQuantity(asset kupogo.AssetID) (int, error) {
+	var quantity int
+	err := m.db.QueryRow(
+		"SELECT COALESCE(SUM(quantity), 0) FROM mirror_assets WHERE asset_id = ?",
+		strings.ToLower(string(asset)),
+	).Scan(&quantity)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query assets: %s", err)
+	}
+	return quantity, nil
+}
+
+func (m *Mirror) query(query string, args ...interface{}) (kupogo.Matches, error) {
+	rows, err := m.db.Query(query+" ORDER BY o.transaction_id, o.output_index", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outputs: %s", err)
+	}
+	defer rows.Close()
+	matches := kupogo.Matches{}
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("failed to query outputs: %s", err)
+		}
+		var match kupogo.Match
+		if err := json.Unmarshal(data, &match); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal match: %s", err)
+		}
+		matches = append(matches, match)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query outputs: %s", err)
+	}
+	return matches, nil
+}
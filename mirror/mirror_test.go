@@ -0,0 +1,203 @@
+package mirror
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const testPolicy = "c0ffee00c0ffee00c0ffee00c0ffee00c0ffee00c0ffee00c0ffee00"
+
+func TestMirror_Sync(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "mirror.db"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	defer db.Close()
+	datumHash := "dd"
+	tip := kupogo.Point{SlotNo: 100, HeaderHash: "aa"}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var resp interface{}
+			switch r.URL.Path {
+			case "/checkpoints":
+				resp = kupogo.Checkpoints{tip}
+			case "/checkpoints/100":
+				resp = kupogo.Point{SlotNo: 100, HeaderHash: "aa"}
+			case "/matches/addr1":
+				switch r.URL.RawQuery {
+				case "unspent&created_before=101":
+					resp = kupogo.Matches{
+						{TransactionID: "t1", Address: "addr1", Value: kupogo.Value{Coins: 1}},
+						{
+							TransactionID: "t2",
+							Address:       "addr1",
+							Value: kupogo.Value{
+								Coins:  2,
+								Assets: kupogo.Assets{testPolicy + ".01": 5},
+							},
+						},
+					}
+				case "created_before=101&spent_after=100":
+					resp = kupogo.Matches{}
+				case "created_after=100&created_before=201":
+					resp = kupogo.Matches{
+						{
+							TransactionID: "t3",
+							Address:       "addr1",
+							DatumHash:     &datumHash,
+							Value:         kupogo.Value{Coins: 3},
+						},
+					}
+				case "spent_after=100&spent_before=201":
+					resp = kupogo.Matches{{TransactionID: "t2", Address: "addr1"}}
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			respBody, _ := json.Marshal(resp)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+	mirror, err := New(db, kupogo.NewClient(server.URL), Config{Patterns: []string{"addr1"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	if err := mirror.Sync(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	byPolicy, err := mirror.UTxOsByPolicy(testPolicy)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(byPolicy) != 1 || byPolicy[0].TransactionID != "t2" {
+		t.Errorf("Unexpected outputs by policy: %v", byPolicy)
+	}
+	quantity, err := mirror.AssetQuantity(kupogo.NewAssetID(testPolicy, "01"))
+	if err != nil || quantity != 5 {
+		t.Errorf("Expected quantity 5, got %d, %v", quantity, err)
+	}
+
+	tip = kupogo.Point{SlotNo: 200, HeaderHash: "bb"}
+	if err := mirror.Sync(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	checkpoint, err := mirror.Checkpoint("addr1")
+	if err != nil || checkpoint == nil || *checkpoint != tip {
+		t.Errorf("Unexpected checkpoint: %v, %v", checkpoint, err)
+	}
+	balance, err := mirror.Balance("addr1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if balance.Coins != 4 || len(balance.Assets) != 0 {
+		t.Errorf("Unexpected balance: %v", balance)
+	}
+	byDatum, err := mirror.UTxOsByDatumHash(datumHash)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(byDatum) != 1 || byDatum[0].TransactionID != "t3" {
+		t.Errorf("Unexpected outputs by datum hash: %v", byDatum)
+	}
+	quantity, err = mirror.AssetQuantity(kupogo.NewAssetID(testPolicy, "01"))
+	if err != nil || quantity != 0 {
+		t.Errorf("Expected spent asset to be pruned, got %d, %v", quantity, err)
+	}
+	utxos, err := mirror.UTxOs("addr1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(utxos) != 2 || utxos[0].TransactionID != "t1" || utxos[1].TransactionID != "t3" {
+		t.Errorf("Unexpected outputs: %v", utxos)
+	}
+}
+
+func TestTieredReader(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "mirror.db"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	defer db.Close()
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var resp interface{}
+			switch {
+			case r.URL.Path == "/checkpoints":
+				resp = kupogo.Checkpoints{{SlotNo: 100, HeaderHash: "aa"}}
+			case r.URL.RawQuery == "unspent&created_before=101":
+				resp = kupogo.Matches{{TransactionID: "t1", Value: kupogo.Value{Coins: 1}}}
+			case r.URL.RawQuery == "created_before=101&spent_after=100":
+				resp = kupogo.Matches{}
+			case r.URL.RawQuery == "unspent":
+				w.Header().Set("X-Most-Recent-Checkpoint", "105")
+				resp = kupogo.Matches{
+					{TransactionID: "t1", Value: kupogo.Value{Coins: 1}},
+					{TransactionID: "t2", Value: kupogo.Value{Coins: 2}},
+				}
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			respBody, _ := json.Marshal(resp)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+	mirror, err := New(db, kupogo.NewClient(server.URL), Config{Patterns: []string{"addr1"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	reader := NewTieredReader(mirror, TieredConfig{MaxStaleness: time.Hour})
+
+	// Nothing is mirrored before the first sync
+	balance, err := reader.Balance(context.Background(), "addr1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if balance.Tier != TierKupo || balance.SlotNo != 105 || balance.Value.Coins != 3 {
+		t.Errorf("Unexpected balance before sync: %+v", balance)
+	}
+
+	if err := mirror.Sync(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	balance, err = reader.Balance(context.Background(), "addr1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if balance.Tier != TierMirror || balance.SlotNo != 100 || balance.Value.Coins != 1 {
+		t.Errorf("Unexpected balance after sync: %+v", balance)
+	}
+	utxos, err := reader.UTxOs(context.Background(), "addr2")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if utxos.Tier != TierKupo {
+		t.Errorf("Expected unmirrored pattern to be read from Kupo, got %s", utxos.Tier)
+	}
+
+	reader = NewTieredReader(mirror, TieredConfig{MaxStaleness: time.Nanosecond})
+	time.Sleep(time.Millisecond)
+	utxos, err = reader.UTxOs(context.Background(), "addr1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if utxos.Tier != TierKupo {
+		t.Errorf("Expected stale mirror to be bypassed, got %s", utxos.Tier)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"context"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// Tier identifies where a tiered read was answered from
+type Tier string
+
+const (
+	TierMirror Tier = "mirror"
+	TierKupo   Tier = "kupo"
+)
+
+// TieredConfig controls when a TieredReader trusts the mirror
+type TieredConfig struct {
+	// MaxStaleness is how long after its last sync a pattern is still served
+	// from the mirror. Defaults to 30 seconds
+	MaxStaleness time.Duration
+	// OnFallback is called when a read falls back to Kupo because the mirror
+	// failed, with the mirror's error
+	OnFallback func(pattern string, err error)
+}
+
+// UTxOResult is the answer to a tiered UTxO query
+type UTxOResult struct {
+	Matches kupogo.Matches
+	// Tier is where the answer came from
+	Tier Tier
+	// SlotNo is the slot the answer is current as of: the mirror's checkpoint,
+	// or Kupo's most recent checkpoint when it answered, or -1 if unknown
+	SlotNo int
+}
+
+// BalanceResult is the answer to a tiered balance query
+type BalanceResult struct {
+	Value  kupogo.Value
+	Tier   Tier
+	SlotNo int
+}
+
+// TieredReader serves reads from a mirror while it is fresh enough and from
+// Kupo otherwise. Patterns which are not mirrored are always read from Kupo
+type TieredReader struct {
+	mirror *Mirror
+	config TieredConfig
+}
+
+// NewTieredReader returns a reader falling back to the client of a mirror
+func NewTieredReader(m *Mirror, config TieredConfig) *TieredReader {
+	if config.MaxStaleness <= 0 {
+		config.MaxStaleness = 30 * time.Second
+	}
+	return &TieredReader{
+		mirror: m,
+		config: config,
+	}
+}
+
+// UTxOs returns the unspent outputs matching a pattern
+func (r *TieredReader) UTxOs(ctx context.Context, pattern string) (*UTxOResult, error) {
+	result, err := r.fromMirror(pattern)
+	if err != nil && r.config.OnFallback != nil {
+		r.config.OnFallback(pattern, err)
+	}
+	if result != nil {
+		return result, nil
+	}
+	var info kupogo.ResponseInfo
+	matches, err := r.mirror.client.GetMatchesWithOptionsContext(
+		kupogo.WithCallOptions(ctx, kupogo.CaptureResponse(&info)),
+		pattern,
+		kupogo.MatchOptions{Unspent: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &UTxOResult{
+		Matches: *matches,
+		Tier:    TierKupo,
+		SlotNo:  info.Checkpoint,
+	}, nil
+}
+
+// Balance returns the total value of the unspent outputs matching a pattern
+func (r *TieredReader) Balance(ctx context.Context, pattern string) (*BalanceResult, error) {
+	utxos, err := r.UTxOs(ctx, pattern)
+	if err != nil {
+		return nil, err
+	}
+	var balance kupogo.Value
+	for _, match := range utxos.Matches {
+		balance = balance.Add(match.Value)
+	}
+	return &BalanceResult{
+		Value:  balance,
+		Tier:   utxos.Tier,
+		SlotNo: utxos.SlotNo,
+	}, nil
+}
+
+// fromMirror answers a query from the mirror, or returns nil if the pattern
+// isn't mirrored or is too stale
+func (r *TieredReader) fromMirror(pattern string) (*UTxOResult, error) {
+	syncedAt, err := r.mirror.LastSync(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if syncedAt.IsZero() || time.Since(syncedAt) > r.config.MaxStaleness {
+		return nil, nil
+	}
+	checkpoint, err := r.mirror.Checkpoint(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint == nil {
+		return nil, nil
+	}
+	matches, err := r.mirror.UTxOs(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &UTxOResult{
+		Matches: matches,
+		Tier:    TierMirror,
+		SlotNo:  checkpoint.SlotNo,
+	}, nil
+}
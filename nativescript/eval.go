@@ -0,0 +1,194 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nativescript
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/internal/cbor"
+)
+
+var ErrNotNative = errors.New("not a native script")
+
+// FromCBOR decodes a native script from its ledger CBOR encoding
+func FromCBOR(data []byte) (*Script, error) {
+	value, err := cbor.Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode script: %s", err)
+	}
+	return fromCBORValue(value)
+}
+
+func fromCBORValue(value any) (*Script, error) {
+	items, ok := value.([]any)
+	if !ok || len(items) < 2 {
+		return nil, errors.New("invalid native script: expected an array")
+	}
+	tag, ok := items[0].(uint64)
+	if !ok {
+		return nil, errors.New("invalid native script: expected a type tag")
+	}
+	switch tag {
+	case 0:
+		keyHash, ok := items[1].([]byte)
+		if !ok || len(items) != 2 {
+			return nil, errors.New("invalid sig script")
+		}
+		return &Script{Type: TypeSig, KeyHash: hex.EncodeToString(keyHash)}, nil
+	case 1, 2:
+		scripts, err := fromCBORList(items[1])
+		if err != nil {
+			return nil, err
+		}
+		script := &Script{Type: TypeAll, Scripts: scripts}
+		if tag == 2 {
+			script.Type = TypeAny
+		}
+		return script, nil
+	case 3:
+		required, ok := items[1].(uint64)
+		if !ok || len(items) != 3 {
+			return nil, errors.New("invalid atLeast script")
+		}
+		scripts, err := fromCBORList(items[2])
+		if err != nil {
+			return nil, err
+		}
+		return &Script{Type: TypeAtLeast, Required: int(required), Scripts: scripts}, nil
+	case 4, 5:
+		slot, ok := items[1].(uint64)
+		if !ok || len(items) != 2 {
+			return nil, errors.New("invalid timelock script")
+		}
+		script := &Script{Type: TypeAfter, Slot: slot}
+		if tag == 5 {
+			script.Type = TypeBefore
+		}
+		return script, nil
+	}
+	return nil, fmt.Errorf("unknown native script tag: %d", tag)
+}
+
+func fromCBORList(value any) ([]Script, error) {
+	items, ok := value.([]any)
+	if !ok {
+		return nil, errors.New("invalid native script: expected a list of scripts")
+	}
+	ret := make([]Script, 0, len(items))
+	for _, item := range items {
+		script, err := fromCBORValue(item)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, *script)
+	}
+	return ret, nil
+}
+
+// FromScriptResponse decodes a native script as returned by Kupo
+func FromScriptResponse(resp kupogo.ScriptResponse) (*Script, error) {
+	if !resp.IsNative() {
+		return nil, fmt.Errorf("%w: %s", ErrNotNative, resp.Language)
+	}
+	data, err := hex.DecodeString(resp.Script)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode script hex: %s", err)
+	}
+	return FromCBOR(data)
+}
+
+// GetScript fetches a native script from Kupo by hash. It returns nil if
+// Kupo doesn't know the script
+func GetScript(ctx context.Context, client *kupogo.Client, scriptHash string) (*Script, error) {
+	resp, err := client.GetScriptByHashContext(ctx, scriptHash)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	return FromScriptResponse(*resp)
+}
+
+// Evaluate returns whether the script can be satisfied at a slot by a
+// transaction signed with the given key hashes. A before script is satisfied
+// by slots strictly before its slot, and an after script by slots at or after
+// its slot, matching the validity interval a transaction submitted at the
+// slot could use
+func (s *Script) Evaluate(slot uint64, keyHashes []string) bool {
+	signers := make(map[string]bool, len(keyHashes))
+	for _, keyHash := range keyHashes {
+		signers[strings.ToLower(keyHash)] = true
+	}
+	return s.evaluate(slot, signers)
+}
+
+func (s *Script) evaluate(slot uint64, signers map[string]bool) bool {
+	switch s.Type {
+	case TypeSig:
+		return signers[strings.ToLower(s.KeyHash)]
+	case TypeAll:
+		for idx := range s.Scripts {
+			if !s.Scripts[idx].evaluate(slot, signers) {
+				return false
+			}
+		}
+		return true
+	case TypeAny:
+		for idx := range s.Scripts {
+			if s.Scripts[idx].evaluate(slot, signers) {
+				return true
+			}
+		}
+		return false
+	case TypeAtLeast:
+		satisfied := 0
+		for idx := range s.Scripts {
+			if s.Scripts[idx].evaluate(slot, signers) {
+				satisfied++
+			}
+		}
+		return satisfied >= s.Required
+	case TypeAfter:
+		return slot >= s.Slot
+	case TypeBefore:
+		return slot < s.Slot
+	}
+	return false
+}
+
+// KeyHashes returns the key hashes referenced by the script, without
+// duplicates, in order of appearance
+func (s *Script) KeyHashes() []string {
+	seen := make(map[string]bool)
+	var ret []string
+	var walk func(script *Script)
+	walk = func(script *Script) {
+		if script.Type == TypeSig && !seen[script.KeyHash] {
+			seen[script.KeyHash] = true
+			ret = append(ret, script.KeyHash)
+		}
+		for idx := range script.Scripts {
+			walk(&script.Scripts[idx])
+		}
+	}
+	walk(s)
+	return ret
+}
@@ -0,0 +1,56 @@
+package nativescript
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestScript_Evaluate(t *testing.T) {
+	var script Script
+	if err := json.Unmarshal([]byte(testScriptJSON), &script); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	scriptCBOR, err := script.CBOR()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	decoded, err := FromScriptResponse(kupogo.ScriptResponse{
+		Language: kupogo.ScriptLanguageNative,
+		Script:   hex.EncodeToString(scriptCBOR),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(*decoded, script) {
+		t.Fatalf("Expected %+v, got %+v", script, *decoded)
+	}
+	keys := decoded.KeyHashes()
+	if len(keys) != 3 {
+		t.Fatalf("Expected 3 key hashes, got %v", keys)
+	}
+	testDefs := []struct {
+		slot     uint64
+		signers  []string
+		expected bool
+	}{
+		{100, []string{keys[0], keys[1]}, true},
+		{100, []string{keys[0]}, false},
+		// The nested timelock is not yet open
+		{999, []string{keys[0], keys[2]}, false},
+		{1000, []string{keys[0], keys[2]}, true},
+		// Nor open anymore
+		{5000000, []string{keys[0], keys[2]}, false},
+	}
+	for _, testDef := range testDefs {
+		if got := decoded.Evaluate(testDef.slot, testDef.signers); got != testDef.expected {
+			t.Errorf("Evaluate(%d, %v): expected %v, got %v", testDef.slot, testDef.signers, testDef.expected, got)
+		}
+	}
+	if _, err := FromScriptResponse(kupogo.ScriptResponse{Language: kupogo.ScriptLanguagePlutusV2}); err == nil {
+		t.Errorf("Expected an error for a Plutus script")
+	}
+}
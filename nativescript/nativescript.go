@@ -0,0 +1,168 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nativescript implements Cardano native (multisig and timelock)
+// scripts in the JSON format used by cardano-cli
+package nativescript
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/internal/bech32"
+	"github.com/blinklabs-io/kupogo/internal/cbor"
+)
+
+// Type is the type of a native script node
+type Type string
+
+const (
+	TypeSig     Type = "sig"
+	TypeAll     Type = "all"
+	TypeAny     Type = "any"
+	TypeAtLeast Type = "atLeast"
+	TypeAfter   Type = "after"
+	TypeBefore  Type = "before"
+)
+
+const (
+	scriptHashLength = 28
+	// Native scripts are hashed with a 0x00 language prefix
+	nativeScriptTag = 0x00
+	// Enterprise address header type with a script payment part
+	enterpriseScriptHeader = 0x70
+	networkIDMainnet       = 1
+)
+
+// Script is a native script node
+type Script struct {
+	Type     Type     `json:"type"`
+	KeyHash  string   `json:"keyHash,omitempty"`
+	Required int      `json:"required,omitempty"`
+	Slot     uint64   `json:"slot,omitempty"`
+	Scripts  []Script `json:"scripts,omitempty"`
+}
+
+// CBOR returns the ledger CBOR encoding of the script
+func (s *Script) CBOR() ([]byte, error) {
+	return s.appendCBOR(nil)
+}
+
+func (s *Script) appendCBOR(b []byte) ([]byte, error) {
+	var err error
+	switch s.Type {
+	case TypeSig:
+		keyHash, err := hex.DecodeString(s.KeyHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key hash: %s", err)
+		}
+		b = cbor.AppendArrayHeader(b, 2)
+		b = cbor.AppendUint(b, 0)
+		return cbor.AppendBytes(b, keyHash), nil
+	case TypeAll, TypeAny:
+		b = cbor.AppendArrayHeader(b, 2)
+		if s.Type == TypeAll {
+			b = cbor.AppendUint(b, 1)
+		} else {
+			b = cbor.AppendUint(b, 2)
+		}
+	case TypeAtLeast:
+		b = cbor.AppendArrayHeader(b, 3)
+		b = cbor.AppendUint(b, 3)
+		b = cbor.AppendUint(b, uint64(s.Required))
+	case TypeAfter:
+		b = cbor.AppendArrayHeader(b, 2)
+		b = cbor.AppendUint(b, 4)
+		return cbor.AppendUint(b, s.Slot), nil
+	case TypeBefore:
+		b = cbor.AppendArrayHeader(b, 2)
+		b = cbor.AppendUint(b, 5)
+		return cbor.AppendUint(b, s.Slot), nil
+	default:
+		return nil, fmt.Errorf("unknown native script type: %s", s.Type)
+	}
+	// Remaining types carry a list of sub-scripts
+	b = cbor.AppendArrayHeader(b, len(s.Scripts))
+	for idx := range s.Scripts {
+		b, err = s.Scripts[idx].appendCBOR(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Hash returns the hex encoded script hash
+func (s *Script) Hash() (string, error) {
+	scriptCBOR, err := s.CBOR()
+	if err != nil {
+		return "", err
+	}
+	hash, _ := blake2b.New(scriptHashLength, nil)
+	hash.Write([]byte{nativeScriptTag})
+	hash.Write(scriptCBOR)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Address returns the bech32 enterprise address locked by the script
+func (s *Script) Address(networkID byte) (string, error) {
+	scriptHash, err := s.Hash()
+	if err != nil {
+		return "", err
+	}
+	hashBytes, _ := hex.DecodeString(scriptHash)
+	data := append([]byte{enterpriseScriptHeader | networkID&0x0f}, hashBytes...)
+	hrp := "addr_test"
+	if networkID == networkIDMainnet {
+		hrp = "addr"
+	}
+	return bech32.Encode(hrp, data)
+}
+
+// Pattern returns the Kupo pattern matching all outputs locked by the script,
+// regardless of their stake part
+func (s *Script) Pattern() (string, error) {
+	scriptHash, err := s.Hash()
+	if err != nil {
+		return "", err
+	}
+	return kupogo.MatchCredential(scriptHash).String(), nil
+}
+
+// Watch registers the script's pattern with Kupo, rolling back to the given
+// point, and returns a watcher tracking the outputs locked by the script
+func Watch(
+	client *kupogo.Client,
+	script *Script,
+	rollbackTo kupogo.Point,
+	limit kupogo.RollbackLimit,
+	config kupogo.WatcherConfig,
+) (*kupogo.Watcher, error) {
+	if script == nil {
+		return nil, errors.New("no script provided")
+	}
+	pattern, err := script.Pattern()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.AddPattern(pattern, rollbackTo, limit); err != nil {
+		return nil, err
+	}
+	config.Pattern = pattern
+	return kupogo.NewWatcher(client, config), nil
+}
@@ -0,0 +1,100 @@
+package nativescript
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+const testScriptJSON = `{
+  "type": "atLeast",
+  "required": 2,
+  "scripts": [
+    {"type": "sig", "keyHash": "9c8273304e495f2f0cad0bdbc6be34af0de6dbcc30836b27c8036e8e"},
+    {"type": "sig", "keyHash": "f554692c2b112bc4d16536ed36aae8e4cda78ed06793980cf475fbd2"},
+    {
+      "type": "all",
+      "scripts": [
+        {"type": "sig", "keyHash": "a7334728bf6e94e0f86fdbb34fa100f63bc89d8a9dc8ed6e11399d53"},
+        {"type": "after", "slot": 1000},
+        {"type": "before", "slot": 5000000}
+      ]
+    }
+  ]
+}`
+
+const testScriptHash = "5fb73ed0158fb6685846c8cec1186bf82f0ea5aa1e968e3b8264b0bc"
+
+func TestScript(t *testing.T) {
+	var script Script
+	if err := json.Unmarshal([]byte(testScriptJSON), &script); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	scriptCBOR, err := script.CBOR()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expectedCBOR := "830302838200581c9c8273304e495f2f0cad0bdbc6be34af0de6dbcc30836b27c8036e8e8200581cf554692c2b112bc4d16536ed36aae8e4cda78ed06793980cf475fbd28201838200581ca7334728bf6e94e0f86fdbb34fa100f63bc89d8a9dc8ed6e11399d5382041903e882051a004c4b40"
+	if hex.EncodeToString(scriptCBOR) != expectedCBOR {
+		t.Errorf("Expected CBOR %s, got %x", expectedCBOR, scriptCBOR)
+	}
+	hash, err := script.Hash()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if hash != testScriptHash {
+		t.Errorf("Expected hash %s, got %s", testScriptHash, hash)
+	}
+	address, err := script.Address(0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expectedAddress := "addr_test1wp0mw0kszk8mv6zcgmyvasgcd0uz7r494g0fdr3msfjtp0q2lcp8e"
+	if address != expectedAddress {
+		t.Errorf("Expected address %s, got %s", expectedAddress, address)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	var script Script
+	_ = json.Unmarshal([]byte(testScriptJSON), &script)
+	registered := false
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodPut && r.URL.Path == "/patterns/"+testScriptHash+"/*":
+				registered = true
+				_, _ = w.Write([]byte(`["` + testScriptHash + `/*"]`))
+			case r.Method == http.MethodGet && r.URL.Path == "/matches/"+testScriptHash+"/*":
+				_, _ = w.Write([]byte(`[{"transaction_id": "aa", "value": {"coins": 42}}]`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer server.Close()
+
+	watcher, err := Watch(
+		kupogo.NewClient(server.URL),
+		&script,
+		kupogo.Point{SlotNo: 1000},
+		kupogo.RollbackLimitUnsafeAllowBeyondSafeZone,
+		kupogo.WatcherConfig{},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !registered {
+		t.Errorf("Expected pattern to be registered")
+	}
+	if _, err := watcher.Poll(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if balance := watcher.Balance(); balance.Coins != 42 {
+		t.Errorf("Expected balance of 42, got %d", balance.Coins)
+	}
+}
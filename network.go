@@ -0,0 +1,166 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"math"
+	"time"
+
+	"github.com/blinklabs-io/kupogo/address"
+)
+
+// Network describes a Cardano network
+type Network struct {
+	Name string
+	// Magic is the network magic used by the node protocols
+	Magic uint32
+	// NetworkID is the network ID carried in Shelley address headers
+	NetworkID byte
+	// SecurityParam is the security parameter k
+	SecurityParam int
+	// ActiveSlotsCoeff is the fraction of slots expected to hold a block,
+	// defaulting to 0.05 if zero
+	ActiveSlotsCoeff float64
+	// SlotConfig maps slots to time since the start of the Shelley era
+	SlotConfig SlotConfig
+	// ShelleyStartEpoch is the first epoch of the Shelley era
+	ShelleyStartEpoch int
+	// EpochLength is the number of slots per epoch since the Shelley era
+	EpochLength int
+}
+
+var (
+	NetworkMainnet = Network{
+		Name:             "mainnet",
+		Magic:            764824073,
+		NetworkID:        1,
+		SecurityParam:    SecurityParamMainnet,
+		ActiveSlotsCoeff: 0.05,
+		SlotConfig: SlotConfig{
+			ZeroSlot:   4492800,
+			ZeroTime:   time.Date(2020, time.July, 29, 21, 44, 51, 0, time.UTC),
+			SlotLength: time.Second,
+		},
+		ShelleyStartEpoch: 208,
+		EpochLength:       432000,
+	}
+	NetworkPreprod = Network{
+		Name:             "preprod",
+		Magic:            1,
+		NetworkID:        0,
+		SecurityParam:    SecurityParamPreprod,
+		ActiveSlotsCoeff: 0.05,
+		SlotConfig: SlotConfig{
+			ZeroSlot:   86400,
+			ZeroTime:   time.Date(2022, time.June, 21, 0, 0, 0, 0, time.UTC),
+			SlotLength: time.Second,
+		},
+		ShelleyStartEpoch: 4,
+		EpochLength:       432000,
+	}
+	NetworkPreview = Network{
+		Name:             "preview",
+		Magic:            2,
+		NetworkID:        0,
+		SecurityParam:    SecurityParamPreview,
+		ActiveSlotsCoeff: 0.05,
+		SlotConfig: SlotConfig{
+			ZeroTime:   time.Date(2022, time.October, 25, 0, 0, 0, 0, time.UTC),
+			SlotLength: time.Second,
+		},
+		EpochLength: 86400,
+	}
+)
+
+// NetworkByName returns the preset network with the given name
+func NetworkByName(name string) (Network, bool) {
+	for _, network := range []Network{NetworkMainnet, NetworkPreprod, NetworkPreview} {
+		if network.Name == name {
+			return network, true
+		}
+	}
+	return Network{}, false
+}
+
+// WithNetwork sets the network used by the client's slot, epoch and
+// finality helpers, which otherwise default to mainnet
+func WithNetwork(network Network) ClientOption {
+	return func(c *Client) {
+		c.network = &network
+	}
+}
+
+// Network returns the network configured with WithNetwork, or mainnet
+func (c *Client) Network() Network {
+	if c.network == nil {
+		return NetworkMainnet
+	}
+	return *c.network
+}
+
+// SlotToTime returns the start time of a slot since the Shelley era
+func (n Network) SlotToTime(slotNo int) time.Time {
+	return n.SlotConfig.SlotToTime(slotNo)
+}
+
+// TimeToSlot returns the slot in progress at a time since the Shelley era
+func (n Network) TimeToSlot(t time.Time) int {
+	return n.SlotConfig.TimeToSlot(t)
+}
+
+// SlotToEpoch returns the epoch of a slot since the Shelley era and the
+// slot's index within it
+func (n Network) SlotToEpoch(slotNo int) (int, int) {
+	offset := slotNo - n.SlotConfig.ZeroSlot
+	return n.ShelleyStartEpoch + offset/n.EpochLength, offset % n.EpochLength
+}
+
+// EpochToSlot returns the first slot of an epoch since the Shelley era
+func (n Network) EpochToSlot(epoch int) int {
+	return n.SlotConfig.ZeroSlot + (epoch-n.ShelleyStartEpoch)*n.EpochLength
+}
+
+// ImmutableSlots returns the number of slots after which a block can no
+// longer be rolled back on the network, the stability window of 3k/f slots
+func (n Network) ImmutableSlots() int {
+	if n.ActiveSlotsCoeff == 0 {
+		return ImmutableSlots(n.SecurityParam)
+	}
+	// Allow for rounding errors of the coefficient's binary representation
+	return int(math.Ceil(3*float64(n.SecurityParam)/n.ActiveSlotsCoeff - 1e-6))
+}
+
+// IsImmutable returns whether a point can no longer be rolled back on the
+// network
+func (n Network) IsImmutable(point Point, tip Point) bool {
+	return tip.SlotNo-point.SlotNo >= n.ImmutableSlots()
+}
+
+// ValidAddress returns whether a Shelley address, stake address or Byron
+// address belongs to the network. Byron addresses are checked against the
+// network magic, which testnet addresses carry
+func (n Network) ValidAddress(addr string) bool {
+	parsed, err := address.Parse(addr)
+	if err != nil {
+		return false
+	}
+	if byron, ok := parsed.Byron(); ok {
+		if byron.ProtocolMagic == nil {
+			return n.NetworkID == address.NetworkIDMainnet
+		}
+		return *byron.ProtocolMagic == n.Magic
+	}
+	return parsed.CheckNetwork(n.NetworkID) == nil
+}
@@ -0,0 +1,74 @@
+package kupogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNetworkValidAddress(t *testing.T) {
+	testDefs := []struct {
+		network  Network
+		address  string
+		expected bool
+	}{
+		{NetworkMainnet, "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x", true},
+		{NetworkMainnet, "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw", true},
+		{NetworkMainnet, "addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae", false},
+		{NetworkPreprod, "addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae", true},
+		{NetworkPreview, "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn", true},
+		{NetworkPreview, "not an address", false},
+	}
+	for _, testDef := range testDefs {
+		if valid := testDef.network.ValidAddress(testDef.address); valid != testDef.expected {
+			t.Errorf("Expected valid=%v for %s on %s, got %v", testDef.expected, testDef.address, testDef.network.Name, valid)
+		}
+	}
+}
+
+func TestNetworkSlots(t *testing.T) {
+	midnight := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testDefs := []struct {
+		network Network
+		slotNo  int
+		epoch   int
+	}{
+		{NetworkMainnet, 112500909, 458},
+		{NetworkPreprod, 48384000, 115},
+		{NetworkPreview, 37411200, 433},
+	}
+	for _, testDef := range testDefs {
+		if slotNo := testDef.network.TimeToSlot(midnight); slotNo != testDef.slotNo {
+			t.Errorf("Expected slot %d on %s, got %d", testDef.slotNo, testDef.network.Name, slotNo)
+		}
+		if epoch, _ := testDef.network.SlotToEpoch(testDef.slotNo); epoch != testDef.epoch {
+			t.Errorf("Expected epoch %d on %s, got %d", testDef.epoch, testDef.network.Name, epoch)
+		}
+		if slotNo := testDef.network.EpochToSlot(testDef.epoch); slotNo > testDef.slotNo {
+			t.Errorf("Expected epoch %d to start by slot %d, got %d", testDef.epoch, testDef.slotNo, slotNo)
+		}
+	}
+}
+
+func TestWithNetwork(t *testing.T) {
+	if network := NewClient("http://localhost:1442").Network(); network.Name != "mainnet" {
+		t.Fatalf("Expected mainnet by default, got %s", network.Name)
+	}
+	network, ok := NetworkByName("preview")
+	if !ok {
+		t.Fatalf("Expected preview network")
+	}
+	client := NewClient("http://localhost:1442", WithNetwork(network))
+	if client.Network().Magic != 2 {
+		t.Fatalf("Expected preview network, got %+v", client.Network())
+	}
+}
+
+func TestNetworkValidByronAddress(t *testing.T) {
+	mainnet := "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi"
+	if !NetworkMainnet.ValidAddress(mainnet) {
+		t.Fatalf("Expected %s to be valid on mainnet", mainnet)
+	}
+	if NetworkPreprod.ValidAddress(mainnet) {
+		t.Fatalf("Expected %s to be invalid on preprod", mainnet)
+	}
+}
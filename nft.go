@@ -0,0 +1,79 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import "sort"
+
+// NFT describes a single-quantity asset held in an output
+type NFT struct {
+	AssetID AssetID
+	// Label is the CIP-67 label of the asset name, if it carries one
+	Label *CIP67Label
+	// Name is the hex encoded asset name with any CIP-67 label removed
+	Name            string
+	Address         string
+	OutputReference OutputReference
+}
+
+// NFTs returns all assets with a quantity of 1 found in the unspent matches,
+// ordered by asset ID
+func (m Matches) NFTs() []NFT {
+	var ret []NFT
+	for _, match := range m {
+		if match.SpentAt != nil {
+			continue
+		}
+		for asset, quantity := range match.Value.Assets {
+			if quantity != 1 {
+				continue
+			}
+			assetID := AssetID(asset)
+			nft := NFT{
+				AssetID:         assetID,
+				Name:            assetID.AssetName(),
+				Address:         match.Address,
+				OutputReference: match.OutputReference(),
+			}
+			if label, name, ok := ParseCIP67Label(nft.Name); ok {
+				nft.Label = &label
+				nft.Name = name
+			}
+			ret = append(ret, nft)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].AssetID < ret[j].AssetID
+	})
+	return ret
+}
+
+// GroupNFTsByPolicy groups NFTs by their policy ID
+func GroupNFTsByPolicy(nfts []NFT) map[string][]NFT {
+	ret := make(map[string][]NFT)
+	for _, nft := range nfts {
+		policyID := nft.AssetID.PolicyID()
+		ret[policyID] = append(ret[policyID], nft)
+	}
+	return ret
+}
+
+// GetNFTs returns the NFTs currently held at an address, grouped by policy ID
+func (c *Client) GetNFTs(address string) (map[string][]NFT, error) {
+	matches, err := c.GetMatches(address)
+	if err != nil {
+		return nil, err
+	}
+	return GroupNFTsByPolicy(matches.NFTs()), nil
+}
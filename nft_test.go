@@ -0,0 +1,62 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetNFTs(t *testing.T) {
+	policyA := "4fc6bb0c93780ad706425d9f7dc1d3c5e3ddbf29ba8486dce904a5fc"
+	policyB := "dca1e44765b9f80c8b18105e17de90d4a07e4d5a83de533e53fee32e"
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			matches := Matches{
+				{
+					TransactionID: "aa",
+					Address:       "addr1",
+					Value: Value{
+						Coins: 2000000,
+						Assets: Assets{
+							policyA + ".000de1404d794e4654": 1,
+							policyA + ".4d79546f6b656e":     1000,
+							policyB:                         1,
+						},
+					},
+				},
+				{
+					TransactionID: "bb",
+					Address:       "addr1",
+					Value: Value{
+						Assets: Assets{policyB + ".4f6c64": 1},
+					},
+					SpentAt: &Point{SlotNo: 1},
+				},
+			}
+			respBody, _ := json.Marshal(matches)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	client := &Client{KupoUrl: server.URL}
+	nfts, err := client.GetNFTs("addr1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(nfts) != 2 || len(nfts[policyA]) != 1 || len(nfts[policyB]) != 1 {
+		t.Fatalf("Expected one NFT for each policy, got %v", nfts)
+	}
+	labeled := nfts[policyA][0]
+	if labeled.Label == nil || *labeled.Label != CIP67LabelNFT {
+		t.Errorf("Expected CIP-67 NFT label, got %v", labeled.Label)
+	}
+	if labeled.Name != "4d794e4654" {
+		t.Errorf("Expected label to be stripped from name, got %s", labeled.Name)
+	}
+	if nfts[policyB][0].Label != nil || nfts[policyB][0].AssetID.AssetName() != "" {
+		t.Errorf("Expected unlabeled NFT with empty name, got %v", nfts[policyB][0])
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+)
+
+// OgmiosUTxO is an unspent output in the JSON shape of Ogmios v6 ledger-state
+// queries such as queryLedgerState/utxo
+type OgmiosUTxO struct {
+	Transaction struct {
+		ID string `json:"id"`
+	} `json:"transaction"`
+	Index     int                       `json:"index"`
+	Address   string                    `json:"address"`
+	Value     map[string]map[string]int `json:"value"`
+	DatumHash string                    `json:"datumHash,omitempty"`
+	Datum     string                    `json:"datum,omitempty"`
+	Script    *OgmiosScript             `json:"script,omitempty"`
+}
+
+// OgmiosScript is a script in Ogmios' JSON shape
+type OgmiosScript struct {
+	Language string `json:"language"`
+	CBOR     string `json:"cbor"`
+}
+
+// OgmiosValue converts a value into Ogmios' shape, where lovelace is under
+// "ada" and assets are grouped by policy ID
+func OgmiosValue(value Value) map[string]map[string]int {
+	ret := map[string]map[string]int{
+		"ada": {"lovelace": value.Coins},
+	}
+	for asset, quantity := range value.Assets {
+		assetID := AssetID(asset)
+		policy, ok := ret[assetID.PolicyID()]
+		if !ok {
+			policy = make(map[string]int)
+			ret[assetID.PolicyID()] = policy
+		}
+		policy[assetID.AssetName()] = quantity
+	}
+	return ret
+}
+
+// ToOgmiosUTxO converts a match into Ogmios' UTxO shape. Inline datums and
+// reference scripts are not part of a match, so a datum is only given by
+// hash. Use GetOgmiosUTxOs to resolve them
+func ToOgmiosUTxO(match Match) OgmiosUTxO {
+	utxo := OgmiosUTxO{
+		Index:   match.OutputIndex,
+		Address: match.Address,
+		Value:   OgmiosValue(match.Value),
+	}
+	utxo.Transaction.ID = match.TransactionID
+	if match.DatumHash != nil {
+		utxo.DatumHash = *match.DatumHash
+	}
+	return utxo
+}
+
+// ToOgmiosUTxOs converts matches into Ogmios' UTxO shape
+func ToOgmiosUTxOs(matches Matches) []OgmiosUTxO {
+	ret := make([]OgmiosUTxO, 0, len(matches))
+	for _, match := range matches {
+		ret = append(ret, ToOgmiosUTxO(match))
+	}
+	return ret
+}
+
+// GetOgmiosUTxOs fetches the unspent outputs of a pattern in Ogmios' UTxO
+// shape, resolving inline datums and reference scripts from Kupo
+func (c *Client) GetOgmiosUTxOs(pattern string) ([]OgmiosUTxO, error) {
+	return c.GetOgmiosUTxOsContext(context.Background(), pattern)
+}
+
+// GetOgmiosUTxOsContext is like GetOgmiosUTxOs with a request context
+func (c *Client) GetOgmiosUTxOsContext(
+	ctx context.Context,
+	pattern string,
+) ([]OgmiosUTxO, error) {
+	matches, err := c.GetMatchesWithOptionsContext(ctx, pattern, MatchOptions{Unspent: true})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]OgmiosUTxO, 0, len(*matches))
+	for _, match := range *matches {
+		utxo := ToOgmiosUTxO(match)
+		// Ogmios gives inline datums by value and hashed datums by hash
+		if match.DatumHash != nil && match.DatumType != nil && *match.DatumType == "inline" {
+			datum, err := c.GetDatumByHashContext(ctx, *match.DatumHash)
+			if err != nil {
+				return nil, err
+			}
+			if datum == nil {
+				return nil, fmt.Errorf("inline datum %s not found", *match.DatumHash)
+			}
+			utxo.DatumHash = ""
+			utxo.Datum = datum.Datum
+		}
+		if match.ScriptHash != nil {
+			script, err := c.GetScriptByHashContext(ctx, *match.ScriptHash)
+			if err != nil {
+				return nil, err
+			}
+			if script == nil {
+				return nil, fmt.Errorf("script %s not found", *match.ScriptHash)
+			}
+			utxo.Script = &OgmiosScript{
+				Language: script.Language,
+				CBOR:     script.Script,
+			}
+		}
+		ret = append(ret, utxo)
+	}
+	return ret, nil
+}
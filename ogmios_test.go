@@ -0,0 +1,68 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestToOgmiosUTxO(t *testing.T) {
+	datumHash := "dd"
+	match := Match{
+		TransactionID: "aa",
+		OutputIndex:   2,
+		Address:       "addr1",
+		Value: Value{
+			Coins: 1500000,
+			Assets: Assets{
+				"pp.746f6b656e": 3,
+				"pp":            1,
+			},
+		},
+		DatumHash: &datumHash,
+	}
+	data, err := json.Marshal(ToOgmiosUTxO(match))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := `{"transaction":{"id":"aa"},"index":2,"address":"addr1",` +
+		`"value":{"ada":{"lovelace":1500000},"pp":{"":1,"746f6b656e":3}},` +
+		`"datumHash":"dd"}`
+	if string(data) != expected {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, data)
+	}
+}
+
+func TestGetOgmiosUTxOs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/matches/addr1":
+			_, _ = w.Write([]byte(`[{"transaction_id":"aa","output_index":0,"address":"addr1",` +
+				`"value":{"coins":2000000},"datum_hash":"dd","datum_type":"inline",` +
+				`"script_hash":"ss","created_at":{"slot_no":1,"header_hash":"hh"}}]`))
+		case "/datums/dd":
+			_, _ = w.Write([]byte(`{"datum":"d87980"}`))
+		case "/scripts/ss":
+			_, _ = w.Write([]byte(`{"language":"plutus:v2","script":"4e4d01"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+	utxos, err := client.GetOgmiosUTxOs("addr1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(utxos) != 1 {
+		t.Fatalf("Expected 1 UTxO, got %d", len(utxos))
+	}
+	utxo := utxos[0]
+	if utxo.Datum != "d87980" || utxo.DatumHash != "" {
+		t.Fatalf("Expected inline datum, got %+v", utxo)
+	}
+	if utxo.Script == nil || utxo.Script.Language != "plutus:v2" || utxo.Script.CBOR != "4e4d01" {
+		t.Fatalf("Unexpected script: %+v", utxo.Script)
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package openapi embeds the part of Kupo's OpenAPI specification used by
+// kupogo and checks Go types, request paths and query parameters against it,
+// so that drift between kupogo and new Kupo releases is caught by tests. Load
+// reads Kupo's published api.yaml to run the same checks against upstream.
+// The schemas and paths are also exposed for code generators
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed kupo.json
+var specJSON []byte
+
+// Spec is a parsed OpenAPI specification
+type Spec struct {
+	OpenAPI string `json:"openapi"`
+	Info    struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	} `json:"info"`
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas    map[string]Schema    `json:"schemas"`
+		Parameters map[string]Parameter `json:"parameters"`
+	} `json:"components"`
+}
+
+// Parameter is the subset of an OpenAPI parameter object used for contract
+// checks
+type Parameter struct {
+	Ref  string `json:"$ref,omitempty"`
+	Name string `json:"name,omitempty"`
+	In   string `json:"in,omitempty"`
+}
+
+// Schema is the subset of an OpenAPI schema object used for contract checks
+type Schema struct {
+	Type       string            `json:"type,omitempty"`
+	Ref        string            `json:"$ref,omitempty"`
+	Required   []string          `json:"required,omitempty"`
+	Properties map[string]Schema `json:"properties,omitempty"`
+	Items      *Schema           `json:"items,omitempty"`
+	Enum       []string          `json:"enum,omitempty"`
+	Nullable   bool              `json:"nullable,omitempty"`
+}
+
+// Kupo returns the embedded Kupo specification
+func Kupo() (*Spec, error) {
+	return Parse(specJSON)
+}
+
+// Parse parses an OpenAPI specification in JSON form
+func Parse(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse specification: %s", err)
+	}
+	return &spec, nil
+}
+
+// ParseYAML parses an OpenAPI specification in YAML form, as Kupo publishes
+// it
+func ParseYAML(data []byte) (*Spec, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse specification: %s", err)
+	}
+	data, err := json.Marshal(jsonValue(doc))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse specification: %s", err)
+	}
+	return Parse(data)
+}
+
+// Load parses the specification at path, in YAML or JSON form
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(path, ".json") {
+		return Parse(data)
+	}
+	return ParseYAML(data)
+}
+
+// jsonValue converts a decoded YAML document to values encoding/json accepts,
+// as YAML allows non-string keys such as response codes
+func jsonValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			v[key] = jsonValue(value)
+		}
+		return v
+	case map[any]any:
+		ret := make(map[string]any, len(v))
+		for key, value := range v {
+			ret[fmt.Sprint(key)] = jsonValue(value)
+		}
+		return ret
+	case []any:
+		for i, value := range v {
+			v[i] = jsonValue(value)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// QueryParameters returns the names of the query parameters accepted by an
+// operation of the path template, declared on the operation or the path
+func (s *Spec) QueryParameters(method string, template string) ([]string, error) {
+	item, ok := s.Paths[template]
+	if !ok {
+		return nil, fmt.Errorf("unknown path %q", template)
+	}
+	operation, ok := item[strings.ToLower(method)]
+	if !ok {
+		return nil, fmt.Errorf("unknown operation %s %s", method, template)
+	}
+	var params []Parameter
+	if raw, ok := item["parameters"]; ok {
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return nil, fmt.Errorf("failed to parse parameters of %s: %s", template, err)
+		}
+	}
+	var op struct {
+		Parameters []Parameter `json:"parameters"`
+	}
+	if err := json.Unmarshal(operation, &op); err != nil {
+		return nil, fmt.Errorf("failed to parse operation %s %s: %s", method, template, err)
+	}
+	params = append(params, op.Parameters...)
+	var ret []string
+	for _, param := range params {
+		if param.Ref != "" {
+			name := strings.TrimPrefix(param.Ref, "#/components/parameters/")
+			resolved, ok := s.Components.Parameters[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown parameter %q", param.Ref)
+			}
+			param = resolved
+		}
+		if param.In == "query" {
+			ret = append(ret, param.Name)
+		}
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// Drift is a difference between a Go type and a schema
+type Drift struct {
+	Schema string
+	Field  string
+	// Problem describes the difference
+	Problem string
+}
+
+func (d Drift) String() string {
+	return fmt.Sprintf("%s.%s: %s", d.Schema, d.Field, d.Problem)
+}
+
+// CheckType compares the JSON fields of a struct, given as a value or pointer,
+// against the properties of the named schema. Properties missing from the
+// type, fields absent from the schema and required properties tagged
+// omitempty are reported
+func (s *Spec) CheckType(schemaName string, v any) ([]Drift, error) {
+	schema, ok := s.Components.Schemas[schemaName]
+	if !ok {
+		return nil, fmt.Errorf("unknown schema %q", schemaName)
+	}
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is not a struct", t)
+	}
+	fields := make(map[string]bool)
+	omitEmpty := make(map[string]bool)
+	collectFields(t, fields, omitEmpty)
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	var drift []Drift
+	for name := range schema.Properties {
+		if !fields[name] {
+			drift = append(drift, Drift{schemaName, name, "missing from " + t.String()})
+		} else if required[name] && omitEmpty[name] {
+			drift = append(drift, Drift{schemaName, name, "required but omitempty"})
+		}
+	}
+	for name := range fields {
+		if _, ok := schema.Properties[name]; !ok {
+			drift = append(drift, Drift{schemaName, name, "not in specification"})
+		}
+	}
+	sort.Slice(drift, func(i, j int) bool {
+		return drift[i].Field < drift[j].Field
+	})
+	return drift, nil
+}
+
+// collectFields gathers JSON field names, descending into embedded structs
+func collectFields(t reflect.Type, fields map[string]bool, omitEmpty map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			collectFields(field.Type, fields, omitEmpty)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = true
+		if strings.Contains(opts, "omitempty") {
+			omitEmpty[name] = true
+		}
+	}
+}
+
+// MatchPath finds the path template of the specification matching a request
+// path for the given method, such as "/datums/{datum-hash}" for
+// "/datums/abcd"
+func (s *Spec) MatchPath(method string, path string) (string, bool) {
+	method = strings.ToLower(method)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	templates := make([]string, 0, len(s.Paths))
+	for template := range s.Paths {
+		templates = append(templates, template)
+	}
+	// Prefer literal templates over parameterized ones
+	sort.Strings(templates)
+	for _, template := range templates {
+		if _, ok := s.Paths[template][method]; !ok {
+			continue
+		}
+		if pathMatches(template, segments) {
+			return template, true
+		}
+	}
+	return "", false
+}
+
+func pathMatches(template string, segments []string) bool {
+	parts := strings.Split(strings.Trim(template, "/"), "/")
+	if len(parts) != len(segments) {
+		return false
+	}
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if segments[i] == "" {
+				return false
+			}
+			continue
+		}
+		if part != segments[i] {
+			return false
+		}
+	}
+	return true
+}
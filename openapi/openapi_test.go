@@ -0,0 +1,112 @@
+package openapi
+
+import (
+	"testing"
+)
+
+func TestMatchPath(t *testing.T) {
+	spec, err := Kupo()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	testDefs := []struct {
+		method   string
+		path     string
+		expected string
+	}{
+		{method: "GET", path: "/matches", expected: "/matches"},
+		{method: "GET", path: "/matches/*@abcd", expected: "/matches/{pattern}"},
+		{method: "PUT", path: "/patterns", expected: "/patterns"},
+		{method: "GET", path: "/datums/abcd", expected: "/datums/{datum-hash}"},
+		{method: "POST", path: "/datums/abcd"},
+		{method: "GET", path: "/unknown"},
+	}
+	for _, testDef := range testDefs {
+		template, ok := spec.MatchPath(testDef.method, testDef.path)
+		if ok != (testDef.expected != "") || template != testDef.expected {
+			t.Fatalf(
+				"%s %s: expected %q, got %q",
+				testDef.method,
+				testDef.path,
+				testDef.expected,
+				template,
+			)
+		}
+	}
+}
+
+func TestCheckType(t *testing.T) {
+	spec, err := Kupo()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	type point struct {
+		SlotNo  int    `json:"slot_no"`
+		Renamed string `json:"headerHash,omitempty"`
+	}
+	drift, err := spec.CheckType("Point", point{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(drift) != 2 {
+		t.Fatalf("Expected 2 drifts, got %v", drift)
+	}
+	if drift[0].Field != "headerHash" || drift[1].Field != "header_hash" {
+		t.Fatalf("Unexpected drift: %v", drift)
+	}
+	if _, err := spec.CheckType("Unknown", point{}); err == nil {
+		t.Fatalf("Expected error for unknown schema")
+	}
+}
+
+func TestQueryParameters(t *testing.T) {
+	spec, err := Kupo()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	params, err := spec.QueryParameters("GET", "/checkpoints/{slot-no}")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(params) != 1 || params[0] != "strict" {
+		t.Fatalf("Unexpected parameters: %v", params)
+	}
+	if _, err := spec.QueryParameters("POST", "/checkpoints/{slot-no}"); err == nil {
+		t.Fatalf("Expected error for unknown operation")
+	}
+}
+
+func TestParseYAML(t *testing.T) {
+	spec, err := ParseYAML([]byte(`
+openapi: 3.0.0
+info:
+  title: Kupo
+  version: v2.7
+paths:
+  /metadata/{slot-no}:
+    parameters:
+      - $ref: "#/components/parameters/transaction-id"
+    get:
+      responses:
+        200:
+          description: Metadata
+components:
+  parameters:
+    transaction-id:
+      name: transaction_id
+      in: query
+`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if _, ok := spec.MatchPath("GET", "/metadata/10"); !ok {
+		t.Fatalf("Expected the path to match")
+	}
+	params, err := spec.QueryParameters("GET", "/metadata/{slot-no}")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(params) != 1 || params[0] != "transaction_id" {
+		t.Fatalf("Unexpected parameters: %v", params)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"net/http"
+	"time"
+)
+
+// ClientOption configures a Client created with NewClient
+type ClientOption func(*Client)
+
+// WithHTTPClient uses the given HTTP client for requests instead of the
+// default one with a 5 minute timeout
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTimeout limits the time of each request, including reading the
+// response body, instead of the 5 minute default
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithHeader sets a header on every request, replacing any value set by the
+// client. Credential headers such as Authorization are not set on redirects
+// to another host stripped by the RedirectPolicy
+func WithHeader(key string, value string) ClientOption {
+	sensitive := isSensitiveHeader(key)
+	return WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if sensitive && IsCrossHostRedirect(req) {
+				return next.RoundTrip(req)
+			}
+			req = req.Clone(req.Context())
+			req.Header.Set(key, value)
+			return next.RoundTrip(req)
+		})
+	})
+}
+
+// WithCache caches successful GET responses in the given cache
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithContentCache caches datums and scripts, which are immutable, in the
+// given cache, such as an LRUCache
+func WithContentCache(cache Cache) ClientOption {
+	return func(c *Client) {
+		c.contentCache = cache
+	}
+}
+
+// WithStaleFallback serves the last known result from the cache configured
+// with WithCache when Kupo is unreachable or failing, as long as it is no
+// older than maxAge (0 for any age) and still retained by the cache. onStale,
+// if not nil, is called for each result served stale
+func WithStaleFallback(maxAge time.Duration, onStale func(Staleness)) ClientOption {
+	return func(c *Client) {
+		c.staleFallback = &staleFallback{
+			maxAge:  maxAge,
+			onStale: onStale,
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNoOracleOutput is returned when an oracle feed has no unspent output
+var ErrNoOracleOutput = errors.New("no unspent oracle output")
+
+// OracleReading is the current value of an on-chain oracle feed
+type OracleReading struct {
+	Match Match
+	// Datum is the hex encoded CBOR datum of the oracle output
+	Datum string
+	// Value is the decoded datum, see DecodeDatum
+	Value any
+	// SlotNo is the slot in which the reading was posted
+	SlotNo int
+}
+
+// GetOracleReading returns the current reading of an oracle feed. The
+// pattern is usually the oracle's script address, or MatchAsset of the
+// oracle's NFT. When several unspent outputs match, the most recently
+// created one is used
+func (c *Client) GetOracleReading(pattern string) (*OracleReading, error) {
+	return c.GetOracleReadingContext(context.Background(), pattern)
+}
+
+// GetOracleReadingContext is like GetOracleReading with a request context
+func (c *Client) GetOracleReadingContext(
+	ctx context.Context,
+	pattern string,
+) (*OracleReading, error) {
+	matches, _, err := c.getMatches(ctx, pattern, MatchOptions{Unspent: true})
+	if err != nil {
+		return nil, err
+	}
+	var latest *Match
+	for i, match := range *matches {
+		if match.DatumHash == nil {
+			continue
+		}
+		if latest == nil || matchLess(*latest, match) {
+			latest = &(*matches)[i]
+		}
+	}
+	if latest == nil {
+		return nil, ErrNoOracleOutput
+	}
+	// Inline datums are also served by hash
+	datum, err := c.GetDatumByHashContext(ctx, *latest.DatumHash)
+	if err != nil {
+		return nil, err
+	}
+	if datum == nil {
+		return nil, fmt.Errorf("datum %s of oracle output %s not found", *latest.DatumHash, latest.OutputReference())
+	}
+	value, err := DecodeDatum(datum.Datum)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleReading{
+		Match:  *latest,
+		Datum:  datum.Datum,
+		Value:  value,
+		SlotNo: latest.CreatedAt.SlotNo,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetOracleReading(t *testing.T) {
+	oldHash, newHash := "aa", "bb"
+	matches := Matches{
+		{TransactionID: "t1", DatumHash: &oldHash, CreatedAt: Point{SlotNo: 10}},
+		{TransactionID: "t2", DatumHash: &newHash, CreatedAt: Point{SlotNo: 20}},
+		{TransactionID: "t3", CreatedAt: Point{SlotNo: 30}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, "/datums/") {
+				// Constr 0 [1000000]
+				_, _ = w.Write([]byte(`{"datum":"d8799f1a000f4240ff"}`))
+				return
+			}
+			if r.URL.Path == "/matches/oracle" && r.URL.RawQuery == "unspent" {
+				_ = json.NewEncoder(w).Encode(matches)
+				return
+			}
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	reading, err := client.GetOracleReading("oracle")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if reading.Match.TransactionID != "t2" || reading.SlotNo != 20 {
+		t.Fatalf("Expected the latest output with a datum, got %+v", reading.Match)
+	}
+	expected := Constr{Index: 0, Fields: []any{big.NewInt(1000000)}}
+	if reading.Datum != "d8799f1a000f4240ff" || !reflect.DeepEqual(reading.Value, expected) {
+		t.Fatalf("Unexpected datum %s decoded as %#v", reading.Datum, reading.Value)
+	}
+
+	if _, err := client.GetOracleReading("other"); !errors.Is(err, ErrNoOracleOutput) {
+		t.Fatalf("Expected ErrNoOracleOutput, got %v", err)
+	}
+}
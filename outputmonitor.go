@@ -0,0 +1,221 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// OutputAlertType identifies the kind of change reported by an OutputMonitor
+type OutputAlertType int
+
+const (
+	// OutputAlertSpent is raised when a tracked output is spent
+	OutputAlertSpent OutputAlertType = iota
+	// OutputAlertSpendRolledBack is raised when the spending of a tracked
+	// output is rolled back, leaving it unspent again
+	OutputAlertSpendRolledBack
+	// OutputAlertCreationRolledBack is raised when a tracked output which was
+	// observed on-chain disappears, or is created again in another block
+	OutputAlertCreationRolledBack
+)
+
+func (t OutputAlertType) String() string {
+	switch t {
+	case OutputAlertSpent:
+		return "spent"
+	case OutputAlertSpendRolledBack:
+		return "spend_rolled_back"
+	case OutputAlertCreationRolledBack:
+		return "creation_rolled_back"
+	default:
+		return "unknown"
+	}
+}
+
+// OutputAlert describes a change of a tracked output
+type OutputAlert struct {
+	Type            OutputAlertType
+	OutputReference OutputReference
+	// Previous is the output as last observed
+	Previous Match
+	// Current is the output as now observed, nil if it disappeared
+	Current *Match
+}
+
+// OutputMonitorConfig configures an OutputMonitor
+type OutputMonitorConfig struct {
+	// References are the outputs tracked from the start
+	References []OutputReference
+	// Interval between polls, defaulting to 10 seconds
+	Interval time.Duration
+	// OnAlert is called for every alert raised while running
+	OnAlert func(OutputAlert)
+	// OnError is called when a poll fails while running
+	OnError func(error)
+}
+
+// OutputMonitor watches a set of outputs for spending and for rollbacks of
+// their creation or spending, for fraud and risk monitoring. Outputs not yet
+// observed are tracked once they appear
+type OutputMonitor struct {
+	client   *Client
+	config   OutputMonitorConfig
+	mu       sync.Mutex
+	tracked  map[OutputReference]bool
+	observed map[OutputReference]Match
+}
+
+// NewOutputMonitor creates a monitor for the configured outputs
+func NewOutputMonitor(client *Client, config OutputMonitorConfig) *OutputMonitor {
+	if config.Interval <= 0 {
+		config.Interval = defaultWatchInterval
+	}
+	m := &OutputMonitor{
+		client:   client,
+		config:   config,
+		tracked:  make(map[OutputReference]bool),
+		observed: make(map[OutputReference]Match),
+	}
+	for _, ref := range config.References {
+		m.tracked[ref] = true
+	}
+	return m
+}
+
+// Track adds an output to the monitored set
+func (m *OutputMonitor) Track(ref OutputReference) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tracked[ref] = true
+}
+
+// Untrack removes an output from the monitored set
+func (m *OutputMonitor) Untrack(ref OutputReference) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.tracked, ref)
+	delete(m.observed, ref)
+}
+
+// Poll looks up every tracked output and returns the alerts raised since the
+// previous poll, ordered by output reference. Outputs seen for the first time
+// raise no alert. Observations are left untouched if a lookup fails
+func (m *OutputMonitor) Poll() ([]OutputAlert, error) {
+	return m.PollContext(context.Background())
+}
+
+// PollContext is like Poll with a request context
+func (m *OutputMonitor) PollContext(ctx context.Context) ([]OutputAlert, error) {
+	m.mu.Lock()
+	refs := make([]OutputReference, 0, len(m.tracked))
+	for ref := range m.tracked {
+		refs = append(refs, ref)
+	}
+	m.mu.Unlock()
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].TransactionID != refs[j].TransactionID {
+			return refs[i].TransactionID < refs[j].TransactionID
+		}
+		return refs[i].OutputIndex < refs[j].OutputIndex
+	})
+	current := make(map[OutputReference]*Match, len(refs))
+	for _, ref := range refs {
+		matches, _, err := m.client.getMatches(
+			ctx,
+			string(MatchOutputRef(ref.TransactionID, ref.OutputIndex)),
+			MatchOptions{},
+		)
+		if err != nil {
+			return nil, err
+		}
+		current[ref] = nil
+		for i := range *matches {
+			if (*matches)[i].OutputReference() == ref {
+				current[ref] = &(*matches)[i]
+				break
+			}
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var alerts []OutputAlert
+	for _, ref := range refs {
+		if !m.tracked[ref] {
+			// Untracked while polling
+			continue
+		}
+		match := current[ref]
+		previous, seen := m.observed[ref]
+		if match == nil {
+			if seen {
+				alerts = append(alerts, OutputAlert{
+					Type:            OutputAlertCreationRolledBack,
+					OutputReference: ref,
+					Previous:        previous,
+				})
+				delete(m.observed, ref)
+			}
+			continue
+		}
+		m.observed[ref] = *match
+		if !seen {
+			continue
+		}
+		alert := OutputAlert{OutputReference: ref, Previous: previous, Current: match}
+		switch {
+		case match.CreatedAt != previous.CreatedAt:
+			alert.Type = OutputAlertCreationRolledBack
+		case previous.SpentAt == nil && match.SpentAt != nil:
+			alert.Type = OutputAlertSpent
+		case previous.SpentAt != nil && match.SpentAt == nil:
+			alert.Type = OutputAlertSpendRolledBack
+		case previous.SpentAt != nil && *previous.SpentAt != *match.SpentAt:
+			// Spent again in another block after a rollback
+			alert.Type = OutputAlertSpent
+		default:
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
+
+// Run polls the tracked outputs until the context is cancelled, delivering
+// alerts and errors to the configured callbacks
+func (m *OutputMonitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+	for {
+		alerts, err := m.PollContext(ctx)
+		if err != nil {
+			if m.config.OnError != nil && ctx.Err() == nil {
+				m.config.OnError(err)
+			}
+		} else if m.config.OnAlert != nil {
+			for _, alert := range alerts {
+				m.config.OnAlert(alert)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestOutputMonitor(t *testing.T) {
+	var mu sync.Mutex
+	outputs := map[string]Match{
+		"0@aa": {TransactionID: "aa", CreatedAt: Point{SlotNo: 10, HeaderHash: "a"}},
+		"1@bb": {TransactionID: "bb", OutputIndex: 1, CreatedAt: Point{SlotNo: 20, HeaderHash: "b"}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			ret := Matches{}
+			if match, ok := outputs[strings.TrimPrefix(r.URL.Path, "/matches/")]; ok {
+				ret = append(ret, match)
+			}
+			_ = json.NewEncoder(w).Encode(ret)
+		}),
+	)
+	defer server.Close()
+	aa := OutputReference{TransactionID: "aa"}
+	bb := OutputReference{TransactionID: "bb", OutputIndex: 1}
+	monitor := NewOutputMonitor(NewClient(server.URL), OutputMonitorConfig{References: []OutputReference{aa}})
+	monitor.Track(bb)
+	monitor.Track(OutputReference{TransactionID: "cc"})
+
+	poll := func() []OutputAlert {
+		alerts, err := monitor.Poll()
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		return alerts
+	}
+	if alerts := poll(); len(alerts) != 0 {
+		t.Fatalf("Expected no alerts on first observation, got %+v", alerts)
+	}
+
+	mu.Lock()
+	spent := outputs["0@aa"]
+	spent.SpentAt = &Point{SlotNo: 30, HeaderHash: "c"}
+	outputs["0@aa"] = spent
+	delete(outputs, "1@bb")
+	mu.Unlock()
+	alerts := poll()
+	if len(alerts) != 2 ||
+		alerts[0].Type != OutputAlertSpent || alerts[0].OutputReference != aa ||
+		alerts[1].Type != OutputAlertCreationRolledBack || alerts[1].Current != nil {
+		t.Fatalf("Unexpected alerts %+v", alerts)
+	}
+
+	mu.Lock()
+	unspent := outputs["0@aa"]
+	unspent.SpentAt = nil
+	outputs["0@aa"] = unspent
+	mu.Unlock()
+	alerts = poll()
+	if len(alerts) != 1 || alerts[0].Type != OutputAlertSpendRolledBack || alerts[0].Type.String() != "spend_rolled_back" {
+		t.Fatalf("Unexpected alerts %+v", alerts)
+	}
+	if alerts := poll(); len(alerts) != 0 {
+		t.Fatalf("Expected no alerts without changes, got %+v", alerts)
+	}
+}
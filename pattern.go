@@ -0,0 +1,491 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo/address"
+	"github.com/blinklabs-io/kupogo/internal/bech32"
+	"golang.org/x/crypto/blake2b"
+)
+
+// PatternKind identifies the form of a Kupo pattern
+type PatternKind int
+
+const (
+	PatternKindWildcard PatternKind = iota
+	PatternKindAddress
+	PatternKindCredentials
+	PatternKindOutputReference
+	PatternKindPolicyID
+	PatternKindAssetID
+)
+
+func (k PatternKind) String() string {
+	switch k {
+	case PatternKindWildcard:
+		return "wildcard"
+	case PatternKindAddress:
+		return "address"
+	case PatternKindCredentials:
+		return "credentials"
+	case PatternKindOutputReference:
+		return "output reference"
+	case PatternKindPolicyID:
+		return "policy id"
+	case PatternKindAssetID:
+		return "asset id"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrInvalidPattern is returned for strings which are not Kupo patterns
+var ErrInvalidPattern = errors.New("invalid pattern")
+
+const (
+	credentialHashLength = 28
+	publicKeyLength      = 32
+	transactionIDLength  = 32
+	maxAssetNameLength   = 32
+)
+
+// Bech32 prefixes accepted by Kupo for the credentials of a pattern
+var credentialPrefixes = map[string]bool{
+	"addr_vk":         true,
+	"addr_vkh":        true,
+	"addr_shared_vk":  true,
+	"addr_shared_vkh": true,
+	"stake_vk":        true,
+	"stake_vkh":       true,
+	"stake_shared_vk": true,
+	"script":          true,
+}
+
+// parsedPattern is the decoded form of a pattern. Hex fields are lowercase
+// and wildcard parts are "*"
+type parsedPattern struct {
+	kind PatternKind
+	// Address patterns
+	address *address.Address
+	// Credentials patterns, as hex credential hashes
+	payment string
+	stake   string
+	// Output reference patterns, with a negative index for any output
+	transactionID string
+	outputIndex   int
+	// Policy and asset ID patterns
+	policyID  string
+	assetName string
+}
+
+func parsePattern(pattern Pattern) (*parsedPattern, error) {
+	text := string(pattern)
+	if text == "*" || text == "*/*" {
+		return &parsedPattern{kind: PatternKindWildcard}, nil
+	}
+	if index, txID, ok := strings.Cut(text, "@"); ok {
+		txID, err := parseHex(txID, transactionIDLength, transactionIDLength)
+		if err != nil {
+			return nil, fmt.Errorf("%w: transaction id: %s", ErrInvalidPattern, err)
+		}
+		ret := &parsedPattern{
+			kind:          PatternKindOutputReference,
+			transactionID: txID,
+			outputIndex:   -1,
+		}
+		if index != "*" {
+			outputIndex, err := strconv.ParseUint(index, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("%w: output index %q", ErrInvalidPattern, index)
+			}
+			ret.outputIndex = int(outputIndex)
+		}
+		return ret, nil
+	}
+	if policyID, assetName, ok := strings.Cut(text, "."); ok {
+		policyID, err := parseHex(policyID, policyIDLength, policyIDLength)
+		if err != nil {
+			return nil, fmt.Errorf("%w: policy id: %s", ErrInvalidPattern, err)
+		}
+		if assetName == "*" {
+			return &parsedPattern{kind: PatternKindPolicyID, policyID: policyID}, nil
+		}
+		assetName, err = parseHex(assetName, 0, maxAssetNameLength)
+		if err != nil {
+			return nil, fmt.Errorf("%w: asset name: %s", ErrInvalidPattern, err)
+		}
+		return &parsedPattern{
+			kind:      PatternKindAssetID,
+			policyID:  policyID,
+			assetName: assetName,
+		}, nil
+	}
+	if payment, stake, ok := strings.Cut(text, "/"); ok {
+		payment, err := parseCredential(payment)
+		if err != nil {
+			return nil, fmt.Errorf("%w: payment credential: %s", ErrInvalidPattern, err)
+		}
+		stake, err = parseCredential(stake)
+		if err != nil {
+			return nil, fmt.Errorf("%w: delegation credential: %s", ErrInvalidPattern, err)
+		}
+		return &parsedPattern{
+			kind:    PatternKindCredentials,
+			payment: payment,
+			stake:   stake,
+		}, nil
+	}
+	parsed, err := address.Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPattern, err)
+	}
+	return &parsedPattern{kind: PatternKindAddress, address: parsed}, nil
+}
+
+// parseHex validates a hex string with a decoded length between min and max
+// bytes and returns it in lowercase
+func parseHex(text string, min int, max int) (string, error) {
+	data, err := hex.DecodeString(text)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < min || len(data) > max {
+		return "", fmt.Errorf("unexpected length %d", len(data))
+	}
+	return hex.EncodeToString(data), nil
+}
+
+// parseCredential decodes a credential in hex or bech32 form, as a key hash,
+// script hash or public key, and returns its hex hash
+func parseCredential(text string) (string, error) {
+	if text == "*" {
+		return text, nil
+	}
+	var data []byte
+	if hrp, decoded, err := bech32.Decode(text); err == nil {
+		if !credentialPrefixes[hrp] {
+			return "", fmt.Errorf("unexpected bech32 prefix %q", hrp)
+		}
+		data = decoded
+	} else {
+		data, err = hex.DecodeString(text)
+		if err != nil {
+			return "", fmt.Errorf("invalid credential %q", text)
+		}
+	}
+	switch len(data) {
+	case credentialHashLength:
+	case publicKeyLength:
+		hash, _ := blake2b.New(credentialHashLength, nil)
+		hash.Write(data)
+		data = hash.Sum(nil)
+	default:
+		return "", fmt.Errorf("unexpected credential length %d", len(data))
+	}
+	return hex.EncodeToString(data), nil
+}
+
+// Validate returns an error wrapping ErrInvalidPattern if the pattern is not
+// a valid Kupo pattern
+func (p Pattern) Validate() error {
+	_, err := parsePattern(p)
+	return err
+}
+
+// Kind returns the form of the pattern
+func (p Pattern) Kind() (PatternKind, error) {
+	parsed, err := parsePattern(p)
+	if err != nil {
+		return 0, err
+	}
+	return parsed.kind, nil
+}
+
+// Matches returns whether Kupo would index the output of the match under the
+// pattern. Invalid patterns match nothing
+func (p Pattern) Matches(match Match) bool {
+	parsed, err := parsePattern(p)
+	if err != nil {
+		return false
+	}
+	return parsed.matchesAddress(match.Address) ||
+		parsed.matchesOutputReference(match.OutputReference()) ||
+		parsed.matchesValue(match.Value)
+}
+
+// MatchesAddress returns whether an output at the address matches the pattern
+// by its address alone
+func (p Pattern) MatchesAddress(address string) bool {
+	parsed, err := parsePattern(p)
+	return err == nil && parsed.matchesAddress(address)
+}
+
+// MatchesOutputReference returns whether the output reference matches the
+// pattern by itself
+func (p Pattern) MatchesOutputReference(ref OutputReference) bool {
+	parsed, err := parsePattern(p)
+	return err == nil && parsed.matchesOutputReference(ref)
+}
+
+// MatchesAsset returns whether an output holding the asset matches the
+// pattern by that asset alone
+func (p Pattern) MatchesAsset(asset AssetID) bool {
+	parsed, err := parsePattern(p)
+	return err == nil && parsed.matchesAsset(asset)
+}
+
+func (p *parsedPattern) matchesAddress(text string) bool {
+	switch p.kind {
+	case PatternKindWildcard:
+		return true
+	case PatternKindAddress:
+		parsed, err := address.Parse(text)
+		return err == nil && bytes.Equal(parsed.Bytes(), p.address.Bytes())
+	case PatternKindCredentials:
+		parsed, err := address.Parse(text)
+		if err != nil {
+			return false
+		}
+		return credentialMatches(p.payment, parsed.Payment) &&
+			credentialMatches(p.stake, parsed.Stake)
+	}
+	return false
+}
+
+// credentialMatches checks one side of a credentials pattern. Addresses
+// without the credential, such as Byron addresses, only match a wildcard
+func credentialMatches(pattern string, credential *address.Credential) bool {
+	if pattern == "*" {
+		return true
+	}
+	return credential != nil && credential.Hex() == pattern
+}
+
+func (p *parsedPattern) matchesOutputReference(ref OutputReference) bool {
+	switch p.kind {
+	case PatternKindWildcard:
+		return true
+	case PatternKindOutputReference:
+		if !strings.EqualFold(ref.TransactionID, p.transactionID) {
+			return false
+		}
+		return p.outputIndex < 0 || p.outputIndex == ref.OutputIndex
+	}
+	return false
+}
+
+func (p *parsedPattern) matchesAsset(asset AssetID) bool {
+	switch p.kind {
+	case PatternKindWildcard:
+		return true
+	case PatternKindPolicyID:
+		return strings.EqualFold(asset.PolicyID(), p.policyID)
+	case PatternKindAssetID:
+		return strings.EqualFold(asset.PolicyID(), p.policyID) &&
+			strings.EqualFold(asset.AssetName(), p.assetName)
+	}
+	return false
+}
+
+func (p *parsedPattern) matchesValue(value Value) bool {
+	if p.kind == PatternKindWildcard {
+		return true
+	}
+	for asset := range value.Assets {
+		if p.matchesAsset(AssetID(asset)) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchAny returns the pattern matching every output
+func MatchAny() Pattern {
+	return "*"
+}
+
+// MatchAddress returns the pattern matching outputs at the address
+func MatchAddress(address string) Pattern {
+	return Pattern(address)
+}
+
+// MatchCredential returns the pattern matching outputs spendable by the
+// payment key or script hash, whatever their delegation
+func MatchCredential(hash string) Pattern {
+	return MatchCredentials(hash, "*")
+}
+
+// MatchStakeCredential returns the pattern matching outputs delegated to the
+// stake key or script hash, whatever their payment part
+func MatchStakeCredential(hash string) Pattern {
+	return MatchCredentials("*", hash)
+}
+
+// MatchCredentials returns the pattern matching outputs by their payment and
+// delegation credentials, either of which may be "*"
+func MatchCredentials(payment string, stake string) Pattern {
+	return Pattern(payment + "/" + stake)
+}
+
+// MatchPolicy returns the pattern matching outputs holding any asset of the
+// policy
+func MatchPolicy(policyID string) Pattern {
+	return Pattern(policyID + ".*")
+}
+
+// MatchAsset returns the pattern matching outputs holding the asset
+func MatchAsset(asset AssetID) Pattern {
+	return Pattern(asset.PolicyID() + "." + asset.AssetName())
+}
+
+// MatchOutputRef returns the pattern matching a single output
+func MatchOutputRef(txID string, index int) Pattern {
+	return Pattern(OutputReference{TransactionID: txID, OutputIndex: index}.String())
+}
+
+// MatchTransaction returns the pattern matching every output of a transaction
+func MatchTransaction(txID string) Pattern {
+	return Pattern("*@" + txID)
+}
+
+// String returns the pattern in the form accepted by Kupo
+func (p Pattern) String() string {
+	return string(p)
+}
+
+// Normalize returns the canonical form of the pattern, so that patterns which
+// differ only in representation compare equal. Hex is lowercased, credentials
+// given in bech32 or as public keys become hex hashes, addresses use their
+// bech32 or base58 form and "*/*" becomes "*"
+func (p Pattern) Normalize() (Pattern, error) {
+	parsed, err := parsePattern(p)
+	if err != nil {
+		return "", err
+	}
+	return parsed.pattern(), nil
+}
+
+// Equal returns whether the patterns are the same once normalized. Invalid
+// patterns are compared literally
+func (p Pattern) Equal(other Pattern) bool {
+	a, errA := p.Normalize()
+	b, errB := other.Normalize()
+	if errA != nil || errB != nil {
+		return p == other
+	}
+	return a == b
+}
+
+// Includes returns whether every output matching other also matches the
+// pattern, for example "*" includes any pattern and a policy ID pattern
+// includes the asset ID patterns of that policy
+func (p Pattern) Includes(other Pattern) bool {
+	a, errA := parsePattern(p)
+	b, errB := parsePattern(other)
+	if errA != nil || errB != nil {
+		return p == other
+	}
+	return a.includes(b)
+}
+
+func (p *parsedPattern) pattern() Pattern {
+	switch p.kind {
+	case PatternKindAddress:
+		return MatchAddress(p.address.String())
+	case PatternKindCredentials:
+		if p.payment == "*" && p.stake == "*" {
+			return MatchAny()
+		}
+		return MatchCredentials(p.payment, p.stake)
+	case PatternKindOutputReference:
+		if p.outputIndex < 0 {
+			return MatchTransaction(p.transactionID)
+		}
+		return MatchOutputRef(p.transactionID, p.outputIndex)
+	case PatternKindPolicyID:
+		return MatchPolicy(p.policyID)
+	case PatternKindAssetID:
+		return Pattern(p.policyID + "." + p.assetName)
+	}
+	return MatchAny()
+}
+
+func (p *parsedPattern) includes(other *parsedPattern) bool {
+	if p.kind == PatternKindWildcard {
+		return true
+	}
+	switch other.kind {
+	case PatternKindWildcard:
+		return false
+	case PatternKindAddress:
+		return p.matchesAddress(other.address.String())
+	case PatternKindCredentials:
+		return p.kind == PatternKindCredentials &&
+			(p.payment == "*" || p.payment == other.payment) &&
+			(p.stake == "*" || p.stake == other.stake)
+	case PatternKindOutputReference:
+		return p.kind == PatternKindOutputReference &&
+			p.transactionID == other.transactionID &&
+			(p.outputIndex < 0 || p.outputIndex == other.outputIndex)
+	case PatternKindPolicyID:
+		return p.kind == PatternKindPolicyID && p.policyID == other.policyID
+	case PatternKindAssetID:
+		return p.matchesAsset(NewAssetID(other.policyID, other.assetName))
+	}
+	return false
+}
+
+// PatternOverlap reports a pattern made redundant by a broader one
+type PatternOverlap struct {
+	Pattern    Pattern
+	SubsumedBy Pattern
+}
+
+// Redundant returns the patterns which are included by another pattern of the
+// set, such as any pattern alongside "*". Of several equal patterns, all but
+// the first are reported. Removing the reported patterns does not change
+// which outputs Kupo indexes
+func (p Patterns) Redundant() []PatternOverlap {
+	parsed := make([]*parsedPattern, len(p))
+	for i, pattern := range p {
+		parsed[i], _ = parsePattern(pattern)
+	}
+	var ret []PatternOverlap
+	for i, pattern := range parsed {
+		if pattern == nil {
+			continue
+		}
+		for j, other := range parsed {
+			if i == j || other == nil || !other.includes(pattern) {
+				continue
+			}
+			// Equal patterns include each other, so only the later is
+			// redundant
+			if pattern.includes(other) && j > i {
+				continue
+			}
+			ret = append(ret, PatternOverlap{Pattern: p[i], SubsumedBy: p[j]})
+			break
+		}
+	}
+	return ret
+}
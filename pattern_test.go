@@ -0,0 +1,223 @@
+package kupogo
+
+import (
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo/internal/bech32"
+)
+
+const (
+	testBaseAddress       = "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x"
+	testEnterpriseAddress = "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"
+	testPaymentKeyHash    = "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	testStakeKeyHash      = "337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251"
+	testPolicyID          = "00000002df633853f6a47465c9496721d2d5b1291b8398016c0e87ae"
+	testTransactionID     = "4e7b4d8a2ec1c5cbd4ae0bb7aca4d5d3ff4d21bc5cd48b2a7f0f2c1e4a9b1f00"
+)
+
+func TestPatternMatches(t *testing.T) {
+	match := Match{
+		TransactionID: testTransactionID,
+		OutputIndex:   1,
+		Address:       testBaseAddress,
+		Value: Value{
+			Coins:  2000000,
+			Assets: Assets{testPolicyID + ".6e7574636f696e": 1},
+		},
+	}
+	testDefs := []struct {
+		pattern Pattern
+		matches bool
+	}{
+		{"*", true},
+		{"*/*", true},
+		{testBaseAddress, true},
+		{testEnterpriseAddress, false},
+		{testPaymentKeyHash + "/*", true},
+		{"*/" + testStakeKeyHash, true},
+		{testPaymentKeyHash + "/" + testStakeKeyHash, true},
+		{Pattern(strings.ToUpper(testPaymentKeyHash) + "/*"), true},
+		{"*/" + testPaymentKeyHash, false},
+		{"1@" + testTransactionID, true},
+		{"*@" + testTransactionID, true},
+		{"0@" + testTransactionID, false},
+		{testPolicyID + ".*", true},
+		{testPolicyID + ".6e7574636f696e", true},
+		{testPolicyID + ".00", false},
+		{"bogus", false},
+	}
+	for _, testDef := range testDefs {
+		if matches := testDef.pattern.Matches(match); matches != testDef.matches {
+			t.Errorf("Pattern %s: expected %v, got %v", testDef.pattern, testDef.matches, matches)
+		}
+	}
+	enterprise := Match{Address: testEnterpriseAddress}
+	if !Pattern(testPaymentKeyHash + "/*").Matches(enterprise) {
+		t.Errorf("Expected payment credential to match enterprise address")
+	}
+	if Pattern("*/" + testStakeKeyHash).Matches(enterprise) {
+		t.Errorf("Expected stake credential not to match enterprise address")
+	}
+}
+
+func TestPatternComponents(t *testing.T) {
+	if !Pattern(testPaymentKeyHash + "/*").MatchesAddress(testEnterpriseAddress) {
+		t.Errorf("Expected address to match")
+	}
+	ref := OutputReference{TransactionID: testTransactionID, OutputIndex: 3}
+	if !Pattern("3@" + testTransactionID).MatchesOutputReference(ref) {
+		t.Errorf("Expected output reference to match")
+	}
+	if !Pattern(testPolicyID + ".*").MatchesAsset(NewAssetID(testPolicyID, "")) {
+		t.Errorf("Expected asset to match")
+	}
+}
+
+func TestPatternValidate(t *testing.T) {
+	testDefs := []struct {
+		pattern Pattern
+		kind    PatternKind
+	}{
+		{"*", PatternKindWildcard},
+		{testBaseAddress, PatternKindAddress},
+		{"Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi", PatternKindAddress},
+		{testPaymentKeyHash + "/*", PatternKindCredentials},
+		{"*@" + testTransactionID, PatternKindOutputReference},
+		{testPolicyID + ".*", PatternKindPolicyID},
+		{testPolicyID + ".6e7574636f696e", PatternKindAssetID},
+	}
+	for _, testDef := range testDefs {
+		kind, err := testDef.pattern.Kind()
+		if err != nil {
+			t.Errorf("Expected no error for %s, got %s", testDef.pattern, err)
+			continue
+		}
+		if kind != testDef.kind {
+			t.Errorf("Pattern %s: expected kind %s, got %s", testDef.pattern, testDef.kind, kind)
+		}
+	}
+	for _, pattern := range []Pattern{"", "bogus", "abcd/*", "x@" + testTransactionID, "1@abcd", "abcd.*"} {
+		if err := pattern.Validate(); !errors.Is(err, ErrInvalidPattern) {
+			t.Errorf("Expected ErrInvalidPattern for %q, got %v", pattern, err)
+		}
+	}
+}
+
+func TestPatternBuilders(t *testing.T) {
+	testDefs := []struct {
+		pattern  Pattern
+		expected string
+		kind     PatternKind
+	}{
+		{MatchAny(), "*", PatternKindWildcard},
+		{MatchAddress(testBaseAddress), testBaseAddress, PatternKindAddress},
+		{MatchCredential(testPaymentKeyHash), testPaymentKeyHash + "/*", PatternKindCredentials},
+		{MatchStakeCredential(testStakeKeyHash), "*/" + testStakeKeyHash, PatternKindCredentials},
+		{MatchCredentials(testPaymentKeyHash, testStakeKeyHash), testPaymentKeyHash + "/" + testStakeKeyHash, PatternKindCredentials},
+		{MatchPolicy(testPolicyID), testPolicyID + ".*", PatternKindPolicyID},
+		{MatchAsset(NewAssetID(testPolicyID, "6e7574636f696e")), testPolicyID + ".6e7574636f696e", PatternKindAssetID},
+		{MatchOutputRef(testTransactionID, 2), "2@" + testTransactionID, PatternKindOutputReference},
+		{MatchTransaction(testTransactionID), "*@" + testTransactionID, PatternKindOutputReference},
+	}
+	for _, testDef := range testDefs {
+		if testDef.pattern.String() != testDef.expected {
+			t.Errorf("Expected pattern %s, got %s", testDef.expected, testDef.pattern)
+		}
+		kind, err := testDef.pattern.Kind()
+		if err != nil {
+			t.Errorf("Expected no error for %s, got %s", testDef.pattern, err)
+		} else if kind != testDef.kind {
+			t.Errorf("Pattern %s: expected kind %s, got %s", testDef.pattern, testDef.kind, kind)
+		}
+	}
+}
+
+func TestPatternNormalize(t *testing.T) {
+	keyHash, _ := hex.DecodeString(testPaymentKeyHash)
+	bech32KeyHash, err := bech32.Encode("addr_vkh", keyHash)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	testDefs := []struct {
+		pattern  Pattern
+		expected Pattern
+	}{
+		{"*/*", "*"},
+		{Pattern(strings.ToUpper(testBaseAddress)), testBaseAddress},
+		{Pattern(strings.ToUpper(testPaymentKeyHash) + "/*"), Pattern(testPaymentKeyHash + "/*")},
+		{Pattern(bech32KeyHash + "/*"), Pattern(testPaymentKeyHash + "/*")},
+		{Pattern("*@" + strings.ToUpper(testTransactionID)), MatchTransaction(testTransactionID)},
+		{Pattern(strings.ToUpper(testPolicyID) + ".*"), MatchPolicy(testPolicyID)},
+	}
+	for _, testDef := range testDefs {
+		normalized, err := testDef.pattern.Normalize()
+		if err != nil {
+			t.Errorf("Expected no error for %s, got %s", testDef.pattern, err)
+			continue
+		}
+		if normalized != testDef.expected {
+			t.Errorf("Expected %s to normalize to %s, got %s", testDef.pattern, testDef.expected, normalized)
+		}
+		if !testDef.pattern.Equal(testDef.expected) {
+			t.Errorf("Expected %s to equal %s", testDef.pattern, testDef.expected)
+		}
+	}
+	if _, err := Pattern("bogus").Normalize(); !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("Expected ErrInvalidPattern, got %v", err)
+	}
+}
+
+func TestPatternIncludes(t *testing.T) {
+	testDefs := []struct {
+		pattern  Pattern
+		other    Pattern
+		includes bool
+	}{
+		{MatchAny(), MatchAddress(testBaseAddress), true},
+		{MatchAddress(testBaseAddress), MatchAny(), false},
+		{MatchCredential(testPaymentKeyHash), MatchAddress(testBaseAddress), true},
+		{MatchCredential(testPaymentKeyHash), MatchAddress(testEnterpriseAddress), true},
+		{MatchStakeCredential(testStakeKeyHash), MatchAddress(testEnterpriseAddress), false},
+		{MatchCredential(testPaymentKeyHash), MatchCredentials(testPaymentKeyHash, testStakeKeyHash), true},
+		{MatchCredentials(testPaymentKeyHash, testStakeKeyHash), MatchCredential(testPaymentKeyHash), false},
+		{MatchAddress(testBaseAddress), MatchCredential(testPaymentKeyHash), false},
+		{MatchTransaction(testTransactionID), MatchOutputRef(testTransactionID, 0), true},
+		{MatchOutputRef(testTransactionID, 0), MatchTransaction(testTransactionID), false},
+		{MatchPolicy(testPolicyID), MatchAsset(NewAssetID(testPolicyID, "6e7574636f696e")), true},
+		{MatchAsset(NewAssetID(testPolicyID, "6e7574636f696e")), MatchPolicy(testPolicyID), false},
+		{MatchAddress(testBaseAddress), Pattern(strings.ToUpper(testBaseAddress)), true},
+	}
+	for _, testDef := range testDefs {
+		if includes := testDef.pattern.Includes(testDef.other); includes != testDef.includes {
+			t.Errorf("Expected %s includes %s to be %v, got %v", testDef.pattern, testDef.other, testDef.includes, includes)
+		}
+	}
+}
+
+func TestPatternsRedundant(t *testing.T) {
+	patterns := Patterns{
+		MatchAddress(testBaseAddress),
+		MatchCredential(testPaymentKeyHash),
+		MatchOutputRef(testTransactionID, 0),
+		MatchTransaction(testTransactionID),
+		MatchPolicy(testPolicyID),
+		Pattern(strings.ToUpper(testPolicyID) + ".*"),
+		"bogus",
+	}
+	expected := []PatternOverlap{
+		{Pattern: MatchAddress(testBaseAddress), SubsumedBy: MatchCredential(testPaymentKeyHash)},
+		{Pattern: MatchOutputRef(testTransactionID, 0), SubsumedBy: MatchTransaction(testTransactionID)},
+		{Pattern: patterns[5], SubsumedBy: MatchPolicy(testPolicyID)},
+	}
+	if redundant := patterns.Redundant(); !reflect.DeepEqual(redundant, expected) {
+		t.Fatalf("Expected %v, got %v", expected, redundant)
+	}
+	withAny := append(Patterns{MatchAny()}, patterns[:2]...)
+	if redundant := withAny.Redundant(); len(redundant) != 2 || redundant[0].SubsumedBy != MatchAny() {
+		t.Fatalf("Expected both patterns subsumed by the wildcard, got %v", redundant)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// EnsurePatternsOptions configures EnsurePatterns
+type EnsurePatternsOptions struct {
+	// RollbackTo is the point Kupo rolls back to when adding patterns. It
+	// defaults to the most recent checkpoint, indexing new patterns from now
+	RollbackTo *Point
+	// Limit controls how far back Kupo may roll back
+	Limit RollbackLimit
+	// DryRun computes the changes without applying them
+	DryRun bool
+	// KeepUnknown leaves registered patterns which are not desired in place
+	// instead of deleting them
+	KeepUnknown bool
+}
+
+// PatternChanges describes the changes made, or planned in a dry run, by
+// EnsurePatterns
+type PatternChanges struct {
+	Added   Patterns
+	Removed Patterns
+}
+
+// Empty returns whether the registered patterns were already as desired
+func (p *PatternChanges) Empty() bool {
+	return len(p.Added) == 0 && len(p.Removed) == 0
+}
+
+// EnsurePatterns makes the patterns registered with Kupo match the desired
+// set, adding missing patterns and deleting the others. Patterns are compared
+// once normalized, so representation differences do not cause changes
+func (c *Client) EnsurePatterns(
+	desired []Pattern,
+	opts EnsurePatternsOptions,
+) (*PatternChanges, error) {
+	return c.EnsurePatternsContext(context.Background(), desired, opts)
+}
+
+// EnsurePatternsContext is like EnsurePatterns with a request context
+func (c *Client) EnsurePatternsContext(
+	ctx context.Context,
+	desired []Pattern,
+	opts EnsurePatternsOptions,
+) (*PatternChanges, error) {
+	wanted := make(map[Pattern]bool, len(desired))
+	for _, pattern := range desired {
+		normalized, err := pattern.Normalize()
+		if err != nil {
+			return nil, err
+		}
+		wanted[normalized] = true
+	}
+	current, err := c.GetAllPatternsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	registered := make(map[Pattern]bool, len(*current))
+	changes := &PatternChanges{}
+	for _, pattern := range *current {
+		// Patterns which cannot be parsed are compared literally
+		normalized, err := pattern.Normalize()
+		if err != nil {
+			normalized = pattern
+		}
+		registered[normalized] = true
+		if !wanted[normalized] && !opts.KeepUnknown {
+			changes.Removed = append(changes.Removed, pattern)
+		}
+	}
+	for _, pattern := range desired {
+		normalized, _ := pattern.Normalize()
+		if !registered[normalized] {
+			changes.Added = append(changes.Added, pattern)
+			// Skip duplicates within the desired patterns
+			registered[normalized] = true
+		}
+	}
+	if opts.DryRun || changes.Empty() {
+		return changes, nil
+	}
+	var plan ChangePlan
+	ctx, proceed, err := c.guardDestructive(ctx, func() (ChangePlan, error) {
+		var err error
+		plan, err = c.planReplacement(ctx, changes, opts)
+		return plan, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !proceed {
+		return changes, nil
+	}
+	// Roll back to the point that was confirmed
+	if plan.RollbackTo != nil {
+		opts.RollbackTo = plan.RollbackTo
+	}
+	rollbackTo, err := c.applyPatternChanges(ctx, changes, opts)
+	c.audit(ctx, AuditEntry{
+		Action:     AuditReplacePatterns,
+		Patterns:   patternStrings(changes.Added),
+		Removed:    patternStrings(changes.Removed),
+		RollbackTo: rollbackTo,
+		Limit:      opts.Limit,
+	}, err)
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// applyPatternChanges adds and removes patterns, returning the point Kupo
+// rolled back to if patterns were added
+func (c *Client) applyPatternChanges(
+	ctx context.Context,
+	changes *PatternChanges,
+	opts EnsurePatternsOptions,
+) (*Point, error) {
+	rollbackTo := opts.RollbackTo
+	if len(changes.Added) > 0 {
+		if rollbackTo == nil {
+			checkpoints, err := c.GetCheckpointsContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if len(*checkpoints) == 0 {
+				return nil, errors.New("no checkpoint to roll back to")
+			}
+			rollbackTo = &(*checkpoints)[0]
+		}
+		if _, err := c.AddPatternsContext(ctx, patternStrings(changes.Added), *rollbackTo, opts.Limit); err != nil {
+			return rollbackTo, err
+		}
+	} else {
+		rollbackTo = nil
+	}
+	for _, pattern := range changes.Removed {
+		if _, err := c.DeletePatternContext(ctx, string(pattern)); err != nil {
+			return rollbackTo, fmt.Errorf("failed to remove pattern %s: %s", pattern, err)
+		}
+	}
+	return rollbackTo, nil
+}
+
+func patternStrings(patterns Patterns) []string {
+	ret := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		ret = append(ret, string(pattern))
+	}
+	return ret
+}
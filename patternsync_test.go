@@ -0,0 +1,89 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEnsurePatterns(t *testing.T) {
+	var added addPatternsRequest
+	var deleted []string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/patterns":
+				_, _ = w.Write([]byte(`["*/` + testStakeKeyHash + `","` + strings.ToUpper(testPolicyID) + `.*","` + testBaseAddress + `"]`))
+			case r.Method == http.MethodGet && r.URL.Path == "/checkpoints":
+				_, _ = w.Write([]byte(`[{"slot_no":100,"header_hash":"aa"},{"slot_no":90,"header_hash":"bb"}]`))
+			case r.Method == http.MethodPut && r.URL.Path == "/patterns":
+				body, _ := io.ReadAll(r.Body)
+				if err := json.Unmarshal(body, &added); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_, _ = w.Write([]byte(`[]`))
+			case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/patterns/"):
+				deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/patterns/"))
+				_, _ = w.Write([]byte(`{"deleted":1}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+	desired := []Pattern{
+		MatchPolicy(testPolicyID),
+		MatchCredential(testPaymentKeyHash),
+		MatchAddress(testBaseAddress),
+	}
+	expected := &PatternChanges{
+		Added:   Patterns{MatchCredential(testPaymentKeyHash)},
+		Removed: Patterns{MatchStakeCredential(testStakeKeyHash)},
+	}
+
+	changes, err := client.EnsurePatterns(desired, EnsurePatternsOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, changes)
+	}
+	if added.Patterns != nil || deleted != nil {
+		t.Fatalf("Expected no changes in a dry run")
+	}
+
+	changes, err = client.EnsurePatterns(desired, EnsurePatternsOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, changes)
+	}
+	if !reflect.DeepEqual(added.Patterns, []string{testPaymentKeyHash + "/*"}) {
+		t.Fatalf("Unexpected added patterns %v", added.Patterns)
+	}
+	if added.RollbackTo.SlotNo != 100 || added.RollbackTo.HeaderHash != "aa" {
+		t.Fatalf("Expected rollback to the latest checkpoint, got %+v", added.RollbackTo)
+	}
+	if !reflect.DeepEqual(deleted, []string{"*/" + testStakeKeyHash}) {
+		t.Fatalf("Unexpected deleted patterns %v", deleted)
+	}
+
+	changes, err = client.EnsurePatterns(desired, EnsurePatternsOptions{KeepUnknown: true, DryRun: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(changes.Removed) != 0 {
+		t.Fatalf("Expected no removals, got %v", changes.Removed)
+	}
+
+	if _, err := client.EnsurePatterns([]Pattern{"bogus"}, EnsurePatternsOptions{}); err == nil {
+		t.Fatalf("Expected error for invalid pattern")
+	}
+}
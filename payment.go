@@ -0,0 +1,122 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// PaymentRequest describes an expected payment
+type PaymentRequest struct {
+	// Pattern matching the receiving address or outputs
+	Pattern string
+	// Amount is the minimum value a single output must carry
+	Amount Value
+	// Confirmations is the number of blocks, including the one carrying the
+	// payment, required before it is considered received
+	Confirmations int
+	// CreatedAfter ignores outputs created at or before this slot, such as
+	// payments made before the request was issued
+	CreatedAfter int
+	// PollInterval defaults to 10 seconds
+	PollInterval time.Duration
+	// OnError is called when a poll fails, such as when Kupo is briefly
+	// unreachable. Polling carries on until the context is done
+	OnError func(error)
+}
+
+// AwaitPayment polls Kupo until an output matching the request reaches the
+// required confirmation depth, and returns it. Outputs which are rolled back
+// before reaching the required depth are disregarded. Failed polls are
+// reported to PaymentRequest.OnError rather than ending the wait, so only the
+// context ends it without a payment
+func (c *Client) AwaitPayment(ctx context.Context, req PaymentRequest) (*Match, error) {
+	interval := req.PollInterval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		match, err := c.findPayment(ctx, req)
+		if err != nil {
+			if req.OnError != nil && ctx.Err() == nil {
+				req.OnError(err)
+			}
+		} else if match != nil {
+			return match, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// findPayment returns the oldest output satisfying the payment request, if any
+func (c *Client) findPayment(ctx context.Context, req PaymentRequest) (*Match, error) {
+	matches, err := c.GetMatchesWithOptionsContext(
+		ctx,
+		req.Pattern,
+		MatchOptions{CreatedAfter: req.CreatedAfter},
+	)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make(Matches, 0, len(*matches))
+	for _, match := range *matches {
+		if match.Value.Covers(req.Amount) {
+			candidates = append(candidates, match)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return matchLess(candidates[i], candidates[j])
+	})
+	checkpoints, err := c.GetCheckpointsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		depth, onChain := checkpoints.Depth(candidate.CreatedAt)
+		if onChain && depth >= req.Confirmations {
+			return &candidate, nil
+		}
+	}
+	return nil, nil
+}
+
+// Depth returns the number of checkpoints from the point up to the most recent
+// one, and whether the point is still part of the chain. Points older than all
+// known checkpoints are assumed to be on-chain
+func (c Checkpoints) Depth(point Point) (int, bool) {
+	depth := 0
+	for _, checkpoint := range c {
+		if checkpoint.SlotNo < point.SlotNo {
+			// The point's slot was skipped over, so its block was rolled back
+			return depth, false
+		}
+		depth++
+		if checkpoint.SlotNo == point.SlotNo {
+			return depth, checkpoint.HeaderHash == point.HeaderHash
+		}
+	}
+	return depth, true
+}
@@ -0,0 +1,170 @@
+package kupogo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_AwaitPayment(t *testing.T) {
+	var mu sync.Mutex
+	polls := 0
+	checkpoints := Checkpoints{
+		{SlotNo: 110, HeaderHash: "h110"},
+		{SlotNo: 105, HeaderHash: "h105"},
+		{SlotNo: 100, HeaderHash: "h100"},
+	}
+	matches := Matches{
+		// Too small
+		{TransactionID: "aa", Value: Value{Coins: 1}, CreatedAt: Point{SlotNo: 100, HeaderHash: "h100"}},
+		// Will be rolled back
+		{TransactionID: "bb", Value: Value{Coins: 50}, CreatedAt: Point{SlotNo: 105, HeaderHash: "orphan"}},
+		// Not deep enough until the chain grows
+		{TransactionID: "cc", Value: Value{Coins: 60}, CreatedAt: Point{SlotNo: 110, HeaderHash: "h110"}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			var respBody []byte
+			switch r.URL.Path {
+			case "/matches/addr1":
+				polls++
+				if polls == 2 {
+					checkpoints = append(Checkpoints{{SlotNo: 115, HeaderHash: "h115"}}, checkpoints...)
+				}
+				respBody, _ = json.Marshal(matches)
+			case "/checkpoints":
+				respBody, _ = json.Marshal(checkpoints)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	client := &Client{KupoUrl: server.URL}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	match, err := client.AwaitPayment(
+		ctx,
+		PaymentRequest{
+			Pattern:       "addr1",
+			Amount:        Value{Coins: 10},
+			Confirmations: 2,
+			PollInterval:  time.Millisecond,
+		},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if match.TransactionID != "cc" {
+		t.Errorf("Expected payment cc, got %s", match.TransactionID)
+	}
+	if polls != 2 {
+		t.Errorf("Expected payment to be found on the second poll, got %d", polls)
+	}
+}
+
+func TestClient_AwaitPaymentRollback(t *testing.T) {
+	var mu sync.Mutex
+	checkpointPolls := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			var respBody []byte
+			switch r.URL.Path {
+			case "/matches/addr1":
+				respBody, _ = json.Marshal(Matches{
+					// Deep enough, but its slot was rolled back
+					{TransactionID: "aa", Value: Value{Coins: 50}, CreatedAt: Point{SlotNo: 107, HeaderHash: "h107"}},
+					{TransactionID: "bb", Value: Value{Coins: 50}, CreatedAt: Point{SlotNo: 110, HeaderHash: "h110"}},
+				})
+			case "/checkpoints":
+				checkpointPolls++
+				if checkpointPolls == 1 {
+					// Kupo is briefly unavailable
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				respBody, _ = json.Marshal(Checkpoints{
+					{SlotNo: 115, HeaderHash: "h115"},
+					{SlotNo: 110, HeaderHash: "h110"},
+					{SlotNo: 105, HeaderHash: "h105"},
+				})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	client := &Client{KupoUrl: server.URL}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var errs []error
+	match, err := client.AwaitPayment(
+		ctx,
+		PaymentRequest{
+			Pattern:       "addr1",
+			Amount:        Value{Coins: 10},
+			Confirmations: 2,
+			PollInterval:  time.Millisecond,
+			OnError: func(err error) {
+				errs = append(errs, err)
+			},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if match.TransactionID != "bb" {
+		t.Errorf("Expected payment bb, got %s", match.TransactionID)
+	}
+	if len(errs) != 1 {
+		t.Errorf("Expected the failed poll to be reported, got %v", errs)
+	}
+}
+
+func TestCheckpoints_Depth(t *testing.T) {
+	checkpoints := Checkpoints{
+		{SlotNo: 30, HeaderHash: "c"},
+		{SlotNo: 20, HeaderHash: "b"},
+		{SlotNo: 10, HeaderHash: "a"},
+	}
+	testDefs := []struct {
+		point   Point
+		depth   int
+		onChain bool
+	}{
+		{Point{SlotNo: 30, HeaderHash: "c"}, 1, true},
+		{Point{SlotNo: 10, HeaderHash: "a"}, 3, true},
+		{Point{SlotNo: 10, HeaderHash: "x"}, 3, false},
+		{Point{SlotNo: 15, HeaderHash: "y"}, 2, false},
+		{Point{SlotNo: 5, HeaderHash: "z"}, 3, true},
+	}
+	for _, testDef := range testDefs {
+		depth, onChain := checkpoints.Depth(testDef.point)
+		if depth != testDef.depth || onChain != testDef.onChain {
+			t.Errorf(
+				"Expected depth %d/%v for %v, got %d/%v",
+				testDef.depth,
+				testDef.onChain,
+				testDef.point,
+				depth,
+				onChain,
+			)
+		}
+	}
+}
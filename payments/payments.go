@@ -0,0 +1,340 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package payments implements an invoice-based payment gateway on top of Kupo
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// Status is the lifecycle state of an invoice
+type Status string
+
+const (
+	// StatusPending invoices are waiting for a payment
+	StatusPending Status = "pending"
+	// StatusPaid invoices have a payment with enough confirmations, which
+	// may still be rolled back
+	StatusPaid Status = "paid"
+	// StatusSettled invoices have a payment deep enough to be final
+	StatusSettled Status = "settled"
+	// StatusExpired invoices were not paid in time
+	StatusExpired Status = "expired"
+)
+
+var ErrInvoiceNotFound = errors.New("invoice not found")
+
+// Invoice is a request for payment of an amount to an address
+type Invoice struct {
+	ID string
+	// Pattern matching the receiving address
+	Pattern string
+	Amount  kupogo.Value
+	// CreatedAfter is the chain tip slot when the invoice was created. Only
+	// outputs created after it can pay the invoice
+	CreatedAfter int
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+	Status       Status
+	Payment      *kupogo.Match
+}
+
+// Store persists invoices
+type Store interface {
+	Save(invoice *Invoice) error
+	Get(id string) (*Invoice, error)
+	// List returns the invoices with any of the given statuses, or all
+	// invoices if none are given
+	List(statuses ...Status) ([]*Invoice, error)
+}
+
+// Config configures a Gateway
+type Config struct {
+	Client *kupogo.Client
+	// Store defaults to an in-memory store
+	Store Store
+	// Confirmations required before an invoice is considered paid
+	Confirmations int
+	// SettleDepth is the depth at which payments are considered final,
+	// defaulting to 2160 blocks
+	SettleDepth int
+	// PollInterval defaults to 10 seconds
+	PollInterval time.Duration
+
+	OnPaid       func(*Invoice)
+	OnSettled    func(*Invoice)
+	OnExpired    func(*Invoice)
+	OnRolledBack func(*Invoice)
+	OnError      func(error)
+}
+
+const (
+	defaultSettleDepth  = 2160
+	defaultPollInterval = 10 * time.Second
+)
+
+// Gateway tracks invoices through their lifecycle
+type Gateway struct {
+	config Config
+	now    func() time.Time
+}
+
+// New creates a payment gateway
+func New(config Config) *Gateway {
+	if config.Store == nil {
+		config.Store = NewMemoryStore()
+	}
+	if config.SettleDepth <= 0 {
+		config.SettleDepth = defaultSettleDepth
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	return &Gateway{
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// CreateInvoice creates and stores a pending invoice for an amount payable to
+// the pattern within the given time
+func (g *Gateway) CreateInvoice(
+	id string,
+	pattern string,
+	amount kupogo.Value,
+	ttl time.Duration,
+) (*Invoice, error) {
+	checkpoints, err := g.config.Client.GetCheckpoints()
+	if err != nil {
+		return nil, err
+	}
+	if len(*checkpoints) == 0 {
+		return nil, errors.New("no checkpoints available")
+	}
+	now := g.now()
+	invoice := &Invoice{
+		ID:           id,
+		Pattern:      pattern,
+		Amount:       amount,
+		CreatedAfter: (*checkpoints)[0].SlotNo,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(ttl),
+		Status:       StatusPending,
+	}
+	if err := g.config.Store.Save(invoice); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// Invoice returns a stored invoice
+func (g *Gateway) Invoice(id string) (*Invoice, error) {
+	return g.config.Store.Get(id)
+}
+
+// Run polls for invoice updates until the context is cancelled
+func (g *Gateway) Run(ctx context.Context) error {
+	ticker := time.NewTicker(g.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := g.Poll(); err != nil && g.config.OnError != nil {
+			g.config.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll performs a single update pass over all open invoices
+func (g *Gateway) Poll() error {
+	checkpoints, err := g.config.Client.GetCheckpoints()
+	if err != nil {
+		return err
+	}
+	if err := g.checkPaid(*checkpoints); err != nil {
+		return err
+	}
+	return g.checkPending(*checkpoints)
+}
+
+// checkPaid watches paid invoices for rollbacks until they settle
+func (g *Gateway) checkPaid(checkpoints kupogo.Checkpoints) error {
+	invoices, err := g.config.Store.List(StatusPaid)
+	if err != nil {
+		return err
+	}
+	for _, invoice := range invoices {
+		point := invoice.Payment.CreatedAt
+		depth, onChain := checkpoints.Depth(point)
+		switch {
+		case !onChain:
+			invoice.Status = StatusPending
+			invoice.Payment = nil
+			if err := g.config.Store.Save(invoice); err != nil {
+				return err
+			}
+			g.notify(g.config.OnRolledBack, invoice)
+		case depth >= g.config.SettleDepth || isOlderThan(checkpoints, point):
+			invoice.Status = StatusSettled
+			if err := g.config.Store.Save(invoice); err != nil {
+				return err
+			}
+			g.notify(g.config.OnSettled, invoice)
+		}
+	}
+	return nil
+}
+
+// checkPending looks for payments of pending invoices, oldest invoice first
+func (g *Gateway) checkPending(checkpoints kupogo.Checkpoints) error {
+	invoices, err := g.config.Store.List(StatusPending)
+	if err != nil {
+		return err
+	}
+	sort.Slice(invoices, func(i, j int) bool {
+		return invoices[i].CreatedAt.Before(invoices[j].CreatedAt)
+	})
+	claimed, err := g.claimedOutputs()
+	if err != nil {
+		return err
+	}
+	now := g.now()
+	for _, invoice := range invoices {
+		if !now.Before(invoice.ExpiresAt) {
+			invoice.Status = StatusExpired
+			if err := g.config.Store.Save(invoice); err != nil {
+				return err
+			}
+			g.notify(g.config.OnExpired, invoice)
+			continue
+		}
+		matches, err := g.config.Client.GetMatchesWithOptions(
+			invoice.Pattern,
+			kupogo.MatchOptions{
+				CreatedAfter: invoice.CreatedAfter,
+				Order:        kupogo.MatchOrderOldestFirst,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to check invoice %s: %s", invoice.ID, err)
+		}
+		for _, match := range *matches {
+			if claimed[match.OutputReference()] || !match.Value.Covers(invoice.Amount) {
+				continue
+			}
+			depth, onChain := checkpoints.Depth(match.CreatedAt)
+			if !onChain || depth < g.config.Confirmations {
+				continue
+			}
+			payment := match
+			invoice.Status = StatusPaid
+			invoice.Payment = &payment
+			claimed[match.OutputReference()] = true
+			if err := g.config.Store.Save(invoice); err != nil {
+				return err
+			}
+			g.notify(g.config.OnPaid, invoice)
+			break
+		}
+	}
+	return nil
+}
+
+// claimedOutputs returns the outputs already used to pay an invoice
+func (g *Gateway) claimedOutputs() (map[kupogo.OutputReference]bool, error) {
+	invoices, err := g.config.Store.List(StatusPaid, StatusSettled)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[kupogo.OutputReference]bool, len(invoices))
+	for _, invoice := range invoices {
+		ret[invoice.Payment.OutputReference()] = true
+	}
+	return ret, nil
+}
+
+func (g *Gateway) notify(callback func(*Invoice), invoice *Invoice) {
+	if callback != nil {
+		callback(invoice)
+	}
+}
+
+// isOlderThan returns true if the point predates all known checkpoints
+func isOlderThan(checkpoints kupogo.Checkpoints, point kupogo.Point) bool {
+	return len(checkpoints) > 0 &&
+		point.SlotNo < checkpoints[len(checkpoints)-1].SlotNo
+}
+
+// MemoryStore is a Store keeping invoices in memory
+type MemoryStore struct {
+	mu       sync.Mutex
+	invoices map[string]Invoice
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		invoices: make(map[string]Invoice),
+	}
+}
+
+func (s *MemoryStore) Save(invoice *Invoice) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.invoices[invoice.ID] = *invoice
+	return nil
+}
+
+func (s *MemoryStore) Get(id string) (*Invoice, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	invoice, ok := s.invoices[id]
+	if !ok {
+		return nil, ErrInvoiceNotFound
+	}
+	return &invoice, nil
+}
+
+func (s *MemoryStore) List(statuses ...Status) ([]*Invoice, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ret []*Invoice
+	for _, invoice := range s.invoices {
+		if len(statuses) > 0 && !hasStatus(invoice.Status, statuses) {
+			continue
+		}
+		invoice := invoice
+		ret = append(ret, &invoice)
+	}
+	return ret, nil
+}
+
+func hasStatus(status Status, statuses []Status) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package payments
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+type testKupo struct {
+	mu          sync.Mutex
+	checkpoints kupogo.Checkpoints
+	matches     map[string]kupogo.Matches
+}
+
+func (k *testKupo) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	var respBody []byte
+	if r.URL.Path == "/checkpoints" {
+		respBody, _ = json.Marshal(k.checkpoints)
+	} else {
+		matches := k.matches[r.URL.Path[len("/matches/"):]]
+		if matches == nil {
+			matches = kupogo.Matches{}
+		}
+		respBody, _ = json.Marshal(matches)
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func TestGateway(t *testing.T) {
+	kupo := &testKupo{
+		checkpoints: kupogo.Checkpoints{{SlotNo: 100, HeaderHash: "h100"}},
+		matches:     map[string]kupogo.Matches{},
+	}
+	server := httptest.NewServer(kupo)
+	defer server.Close()
+
+	var events []string
+	record := func(event string) func(*Invoice) {
+		return func(invoice *Invoice) {
+			events = append(events, event+":"+invoice.ID)
+		}
+	}
+	now := time.Unix(1700000000, 0)
+	gateway := New(Config{
+		Client:        kupogo.NewClient(server.URL),
+		Confirmations: 1,
+		SettleDepth:   3,
+		OnPaid:        record("paid"),
+		OnSettled:     record("settled"),
+		OnExpired:     record("expired"),
+		OnRolledBack:  record("rolledback"),
+	})
+	gateway.now = func() time.Time { return now }
+
+	if _, err := gateway.CreateInvoice("inv1", "addr1", kupogo.Value{Coins: 10}, time.Hour); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if _, err := gateway.CreateInvoice("inv2", "addr2", kupogo.Value{Coins: 10}, time.Minute); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	// inv1 gets paid
+	kupo.mu.Lock()
+	kupo.checkpoints = kupogo.Checkpoints{{SlotNo: 101, HeaderHash: "h101"}, {SlotNo: 100, HeaderHash: "h100"}}
+	kupo.matches["addr1"] = kupogo.Matches{
+		{TransactionID: "aa", Value: kupogo.Value{Coins: 10}, CreatedAt: kupogo.Point{SlotNo: 101, HeaderHash: "h101"}},
+	}
+	kupo.mu.Unlock()
+	if err := gateway.Poll(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	// The payment is rolled back and inv2 expires
+	now = now.Add(2 * time.Minute)
+	kupo.mu.Lock()
+	kupo.checkpoints = kupogo.Checkpoints{{SlotNo: 102, HeaderHash: "h102"}, {SlotNo: 100, HeaderHash: "h100"}}
+	kupo.matches["addr1"] = nil
+	kupo.mu.Unlock()
+	if err := gateway.Poll(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	// inv1 gets paid again and settles
+	kupo.mu.Lock()
+	kupo.matches["addr1"] = kupogo.Matches{
+		{TransactionID: "bb", Value: kupogo.Value{Coins: 12}, CreatedAt: kupogo.Point{SlotNo: 102, HeaderHash: "h102"}},
+	}
+	kupo.mu.Unlock()
+	if err := gateway.Poll(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	kupo.mu.Lock()
+	kupo.checkpoints = append(
+		kupogo.Checkpoints{{SlotNo: 104, HeaderHash: "h104"}, {SlotNo: 103, HeaderHash: "h103"}},
+		kupo.checkpoints...,
+	)
+	kupo.mu.Unlock()
+	if err := gateway.Poll(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	expectedEvents := []string{"paid:inv1", "rolledback:inv1", "expired:inv2", "paid:inv1", "settled:inv1"}
+	if len(events) != len(expectedEvents) {
+		t.Fatalf("Expected events %v, got %v", expectedEvents, events)
+	}
+	for idx := range events {
+		if events[idx] != expectedEvents[idx] {
+			t.Fatalf("Expected events %v, got %v", expectedEvents, events)
+		}
+	}
+	invoice, err := gateway.Invoice("inv1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if invoice.Status != StatusSettled || invoice.Payment.TransactionID != "bb" {
+		t.Errorf("Unexpected final invoice state: %v", invoice)
+	}
+}
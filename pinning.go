@@ -0,0 +1,117 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrCertificatePin = errors.New("certificate does not match pins")
+
+// spkiPinPrefix is the prefix of SPKI pins in curl's --pinnedpubkey format
+const spkiPinPrefix = "sha256//"
+
+// CertificatePins restricts the certificates Kupo may present, for
+// deployments that cannot rely solely on CA trust. A connection is accepted
+// if any pin matches
+type CertificatePins struct {
+	// SPKI are base64 SHA-256 hashes of subject public key infos, as
+	// returned by SPKIPin. They survive certificate renewals which keep the
+	// key. A "sha256//" prefix, as used by curl, is accepted
+	SPKI []string
+	// Fingerprints are hex SHA-256 hashes of DER certificates, as returned
+	// by CertificateFingerprint
+	Fingerprints []string
+	// SkipCAVerification accepts certificates matching a pin without
+	// checking they are signed by a trusted CA, such as the self-signed
+	// certificate of a Kupo reverse proxy. Only the server's own certificate
+	// is then compared to the pins. Otherwise the pins may match any
+	// certificate of the verified chain, such as an intermediate CA
+	SkipCAVerification bool
+}
+
+// SPKIPin returns the SPKI pin of a certificate
+func SPKIPin(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// CertificateFingerprint returns the SHA-256 fingerprint of a certificate
+func CertificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// WithCertificatePins only accepts TLS connections to Kupo presenting a
+// pinned certificate or key. Like WithTransport, it replaces the transport of
+// a client given with WithHTTPClient
+func WithCertificatePins(pins CertificatePins) ClientOption {
+	return func(c *Client) {
+		var config TransportConfig
+		if c.transport != nil {
+			config = *c.transport
+		}
+		config.Pins = &pins
+		c.transport = &config
+	}
+}
+
+// tlsConfig returns a TLS configuration enforcing the pins
+func (p *CertificatePins) tlsConfig() *tls.Config {
+	spki := make(map[string]bool, len(p.SPKI))
+	for _, pin := range p.SPKI {
+		spki[strings.TrimPrefix(pin, spkiPinPrefix)] = true
+	}
+	fingerprints := make(map[string]bool, len(p.Fingerprints))
+	for _, fingerprint := range p.Fingerprints {
+		// Fingerprints are often written with colons, as by openssl
+		fingerprint = strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+		fingerprints[fingerprint] = true
+	}
+	matches := func(cert *x509.Certificate) bool {
+		return spki[SPKIPin(cert)] || fingerprints[CertificateFingerprint(cert)]
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// VerifyConnection runs even when CA verification is skipped
+		InsecureSkipVerify: p.SkipCAVerification, //nolint:gosec
+		VerifyConnection: func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return ErrCertificatePin
+			}
+			if p.SkipCAVerification {
+				if matches(state.PeerCertificates[0]) {
+					return nil
+				}
+				return fmt.Errorf("%w: %s", ErrCertificatePin, state.ServerName)
+			}
+			for _, chain := range state.VerifiedChains {
+				for _, cert := range chain {
+					if matches(cert) {
+						return nil
+					}
+				}
+			}
+			return fmt.Errorf("%w: %s", ErrCertificatePin, state.ServerName)
+		},
+	}
+}
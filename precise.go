@@ -0,0 +1,181 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"reflect"
+)
+
+// WithJSONNumbers decodes numbers as json.Number instead of float64 wherever
+// responses are decoded into interface values, such as with GetJSON, so large
+// integers keep their precision
+func WithJSONNumbers() ClientOption {
+	return func(c *Client) {
+		c.jsonNumbers = true
+	}
+}
+
+// BigValue is a Value with arbitrary precision quantities. Cardano quantities
+// go up to 2^64-1, beyond the range of int
+type BigValue struct {
+	Coins  *big.Int            `json:"coins"`
+	Assets map[string]*big.Int `json:"assets"`
+}
+
+// UnmarshalJSON decodes the quantities without going through float64
+func (v *BigValue) UnmarshalJSON(data []byte) error {
+	var value struct {
+		Coins  json.Number            `json:"coins"`
+		Assets map[string]json.Number `json:"assets"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	coins, ok := new(big.Int).SetString(string(value.Coins), 10)
+	if !ok {
+		return fmt.Errorf("invalid coins quantity %q", value.Coins)
+	}
+	*v = BigValue{Coins: coins}
+	if len(value.Assets) > 0 {
+		v.Assets = make(map[string]*big.Int, len(value.Assets))
+	}
+	for assetID, number := range value.Assets {
+		quantity, ok := new(big.Int).SetString(string(number), 10)
+		if !ok {
+			return fmt.Errorf("invalid quantity %q of asset %s", number, assetID)
+		}
+		v.Assets[assetID] = quantity
+	}
+	return nil
+}
+
+// MarshalJSON encodes the value in Kupo's format
+func (v BigValue) MarshalJSON() ([]byte, error) {
+	assets := v.Assets
+	if assets == nil {
+		assets = map[string]*big.Int{}
+	}
+	coins := v.Coins
+	if coins == nil {
+		coins = new(big.Int)
+	}
+	return json.Marshal(struct {
+		Coins  *big.Int            `json:"coins"`
+		Assets map[string]*big.Int `json:"assets"`
+	}{coins, assets})
+}
+
+// Value returns the value with int quantities, and false if a quantity does
+// not fit
+func (v BigValue) Value() (Value, bool) {
+	var ret Value
+	if v.Coins != nil {
+		if !v.Coins.IsInt64() {
+			return Value{}, false
+		}
+		ret.Coins = int(v.Coins.Int64())
+	}
+	if len(v.Assets) > 0 {
+		ret.Assets = make(Assets, len(v.Assets))
+	}
+	for assetID, quantity := range v.Assets {
+		if !quantity.IsInt64() {
+			return Value{}, false
+		}
+		ret.Assets[assetID] = int(quantity.Int64())
+	}
+	return ret, true
+}
+
+// PreciseMatch is a match whose value is decoded with arbitrary precision.
+// Match.Value is only set if all quantities fit in an int
+type PreciseMatch struct {
+	Match Match
+	Value BigValue
+}
+
+// UnmarshalJSON decodes a match in Kupo's format
+func (m *PreciseMatch) UnmarshalJSON(data []byte) error {
+	projection := reflect.New(projectionType(AllMatchFields &^ (FieldCoins | FieldAssets)))
+	if err := json.Unmarshal(data, projection.Interface()); err != nil {
+		return err
+	}
+	var value struct {
+		Value BigValue `json:"value"`
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*m = PreciseMatch{Value: value.Value}
+	copyFields(reflect.ValueOf(&m.Match).Elem(), projection.Elem())
+	if small, ok := value.Value.Value(); ok {
+		m.Match.Value = small
+	}
+	return nil
+}
+
+// MarshalJSON encodes the match in Kupo's format, with its precise value
+func (m PreciseMatch) MarshalJSON() ([]byte, error) {
+	// The conversion drops the methods of Match, and the outer Value field
+	// shadows the one of Match
+	type match Match
+	return json.Marshal(struct {
+		*match
+		Value BigValue `json:"value"`
+	}{(*match)(&m.Match), m.Value})
+}
+
+// GetPreciseMatches is like GetMatchesWithOptions, decoding values with
+// arbitrary precision
+func (c *Client) GetPreciseMatches(pattern string, opts MatchOptions) ([]PreciseMatch, error) {
+	return c.GetPreciseMatchesContext(context.Background(), pattern, opts)
+}
+
+// GetPreciseMatchesContext is like GetPreciseMatches with a request context
+func (c *Client) GetPreciseMatchesContext(
+	ctx context.Context,
+	pattern string,
+	opts MatchOptions,
+) ([]PreciseMatch, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.matchesURL(pattern, opts), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matches: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"failed to get matches: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	var matches []PreciseMatch
+	if err := c.decodeJSON(req, resp.Body, &matches); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal matches: %s", err)
+	}
+	return matches, nil
+}
@@ -0,0 +1,59 @@
+package kupogo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPreciseMatches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"transaction_id":"aa","output_index":0,"address":"addr1","value":{"coins":2,"assets":{"cc.dd":18446744073709551615}},"created_at":{"slot_no":1,"header_hash":"bb"}},
+			{"transaction_id":"aa","output_index":1,"address":"addr1","value":{"coins":3,"assets":{}},"created_at":{"slot_no":1,"header_hash":"bb"}}
+		]`))
+	}))
+	defer server.Close()
+
+	matches, err := NewClient(server.URL).GetPreciseMatches("*", MatchOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("Expected 2 matches, got %d", len(matches))
+	}
+	if matches[0].Value.Assets["cc.dd"].String() != "18446744073709551615" {
+		t.Fatalf("Unexpected quantity: %s", matches[0].Value.Assets["cc.dd"])
+	}
+	if matches[0].Match.Value.Coins != 0 || matches[0].Match.Address != "addr1" {
+		t.Fatalf("Expected only the value to be left unset, got %+v", matches[0].Match)
+	}
+	if matches[1].Match.Value.Coins != 3 || matches[1].Match.OutputIndex != 1 {
+		t.Fatalf("Expected a value fitting an int to be set, got %+v", matches[1].Match)
+	}
+	data, err := json.Marshal(matches[0].Value)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if string(data) != `{"coins":2,"assets":{"cc.dd":18446744073709551615}}` {
+		t.Fatalf("Unexpected encoding: %s", data)
+	}
+}
+
+func TestWithJSONNumbers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"quantity":18446744073709551615}`))
+	}))
+	defer server.Close()
+
+	var out map[string]any
+	client := NewClient(server.URL, WithJSONNumbers())
+	if err := client.GetJSON(context.Background(), "/custom", nil, &out); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if number, ok := out["quantity"].(json.Number); !ok || number.String() != "18446744073709551615" {
+		t.Fatalf("Expected an exact json.Number, got %#v", out["quantity"])
+	}
+}
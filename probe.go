@@ -0,0 +1,82 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrNotReady is wrapped by the errors of CheckReady
+var ErrNotReady = errors.New("kupo not ready")
+
+const defaultProbeTimeout = 5 * time.Second
+
+// ProbeConfig configures ProbeHandler
+type ProbeConfig struct {
+	// MaxSlotLag is the maximum number of slots Kupo's most recent checkpoint
+	// may be behind the node tip, 0 for no limit
+	MaxSlotLag int
+	// Timeout of the health request, defaulting to 5 seconds
+	Timeout time.Duration
+}
+
+// CheckReady returns an error wrapping ErrNotReady unless Kupo is reachable,
+// connected to its node and, if maxSlotLag is not 0, at most maxSlotLag slots
+// behind the node tip
+func (c *Client) CheckReady(ctx context.Context, maxSlotLag int) error {
+	health, err := c.GetHealthContext(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrNotReady, err)
+	}
+	if !health.IsConnected() {
+		return fmt.Errorf("%w: disconnected from node", ErrNotReady)
+	}
+	if maxSlotLag <= 0 {
+		return nil
+	}
+	lag, ok := health.SyncLag()
+	if !ok {
+		return fmt.Errorf("%w: sync lag unknown", ErrNotReady)
+	}
+	if lag > maxSlotLag {
+		return fmt.Errorf("%w: %d slots behind node tip", ErrNotReady, lag)
+	}
+	return nil
+}
+
+// ProbeHandler returns an http.Handler for Kubernetes readiness and liveness
+// probes, answering 200 when CheckReady succeeds and 503 with the reason
+// otherwise
+func ProbeHandler(client *Client, config ProbeConfig) http.Handler {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultProbeTimeout
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), config.Timeout)
+		defer cancel()
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		if err := client.CheckReady(ctx, config.MaxSlotLag); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, err)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+}
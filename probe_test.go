@@ -0,0 +1,43 @@
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestProbeHandler(t *testing.T) {
+	health := `{"connection_status":"connected","most_recent_checkpoint":90,"most_recent_node_tip":100}`
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(health))
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	probe := func(maxSlotLag int) (int, string) {
+		rec := httptest.NewRecorder()
+		ProbeHandler(client, ProbeConfig{MaxSlotLag: maxSlotLag}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+		return rec.Code, rec.Body.String()
+	}
+	if code, body := probe(10); code != http.StatusOK || body != "ok\n" {
+		t.Fatalf("Expected ready, got %d %s", code, body)
+	}
+	if code, body := probe(5); code != http.StatusServiceUnavailable || !strings.Contains(body, "10 slots behind") {
+		t.Fatalf("Expected not ready, got %d %s", code, body)
+	}
+
+	health = `{"connection_status":"disconnected"}`
+	if code, _ := probe(0); code != http.StatusServiceUnavailable {
+		t.Fatalf("Expected not ready when disconnected, got %d", code)
+	}
+
+	server.Close()
+	if err := client.CheckReady(context.Background(), 0); !errors.Is(err, ErrNotReady) {
+		t.Fatalf("Expected ErrNotReady, got %v", err)
+	}
+}
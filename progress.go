@@ -0,0 +1,105 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"time"
+)
+
+// Progress describes how far the download of a response has gone
+type Progress struct {
+	// Bytes is the number of body bytes read so far
+	Bytes int64
+	// Total is the size of the body, or -1 if Kupo did not announce it
+	Total int64
+	// Items is the number of matches received so far, for matches queries
+	Items int
+	// Elapsed is the time since the request was sent
+	Elapsed time.Duration
+	// Done is set on the last report, once the body was read entirely
+	Done bool
+}
+
+// ReportProgress calls onProgress as each response body is read, for showing
+// progress bars or detecting stalled downloads of large results. It is called
+// from the goroutine reading the body, often, so it should be quick
+func ReportProgress(onProgress func(Progress)) CallOption {
+	return func(o *callOptions) {
+		o.progress = onProgress
+	}
+}
+
+type progressBody struct {
+	io.ReadCloser
+	onProgress func(Progress)
+	progress   Progress
+	start      time.Time
+	countItems bool
+	// tail holds the end of the previous read, for finding items split
+	// across reads
+	tail []byte
+}
+
+func newProgressBody(
+	body io.ReadCloser,
+	onProgress func(Progress),
+	total int64,
+	start time.Time,
+	countItems bool,
+) *progressBody {
+	return &progressBody{
+		ReadCloser: body,
+		onProgress: onProgress,
+		progress:   Progress{Total: total},
+		start:      start,
+		countItems: countItems,
+	}
+}
+
+func (b *progressBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if b.progress.Done {
+		return n, err
+	}
+	if n > 0 {
+		b.progress.Bytes += int64(n)
+		if b.countItems {
+			b.count(p[:n])
+		}
+	}
+	if errors.Is(err, io.EOF) {
+		b.progress.Done = true
+	}
+	if n > 0 || b.progress.Done {
+		b.progress.Elapsed = time.Since(b.start)
+		b.onProgress(b.progress)
+	}
+	return n, err
+}
+
+// count counts the matches in a chunk, each having the key exactly once
+func (b *progressBody) count(chunk []byte) {
+	data := append(b.tail, chunk...)
+	b.progress.Items += bytes.Count(data, matchKey)
+	keep := len(matchKey) - 1
+	if keep > len(data) {
+		keep = len(data)
+	}
+	// The kept bytes are shorter than the key, so no key is counted twice
+	b.tail = append(b.tail[:0], data[len(data)-keep:]...)
+}
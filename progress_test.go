@@ -0,0 +1,55 @@
+package kupogo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+const testProgressMatches = `[{"transaction_id":"aa"},{"transaction_id":"bb"},{"transaction_id":"cc"}]`
+
+func TestReportProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testProgressMatches))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	var reports []Progress
+	ctx := WithCallOptions(context.Background(), ReportProgress(func(p Progress) {
+		reports = append(reports, p)
+	}))
+	if _, err := client.GetMatchesContext(ctx, "*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(reports) == 0 {
+		t.Fatalf("Expected progress reports")
+	}
+	last := reports[len(reports)-1]
+	if !last.Done || last.Items != 3 || last.Bytes != int64(len(testProgressMatches)) ||
+		last.Total != int64(len(testProgressMatches)) {
+		t.Fatalf("Unexpected final progress: %+v", last)
+	}
+}
+
+func TestProgressCountsSplitItems(t *testing.T) {
+	var last Progress
+	body := newProgressBody(
+		io.NopCloser(iotest.OneByteReader(strings.NewReader(testProgressMatches))),
+		func(p Progress) { last = p },
+		-1,
+		time.Now(),
+		true,
+	)
+	if _, err := io.ReadAll(body); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if last.Items != 3 || !last.Done {
+		t.Fatalf("Unexpected progress: %+v", last)
+	}
+}
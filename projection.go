@@ -0,0 +1,192 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+)
+
+// MatchFields selects the fields of matches to decode
+type MatchFields uint
+
+const (
+	// FieldOutputReference is the transaction ID, transaction index and
+	// output index
+	FieldOutputReference MatchFields = 1 << iota
+	// FieldAddress is the address
+	FieldAddress
+	// FieldCoins is the lovelace of the value
+	FieldCoins
+	// FieldAssets is the native assets of the value
+	FieldAssets
+	// FieldDatum is the datum hash and type
+	FieldDatum
+	// FieldScriptHash is the hash of the reference script
+	FieldScriptHash
+	// FieldCreatedAt is the point the output was created at
+	FieldCreatedAt
+	// FieldSpentAt is the point the output was spent at
+	FieldSpentAt
+
+	// AllMatchFields decodes matches entirely
+	AllMatchFields = FieldOutputReference | FieldAddress | FieldCoins |
+		FieldAssets | FieldDatum | FieldScriptHash | FieldCreatedAt | FieldSpentAt
+)
+
+// matchFieldNames lists the fields of Match decoded for each selection
+var matchFieldNames = []struct {
+	fields MatchFields
+	names  []string
+}{
+	{FieldOutputReference, []string{"TransactionIndex", "TransactionID", "OutputIndex"}},
+	{FieldAddress, []string{"Address"}},
+	{FieldDatum, []string{"DatumHash", "DatumType"}},
+	{FieldScriptHash, []string{"ScriptHash"}},
+	{FieldCreatedAt, []string{"CreatedAt"}},
+	{FieldSpentAt, []string{"SpentAt"}},
+}
+
+var projectionTypes sync.Map
+
+// projectionType returns a struct type with only the selected fields of
+// Match, so that decoding into it skips the others entirely
+func projectionType(fields MatchFields) reflect.Type {
+	if ret, ok := projectionTypes.Load(fields); ok {
+		return ret.(reflect.Type)
+	}
+	matchType := reflect.TypeOf(Match{})
+	var structFields []reflect.StructField
+	for _, group := range matchFieldNames {
+		if fields&group.fields == 0 {
+			continue
+		}
+		for _, name := range group.names {
+			field, _ := matchType.FieldByName(name)
+			field.Index = nil
+			field.Offset = 0
+			structFields = append(structFields, field)
+		}
+	}
+	if fields&(FieldCoins|FieldAssets) != 0 {
+		valueField, _ := matchType.FieldByName("Value")
+		var valueFields []reflect.StructField
+		for _, name := range []string{"Coins", "Assets"} {
+			if (name == "Coins" && fields&FieldCoins == 0) ||
+				(name == "Assets" && fields&FieldAssets == 0) {
+				continue
+			}
+			field, _ := valueField.Type.FieldByName(name)
+			field.Index = nil
+			field.Offset = 0
+			valueFields = append(valueFields, field)
+		}
+		valueField.Type = reflect.StructOf(valueFields)
+		valueField.Index = nil
+		valueField.Offset = 0
+		structFields = append(structFields, valueField)
+	}
+	ret := reflect.StructOf(structFields)
+	projectionTypes.Store(fields, ret)
+	return ret
+}
+
+// copyFields sets the fields of dst from the fields of the same name of src
+func copyFields(dst reflect.Value, src reflect.Value) {
+	for i := 0; i < src.NumField(); i++ {
+		name := src.Type().Field(i).Name
+		field := dst.FieldByName(name)
+		if field.Kind() == reflect.Struct && field.Type() != src.Field(i).Type() {
+			copyFields(field, src.Field(i))
+			continue
+		}
+		field.Set(src.Field(i))
+	}
+}
+
+// GetMatchesProjected is like GetMatchesWithOptions, decoding only the
+// selected fields of the matches and leaving the others zero. Skipping
+// unneeded fields, especially assets, cuts decoding time and memory for uses
+// such as accounting which only need references and coins
+func (c *Client) GetMatchesProjected(
+	pattern string,
+	opts MatchOptions,
+	fields MatchFields,
+) (*Matches, error) {
+	return c.GetMatchesProjectedContext(context.Background(), pattern, opts, fields)
+}
+
+// GetMatchesProjectedContext is like GetMatchesProjected with a request
+// context
+func (c *Client) GetMatchesProjectedContext(
+	ctx context.Context,
+	pattern string,
+	opts MatchOptions,
+	fields MatchFields,
+) (*Matches, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.matchesURL(pattern, opts), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matches: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"failed to get matches: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	token, err := decoder.Token()
+	if err == nil {
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			err = fmt.Errorf("expected JSON array, got %v", token)
+		}
+	}
+	if err != nil {
+		c.logDecodeFailure(req, err)
+		return nil, fmt.Errorf("failed to read matches: %s", err)
+	}
+	projection := reflect.New(projectionType(fields))
+	keep := matchFilter(req)
+	matches := Matches{}
+	for i := 0; decoder.More(); i++ {
+		projection.Elem().SetZero()
+		if err := decoder.Decode(projection.Interface()); err != nil {
+			c.logDecodeFailure(req, err)
+			return nil, fmt.Errorf("failed to unmarshal match %d: %s", i, err)
+		}
+		var match Match
+		copyFields(reflect.ValueOf(&match).Elem(), projection.Elem())
+		// Filters only see the projected fields
+		if keep != nil && !keep(match) {
+			continue
+		}
+		matches = append(matches, match)
+	}
+	if _, err := decoder.Token(); err != nil {
+		c.logDecodeFailure(req, err)
+		return nil, fmt.Errorf("failed to read matches: %s", err)
+	}
+	return &matches, nil
+}
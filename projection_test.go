@@ -0,0 +1,44 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+const testProjectionMatches = `[{"transaction_index":1,"transaction_id":"aa","output_index":2,"address":"addr1","value":{"coins":5,"assets":{"cc.dd":1}},"datum_hash":"ee","datum_type":"inline","script_hash":null,"created_at":{"slot_no":10,"header_hash":"bb"},"spent_at":null}]`
+
+func TestGetMatchesProjected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testProjectionMatches))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	matches, err := client.GetMatchesProjected("*", MatchOptions{}, FieldOutputReference|FieldCoins)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := Matches{{
+		TransactionIndex: 1,
+		TransactionID:    "aa",
+		OutputIndex:      2,
+		Value:            Value{Coins: 5},
+	}}
+	if !reflect.DeepEqual(*matches, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, *matches)
+	}
+
+	full, err := client.GetMatchesProjected("*", MatchOptions{}, AllMatchFields)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	unprojected, err := client.GetMatches("*")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(full, unprojected) {
+		t.Fatalf("Expected all fields to be decoded, got %+v", *full)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafkapub publishes Kupo events to Kafka
+package kafkapub
+
+import (
+	"context"
+
+	"github.com/blinklabs-io/kupogo/publish"
+	"github.com/segmentio/kafka-go"
+)
+
+// Publisher publishes messages with a Kafka writer
+type Publisher struct {
+	writer *kafka.Writer
+}
+
+// New creates a publisher writing with the given writer, which must not set
+// a Topic of its own since each message has one
+func New(writer *kafka.Writer) *Publisher {
+	return &Publisher{writer: writer}
+}
+
+// Publish writes the messages, keyed so that events of an output go to the
+// same partition
+func (p *Publisher) Publish(ctx context.Context, messages ...publish.Message) error {
+	kafkaMessages := make([]kafka.Message, 0, len(messages))
+	for _, message := range messages {
+		kafkaMessage := kafka.Message{
+			Topic: message.Topic,
+			Value: message.Value,
+		}
+		if message.Key != "" {
+			kafkaMessage.Key = []byte(message.Key)
+		}
+		for key, value := range message.Headers {
+			kafkaMessage.Headers = append(
+				kafkaMessage.Headers,
+				kafka.Header{Key: key, Value: []byte(value)},
+			)
+		}
+		kafkaMessages = append(kafkaMessages, kafkaMessage)
+	}
+	return p.writer.WriteMessages(ctx, kafkaMessages...)
+}
+
+// Close closes the writer
+func (p *Publisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package natspub publishes Kupo events to NATS
+package natspub
+
+import (
+	"context"
+
+	"github.com/blinklabs-io/kupogo/publish"
+	"github.com/nats-io/nats.go"
+)
+
+// KeyHeader carries the key of messages
+const KeyHeader = "Kupo-Key"
+
+// Publisher publishes messages on a NATS connection, using topics as
+// subjects
+type Publisher struct {
+	conn *nats.Conn
+}
+
+// New creates a publisher using the given connection
+func New(conn *nats.Conn) *Publisher {
+	return &Publisher{conn: conn}
+}
+
+// Publish publishes the messages and flushes them to the server. The key is
+// sent in the Kupo-Key header
+func (p *Publisher) Publish(ctx context.Context, messages ...publish.Message) error {
+	for _, message := range messages {
+		natsMessage := nats.NewMsg(message.Topic)
+		natsMessage.Data = message.Value
+		for key, value := range message.Headers {
+			natsMessage.Header.Set(key, value)
+		}
+		if message.Key != "" {
+			natsMessage.Header.Set(KeyHeader, message.Key)
+		}
+		if err := p.conn.PublishMsg(natsMessage); err != nil {
+			return err
+		}
+	}
+	return p.conn.FlushWithContext(ctx)
+}
+
+// Close drains and closes the connection
+func (p *Publisher) Close() error {
+	return p.conn.Drain()
+}
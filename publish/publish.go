@@ -0,0 +1,215 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package publish pushes Kupo watcher and follower events onto message
+// queues, through a Publisher implemented for Kafka in kafkapub, for NATS in
+// natspub, or for any other system
+package publish
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// Message is a message to publish
+type Message struct {
+	// Topic is the topic or subject to publish to
+	Topic string
+	// Key orders related messages, such as by Kafka partition
+	Key string
+	// Value is the message body
+	Value   []byte
+	Headers map[string]string
+}
+
+// Publisher publishes messages to a queue
+type Publisher interface {
+	Publish(ctx context.Context, messages ...Message) error
+	Close() error
+}
+
+// EventType identifies the kind of an event
+type EventType string
+
+const (
+	EventCreated  EventType = "created"
+	EventSpent    EventType = "spent"
+	EventRollback EventType = "rollback"
+)
+
+// Event is the JSON body of the published messages
+type Event struct {
+	Type    EventType     `json:"type"`
+	Pattern string        `json:"pattern"`
+	Match   *kupogo.Match `json:"match,omitempty"`
+	// Point is the point rolled back to, or the tip of a roll forward
+	Point *kupogo.Point `json:"point,omitempty"`
+}
+
+// Config configures a Bridge
+type Config struct {
+	// Topic returns the topic of an event, defaulting to "kupo." followed
+	// by the event type
+	Topic func(Event) string
+	// OnError is called when the events of a watcher could not be published
+	OnError func(error)
+}
+
+// Bridge publishes events of watchers and followers
+type Bridge struct {
+	publisher Publisher
+	config    Config
+}
+
+// NewBridge creates a bridge publishing to the given publisher
+func NewBridge(publisher Publisher, config Config) *Bridge {
+	if config.Topic == nil {
+		config.Topic = func(event Event) string {
+			return "kupo." + string(event.Type)
+		}
+	}
+	return &Bridge{publisher: publisher, config: config}
+}
+
+// Publish publishes events, keyed by output reference for match events so
+// that the creation and spending of an output stay in order
+func (b *Bridge) Publish(ctx context.Context, events ...Event) error {
+	messages := make([]Message, 0, len(events))
+	for _, event := range events {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to marshal event: %s", err)
+		}
+		message := Message{
+			Topic:   b.config.Topic(event),
+			Value:   value,
+			Headers: map[string]string{"event-type": string(event.Type)},
+		}
+		if event.Match != nil {
+			message.Key = event.Match.OutputReference().String()
+		}
+		messages = append(messages, message)
+	}
+	if err := b.publisher.Publish(ctx, messages...); err != nil {
+		return fmt.Errorf("failed to publish events: %s", err)
+	}
+	return nil
+}
+
+// WatchHandler returns a function publishing the events of a watcher of the
+// given pattern, for WatcherConfig.OnEvent
+func (b *Bridge) WatchHandler(pattern string) func(kupogo.WatchEvent) {
+	return func(watchEvent kupogo.WatchEvent) {
+		match := watchEvent.Match
+		event := Event{Type: EventCreated, Pattern: pattern, Match: &match}
+		if watchEvent.Type == kupogo.WatchEventSpent {
+			event.Type = EventSpent
+		}
+		if err := b.Publish(context.Background(), event); err != nil && b.config.OnError != nil {
+			b.config.OnError(err)
+		}
+	}
+}
+
+// FollowerHandlers returns functions publishing the rollbacks and changes of
+// a follower of the given pattern, for FollowerConfig.OnRollBackward and
+// OnRollForward. Failures fail the callbacks, so the follower delivers the
+// changes again
+func (b *Bridge) FollowerHandlers(pattern string) (
+	func(kupogo.Point) error,
+	func(kupogo.RollForward) error,
+) {
+	onRollBackward := func(point kupogo.Point) error {
+		return b.Publish(context.Background(), Event{Type: EventRollback, Pattern: pattern, Point: &point})
+	}
+	onRollForward := func(rollForward kupogo.RollForward) error {
+		events := make([]Event, 0, len(rollForward.Spent)+len(rollForward.Created))
+		tip := rollForward.Tip
+		for i := range rollForward.Spent {
+			events = append(events, Event{
+				Type:    EventSpent,
+				Pattern: pattern,
+				Match:   &rollForward.Spent[i],
+				Point:   &tip,
+			})
+		}
+		for i := range rollForward.Created {
+			events = append(events, Event{
+				Type:    EventCreated,
+				Pattern: pattern,
+				Match:   &rollForward.Created[i],
+				Point:   &tip,
+			})
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		return b.Publish(context.Background(), events...)
+	}
+	return onRollBackward, onRollForward
+}
+
+// WriterPublisher writes messages to a writer as JSON Lines, for piping
+// events to other processes or testing
+type WriterPublisher struct {
+	mu sync.Mutex
+	w  *bufio.Writer
+}
+
+// NewWriterPublisher creates a publisher writing to w
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{w: bufio.NewWriter(w)}
+}
+
+type writerMessage struct {
+	Topic   string            `json:"topic"`
+	Key     string            `json:"key,omitempty"`
+	Value   json.RawMessage   `json:"value"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// Publish writes the messages, one per line
+func (p *WriterPublisher) Publish(_ context.Context, messages ...Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, message := range messages {
+		line, err := json.Marshal(writerMessage{
+			Topic:   message.Topic,
+			Key:     message.Key,
+			Value:   message.Value,
+			Headers: message.Headers,
+		})
+		if err != nil {
+			return err
+		}
+		line = append(line, '\n')
+		if _, err := p.w.Write(line); err != nil {
+			return err
+		}
+	}
+	return p.w.Flush()
+}
+
+// Close flushes any buffered output
+func (p *WriterPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.w.Flush()
+}
@@ -0,0 +1,53 @@
+package publish
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestBridgeFollowerHandlers(t *testing.T) {
+	var buf bytes.Buffer
+	bridge := NewBridge(NewWriterPublisher(&buf), Config{})
+	onRollBackward, onRollForward := bridge.FollowerHandlers("addr1")
+	if err := onRollBackward(kupogo.Point{SlotNo: 5}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	err := onRollForward(kupogo.RollForward{
+		Created: kupogo.Matches{{TransactionID: "bb", OutputIndex: 1}},
+		Spent:   kupogo.Matches{{TransactionID: "aa"}},
+		Tip:     kupogo.Point{SlotNo: 10},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 messages, got %q", lines)
+	}
+	var messages []writerMessage
+	for _, line := range lines {
+		var message writerMessage
+		if err := json.Unmarshal([]byte(line), &message); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		messages = append(messages, message)
+	}
+	if messages[0].Topic != "kupo.rollback" || messages[0].Key != "" {
+		t.Errorf("Unexpected rollback message: %+v", messages[0])
+	}
+	if messages[1].Topic != "kupo.spent" || messages[1].Key != "0@aa" ||
+		messages[2].Topic != "kupo.created" || messages[2].Key != "1@bb" {
+		t.Errorf("Unexpected messages: %+v", messages[1:])
+	}
+	var event Event
+	if err := json.Unmarshal(messages[2].Value, &event); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if event.Pattern != "addr1" || event.Match.TransactionID != "bb" || event.Point.SlotNo != 10 {
+		t.Errorf("Unexpected event: %+v", event)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// The Raw variants return Kupo's response body unparsed, for forwarding it
+// verbatim or decoding into other types. A non-200 status is an error
+
+// GetMatchesRaw returns the unparsed response of GetMatches
+func (c *Client) GetMatchesRaw(pattern string) ([]byte, error) {
+	return c.GetMatchesRawContext(context.Background(), pattern)
+}
+
+// GetMatchesRawContext is like GetMatchesRaw with a request context
+func (c *Client) GetMatchesRawContext(ctx context.Context, pattern string) ([]byte, error) {
+	return c.GetMatchesWithOptionsRawContext(ctx, pattern, MatchOptions{})
+}
+
+// GetMatchesWithOptionsRaw returns the unparsed response of
+// GetMatchesWithOptions
+func (c *Client) GetMatchesWithOptionsRaw(pattern string, opts MatchOptions) ([]byte, error) {
+	return c.GetMatchesWithOptionsRawContext(context.Background(), pattern, opts)
+}
+
+// GetMatchesWithOptionsRawContext is like GetMatchesWithOptionsRaw with a
+// request context
+func (c *Client) GetMatchesWithOptionsRawContext(
+	ctx context.Context,
+	pattern string,
+	opts MatchOptions,
+) ([]byte, error) {
+	return c.getRaw(ctx, c.matchesURL(pattern, opts))
+}
+
+// GetMetadataRaw returns the unparsed response of GetMetadata
+func (c *Client) GetMetadataRaw(slotNo int, txId string) ([]byte, error) {
+	return c.GetMetadataRawContext(context.Background(), slotNo, txId)
+}
+
+// GetMetadataRawContext is like GetMetadataRaw with a request context
+func (c *Client) GetMetadataRawContext(
+	ctx context.Context,
+	slotNo int,
+	txId string,
+) ([]byte, error) {
+	url := fmt.Sprintf("%s/metadata/%d", c.KupoUrl, slotNo)
+	if txId != "" {
+		url += fmt.Sprintf("?transaction_id=%s", txId)
+	}
+	return c.getRaw(ctx, url)
+}
+
+// GetAllPatternsRaw returns the unparsed response of GetAllPatterns
+func (c *Client) GetAllPatternsRaw() ([]byte, error) {
+	return c.GetAllPatternsRawContext(context.Background())
+}
+
+// GetAllPatternsRawContext is like GetAllPatternsRaw with a request context
+func (c *Client) GetAllPatternsRawContext(ctx context.Context) ([]byte, error) {
+	return c.getRaw(ctx, c.KupoUrl+"/patterns")
+}
+
+// GetPatternRaw returns the unparsed response of GetPattern
+func (c *Client) GetPatternRaw(pattern string) ([]byte, error) {
+	return c.GetPatternRawContext(context.Background(), pattern)
+}
+
+// GetPatternRawContext is like GetPatternRaw with a request context
+func (c *Client) GetPatternRawContext(ctx context.Context, pattern string) ([]byte, error) {
+	return c.getRaw(ctx, fmt.Sprintf("%s/patterns/%s", c.KupoUrl, pattern))
+}
+
+// GetScriptByHashRaw returns the unparsed response of GetScriptByHash
+func (c *Client) GetScriptByHashRaw(scriptHash string) ([]byte, error) {
+	return c.GetScriptByHashRawContext(context.Background(), scriptHash)
+}
+
+// GetScriptByHashRawContext is like GetScriptByHashRaw with a request context
+func (c *Client) GetScriptByHashRawContext(
+	ctx context.Context,
+	scriptHash string,
+) ([]byte, error) {
+	return c.getRaw(ctx, fmt.Sprintf("%s/scripts/%s", c.KupoUrl, scriptHash))
+}
+
+// GetDatumByHashRaw returns the unparsed response of GetDatumByHash
+func (c *Client) GetDatumByHashRaw(datumHash string) ([]byte, error) {
+	return c.GetDatumByHashRawContext(context.Background(), datumHash)
+}
+
+// GetDatumByHashRawContext is like GetDatumByHashRaw with a request context
+func (c *Client) GetDatumByHashRawContext(
+	ctx context.Context,
+	datumHash string,
+) ([]byte, error) {
+	return c.getRaw(ctx, fmt.Sprintf("%s/datums/%s", c.KupoUrl, datumHash))
+}
+
+// GetCheckpointsRaw returns the unparsed response of GetCheckpoints
+func (c *Client) GetCheckpointsRaw() ([]byte, error) {
+	return c.GetCheckpointsRawContext(context.Background())
+}
+
+// GetCheckpointsRawContext is like GetCheckpointsRaw with a request context
+func (c *Client) GetCheckpointsRawContext(ctx context.Context) ([]byte, error) {
+	return c.getRaw(ctx, c.KupoUrl+"/checkpoints")
+}
+
+// GetCheckpointBySlotRaw returns the unparsed response of GetCheckpointBySlot
+func (c *Client) GetCheckpointBySlotRaw(slotNo int, strict bool) ([]byte, error) {
+	return c.GetCheckpointBySlotRawContext(context.Background(), slotNo, strict)
+}
+
+// GetCheckpointBySlotRawContext is like GetCheckpointBySlotRaw with a request
+// context
+func (c *Client) GetCheckpointBySlotRawContext(
+	ctx context.Context,
+	slotNo int,
+	strict bool,
+) ([]byte, error) {
+	url := fmt.Sprintf("%s/checkpoints/%d", c.KupoUrl, slotNo)
+	if strict {
+		url += "?strict"
+	}
+	return c.getRaw(ctx, url)
+}
+
+// GetHealthRaw returns the unparsed response of GetHealth
+func (c *Client) GetHealthRaw() ([]byte, error) {
+	return c.GetHealthRawContext(context.Background())
+}
+
+// GetHealthRawContext is like GetHealthRaw with a request context. Kupo
+// reports 503 while syncing, which is not an error here
+func (c *Client) GetHealthRawContext(ctx context.Context) ([]byte, error) {
+	return c.getRaw(ctx, c.KupoUrl+"/health", http.StatusServiceUnavailable)
+}
+
+// getRaw fetches a URL and returns the body of a 200 response, or of a
+// response with one of the extra accepted status codes
+func (c *Client) getRaw(ctx context.Context, url string, accept ...int) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %s", req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+	accepted := resp.StatusCode == http.StatusOK
+	for _, statusCode := range accept {
+		accepted = accepted || resp.StatusCode == statusCode
+	}
+	if !accepted {
+		return nil, fmt.Errorf(
+			"failed to get %s: status code %d%s",
+			req.URL.Path,
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	respBodyBytes, err := readBody(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %s", err)
+	}
+	return respBodyBytes, nil
+}
@@ -0,0 +1,43 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRawAccessors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/matches/*":
+			if r.URL.RawQuery != "unspent" {
+				t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`[{"transaction_id":"aa","future_field":true}]`))
+		case "/health":
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"connection_status":"connected"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+	raw, err := client.GetMatchesWithOptionsRaw("*", MatchOptions{Unspent: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if string(raw) != `[{"transaction_id":"aa","future_field":true}]` {
+		t.Fatalf("Unexpected body: %s", raw)
+	}
+	raw, err = client.GetHealthRaw()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if string(raw) != `{"connection_status":"connected"}` {
+		t.Fatalf("Unexpected body: %s", raw)
+	}
+	if _, err := client.GetDatumByHashRaw("abcd"); err == nil {
+		t.Fatalf("Expected error for status 404")
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrRedirectRefused is returned when Kupo redirects a request in a way the
+// configured RedirectPolicy does not allow
+var ErrRedirectRefused = errors.New("redirect refused")
+
+const defaultMaxRedirects = 10
+
+// CrossHostRedirect selects how redirects to another host are followed
+type CrossHostRedirect int
+
+const (
+	// CrossHostStrip follows redirects to another host without credentials,
+	// removing the Authorization, Proxy-Authorization and Cookie headers and
+	// skipping the authentication and signing middleware of this package
+	CrossHostStrip CrossHostRedirect = iota
+	// CrossHostRefuse fails requests redirected to another host
+	CrossHostRefuse
+	// CrossHostAllow follows redirects to another host like any other
+	CrossHostAllow
+)
+
+// RedirectPolicy controls how redirects from Kupo are followed
+type RedirectPolicy struct {
+	// Disabled returns redirect responses instead of following them
+	Disabled bool
+	// MaxRedirects limits the redirects followed for a request, defaulting
+	// to 10
+	MaxRedirects int
+	// CrossHost selects how redirects to another host are followed
+	CrossHost CrossHostRedirect
+}
+
+// WithRedirectPolicy follows redirects according to the given policy instead
+// of following up to 10 of them to any host. Without it, middleware adding
+// credentials also adds them to requests redirected to other hosts
+func WithRedirectPolicy(policy RedirectPolicy) ClientOption {
+	return func(c *Client) {
+		c.redirectPolicy = &policy
+	}
+}
+
+type crossHostRedirectKey struct{}
+
+// IsCrossHostRedirect reports whether the request follows a redirect to
+// another host which must not receive credentials. Middleware adding
+// credentials should leave such requests untouched
+func IsCrossHostRedirect(req *http.Request) bool {
+	ret, _ := req.Context().Value(crossHostRedirectKey{}).(bool)
+	return ret
+}
+
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie"}
+
+// isSensitiveHeader reports whether a header carries credentials which must
+// not follow cross-host redirects
+func isSensitiveHeader(key string) bool {
+	key = http.CanonicalHeaderKey(key)
+	for _, header := range sensitiveHeaders {
+		if key == header {
+			return true
+		}
+	}
+	return false
+}
+
+func (p RedirectPolicy) checkRedirect(req *http.Request, via []*http.Request) error {
+	if p.Disabled {
+		return http.ErrUseLastResponse
+	}
+	maxRedirects := p.MaxRedirects
+	if maxRedirects <= 0 {
+		maxRedirects = defaultMaxRedirects
+	}
+	if len(via) > maxRedirects {
+		return fmt.Errorf("%w: stopped after %d redirects", ErrRedirectRefused, maxRedirects)
+	}
+	if req.URL.Host == via[0].URL.Host {
+		return nil
+	}
+	switch p.CrossHost {
+	case CrossHostRefuse:
+		return fmt.Errorf("%w: redirect to %s", ErrRedirectRefused, req.URL.Host)
+	case CrossHostStrip:
+		for _, header := range sensitiveHeaders {
+			req.Header.Del(header)
+		}
+		// The redirected request is used as is, so mark it in place
+		*req = *req.WithContext(
+			context.WithValue(req.Context(), crossHostRedirectKey{}, true),
+		)
+	}
+	return nil
+}
+
+// applyRedirectPolicy replaces the HTTP client with a copy following the
+// configured redirect policy
+func (c *Client) applyRedirectPolicy() {
+	if c.redirectPolicy == nil {
+		return
+	}
+	base := c.httpClient
+	if base == nil {
+		base = defaultHTTPClient
+	}
+	httpClient := *base
+	httpClient.CheckRedirect = c.redirectPolicy.checkRedirect
+	c.httpClient = &httpClient
+}
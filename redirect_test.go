@@ -0,0 +1,99 @@
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRedirectPolicy(t *testing.T) {
+	var authorization string
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer other.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/loop":
+			http.Redirect(w, r, "/loop", http.StatusFound)
+		default:
+			http.Redirect(w, r, other.URL+r.URL.Path, http.StatusFound)
+		}
+	}))
+	defer server.Close()
+	token := WithBearerToken(func(context.Context) (string, error) {
+		return "secret", nil
+	})
+
+	client := NewClient(server.URL, token, WithRedirectPolicy(RedirectPolicy{}))
+	if _, err := client.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if authorization != "" {
+		t.Fatalf("Expected credentials to be stripped, got %q", authorization)
+	}
+
+	client = NewClient(server.URL, token, WithRedirectPolicy(RedirectPolicy{CrossHost: CrossHostAllow}))
+	if _, err := client.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if authorization != "Bearer secret" {
+		t.Fatalf("Expected credentials to be sent, got %q", authorization)
+	}
+
+	client = NewClient(server.URL, WithRedirectPolicy(RedirectPolicy{CrossHost: CrossHostRefuse}))
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/matches", nil)
+	if _, err := client.Do(req); !errors.Is(err, ErrRedirectRefused) {
+		t.Fatalf("Expected ErrRedirectRefused, got %v", err)
+	}
+
+	client = NewClient(server.URL, WithRedirectPolicy(RedirectPolicy{MaxRedirects: 2}))
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/loop", nil)
+	if _, err := client.Do(req); !errors.Is(err, ErrRedirectRefused) {
+		t.Fatalf("Expected ErrRedirectRefused, got %v", err)
+	}
+
+	client = NewClient(server.URL, WithRedirectPolicy(RedirectPolicy{Disabled: true}))
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/matches", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("Expected the redirect response, got %d", resp.StatusCode)
+	}
+}
+
+func TestRedirectPolicyWithHeader(t *testing.T) {
+	var authorization, custom string
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		custom = r.Header.Get("X-Custom")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer other.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, other.URL+r.URL.Path, http.StatusFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		server.URL,
+		WithHeader("authorization", "Bearer secret"),
+		WithHeader("X-Custom", "value"),
+		WithRedirectPolicy(RedirectPolicy{CrossHost: CrossHostStrip}),
+	)
+	if _, err := client.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if authorization != "" {
+		t.Fatalf("Expected credentials to be stripped, got %q", authorization)
+	}
+	if custom != "value" {
+		t.Fatalf("Expected other headers to be kept, got %q", custom)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrNoReferenceInput is returned when no output qualifies as a reference
+// input
+var ErrNoReferenceInput = errors.New("no suitable reference input")
+
+// ReferenceInput is a candidate read-only input for a transaction, such as
+// an output carrying a reference script
+type ReferenceInput struct {
+	OutputReference
+	Match Match
+}
+
+// ReferenceScriptInputs returns the unspent outputs among the matches which
+// carry the reference script with the given hash, oldest first
+func (m Matches) ReferenceScriptInputs(scriptHash string) []ReferenceInput {
+	var ret []ReferenceInput
+	for _, match := range m {
+		if match.SpentAt != nil || match.ScriptHash == nil ||
+			!strings.EqualFold(*match.ScriptHash, scriptHash) {
+			continue
+		}
+		ret = append(ret, ReferenceInput{
+			OutputReference: match.OutputReference(),
+			Match:           match,
+		})
+	}
+	sortReferenceInputs(ret)
+	return ret
+}
+
+// FindReferenceScript fetches the unspent matches of pattern, usually the
+// address scripts are deployed to, and returns those carrying the reference
+// script with the given hash, oldest first
+func (c *Client) FindReferenceScript(pattern string, scriptHash string) ([]ReferenceInput, error) {
+	return c.FindReferenceScriptContext(context.Background(), pattern, scriptHash)
+}
+
+// FindReferenceScriptContext is like FindReferenceScript with a request
+// context
+func (c *Client) FindReferenceScriptContext(
+	ctx context.Context,
+	pattern string,
+	scriptHash string,
+) ([]ReferenceInput, error) {
+	matches, _, err := c.getMatches(ctx, pattern, MatchOptions{Unspent: true})
+	if err != nil {
+		return nil, err
+	}
+	return matches.ReferenceScriptInputs(scriptHash), nil
+}
+
+// ReferenceInputs returns the unspent outputs among the matches which are
+// still on-chain and at least minDepth blocks deep, counting the block
+// creating them, below the most recent checkpoint. Inputs are ordered oldest
+// first
+func (c Checkpoints) ReferenceInputs(matches Matches, minDepth int) []ReferenceInput {
+	var ret []ReferenceInput
+	for _, match := range matches {
+		if match.SpentAt != nil {
+			continue
+		}
+		confirmation := c.Confirmations(match)
+		if !confirmation.OnChain || confirmation.Blocks < minDepth {
+			continue
+		}
+		ret = append(ret, ReferenceInput{
+			OutputReference: match.OutputReference(),
+			Match:           match,
+		})
+	}
+	sortReferenceInputs(ret)
+	return ret
+}
+
+// SelectReferenceInputs fetches the unspent matches of pattern, such as
+// MatchAsset of an oracle's NFT, and returns those at least minDepth blocks
+// deep, oldest first
+func (c *Client) SelectReferenceInputs(pattern string, minDepth int) ([]ReferenceInput, error) {
+	return c.SelectReferenceInputsContext(context.Background(), pattern, minDepth)
+}
+
+// SelectReferenceInputsContext is like SelectReferenceInputs with a request
+// context
+func (c *Client) SelectReferenceInputsContext(
+	ctx context.Context,
+	pattern string,
+	minDepth int,
+) ([]ReferenceInput, error) {
+	matches, _, err := c.getMatches(ctx, pattern, MatchOptions{Unspent: true})
+	if err != nil {
+		return nil, err
+	}
+	checkpoints, err := c.GetCheckpointsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return checkpoints.ReferenceInputs(*matches, minDepth), nil
+}
+
+// SelectCIP68ReferenceInput returns the output holding the CIP-68 reference
+// token of asset, which may be the reference token itself or one of its user
+// tokens. The output must be at least minDepth blocks deep. It returns
+// ErrNoReferenceInput if no such output exists
+func (c *Client) SelectCIP68ReferenceInput(asset AssetID, minDepth int) (*ReferenceInput, error) {
+	return c.SelectCIP68ReferenceInputContext(context.Background(), asset, minDepth)
+}
+
+// SelectCIP68ReferenceInputContext is like SelectCIP68ReferenceInput with a
+// request context
+func (c *Client) SelectCIP68ReferenceInputContext(
+	ctx context.Context,
+	asset AssetID,
+	minDepth int,
+) (*ReferenceInput, error) {
+	reference, ok := asset.WithCIP67Label(CIP67LabelReferenceNFT)
+	if !ok {
+		return nil, fmt.Errorf("asset %s does not carry a CIP-67 label", asset)
+	}
+	inputs, err := c.SelectReferenceInputsContext(ctx, string(MatchAsset(reference)), minDepth)
+	if err != nil {
+		return nil, err
+	}
+	if len(inputs) == 0 {
+		return nil, ErrNoReferenceInput
+	}
+	// The reference token should only ever be held by one output
+	return &inputs[len(inputs)-1], nil
+}
+
+func sortReferenceInputs(inputs []ReferenceInput) {
+	sort.Slice(inputs, func(i, j int) bool {
+		return matchLess(inputs[i].Match, inputs[j].Match)
+	})
+}
@@ -0,0 +1,97 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFindReferenceScript(t *testing.T) {
+	scriptHash, otherHash := "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f", "ab"
+	upperHash := "C37B1B5DC0669F1D3C61A6FDDB2E8FDE96BE87B881C60BCE8E8D542F"
+	matches := Matches{
+		{TransactionID: "t1", OutputIndex: 1, ScriptHash: &scriptHash, CreatedAt: Point{SlotNo: 20}},
+		{TransactionID: "t2", ScriptHash: &otherHash, CreatedAt: Point{SlotNo: 5}},
+		{TransactionID: "t3", CreatedAt: Point{SlotNo: 5}},
+		{TransactionID: "t4", ScriptHash: &upperHash, CreatedAt: Point{SlotNo: 10}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/matches/deployer" || r.URL.RawQuery != "unspent" {
+				t.Errorf("Unexpected request %s", r.URL)
+			}
+			_ = json.NewEncoder(w).Encode(matches)
+		}),
+	)
+	defer server.Close()
+
+	inputs, err := NewClient(server.URL).FindReferenceScript("deployer", scriptHash)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(inputs) != 2 || inputs[0].TransactionID != "t4" || inputs[1].String() != "1@t1" {
+		t.Fatalf("Unexpected reference inputs %+v", inputs)
+	}
+
+	spent := matches[0]
+	spent.SpentAt = &Point{SlotNo: 30}
+	if inputs := (Matches{spent}).ReferenceScriptInputs(scriptHash); len(inputs) != 0 {
+		t.Fatalf("Expected spent outputs to be skipped, got %+v", inputs)
+	}
+}
+
+func TestSelectReferenceInputs(t *testing.T) {
+	policyID := "11111111111111111111111111111111111111111111111111111111"
+	checkpoints := Checkpoints{
+		{SlotNo: 30, HeaderHash: "c"},
+		{SlotNo: 20, HeaderHash: "b"},
+		{SlotNo: 10, HeaderHash: "a"},
+	}
+	matches := Matches{
+		{TransactionID: "deep", CreatedAt: Point{SlotNo: 10, HeaderHash: "a"}},
+		{TransactionID: "shallow", CreatedAt: Point{SlotNo: 30, HeaderHash: "c"}},
+		{TransactionID: "rolledback", CreatedAt: Point{SlotNo: 20, HeaderHash: "x"}},
+	}
+	var paths []string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			if r.URL.Path == "/checkpoints" {
+				_ = json.NewEncoder(w).Encode(checkpoints)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(matches)
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	inputs, err := client.SelectReferenceInputs("oracle", 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(inputs) != 1 || inputs[0].TransactionID != "deep" {
+		t.Fatalf("Expected only the deep output, got %+v", inputs)
+	}
+
+	input, err := client.SelectCIP68ReferenceInput(NewAssetID(policyID, "000de1404d794e4654"), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if input.TransactionID != "shallow" {
+		t.Fatalf("Expected the most recent output, got %+v", input)
+	}
+	if paths[len(paths)-2] != "/matches/"+policyID+".000643b04d794e4654" {
+		t.Fatalf("Expected lookup of the reference token, got %s", paths[len(paths)-2])
+	}
+
+	matches = Matches{}
+	if _, err := client.SelectCIP68ReferenceInput(NewAssetID(policyID, "000643b04d794e4654"), 1); !errors.Is(err, ErrNoReferenceInput) {
+		t.Fatalf("Expected ErrNoReferenceInput, got %v", err)
+	}
+	if _, err := client.SelectCIP68ReferenceInput(NewAssetID(policyID, "4d794e4654"), 1); err == nil {
+		t.Fatalf("Expected error for an unlabelled asset")
+	}
+}
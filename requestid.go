@@ -0,0 +1,82 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// RequestIDHeader is the header carrying the request ID
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a context whose requests carry the given
+// request ID, so it can be correlated with the caller's own logs
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID set with ContextWithRequestID
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok
+}
+
+// WithRequestID sends an X-Request-ID header with every request and includes
+// it in errors and logs. The ID is taken from the request context if set with
+// ContextWithRequestID, or else generated with generate, defaulting to 16
+// random hex encoded bytes
+func WithRequestID(generate func() string) ClientOption {
+	return func(c *Client) {
+		if generate == nil {
+			generate = randomRequestID
+		}
+		c.requestIDs = generate
+	}
+}
+
+func randomRequestID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// setRequestID sets the request ID header, unless disabled or already set
+func (c *Client) setRequestID(req *http.Request) {
+	if req.Header.Get(RequestIDHeader) != "" {
+		return
+	}
+	if requestID, ok := RequestIDFromContext(requestContext(req)); ok {
+		req.Header.Set(RequestIDHeader, requestID)
+		return
+	}
+	if c.requestIDs != nil {
+		req.Header.Set(RequestIDHeader, c.requestIDs())
+	}
+}
+
+// requestIDSuffix returns the request ID formatted for error messages, or an
+// empty string if the request has none
+func requestIDSuffix(req *http.Request) string {
+	requestID := req.Header.Get(RequestIDHeader)
+	if requestID == "" {
+		return ""
+	}
+	return " (request ID " + requestID + ")"
+}
@@ -0,0 +1,34 @@
+package kupogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_WithRequestID(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = append(received, r.Header.Get(RequestIDHeader))
+			w.WriteHeader(http.StatusInternalServerError)
+		}),
+	)
+	defer server.Close()
+
+	client := NewClient(server.URL, WithRequestID(func() string { return "generated" }))
+	_, err := client.GetMatches("addr1")
+	if err == nil || !strings.Contains(err.Error(), "(request ID generated)") {
+		t.Errorf("Expected error with request ID, got %v", err)
+	}
+	ctx := ContextWithRequestID(context.Background(), "propagated")
+	_, err = client.GetDatumByHashContext(ctx, "abcd")
+	if err == nil || !strings.Contains(err.Error(), "(request ID propagated)") {
+		t.Errorf("Expected error with request ID, got %v", err)
+	}
+	if len(received) != 2 || received[0] != "generated" || received[1] != "propagated" {
+		t.Errorf("Unexpected request IDs: %v", received)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reserves
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/internal/cbor"
+	"golang.org/x/crypto/blake2b"
+)
+
+var ErrOutputNotCommitted = errors.New("output not part of the commitment")
+
+// Domain separation prefixes of the Merkle tree hashes, so that a leaf can
+// never be passed off as an inner node
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+// Commitment is a Merkle commitment to the UTxOs of a report. Each leaf is
+// the BLAKE2b-256 hash of the canonical CBOR encoding of a UTxO, and leaves
+// are ordered by output reference, so anyone holding the same UTxO set
+// computes the same root
+type Commitment struct {
+	Checkpoint kupogo.Point `json:"checkpoint"`
+	// Root is the hex encoded Merkle root
+	Root  string `json:"root"`
+	Count int    `json:"count"`
+}
+
+// ProofStep is a sibling hash on the path from a leaf to the root
+type ProofStep struct {
+	Hash string `json:"hash"`
+	// Left is set if the sibling is the left operand
+	Left bool `json:"left"`
+}
+
+// Commit generates a report of the outputs matching the patterns which were
+// unspent at the checkpoint and returns its commitment
+func Commit(
+	client *kupogo.Client,
+	checkpoint kupogo.Point,
+	patterns []string,
+) (*Commitment, error) {
+	report, err := Generate(client, checkpoint, patterns)
+	if err != nil {
+		return nil, err
+	}
+	return report.Commitment()
+}
+
+// Commitment computes the Merkle commitment to the report's UTxOs
+func (r *Report) Commitment() (*Commitment, error) {
+	leaves, err := r.leaves()
+	if err != nil {
+		return nil, err
+	}
+	root := merkleRoot(leaves)
+	return &Commitment{
+		Checkpoint: r.Checkpoint,
+		Root:       hex.EncodeToString(root),
+		Count:      len(leaves),
+	}, nil
+}
+
+// Proof returns the inclusion proof of an output in the report's commitment,
+// or ErrOutputNotCommitted if the output is not part of the report
+func (r *Report) Proof(ref kupogo.OutputReference) ([]ProofStep, error) {
+	leaves, err := r.leaves()
+	if err != nil {
+		return nil, err
+	}
+	index := -1
+	for i, utxo := range r.sortedUTxOs() {
+		if utxo.OutputReference == ref {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, ErrOutputNotCommitted
+	}
+	var proof []ProofStep
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, ProofStep{
+				Hash: hex.EncodeToString(level[sibling]),
+				Left: sibling < index,
+			})
+		}
+		level = merkleLevel(level)
+		index /= 2
+	}
+	return proof, nil
+}
+
+// VerifyProof checks that a UTxO is part of the commitment with the given hex
+// encoded root
+func VerifyProof(root string, utxo UTxO, proof []ProofStep) (bool, error) {
+	data, err := encodeUTxO(utxo)
+	if err != nil {
+		return false, err
+	}
+	hash := leafHash(data)
+	for _, step := range proof {
+		sibling, err := hex.DecodeString(step.Hash)
+		if err != nil {
+			return false, fmt.Errorf("invalid proof hash: %s", err)
+		}
+		if step.Left {
+			hash = nodeHash(sibling, hash)
+		} else {
+			hash = nodeHash(hash, sibling)
+		}
+	}
+	return hex.EncodeToString(hash) == root, nil
+}
+
+// sortedUTxOs returns the UTxOs of the report ordered by output reference,
+// as Generate does
+func (r *Report) sortedUTxOs() []UTxO {
+	ret := append([]UTxO{}, r.UTxOs...)
+	sort.Slice(ret, func(i, j int) bool {
+		a, b := ret[i].OutputReference, ret[j].OutputReference
+		if a.TransactionID != b.TransactionID {
+			return a.TransactionID < b.TransactionID
+		}
+		return a.OutputIndex < b.OutputIndex
+	})
+	return ret
+}
+
+func (r *Report) leaves() ([][]byte, error) {
+	utxos := r.sortedUTxOs()
+	ret := make([][]byte, 0, len(utxos))
+	for _, utxo := range utxos {
+		data, err := encodeUTxO(utxo)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, leafHash(data))
+	}
+	return ret, nil
+}
+
+// encodeUTxO encodes a UTxO as the canonical CBOR array
+// [transaction_id, output_index, address, coins, {asset: quantity}], with map
+// keys sorted bytewise. The pattern which found the output is not committed
+func encodeUTxO(utxo UTxO) ([]byte, error) {
+	txID, err := hex.DecodeString(utxo.OutputReference.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID %s: %s", utxo.OutputReference.TransactionID, err)
+	}
+	if utxo.OutputReference.OutputIndex < 0 || utxo.Value.Coins < 0 {
+		return nil, fmt.Errorf("invalid UTxO %s", utxo.OutputReference)
+	}
+	b := cbor.AppendArrayHeader(nil, 5)
+	b = cbor.AppendBytes(b, txID)
+	b = cbor.AppendUint(b, uint64(utxo.OutputReference.OutputIndex))
+	b = cbor.AppendText(b, utxo.Address)
+	b = cbor.AppendUint(b, uint64(utxo.Value.Coins))
+	assets := make([][]byte, 0, len(utxo.Value.Assets))
+	for asset, quantity := range utxo.Value.Assets {
+		if quantity < 0 {
+			return nil, fmt.Errorf("invalid quantity of %s in UTxO %s", asset, utxo.OutputReference)
+		}
+		entry := cbor.AppendText(nil, asset)
+		assets = append(assets, cbor.AppendUint(entry, uint64(quantity)))
+	}
+	// Keys are unique, so sorting the encoded entries sorts by encoded key
+	sort.Slice(assets, func(i, j int) bool {
+		return bytes.Compare(assets[i], assets[j]) < 0
+	})
+	b = cbor.AppendMapHeader(b, len(assets))
+	for _, entry := range assets {
+		b = append(b, entry...)
+	}
+	return b, nil
+}
+
+func leafHash(data []byte) []byte {
+	sum := blake2b.Sum256(append([]byte{leafPrefix}, data...))
+	return sum[:]
+}
+
+func nodeHash(left []byte, right []byte) []byte {
+	data := make([]byte, 0, 1+len(left)+len(right))
+	data = append(data, nodePrefix)
+	data = append(data, left...)
+	data = append(data, right...)
+	sum := blake2b.Sum256(data)
+	return sum[:]
+}
+
+// merkleLevel hashes pairs of nodes into the next level. A trailing odd node
+// is carried up unchanged
+func merkleLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, nodeHash(level[i], level[i+1]))
+	}
+	return next
+}
+
+// merkleRoot returns the root of the tree over the leaves. The root of an
+// empty tree is the hash of nothing
+func merkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		sum := blake2b.Sum256(nil)
+		return sum[:]
+	}
+	level := leaves
+	for len(level) > 1 {
+		level = merkleLevel(level)
+	}
+	return level[0]
+}
@@ -0,0 +1,53 @@
+package reserves
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestCommitment(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client := &kupogo.Client{KupoUrl: server.URL}
+	checkpoint := kupogo.Point{SlotNo: 1000, HeaderHash: "abcd"}
+
+	commitment, err := Commit(client, checkpoint, []string{"addr1", "stake1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if commitment.Count != 3 || commitment.Checkpoint != checkpoint || len(commitment.Root) != 64 {
+		t.Fatalf("Unexpected commitment %+v", commitment)
+	}
+	// The commitment only depends on the UTxO set
+	reordered, err := Commit(client, checkpoint, []string{"stake1", "addr1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if reordered.Root != commitment.Root {
+		t.Fatalf("Expected the same root, got %s and %s", commitment.Root, reordered.Root)
+	}
+
+	report, err := Generate(client, checkpoint, []string{"addr1", "stake1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	for _, utxo := range report.UTxOs {
+		proof, err := report.Proof(utxo.OutputReference)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if ok, err := VerifyProof(commitment.Root, utxo, proof); err != nil || !ok {
+			t.Fatalf("Expected proof of %s to verify, got %v, %v", utxo.OutputReference, ok, err)
+		}
+		tampered := utxo
+		tampered.Value.Coins++
+		if ok, _ := VerifyProof(commitment.Root, tampered, proof); ok {
+			t.Fatalf("Expected proof of tampered UTxO to fail")
+		}
+	}
+	if _, err := report.Proof(kupogo.OutputReference{TransactionID: "ff"}); !errors.Is(err, ErrOutputNotCommitted) {
+		t.Fatalf("Expected ErrOutputNotCommitted, got %v", err)
+	}
+}
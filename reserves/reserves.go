@@ -0,0 +1,172 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package reserves generates balance reports at a fixed checkpoint, listing
+// every contributing UTxO, for proof-of-reserves style audits
+package reserves
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+var (
+	ErrCheckpointNotFound = errors.New("checkpoint not found")
+	ErrDigestMismatch     = errors.New("report digest does not match contents")
+	ErrInvalidSignature   = errors.New("invalid report signature")
+)
+
+// UTxO is an output contributing to a report
+type UTxO struct {
+	// Pattern is the first requested pattern which matched the output
+	Pattern         string                 `json:"pattern"`
+	OutputReference kupogo.OutputReference `json:"output_reference"`
+	Address         string                 `json:"address"`
+	Value           kupogo.Value           `json:"value"`
+	CreatedAt       kupogo.Point           `json:"created_at"`
+}
+
+// Report is a balance report for a set of patterns at a checkpoint
+type Report struct {
+	Checkpoint  kupogo.Point `json:"checkpoint"`
+	GeneratedAt time.Time    `json:"generated_at"`
+	Patterns    []string     `json:"patterns"`
+	UTxOs       []UTxO       `json:"utxos"`
+	Total       kupogo.Value `json:"total"`
+	// Digest is the hex SHA-256 of the report contents above
+	Digest string `json:"digest"`
+	// PublicKey and Signature are the hex Ed25519 key and signature over the
+	// digest, if the report was signed
+	PublicKey string `json:"public_key,omitempty"`
+	Signature string `json:"signature,omitempty"`
+}
+
+// Generate builds a report of the outputs matching the patterns which were
+// unspent at the checkpoint. The checkpoint must be known to Kupo with the
+// same header hash, so the report refers to a block actually on chain
+func Generate(
+	client *kupogo.Client,
+	checkpoint kupogo.Point,
+	patterns []string,
+) (*Report, error) {
+	point, err := client.GetCheckpointBySlot(checkpoint.SlotNo, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoint: %s", err)
+	}
+	if point == nil || point.HeaderHash != checkpoint.HeaderHash {
+		return nil, ErrCheckpointNotFound
+	}
+	r := &Report{
+		Checkpoint:  checkpoint,
+		GeneratedAt: time.Now().UTC(),
+		Patterns:    patterns,
+		UTxOs:       []UTxO{},
+	}
+	seen := make(map[kupogo.OutputReference]bool)
+	for _, pattern := range patterns {
+		matches, err := client.GetUTxOsAt(pattern, checkpoint.SlotNo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get UTxOs for %s: %s", pattern, err)
+		}
+		for _, match := range matches {
+			ref := match.OutputReference()
+			if seen[ref] {
+				continue
+			}
+			seen[ref] = true
+			r.UTxOs = append(
+				r.UTxOs,
+				UTxO{
+					Pattern:         pattern,
+					OutputReference: ref,
+					Address:         match.Address,
+					Value:           match.Value,
+					CreatedAt:       match.CreatedAt,
+				},
+			)
+			r.Total = r.Total.Add(match.Value)
+		}
+	}
+	sort.Slice(r.UTxOs, func(i, j int) bool {
+		a, b := r.UTxOs[i].OutputReference, r.UTxOs[j].OutputReference
+		if a.TransactionID != b.TransactionID {
+			return a.TransactionID < b.TransactionID
+		}
+		return a.OutputIndex < b.OutputIndex
+	})
+	digest, err := r.computeDigest()
+	if err != nil {
+		return nil, err
+	}
+	r.Digest = digest
+	return r, nil
+}
+
+// Sign signs the report digest with an Ed25519 key
+func (r *Report) Sign(key ed25519.PrivateKey) {
+	digest, _ := hex.DecodeString(r.Digest)
+	r.PublicKey = hex.EncodeToString(key.Public().(ed25519.PublicKey))
+	r.Signature = hex.EncodeToString(ed25519.Sign(key, digest))
+}
+
+// Verify checks the digest against the report contents, and the signature if
+// present
+func (r *Report) Verify() error {
+	digest, err := r.computeDigest()
+	if err != nil {
+		return err
+	}
+	if digest != r.Digest {
+		return ErrDigestMismatch
+	}
+	if r.Signature == "" {
+		return nil
+	}
+	publicKey, err := hex.DecodeString(r.PublicKey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return ErrInvalidSignature
+	}
+	signature, err := hex.DecodeString(r.Signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	digestBytes, _ := hex.DecodeString(digest)
+	if !ed25519.Verify(publicKey, digestBytes, signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// computeDigest hashes the JSON encoding of the report without its digest and
+// signature
+func (r *Report) computeDigest() (string, error) {
+	contents := *r
+	contents.Digest = ""
+	contents.PublicKey = ""
+	contents.Signature = ""
+	data, err := json.Marshal(contents)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal report: %s", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
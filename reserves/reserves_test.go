@@ -0,0 +1,101 @@
+package reserves
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func newTestServer() *httptest.Server {
+	return httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var respBody []byte
+			switch r.URL.Path {
+			case "/checkpoints/1000":
+				respBody, _ = json.Marshal(kupogo.Point{SlotNo: 1000, HeaderHash: "abcd"})
+			case "/matches/addr1":
+				matches := kupogo.Matches{}
+				if r.URL.Query().Has("unspent") {
+					matches = kupogo.Matches{
+						{TransactionID: "bb", OutputIndex: 1, Address: "addr1", Value: kupogo.Value{Coins: 10}},
+						{TransactionID: "aa", OutputIndex: 0, Address: "addr1", Value: kupogo.Value{Coins: 5}},
+					}
+				}
+				respBody, _ = json.Marshal(matches)
+			case "/matches/stake1":
+				matches := kupogo.Matches{}
+				if r.URL.Query().Has("unspent") {
+					matches = kupogo.Matches{
+						{TransactionID: "aa", OutputIndex: 0, Address: "addr1", Value: kupogo.Value{Coins: 5}},
+					}
+				} else {
+					matches = kupogo.Matches{
+						{
+							TransactionID: "cc",
+							Address:       "addr2",
+							Value:         kupogo.Value{Coins: 3, Assets: kupogo.Assets{"dd.ee": 1}},
+							SpentAt:       &kupogo.Point{SlotNo: 2000},
+						},
+					}
+				}
+				respBody, _ = json.Marshal(matches)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(respBody)
+		}),
+	)
+}
+
+func TestGenerate(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client := &kupogo.Client{KupoUrl: server.URL}
+
+	report, err := Generate(
+		client,
+		kupogo.Point{SlotNo: 1000, HeaderHash: "abcd"},
+		[]string{"addr1", "stake1"},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(report.UTxOs) != 3 {
+		t.Fatalf("Expected 3 UTxOs, got %d", len(report.UTxOs))
+	}
+	if report.UTxOs[0].OutputReference.TransactionID != "aa" ||
+		report.UTxOs[0].Pattern != "addr1" {
+		t.Errorf("Unexpected first UTxO: %v", report.UTxOs[0])
+	}
+	if report.Total.Coins != 18 || report.Total.Assets["dd.ee"] != 1 {
+		t.Errorf("Unexpected total: %v", report.Total)
+	}
+	if err := report.Verify(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	_, key, _ := ed25519.GenerateKey(nil)
+	report.Sign(key)
+	if err := report.Verify(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	report.Total.Coins++
+	if err := report.Verify(); !errors.Is(err, ErrDigestMismatch) {
+		t.Errorf("Expected digest mismatch, got %v", err)
+	}
+
+	_, err = Generate(
+		client,
+		kupogo.Point{SlotNo: 1000, HeaderHash: "ffff"},
+		[]string{"addr1"},
+	)
+	if !errors.Is(err, ErrCheckpointNotFound) {
+		t.Errorf("Expected checkpoint not found, got %v", err)
+	}
+}
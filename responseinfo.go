@@ -0,0 +1,89 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ResponseInfo describes the response to a request made to Kupo
+type ResponseInfo struct {
+	// StatusCode is the HTTP status of the response
+	StatusCode int
+	// Header holds the response headers
+	Header http.Header
+	// Latency is the time until the response headers were received
+	Latency time.Duration
+	// Checkpoint is the slot of Kupo's most recent checkpoint when it
+	// answered, or -1 if the response did not include it
+	Checkpoint int
+	// RequestID is the ID sent with the request, if any
+	RequestID string
+}
+
+// ETag returns the entity tag of the response, if any
+func (i ResponseInfo) ETag() string {
+	return i.Header.Get("ETag")
+}
+
+// CaptureResponse stores the description of each response into info, so that
+// after a call it describes the last response of that call. Unlike
+// LastResponse, it is not affected by calls made concurrently
+func CaptureResponse(info *ResponseInfo) CallOption {
+	return func(o *callOptions) {
+		o.capture = info
+	}
+}
+
+type lastResponse struct {
+	mu   sync.Mutex
+	info *ResponseInfo
+}
+
+// LastResponse returns the description of the last response received by the
+// client, in any goroutine, and false if there was none. Use CaptureResponse
+// to get the response of a given call
+func (c *Client) LastResponse() (ResponseInfo, bool) {
+	c.lastResponse.mu.Lock()
+	defer c.lastResponse.mu.Unlock()
+	if c.lastResponse.info == nil {
+		return ResponseInfo{}, false
+	}
+	return *c.lastResponse.info, true
+}
+
+// recordResponse makes the response available with LastResponse and
+// CaptureResponse
+func (c *Client) recordResponse(req *http.Request, resp *http.Response, latency time.Duration) {
+	info := &ResponseInfo{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Latency:    latency,
+		Checkpoint: -1,
+		RequestID:  req.Header.Get(RequestIDHeader),
+	}
+	if slotNo, err := strconv.Atoi(resp.Header.Get(mostRecentCheckpointHeader)); err == nil {
+		info.Checkpoint = slotNo
+	}
+	c.lastResponse.mu.Lock()
+	c.lastResponse.info = info
+	c.lastResponse.mu.Unlock()
+	if opts := callOptionsFrom(req.Context()); opts != nil && opts.capture != nil {
+		*opts.capture = *info
+	}
+}
@@ -0,0 +1,35 @@
+package kupogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResponseInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"abc"`)
+		w.Header().Set("X-Most-Recent-Checkpoint", "1234")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, WithRequestID(func() string { return "req-1" }))
+
+	if _, ok := client.LastResponse(); ok {
+		t.Fatalf("Expected no last response before any call")
+	}
+	var info ResponseInfo
+	ctx := WithCallOptions(context.Background(), CaptureResponse(&info))
+	if _, err := client.GetMatchesContext(ctx, "*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if info.StatusCode != http.StatusOK || info.ETag() != `"abc"` ||
+		info.Checkpoint != 1234 || info.RequestID != "req-1" || info.Latency <= 0 {
+		t.Fatalf("Unexpected response info: %+v", info)
+	}
+	last, ok := client.LastResponse()
+	if !ok || last.Checkpoint != 1234 {
+		t.Fatalf("Unexpected last response: %+v", last)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"sort"
+)
+
+// CheckRollback verifies that a previously observed point is still on chain.
+// It returns nil if it is, or else the divergence point: the closest
+// checkpoint before it that is still on chain, or the zero point if there is
+// none. A point without a header hash is only checked for its slot
+func (c *Client) CheckRollback(lastKnown Point) (*Point, error) {
+	return c.CheckRollbackContext(context.Background(), lastKnown)
+}
+
+// CheckRollbackContext is like CheckRollback with a request context
+func (c *Client) CheckRollbackContext(ctx context.Context, lastKnown Point) (*Point, error) {
+	point, err := c.GetCheckpointBySlotContext(ctx, lastKnown.SlotNo, true)
+	if err != nil {
+		return nil, err
+	}
+	if point != nil && (lastKnown.HeaderHash == "" || point.HeaderHash == lastKnown.HeaderHash) {
+		return nil, nil
+	}
+	ancestor, err := c.GetCheckpointBySlotContext(ctx, lastKnown.SlotNo, false)
+	if err != nil {
+		return nil, err
+	}
+	// When the slot holds a different block, the closest checkpoint is that
+	// block, so look before it
+	if ancestor != nil && ancestor.SlotNo == lastKnown.SlotNo {
+		if lastKnown.SlotNo == 0 {
+			ancestor = nil
+		} else {
+			ancestor, err = c.GetCheckpointBySlotContext(ctx, lastKnown.SlotNo-1, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if ancestor == nil {
+		return &Point{}, nil
+	}
+	return ancestor, nil
+}
+
+// FindIntersection returns the most recent of a list of locally saved points
+// which is still on chain, or nil if none is, like chain-sync intersection
+// negotiation. Points are tried from the most recent slot down
+func (c *Client) FindIntersection(points []Point) (*Point, error) {
+	return c.FindIntersectionContext(context.Background(), points)
+}
+
+// FindIntersectionContext is like FindIntersection with a request context
+func (c *Client) FindIntersectionContext(ctx context.Context, points []Point) (*Point, error) {
+	candidates := append([]Point(nil), points...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].SlotNo > candidates[j].SlotNo
+	})
+	for _, candidate := range candidates {
+		point, err := c.GetCheckpointBySlotContext(ctx, candidate.SlotNo, true)
+		if err != nil {
+			return nil, err
+		}
+		if point != nil && (candidate.HeaderHash == "" || point.HeaderHash == candidate.HeaderHash) {
+			return point, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,97 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_CheckRollback(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			strict := r.URL.Query().Has("strict")
+			var resp interface{}
+			switch r.URL.Path {
+			case "/checkpoints/100":
+				resp = Point{SlotNo: 100, HeaderHash: "h100"}
+			case "/checkpoints/120":
+				// Slot 120 was rolled back
+				if !strict {
+					resp = Point{SlotNo: 110, HeaderHash: "h110"}
+				}
+			case "/checkpoints/130":
+				// Slot 130 now holds a different block
+				resp = Point{SlotNo: 130, HeaderHash: "h130b"}
+			case "/checkpoints/129":
+				resp = Point{SlotNo: 125, HeaderHash: "h125"}
+			case "/checkpoints/5":
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			respBody, _ := json.Marshal(resp)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	testDefs := []struct {
+		lastKnown Point
+		expected  *Point
+	}{
+		{Point{SlotNo: 100, HeaderHash: "h100"}, nil},
+		{Point{SlotNo: 100}, nil},
+		{Point{SlotNo: 120, HeaderHash: "h120"}, &Point{SlotNo: 110, HeaderHash: "h110"}},
+		{Point{SlotNo: 130, HeaderHash: "h130a"}, &Point{SlotNo: 125, HeaderHash: "h125"}},
+		{Point{SlotNo: 5, HeaderHash: "h5"}, &Point{}},
+	}
+	for _, testDef := range testDefs {
+		divergence, err := client.CheckRollback(testDef.lastKnown)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if (divergence == nil) != (testDef.expected == nil) ||
+			(divergence != nil && *divergence != *testDef.expected) {
+			t.Errorf("Expected divergence %v for %v, got %v", testDef.expected, testDef.lastKnown, divergence)
+		}
+	}
+}
+
+func TestClient_FindIntersection(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var resp interface{}
+			switch r.URL.Path {
+			case "/checkpoints/100":
+				resp = Point{SlotNo: 100, HeaderHash: "h100"}
+			case "/checkpoints/130":
+				resp = Point{SlotNo: 130, HeaderHash: "h130b"}
+			case "/checkpoints/120":
+			default:
+				t.Errorf("Unexpected request: %s", r.URL)
+			}
+			respBody, _ := json.Marshal(resp)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	intersection, err := client.FindIntersection([]Point{
+		{SlotNo: 100, HeaderHash: "h100"},
+		{SlotNo: 130, HeaderHash: "h130a"},
+		{SlotNo: 120, HeaderHash: "h120"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if intersection == nil || *intersection != (Point{SlotNo: 100, HeaderHash: "h100"}) {
+		t.Fatalf("Unexpected intersection: %v", intersection)
+	}
+	intersection, err = client.FindIntersection([]Point{{SlotNo: 120, HeaderHash: "h120"}})
+	if err != nil || intersection != nil {
+		t.Fatalf("Expected no intersection, got %v, %v", intersection, err)
+	}
+}
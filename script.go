@@ -0,0 +1,119 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blinklabs-io/kupogo/internal/cbor"
+)
+
+// Script languages reported by Kupo. Other languages may appear with future
+// eras and are passed through as is
+const (
+	ScriptLanguageNative   = "native"
+	ScriptLanguagePlutusV1 = "plutus:v1"
+	ScriptLanguagePlutusV2 = "plutus:v2"
+	ScriptLanguagePlutusV3 = "plutus:v3"
+)
+
+const plutusLanguagePrefix = "plutus:v"
+
+// IsNative returns whether the script is a native (timelock) script
+func (s ScriptResponse) IsNative() bool {
+	return s.Language == ScriptLanguageNative
+}
+
+// IsPlutus returns whether the script is a Plutus script, including versions
+// newer than those known to this package
+func (s ScriptResponse) IsPlutus() bool {
+	return s.Version() > 0
+}
+
+// Version returns the Plutus version of the script, such as 3 for plutus:v3,
+// or 0 if it is not a Plutus script
+func (s ScriptResponse) Version() int {
+	if !strings.HasPrefix(s.Language, plutusLanguagePrefix) {
+		return 0
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(s.Language, plutusLanguagePrefix))
+	if err != nil || version < 1 {
+		return 0
+	}
+	return version
+}
+
+// UnwrapScript strips any CBOR byte string layers wrapping a Plutus script,
+// returning its flat encoded program. Scripts are commonly found wrapped once,
+// as in transaction witness sets, or twice, as in the cborHex of cardano-cli
+// text envelopes
+func UnwrapScript(script []byte) []byte {
+	// Flat encoded programs start with their version, which can never be
+	// mistaken for a byte string head
+	for len(script) > 0 && script[0]>>5 == cbor.MajorTypeBytes {
+		value, rest, err := cbor.DecodeFirst(script)
+		if err != nil || len(rest) > 0 {
+			break
+		}
+		script = value.([]byte)
+	}
+	return script
+}
+
+// WrapScript wraps a Plutus script in the given number of CBOR byte string
+// layers, after stripping any it already has
+func WrapScript(script []byte, layers int) []byte {
+	ret := UnwrapScript(script)
+	for i := 0; i < layers; i++ {
+		ret = cbor.AppendBytes(nil, ret)
+	}
+	return ret
+}
+
+// FlatBytes returns the flat encoded program of a Plutus script, without any
+// CBOR wrapping
+func (s ScriptResponse) FlatBytes() ([]byte, error) {
+	if !s.IsPlutus() {
+		return nil, fmt.Errorf("not a Plutus script: %s", s.Language)
+	}
+	data, err := hex.DecodeString(s.Script)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode script hex: %s", err)
+	}
+	return UnwrapScript(data), nil
+}
+
+// CBORHex returns a Plutus script wrapped once, as attached to transaction
+// witness sets
+func (s ScriptResponse) CBORHex() (string, error) {
+	return s.wrappedHex(1)
+}
+
+// DoubleCBORHex returns a Plutus script wrapped twice, as in the cborHex of
+// cardano-cli text envelopes
+func (s ScriptResponse) DoubleCBORHex() (string, error) {
+	return s.wrappedHex(2)
+}
+
+func (s ScriptResponse) wrappedHex(layers int) (string, error) {
+	flat, err := s.FlatBytes()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(WrapScript(flat, layers)), nil
+}
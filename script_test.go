@@ -0,0 +1,63 @@
+package kupogo
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestScriptResponseVersion(t *testing.T) {
+	testDefs := []struct {
+		language string
+		native   bool
+		version  int
+	}{
+		{language: ScriptLanguageNative, native: true},
+		{language: ScriptLanguagePlutusV1, version: 1},
+		{language: ScriptLanguagePlutusV2, version: 2},
+		{language: ScriptLanguagePlutusV3, version: 3},
+		{language: "plutus:v4", version: 4},
+		{language: "plutus:vx"},
+		{language: "plutus:v0"},
+		{language: "future"},
+	}
+	for _, testDef := range testDefs {
+		script := ScriptResponse{Language: testDef.language, Script: "00"}
+		if script.IsNative() != testDef.native || script.Version() != testDef.version ||
+			script.IsPlutus() != (testDef.version > 0) {
+			t.Fatalf("Unexpected classification of %s", testDef.language)
+		}
+		// Unknown languages must not fail validation
+		if err := script.validate(); err != nil {
+			t.Fatalf("Expected no error validating %s, got %s", testDef.language, err)
+		}
+	}
+}
+
+func TestScriptWrapping(t *testing.T) {
+	// A 19 byte flat encoded program
+	flat := "0100003222253330044a229309b2b1bad5734a"
+	testDefs := []string{
+		flat,
+		"53" + flat,
+		"5453" + flat,
+	}
+	for _, script := range testDefs {
+		response := ScriptResponse{Language: ScriptLanguagePlutusV2, Script: script}
+		raw, err := response.FlatBytes()
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if hex.EncodeToString(raw) != flat {
+			t.Fatalf("Expected flat program %s from %s, got %x", flat, script, raw)
+		}
+		if single, _ := response.CBORHex(); single != "53"+flat {
+			t.Fatalf("Unexpected single CBOR %s", single)
+		}
+		if double, _ := response.DoubleCBORHex(); double != "5453"+flat {
+			t.Fatalf("Unexpected double CBOR %s", double)
+		}
+	}
+	if _, err := (ScriptResponse{Language: ScriptLanguageNative, Script: "8200581c"}).FlatBytes(); err == nil {
+		t.Fatalf("Expected error for native script")
+	}
+}
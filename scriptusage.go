@@ -0,0 +1,64 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import "sort"
+
+// ScriptUsage summarizes the outputs locked by a script
+type ScriptUsage struct {
+	ScriptHash string
+	// Outputs is the number of unspent outputs locked by the script
+	Outputs int
+	// Spent is the number of spent outputs it locked
+	Spent int
+	// Locked is the total value of the unspent outputs
+	Locked Value
+}
+
+// ScriptUsage groups the matches by the hash of the script locking their
+// output, derived from their address, and reports the usage of each script
+// ordered by locked lovelace, largest first. Outputs not locked by a script
+// are skipped
+func (m Matches) ScriptUsage() []ScriptUsage {
+	usage := make(map[string]*ScriptUsage)
+	for _, match := range m {
+		hash, ok := match.PaymentScriptHash()
+		if !ok {
+			continue
+		}
+		entry, ok := usage[hash]
+		if !ok {
+			entry = &ScriptUsage{ScriptHash: hash}
+			usage[hash] = entry
+		}
+		if match.SpentAt != nil {
+			entry.Spent++
+			continue
+		}
+		entry.Outputs++
+		entry.Locked = entry.Locked.Add(match.Value)
+	}
+	ret := make([]ScriptUsage, 0, len(usage))
+	for _, entry := range usage {
+		ret = append(ret, *entry)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Locked.Coins != ret[j].Locked.Coins {
+			return ret[i].Locked.Coins > ret[j].Locked.Coins
+		}
+		return ret[i].ScriptHash < ret[j].ScriptHash
+	})
+	return ret
+}
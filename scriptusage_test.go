@@ -0,0 +1,28 @@
+package kupogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatchesScriptUsage(t *testing.T) {
+	scriptAddress := "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx"
+	keyAddress := "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"
+	matches := Matches{
+		{Address: scriptAddress, Value: Value{Coins: 5, Assets: Assets{"a.b": 1}}},
+		{Address: scriptAddress, Value: Value{Coins: 7, Assets: Assets{"a.b": 2}}},
+		{Address: scriptAddress, Value: Value{Coins: 100}, SpentAt: &Point{SlotNo: 1}},
+		{Address: keyAddress, Value: Value{Coins: 1000}},
+	}
+	expected := []ScriptUsage{
+		{
+			ScriptHash: "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f",
+			Outputs:    2,
+			Spent:      1,
+			Locked:     Value{Coins: 12, Assets: Assets{"a.b": 3}},
+		},
+	}
+	if usage := matches.ScriptUsage(); !reflect.DeepEqual(usage, expected) {
+		t.Fatalf("Unexpected script usage %+v", usage)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+const (
+	defaultSegmentWindow      = 86400
+	defaultSegmentConcurrency = 4
+)
+
+// SegmentOptions configures GetMatchesSegmented
+type SegmentOptions struct {
+	// FromSlot and ToSlot bound the creation slot of the matches fetched,
+	// inclusive. ToSlot defaults to Kupo's most recent checkpoint
+	FromSlot int
+	ToSlot   int
+	// Window is the number of slots queried by each segment, defaulting to a
+	// day of mainnet slots
+	Window int
+	// Concurrency is the number of segments fetched at once, defaulting to 4
+	Concurrency int
+}
+
+// GetMatchesSegmented fetches the matches of a pattern by splitting the
+// creation slot range into windows queried concurrently, then stitching them
+// together in the order requested by opts. CreatedAfter and CreatedBefore in
+// opts further narrow the range. Each segment is answered at its own point in
+// time, so outputs spent during the download may be reported as unspent
+func (c *Client) GetMatchesSegmented(
+	pattern string,
+	opts MatchOptions,
+	segments SegmentOptions,
+) (*Matches, error) {
+	return c.GetMatchesSegmentedContext(context.Background(), pattern, opts, segments)
+}
+
+// GetMatchesSegmentedContext is like GetMatchesSegmented with a request
+// context
+func (c *Client) GetMatchesSegmentedContext(
+	ctx context.Context,
+	pattern string,
+	opts MatchOptions,
+	segments SegmentOptions,
+) (*Matches, error) {
+	if segments.Window <= 0 {
+		segments.Window = defaultSegmentWindow
+	}
+	if segments.Concurrency <= 0 {
+		segments.Concurrency = defaultSegmentConcurrency
+	}
+	if segments.ToSlot <= 0 {
+		checkpoints, err := c.GetCheckpointsContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(*checkpoints) == 0 {
+			return nil, errors.New("no checkpoint to end segments at")
+		}
+		segments.ToSlot = (*checkpoints)[0].SlotNo
+	}
+	if opts.HasTimeBounds() {
+		opts = opts.WithSlotBounds(c.Network())
+	}
+	if opts.CreatedAfter > 0 && opts.CreatedAfter+1 > segments.FromSlot {
+		segments.FromSlot = opts.CreatedAfter + 1
+	}
+	if opts.CreatedBefore > 0 && opts.CreatedBefore-1 < segments.ToSlot {
+		segments.ToSlot = opts.CreatedBefore - 1
+	}
+	var windows []MatchOptions
+	for from := segments.FromSlot; from <= segments.ToSlot; from += segments.Window {
+		to := from + segments.Window - 1
+		if to > segments.ToSlot {
+			to = segments.ToSlot
+		}
+		window := opts
+		window.CreatedAfter = from - 1
+		window.CreatedBefore = to + 1
+		windows = append(windows, window)
+	}
+	results := make([]Matches, len(windows))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var firstErr error
+	sem := make(chan struct{}, segments.Concurrency)
+	for i := range windows {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			matches, _, err := c.getMatches(ctx, pattern, windows[i])
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			results[i] = *matches
+		}(i)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	total := 0
+	for _, result := range results {
+		total += len(result)
+	}
+	ret := make(Matches, 0, total)
+	// Kupo returns the most recent matches first unless asked otherwise
+	oldestFirst := opts.Order == MatchOrderOldestFirst
+	for i := range results {
+		if !oldestFirst {
+			i = len(results) - 1 - i
+		}
+		ret = append(ret, results[i]...)
+	}
+	return &ret, nil
+}
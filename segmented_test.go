@@ -0,0 +1,87 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestGetMatchesSegmented(t *testing.T) {
+	var all Matches
+	for slot := 0; slot < 100; slot += 7 {
+		all = append(all, Match{TransactionID: strconv.Itoa(slot), CreatedAt: Point{SlotNo: slot}})
+	}
+	var mu sync.Mutex
+	requests := 0
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/checkpoints" {
+				_, _ = w.Write([]byte(`[{"slot_no":95,"header_hash":"aa"}]`))
+				return
+			}
+			mu.Lock()
+			requests++
+			mu.Unlock()
+			query := r.URL.Query()
+			after, before := -1, 1<<30
+			if value := query.Get("created_after"); value != "" {
+				after, _ = strconv.Atoi(value)
+			}
+			if value := query.Get("created_before"); value != "" {
+				before, _ = strconv.Atoi(value)
+			}
+			ret := Matches{}
+			for _, match := range all {
+				if match.CreatedAt.SlotNo > after && match.CreatedAt.SlotNo < before {
+					ret = append(ret, match)
+				}
+			}
+			if query.Get("order") != string(MatchOrderOldestFirst) {
+				sort.SliceStable(ret, func(i, j int) bool {
+					return ret[i].CreatedAt.SlotNo > ret[j].CreatedAt.SlotNo
+				})
+			}
+			_ = json.NewEncoder(w).Encode(ret)
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	matches, err := client.GetMatchesSegmented(
+		"*",
+		MatchOptions{Order: MatchOrderOldestFirst},
+		SegmentOptions{Window: 20, Concurrency: 3},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	// Slot 98 is after the most recent checkpoint
+	expected := all[:len(all)-1]
+	if !reflect.DeepEqual(*matches, expected) {
+		t.Fatalf("Expected %v, got %v", expected, *matches)
+	}
+	if requests != 5 {
+		t.Fatalf("Expected 5 segments, got %d", requests)
+	}
+
+	matches, err = client.GetMatchesSegmented(
+		"*",
+		MatchOptions{CreatedAfter: 10},
+		SegmentOptions{ToSlot: 50, Window: 15},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	var slots []int
+	for _, match := range *matches {
+		slots = append(slots, match.CreatedAt.SlotNo)
+	}
+	if expectedSlots := []int{49, 42, 35, 28, 21, 14}; !reflect.DeepEqual(slots, expectedSlots) {
+		t.Fatalf("Expected slots %v, got %v", expectedSlots, slots)
+	}
+}
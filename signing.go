@@ -0,0 +1,96 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"net/http"
+	"time"
+)
+
+// RequestSigner signs a request before it is sent, typically by setting
+// headers. It receives a copy of the request it may modify
+type RequestSigner func(req *http.Request) error
+
+// WithRequestSigner signs every request with the given signer
+func WithRequestSigner(sign RequestSigner) ClientOption {
+	return WithMiddleware(SigningMiddleware(sign))
+}
+
+// SigningMiddleware signs requests with the given signer. It should come
+// after other middleware modifying requests, so what they set is signed
+func SigningMiddleware(sign RequestSigner) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if IsCrossHostRedirect(req) {
+				return next.RoundTrip(req)
+			}
+			req = req.Clone(req.Context())
+			if err := sign(req); err != nil {
+				return nil, fmt.Errorf("failed to sign request: %s", err)
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// HMACConfig configures HMACSigner
+type HMACConfig struct {
+	// KeyID identifies the secret to the gateway
+	KeyID string
+	// Secret is the shared HMAC key
+	Secret []byte
+	// Hash creates the hash used for the HMAC, defaulting to sha256.New
+	Hash func() hash.Hash
+	// Now returns the time put in the Date header, defaulting to time.Now
+	Now func() time.Time
+}
+
+// HMACSigner signs requests with an HMAC of their method, path including the
+// query string and date, separated by newlines. It sets the Date header and
+// an Authorization header of the form "HMAC <key id>:<base64 signature>"
+func HMACSigner(config HMACConfig) RequestSigner {
+	if config.Hash == nil {
+		config.Hash = sha256.New
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return func(req *http.Request) error {
+		date := config.Now().UTC().Format(http.TimeFormat)
+		req.Header.Set("Date", date)
+		signature := HMACSignature(config.Hash, config.Secret, req.Method, req.URL.RequestURI(), date)
+		req.Header.Set("Authorization", "HMAC "+config.KeyID+":"+signature)
+		return nil
+	}
+}
+
+// HMACSignature returns the base64 encoded signature computed by HMACSigner,
+// for verifying requests
+func HMACSignature(
+	hashFunc func() hash.Hash,
+	secret []byte,
+	method string,
+	path string,
+	date string,
+) string {
+	mac := hmac.New(hashFunc, secret)
+	_, _ = mac.Write([]byte(method + "\n" + path + "\n" + date))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
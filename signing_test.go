@@ -0,0 +1,37 @@
+package kupogo
+
+import (
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHMACSigner(t *testing.T) {
+	secret := []byte("shared secret")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		date := r.Header.Get("Date")
+		if date != "Tue, 02 Jan 2024 03:04:05 GMT" {
+			t.Errorf("Unexpected date: %s", date)
+		}
+		expected := "HMAC gateway:" + HMACSignature(sha256.New, secret, r.Method, r.URL.RequestURI(), date)
+		if r.Header.Get("Authorization") != expected {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithRequestSigner(HMACSigner(HMACConfig{
+		KeyID:  "gateway",
+		Secret: secret,
+		Now: func() time.Time {
+			return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		},
+	})))
+	if _, err := client.GetMatchesWithOptions("*", MatchOptions{Unspent: true}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+}
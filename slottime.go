@@ -0,0 +1,65 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"time"
+)
+
+// SlotConfig maps slots to wall-clock time across a span of the chain with a
+// fixed slot length, such as the Shelley era and later
+type SlotConfig struct {
+	// ZeroSlot is the first slot of the span
+	ZeroSlot int
+	// ZeroTime is the start time of ZeroSlot
+	ZeroTime   time.Time
+	SlotLength time.Duration
+}
+
+// TimeToSlot returns the slot in progress at a time
+func (s SlotConfig) TimeToSlot(t time.Time) int {
+	elapsed := t.Sub(s.ZeroTime)
+	slots := int(elapsed / s.SlotLength)
+	if elapsed < 0 && elapsed%s.SlotLength != 0 {
+		slots--
+	}
+	return s.ZeroSlot + slots
+}
+
+// SlotToTime returns the start time of a slot
+func (s SlotConfig) SlotToTime(slotNo int) time.Time {
+	return s.ZeroTime.Add(time.Duration(slotNo-s.ZeroSlot) * s.SlotLength)
+}
+
+// GetCheckpointAtTime returns the most recent checkpoint at or before a time,
+// such as to query state as of midnight UTC, or nil if there is none
+func (c *Client) GetCheckpointAtTime(t time.Time, slots SlotConfig) (*Point, error) {
+	return c.GetCheckpointAtTimeContext(context.Background(), t, slots)
+}
+
+// GetCheckpointAtTimeContext is like GetCheckpointAtTime with a request
+// context
+func (c *Client) GetCheckpointAtTimeContext(
+	ctx context.Context,
+	t time.Time,
+	slots SlotConfig,
+) (*Point, error) {
+	slotNo := slots.TimeToSlot(t)
+	if slotNo < 0 {
+		return nil, nil
+	}
+	return c.GetCheckpointBySlotContext(ctx, slotNo, false)
+}
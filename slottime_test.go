@@ -0,0 +1,54 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// Mainnet's Shelley era
+var testSlotConfig = SlotConfig{
+	ZeroSlot:   4492800,
+	ZeroTime:   time.Date(2020, time.July, 29, 21, 44, 51, 0, time.UTC),
+	SlotLength: time.Second,
+}
+
+func TestSlotConfig(t *testing.T) {
+	midnight := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	slotNo := testSlotConfig.TimeToSlot(midnight)
+	if slotNo != 112500909 {
+		t.Fatalf("Expected slot 112500909, got %d", slotNo)
+	}
+	if slotTime := testSlotConfig.SlotToTime(slotNo); !slotTime.Equal(midnight) {
+		t.Fatalf("Expected %s, got %s", midnight, slotTime)
+	}
+	if slotNo := testSlotConfig.TimeToSlot(testSlotConfig.ZeroTime.Add(-500 * time.Millisecond)); slotNo != 4492799 {
+		t.Fatalf("Expected slot 4492799, got %d", slotNo)
+	}
+}
+
+func TestClient_GetCheckpointAtTime(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/checkpoints/112500909" || r.URL.Query().Has("strict") {
+				t.Errorf("Unexpected request: %s", r.URL)
+			}
+			respBody, _ := json.Marshal(Point{SlotNo: 112500890, HeaderHash: "hh"})
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	point, err := NewClient(server.URL).GetCheckpointAtTime(
+		time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		testSlotConfig,
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if point == nil || point.SlotNo != 112500890 {
+		t.Fatalf("Unexpected checkpoint: %v", point)
+	}
+}
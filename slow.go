@@ -0,0 +1,103 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SlowRequest describes a request which took longer than the configured
+// threshold, measured until its response body was closed
+type SlowRequest struct {
+	Method   string
+	URL      string
+	Endpoint string
+	// Pattern is the pattern, hash or slot following the endpoint in the
+	// path, if any
+	Pattern    string
+	StatusCode int
+	Bytes      int
+	Duration   time.Duration
+}
+
+type slowRequests struct {
+	threshold time.Duration
+	onSlow    func(SlowRequest)
+}
+
+// WithSlowRequests reports requests taking longer than threshold to onSlow,
+// or logs them at the error level of WithLogger if onSlow is nil
+func WithSlowRequests(threshold time.Duration, onSlow func(SlowRequest)) ClientOption {
+	return func(c *Client) {
+		c.slowRequests = &slowRequests{
+			threshold: threshold,
+			onSlow:    onSlow,
+		}
+	}
+}
+
+// wrap returns a response body which checks the request duration once closed
+func (s *slowRequests) wrap(
+	c *Client,
+	req *http.Request,
+	resp *http.Response,
+	start time.Time,
+) io.ReadCloser {
+	return &observedBody{
+		ReadCloser: resp.Body,
+		done: func(n int) {
+			duration := time.Since(start)
+			if duration < s.threshold {
+				return
+			}
+			endpoint := EndpointName(req.URL.Path)
+			slow := SlowRequest{
+				Method:     req.Method,
+				URL:        req.URL.String(),
+				Endpoint:   endpoint,
+				Pattern:    pathPattern(req.URL.Path, endpoint),
+				StatusCode: resp.StatusCode,
+				Bytes:      n,
+				Duration:   duration,
+			}
+			if s.onSlow != nil {
+				s.onSlow(slow)
+			} else if c.logger != nil {
+				c.logger.Log(
+					requestContext(req),
+					c.logLevels.Error,
+					"slow kupo request",
+					"method", slow.Method,
+					"url", slow.URL,
+					"pattern", slow.Pattern,
+					"bytes", slow.Bytes,
+					"duration", slow.Duration,
+				)
+			}
+		},
+	}
+}
+
+// pathPattern returns the part of a request path following the endpoint
+func pathPattern(path string, endpoint string) string {
+	idx := strings.Index(path, "/"+endpoint+"/")
+	if idx < 0 {
+		return ""
+	}
+	return path[idx+len(endpoint)+2:]
+}
@@ -0,0 +1,40 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_WithSlowRequests(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/matches/slow*" {
+				time.Sleep(50 * time.Millisecond)
+			}
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+
+	var slow []SlowRequest
+	client := NewClient(
+		server.URL,
+		WithSlowRequests(25*time.Millisecond, func(s SlowRequest) {
+			slow = append(slow, s)
+		}),
+	)
+	if _, err := client.GetMatches("fast*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if _, err := client.GetMatches("slow*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(slow) != 1 {
+		t.Fatalf("Expected 1 slow request, got %v", slow)
+	}
+	if slow[0].Pattern != "slow*" || slow[0].Endpoint != "matches" || slow[0].Bytes != 2 {
+		t.Errorf("Unexpected slow request: %+v", slow[0])
+	}
+}
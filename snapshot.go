@@ -0,0 +1,91 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+// Snapshot captures the balances of a set of addresses at a checkpoint
+type Snapshot struct {
+	Checkpoint Point
+	Balances   map[string]Value
+}
+
+// SnapshotDiff describes the balance changes between two snapshots. Credits
+// and debits are keyed by address and only hold positive quantities
+type SnapshotDiff struct {
+	From    Point
+	To      Point
+	Credits map[string]Value
+	Debits  map[string]Value
+}
+
+// NewSnapshot builds a snapshot from the unspent matches as of the checkpoint
+func NewSnapshot(checkpoint Point, matches Matches) *Snapshot {
+	s := &Snapshot{
+		Checkpoint: checkpoint,
+		Balances:   make(map[string]Value),
+	}
+	for _, match := range matches {
+		if match.SpentAt != nil {
+			continue
+		}
+		s.Balances[match.Address] = s.Balances[match.Address].Add(match.Value)
+	}
+	return s
+}
+
+// Diff computes the credits and debits per address and asset between two
+// snapshots
+func Diff(oldSnapshot *Snapshot, newSnapshot *Snapshot) *SnapshotDiff {
+	d := &SnapshotDiff{
+		From:    oldSnapshot.Checkpoint,
+		To:      newSnapshot.Checkpoint,
+		Credits: make(map[string]Value),
+		Debits:  make(map[string]Value),
+	}
+	addresses := make(map[string]bool)
+	for address := range oldSnapshot.Balances {
+		addresses[address] = true
+	}
+	for address := range newSnapshot.Balances {
+		addresses[address] = true
+	}
+	for address := range addresses {
+		delta := newSnapshot.Balances[address].Sub(oldSnapshot.Balances[address])
+		var credit, debit Value
+		if delta.Coins > 0 {
+			credit.Coins = delta.Coins
+		} else {
+			debit.Coins = -delta.Coins
+		}
+		for asset, quantity := range delta.Assets {
+			if quantity > 0 {
+				credit = credit.Add(Value{Assets: Assets{asset: quantity}})
+			} else {
+				debit = debit.Add(Value{Assets: Assets{asset: -quantity}})
+			}
+		}
+		if !credit.IsZero() {
+			d.Credits[address] = credit
+		}
+		if !debit.IsZero() {
+			d.Debits[address] = debit
+		}
+	}
+	return d
+}
+
+// IsEmpty returns true if no balances changed between the snapshots
+func (d *SnapshotDiff) IsEmpty() bool {
+	return len(d.Credits) == 0 && len(d.Debits) == 0
+}
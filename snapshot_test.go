@@ -0,0 +1,46 @@
+package kupogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	oldSnapshot := NewSnapshot(
+		Point{SlotNo: 100},
+		Matches{
+			{Address: "addr1", Value: Value{Coins: 10, Assets: Assets{"aa": 1}}},
+			{Address: "addr1", Value: Value{Coins: 5}},
+			{Address: "addr2", Value: Value{Coins: 7}},
+			{Address: "addr3", Value: Value{Coins: 100}, SpentAt: &Point{SlotNo: 50}},
+		},
+	)
+	if oldSnapshot.Balances["addr1"].Coins != 15 {
+		t.Errorf("Expected addr1 balance of 15, got %v", oldSnapshot.Balances["addr1"])
+	}
+	newSnapshot := NewSnapshot(
+		Point{SlotNo: 200},
+		Matches{
+			{Address: "addr1", Value: Value{Coins: 20, Assets: Assets{"bb": 3}}},
+			{Address: "addr3", Value: Value{Coins: 1}},
+		},
+	)
+	diff := Diff(oldSnapshot, newSnapshot)
+	expectedCredits := map[string]Value{
+		"addr1": {Coins: 5, Assets: Assets{"bb": 3}},
+		"addr3": {Coins: 1},
+	}
+	expectedDebits := map[string]Value{
+		"addr1": {Assets: Assets{"aa": 1}},
+		"addr2": {Coins: 7},
+	}
+	if !reflect.DeepEqual(diff.Credits, expectedCredits) {
+		t.Errorf("Expected credits %v, got %v", expectedCredits, diff.Credits)
+	}
+	if !reflect.DeepEqual(diff.Debits, expectedDebits) {
+		t.Errorf("Expected debits %v, got %v", expectedDebits, diff.Debits)
+	}
+	if !Diff(newSnapshot, newSnapshot).IsEmpty() {
+		t.Errorf("Expected empty diff between identical snapshots")
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// defaultMaxInMemory is the default number of matches an iterator keeps in
+// memory before spilling to disk
+const defaultMaxInMemory = 100000
+
+// SpillOptions controls when a MatchIterator spills matches to disk
+type SpillOptions struct {
+	// MaxInMemory is the number of matches kept in memory. Further matches
+	// are written to a temporary file. Defaults to 100000
+	MaxInMemory int
+	// Dir is the directory of the temporary file, defaulting to the
+	// system's temporary directory
+	Dir string
+}
+
+// MatchIterator iterates over a result set which may not fit in memory. The
+// response is read in full when the iterator is created, so the connection to
+// Kupo is not held while matches are processed, with the matches beyond the
+// in-memory limit spilled to a temporary file. Close removes the file
+type MatchIterator struct {
+	memory  Matches
+	file    *os.File
+	spilled int
+	// Iteration state
+	pos     int
+	reader  *bufio.Reader
+	current Match
+	err     error
+}
+
+// IterateMatches returns an iterator over the matches of a pattern, spilling
+// to disk the matches beyond the limit of spill. Filters set with MatchFilter
+// apply before matches are stored
+func (c *Client) IterateMatches(
+	ctx context.Context,
+	pattern string,
+	opts MatchOptions,
+	spill SpillOptions,
+) (*MatchIterator, error) {
+	if spill.MaxInMemory <= 0 {
+		spill.MaxInMemory = defaultMaxInMemory
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.matchesURL(pattern, opts), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matches: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"failed to get matches: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	it := &MatchIterator{}
+	if err := it.load(resp.Body, spill, matchFilter(req)); err != nil {
+		it.Close()
+		c.logDecodeFailure(req, err)
+		return nil, fmt.Errorf("failed to read matches: %s", err)
+	}
+	return it, nil
+}
+
+// load reads an array of matches, keeping the first ones in memory and
+// writing the others to a temporary file as JSON Lines
+func (it *MatchIterator) load(r io.Reader, spill SpillOptions, keep func(Match) bool) error {
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected JSON array, got %v", token)
+	}
+	var writer *bufio.Writer
+	var raw json.RawMessage
+	var line bytes.Buffer
+	for decoder.More() {
+		raw = raw[:0]
+		if err := decoder.Decode(&raw); err != nil {
+			return err
+		}
+		var match Match
+		if keep != nil || len(it.memory) < spill.MaxInMemory {
+			if err := json.Unmarshal(raw, &match); err != nil {
+				return err
+			}
+			if keep != nil && !keep(match) {
+				continue
+			}
+		}
+		if len(it.memory) < spill.MaxInMemory {
+			it.memory = append(it.memory, match)
+			continue
+		}
+		if writer == nil {
+			it.file, err = os.CreateTemp(spill.Dir, "kupogo-matches-*.jsonl")
+			if err != nil {
+				return err
+			}
+			writer = bufio.NewWriter(it.file)
+		}
+		// Compacting guarantees the match fits on one line
+		line.Reset()
+		if err := json.Compact(&line, raw); err != nil {
+			return err
+		}
+		line.WriteByte('\n')
+		if _, err := writer.Write(line.Bytes()); err != nil {
+			return err
+		}
+		it.spilled++
+	}
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+	if writer != nil {
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+	}
+	return it.Reset()
+}
+
+// Len returns the number of matches
+func (it *MatchIterator) Len() int {
+	return len(it.memory) + it.spilled
+}
+
+// Spilled returns the number of matches which were written to disk
+func (it *MatchIterator) Spilled() int {
+	return it.spilled
+}
+
+// Next advances to the next match, returning false at the end or on error
+func (it *MatchIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.pos < len(it.memory) {
+		it.current = it.memory[it.pos]
+		it.pos++
+		return true
+	}
+	if it.reader == nil {
+		return false
+	}
+	line, err := it.reader.ReadBytes('\n')
+	if err == io.EOF && len(line) == 0 {
+		return false
+	}
+	if err != nil && err != io.EOF {
+		it.err = fmt.Errorf("failed to read spilled matches: %s", err)
+		return false
+	}
+	var match Match
+	if err := json.Unmarshal(line, &match); err != nil {
+		it.err = fmt.Errorf("failed to unmarshal spilled match: %s", err)
+		return false
+	}
+	it.current = match
+	it.pos++
+	return true
+}
+
+// Match returns the current match
+func (it *MatchIterator) Match() Match {
+	return it.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *MatchIterator) Err() error {
+	return it.err
+}
+
+// Reset rewinds the iterator to the first match
+func (it *MatchIterator) Reset() error {
+	it.pos = 0
+	it.err = nil
+	it.current = Match{}
+	it.reader = nil
+	if it.file == nil {
+		return nil
+	}
+	if _, err := it.file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind spilled matches: %s", err)
+	}
+	it.reader = bufio.NewReader(it.file)
+	return nil
+}
+
+// Close releases the matches and removes the temporary file, if any
+func (it *MatchIterator) Close() error {
+	it.memory = nil
+	it.reader = nil
+	if it.file == nil {
+		return nil
+	}
+	file := it.file
+	it.file = nil
+	closeErr := file.Close()
+	if err := os.Remove(file.Name()); err != nil {
+		return fmt.Errorf("failed to remove spilled matches: %s", err)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("failed to close spilled matches: %s", closeErr)
+	}
+	return nil
+}
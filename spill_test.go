@@ -0,0 +1,69 @@
+package kupogo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestIterateMatches(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var matches []string
+			for i := 0; i < 5; i++ {
+				// Pretty printed matches must still be spilled one per line
+				matches = append(matches, fmt.Sprintf("{\n\"transaction_id\": \"t%d\",\n\"value\": {\"coins\": %d}\n}", i, i))
+			}
+			_, _ = w.Write([]byte("[" + strings.Join(matches, ",") + "]"))
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+	dir := t.TempDir()
+
+	it, err := client.IterateMatches(context.Background(), "*", MatchOptions{}, SpillOptions{MaxInMemory: 2, Dir: dir})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if it.Len() != 5 || it.Spilled() != 3 {
+		t.Errorf("Expected 5 matches with 3 spilled, got %d and %d", it.Len(), it.Spilled())
+	}
+	for pass := 0; pass < 2; pass++ {
+		var coins []int
+		for it.Next() {
+			coins = append(coins, it.Match().Value.Coins)
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if fmt.Sprint(coins) != "[0 1 2 3 4]" {
+			t.Errorf("Unexpected coins in pass %d: %v", pass, coins)
+		}
+		if err := it.Reset(); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	}
+	if err := it.Close(); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("Expected the spill file to be removed, got %v", entries)
+	}
+
+	ctx := WithCallOptions(context.Background(), MatchFilter(func(match Match) bool {
+		return match.Value.Coins%2 == 0
+	}))
+	it, err = client.IterateMatches(ctx, "*", MatchOptions{}, SpillOptions{MaxInMemory: 1, Dir: dir})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	defer it.Close()
+	if it.Len() != 3 || it.Spilled() != 2 {
+		t.Errorf("Expected 3 filtered matches with 2 spilled, got %d and %d", it.Len(), it.Spilled())
+	}
+}
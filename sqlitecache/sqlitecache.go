@@ -0,0 +1,306 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlitecache persists matches, datums and scripts in a SQLite
+// database so a restarted service can serve reads and resume incremental sync
+// without re-downloading its working set from Kupo.
+//
+// The package does not import a SQLite driver: open the database with the
+// driver of your choice and pass the *sql.DB to New
+package sqlitecache
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+var ErrNoCheckpoint = errors.New("no checkpoints available")
+
+const schema = `
+CREATE TABLE IF NOT EXISTS kupo_cache (
+	key TEXT PRIMARY KEY,
+	value BLOB NOT NULL
+);
+CREATE TABLE IF NOT EXISTS kupo_patterns (
+	pattern TEXT PRIMARY KEY,
+	slot_no INTEGER NOT NULL,
+	header_hash TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS kupo_matches (
+	pattern TEXT NOT NULL,
+	transaction_id TEXT NOT NULL,
+	output_index INTEGER NOT NULL,
+	data BLOB NOT NULL,
+	PRIMARY KEY (pattern, transaction_id, output_index)
+);
+CREATE TABLE IF NOT EXISTS kupo_cursors (
+	name TEXT PRIMARY KEY,
+	slot_no INTEGER NOT NULL,
+	header_hash TEXT NOT NULL
+);
+`
+
+// Store is a SQLite-backed cache. It implements kupogo.Cache, so it can be
+// passed to kupogo.WithContentCache to persist datums and scripts, and
+// kupogo.CursorStore, so watchers can persist their progress
+type Store struct {
+	db *sql.DB
+}
+
+// New creates the cache tables in the database if needed
+func New(db *sql.DB) (*Store, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create cache tables: %s", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// Get returns a cached value
+func (s *Store) Get(key string) ([]byte, bool) {
+	var value []byte
+	err := s.db.QueryRow(
+		"SELECT value FROM kupo_cache WHERE key = ?",
+		key,
+	).Scan(&value)
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// Set stores a value, ignoring errors as a cache miss is always recoverable
+func (s *Store) Set(key string, value []byte) {
+	_, _ = s.db.Exec(
+		"INSERT OR REPLACE INTO kupo_cache (key, value) VALUES (?, ?)",
+		key,
+		value,
+	)
+}
+
+// LoadCursor returns the saved point for a name, or nil if there is none
+func (s *Store) LoadCursor(name string) (*kupogo.Point, error) {
+	point := &kupogo.Point{}
+	err := s.db.QueryRow(
+		"SELECT slot_no, header_hash FROM kupo_cursors WHERE name = ?",
+		name,
+	).Scan(&point.SlotNo, &point.HeaderHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cursor: %s", err)
+	}
+	return point, nil
+}
+
+// SaveCursor saves the point for a name
+func (s *Store) SaveCursor(name string, point kupogo.Point) error {
+	_, err := s.db.Exec(
+		"INSERT OR REPLACE INTO kupo_cursors (name, slot_no, header_hash) VALUES (?, ?, ?)",
+		name,
+		point.SlotNo,
+		point.HeaderHash,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save cursor: %s", err)
+	}
+	return nil
+}
+
+// SaveMatches replaces the stored UTxO set of a pattern with the given matches
+// as of the checkpoint
+func (s *Store) SaveMatches(
+	pattern string,
+	checkpoint kupogo.Point,
+	matches kupogo.Matches,
+) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %s", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	if _, err := tx.Exec(
+		"DELETE FROM kupo_matches WHERE pattern = ?",
+		pattern,
+	); err != nil {
+		return fmt.Errorf("failed to delete matches: %s", err)
+	}
+	for _, match := range matches {
+		data, err := json.Marshal(match)
+		if err != nil {
+			return fmt.Errorf("failed to marshal match: %s", err)
+		}
+		if _, err := tx.Exec(
+			"INSERT OR REPLACE INTO kupo_matches (pattern, transaction_id, output_index, data) VALUES (?, ?, ?, ?)",
+			pattern,
+			match.TransactionID,
+			match.OutputIndex,
+			data,
+		); err != nil {
+			return fmt.Errorf("failed to insert match: %s", err)
+		}
+	}
+	if _, err := tx.Exec(
+		"INSERT OR REPLACE INTO kupo_patterns (pattern, slot_no, header_hash) VALUES (?, ?, ?)",
+		pattern,
+		checkpoint.SlotNo,
+		checkpoint.HeaderHash,
+	); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %s", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %s", err)
+	}
+	return nil
+}
+
+// LoadMatches returns the stored UTxO set of a pattern and its checkpoint. It
+// returns a nil checkpoint if the pattern has never been saved
+func (s *Store) LoadMatches(pattern string) (*kupogo.Point, kupogo.Matches, error) {
+	checkpoint := &kupogo.Point{}
+	err := s.db.QueryRow(
+		"SELECT slot_no, header_hash FROM kupo_patterns WHERE pattern = ?",
+		pattern,
+	).Scan(&checkpoint.SlotNo, &checkpoint.HeaderHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load checkpoint: %s", err)
+	}
+	rows, err := s.db.Query(
+		"SELECT data FROM kupo_matches WHERE pattern = ? ORDER BY transaction_id, output_index",
+		pattern,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load matches: %s", err)
+	}
+	defer rows.Close()
+	matches := kupogo.Matches{}
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, nil, fmt.Errorf("failed to load matches: %s", err)
+		}
+		var match kupogo.Match
+		if err := json.Unmarshal(data, &match); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal match: %s", err)
+		}
+		matches = append(matches, match)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to load matches: %s", err)
+	}
+	return checkpoint, matches, nil
+}
+
+// Sync brings the stored UTxO set of a pattern up to Kupo's most recent
+// checkpoint and returns it. Only changes since the stored checkpoint are
+// fetched, unless that checkpoint has been rolled back, in which case the set
+// is rebuilt from scratch
+func (s *Store) Sync(client *kupogo.Client, pattern string) (kupogo.Matches, error) {
+	checkpoints, err := client.GetCheckpoints()
+	if err != nil {
+		return nil, err
+	}
+	if len(*checkpoints) == 0 {
+		return nil, ErrNoCheckpoint
+	}
+	tip := (*checkpoints)[0]
+	from, stored, err := s.LoadMatches(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if from != nil {
+		point, err := client.GetCheckpointBySlot(from.SlotNo, true)
+		if err != nil {
+			return nil, err
+		}
+		if point == nil || point.HeaderHash != from.HeaderHash {
+			from = nil
+		}
+	}
+	var matches kupogo.Matches
+	if from == nil {
+		matches, err = client.GetUTxOsAt(pattern, tip.SlotNo)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		matches, err = syncSince(client, pattern, *from, tip, stored)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := s.SaveMatches(pattern, tip, matches); err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// syncSince applies the outputs created and spent between two checkpoints to
+// a stored UTxO set
+func syncSince(
+	client *kupogo.Client,
+	pattern string,
+	from kupogo.Point,
+	tip kupogo.Point,
+	stored kupogo.Matches,
+) (kupogo.Matches, error) {
+	created, err := client.GetMatchesWithOptions(
+		pattern,
+		kupogo.MatchOptions{
+			CreatedAfter:  from.SlotNo,
+			CreatedBefore: tip.SlotNo + 1,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	spent, err := client.GetMatchesWithOptions(
+		pattern,
+		kupogo.MatchOptions{
+			SpentAfter:  from.SlotNo,
+			SpentBefore: tip.SlotNo + 1,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	utxos := make(map[kupogo.OutputReference]kupogo.Match)
+	for _, match := range stored {
+		utxos[match.OutputReference()] = match
+	}
+	for _, match := range *created {
+		// Outputs spent after the tip were still unspent at it
+		if match.SpentAt == nil || match.SpentAt.SlotNo > tip.SlotNo {
+			match.SpentAt = nil
+			utxos[match.OutputReference()] = match
+		}
+	}
+	for _, match := range *spent {
+		delete(utxos, match.OutputReference())
+	}
+	ret := make(kupogo.Matches, 0, len(utxos))
+	for _, match := range utxos {
+		ret = append(ret, match)
+	}
+	return ret, nil
+}
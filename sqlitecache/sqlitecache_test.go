@@ -0,0 +1,136 @@
+package sqlitecache
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newTestStore(t *testing.T) *Store {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "cache.db"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+	store, err := New(db)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	return store
+}
+
+func TestStore_Cache(t *testing.T) {
+	store := newTestStore(t)
+	if _, ok := store.Get("datums/abcd"); ok {
+		t.Fatalf("Expected cache miss")
+	}
+	store.Set("datums/abcd", []byte(`{"datum":"d87980"}`))
+	value, ok := store.Get("datums/abcd")
+	if !ok || string(value) != `{"datum":"d87980"}` {
+		t.Errorf("Unexpected cached value: %s", value)
+	}
+}
+
+func TestStore_Sync(t *testing.T) {
+	store := newTestStore(t)
+	tip := kupogo.Point{SlotNo: 100, HeaderHash: "aa"}
+	var queries []string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var resp interface{}
+			switch r.URL.Path {
+			case "/checkpoints":
+				resp = kupogo.Checkpoints{tip}
+			case "/checkpoints/100":
+				resp = kupogo.Point{SlotNo: 100, HeaderHash: "aa"}
+			case "/matches/addr1":
+				queries = append(queries, r.URL.RawQuery)
+				switch r.URL.RawQuery {
+				case "unspent&created_before=101":
+					resp = kupogo.Matches{{TransactionID: "t1", Value: kupogo.Value{Coins: 1}}}
+				case "created_before=101&spent_after=100":
+					resp = kupogo.Matches{}
+				case "created_after=100&created_before=201":
+					resp = kupogo.Matches{
+						{TransactionID: "t2", Value: kupogo.Value{Coins: 2}},
+						{TransactionID: "t3", SpentAt: &kupogo.Point{SlotNo: 150}},
+						{TransactionID: "t4", SpentAt: &kupogo.Point{SlotNo: 250}},
+					}
+				case "spent_after=100&spent_before=201":
+					resp = kupogo.Matches{{TransactionID: "t1"}, {TransactionID: "t3"}}
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			respBody, _ := json.Marshal(resp)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+	client := kupogo.NewClient(server.URL)
+
+	matches, err := store.Sync(client, "addr1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(matches) != 1 || matches[0].TransactionID != "t1" {
+		t.Fatalf("Unexpected matches after initial sync: %v", matches)
+	}
+
+	tip = kupogo.Point{SlotNo: 200, HeaderHash: "bb"}
+	matches, err = store.Sync(client, "addr1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	var txIDs []string
+	for _, match := range matches {
+		txIDs = append(txIDs, match.TransactionID)
+	}
+	sort.Strings(txIDs)
+	if len(txIDs) != 2 || txIDs[0] != "t2" || txIDs[1] != "t4" {
+		t.Errorf("Unexpected matches after incremental sync: %v", txIDs)
+	}
+
+	checkpoint, stored, err := store.LoadMatches("addr1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if *checkpoint != tip || len(stored) != 2 {
+		t.Errorf("Unexpected stored state: %v %v", checkpoint, stored)
+	}
+	if len(queries) != 4 {
+		t.Errorf("Expected 4 match queries, got %v", queries)
+	}
+}
+
+func TestStore_Cursor(t *testing.T) {
+	store := newTestStore(t)
+	var _ kupogo.CursorStore = store
+	cursor, err := store.LoadCursor("watcher")
+	if err != nil || cursor != nil {
+		t.Fatalf("Expected no cursor, got %v, %v", cursor, err)
+	}
+	if err := store.SaveCursor("watcher", kupogo.Point{SlotNo: 42, HeaderHash: "aa"}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	cursor, err = store.LoadCursor("watcher")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if cursor == nil || *cursor != (kupogo.Point{SlotNo: 42, HeaderHash: "aa"}) {
+		t.Errorf("Unexpected cursor: %v", cursor)
+	}
+}
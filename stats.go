@@ -0,0 +1,118 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// EndpointStats holds the counters of requests to a Kupo endpoint
+type EndpointStats struct {
+	Calls int
+	// Errors counts failed requests and responses with an error status
+	Errors int
+	// Bytes counts response body bytes read
+	Bytes int64
+	// Latency is the cumulative time until response headers were received
+	Latency time.Duration
+}
+
+// AverageLatency returns the mean latency per call
+func (s EndpointStats) AverageLatency() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+	return s.Latency / time.Duration(s.Calls)
+}
+
+type clientStats struct {
+	mu        sync.Mutex
+	endpoints map[string]*EndpointStats
+}
+
+// record counts a request and returns its endpoint name
+func (s *clientStats) record(
+	req *http.Request,
+	resp *http.Response,
+	err error,
+	latency time.Duration,
+) string {
+	endpoint := EndpointName(req.URL.Path)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.endpoint(endpoint)
+	stats.Calls++
+	stats.Latency += latency
+	if err != nil || resp.StatusCode >= http.StatusBadRequest {
+		stats.Errors++
+	}
+	return endpoint
+}
+
+func (s *clientStats) addBytes(endpoint string, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.endpoint(endpoint).Bytes += int64(n)
+}
+
+func (s *clientStats) endpoint(endpoint string) *EndpointStats {
+	if s.endpoints == nil {
+		s.endpoints = make(map[string]*EndpointStats)
+	}
+	stats, ok := s.endpoints[endpoint]
+	if !ok {
+		stats = &EndpointStats{}
+		s.endpoints[endpoint] = stats
+	}
+	return stats
+}
+
+// Stats returns a snapshot of the request counters, keyed by endpoint name as
+// returned by EndpointName
+func (c *Client) Stats() map[string]EndpointStats {
+	c.stats.mu.Lock()
+	defer c.stats.mu.Unlock()
+	ret := make(map[string]EndpointStats, len(c.stats.endpoints))
+	for endpoint, stats := range c.stats.endpoints {
+		ret[endpoint] = *stats
+	}
+	return ret
+}
+
+// observedBody calls done with the number of bytes read once a response body
+// is closed
+type observedBody struct {
+	io.ReadCloser
+	n      int
+	done   func(int)
+	closed bool
+}
+
+func (b *observedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += n
+	return n, err
+}
+
+func (b *observedBody) Close() error {
+	if !b.closed {
+		b.closed = true
+		b.done(b.n)
+	}
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,38 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_Stats(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/datums/abcd" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetMatches("addr1"); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	}
+	_, _ = client.GetDatumByHash("abcd")
+	stats := client.Stats()
+	if stats["matches"].Calls != 3 || stats["matches"].Errors != 0 || stats["matches"].Bytes != 6 {
+		t.Errorf("Unexpected matches stats: %+v", stats["matches"])
+	}
+	if stats["datums"].Calls != 1 || stats["datums"].Errors != 1 {
+		t.Errorf("Unexpected datums stats: %+v", stats["datums"])
+	}
+	if stats["matches"].AverageLatency() <= 0 {
+		t.Errorf("Expected positive latency")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PatternSummary gives an overview of the matches of a pattern
+type PatternSummary struct {
+	Pattern string
+	// Matches and Unspent are the number of matches and of unspent matches
+	Matches int
+	Unspent int
+	// Coins and UnspentCoins are the lovelace of all and of unspent matches
+	Coins        int
+	UnspentCoins int
+	// Addresses and Assets are the numbers of distinct addresses and assets
+	// across all matches
+	Addresses int
+	Assets    int
+	// Checkpoint is the slot of Kupo's most recent checkpoint when it
+	// answered, or -1 if unknown
+	Checkpoint int
+}
+
+// SummarizePattern streams the matches of a pattern once, without keeping
+// them, and summarizes them
+func (c *Client) SummarizePattern(pattern string) (*PatternSummary, error) {
+	return c.SummarizePatternContext(context.Background(), pattern)
+}
+
+// SummarizePatternContext is like SummarizePattern with a request context
+func (c *Client) SummarizePatternContext(
+	ctx context.Context,
+	pattern string,
+) (*PatternSummary, error) {
+	// The response cache would keep the whole body
+	ctx = WithCallOptions(ctx, NoCache())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.matchesURL(pattern, MatchOptions{}), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matches: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"failed to get matches: status code %d%s",
+			resp.StatusCode,
+			requestIDSuffix(req),
+		)
+	}
+	summary := &PatternSummary{
+		Pattern:    pattern,
+		Checkpoint: mostRecentCheckpoint(resp),
+	}
+	keep := matchFilter(req)
+	addresses := make(map[string]struct{})
+	assets := make(map[string]struct{})
+	err = forEachMatch(resp.Body, func(match Match) {
+		if keep != nil && !keep(match) {
+			return
+		}
+		summary.Matches++
+		summary.Coins += match.Value.Coins
+		if match.SpentAt == nil {
+			summary.Unspent++
+			summary.UnspentCoins += match.Value.Coins
+		}
+		addresses[match.Address] = struct{}{}
+		for asset := range match.Value.Assets {
+			assets[asset] = struct{}{}
+		}
+	})
+	if err != nil {
+		c.logDecodeFailure(req, err)
+		return nil, fmt.Errorf("failed to read matches: %s", err)
+	}
+	summary.Addresses = len(addresses)
+	summary.Assets = len(assets)
+	return summary, nil
+}
+
+// SummarizePatterns summarizes every pattern registered with Kupo, one at a
+// time
+func (c *Client) SummarizePatterns() ([]PatternSummary, error) {
+	return c.SummarizePatternsContext(context.Background())
+}
+
+// SummarizePatternsContext is like SummarizePatterns with a request context
+func (c *Client) SummarizePatternsContext(ctx context.Context) ([]PatternSummary, error) {
+	patterns, err := c.GetAllPatternsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]PatternSummary, 0, len(*patterns))
+	for _, pattern := range *patterns {
+		summary, err := c.SummarizePatternContext(ctx, string(pattern))
+		if err != nil {
+			return nil, fmt.Errorf("failed to summarize pattern %s: %w", pattern, err)
+		}
+		ret = append(ret, *summary)
+	}
+	return ret, nil
+}
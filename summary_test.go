@@ -0,0 +1,48 @@
+package kupogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestSummarizePatterns(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/patterns":
+				_, _ = w.Write([]byte(`["*"]`))
+			case "/matches/*":
+				w.Header().Set("X-Most-Recent-Checkpoint", "100")
+				_, _ = w.Write([]byte(`[
+					{"transaction_id":"t1","address":"addr1a","value":{"coins":5,"assets":{"aa.01":1}}},
+					{"transaction_id":"t2","address":"addr1b","value":{"coins":50,"assets":{"aa.01":2,"bb":1}},
+					 "spent_at":{"slot_no":90,"header_hash":"hh"}},
+					{"transaction_id":"t3","address":"addr1a","value":{"coins":500}}
+				]`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+	summaries, err := client.SummarizePatterns()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := []PatternSummary{{
+		Pattern:      "*",
+		Matches:      3,
+		Unspent:      2,
+		Coins:        555,
+		UnspentCoins: 505,
+		Addresses:    2,
+		Assets:       2,
+		Checkpoint:   100,
+	}}
+	if !reflect.DeepEqual(summaries, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, summaries)
+	}
+}
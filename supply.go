@@ -0,0 +1,142 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"sort"
+)
+
+// AssetSupply is the on-chain supply of an asset
+type AssetSupply struct {
+	Asset AssetID
+	// Circulating is the quantity held by unspent outputs
+	Circulating int
+	// Minted and Burned are the quantities minted and burned over the history
+	// covered by the matches, derived from the net change of the asset's
+	// quantity in each block. They are only reported when spent outputs are
+	// included
+	Minted int
+	Burned int
+}
+
+// SupplyOptions configures GetAssetSupply
+type SupplyOptions struct {
+	// History includes spent outputs, to report minted and burned quantities
+	History bool
+}
+
+// Supply returns the supply of the assets held by the matches, ordered by
+// asset. Minted and burned quantities are only reported if the matches
+// include spent outputs, and are only complete if they cover every output
+// ever holding the assets, such as the matches of a policy ID pattern
+func (m Matches) Supply() []AssetSupply {
+	return matchesSupply(m, nil)
+}
+
+// GetAssetSupply computes the supply of the assets matched by an asset ID or
+// policy ID pattern, such as MatchPolicy(policyID)
+func (c *Client) GetAssetSupply(pattern string, opts SupplyOptions) ([]AssetSupply, error) {
+	return c.GetAssetSupplyContext(context.Background(), pattern, opts)
+}
+
+// GetAssetSupplyContext is like GetAssetSupply with a request context
+func (c *Client) GetAssetSupplyContext(
+	ctx context.Context,
+	pattern string,
+	opts SupplyOptions,
+) ([]AssetSupply, error) {
+	matches, _, err := c.getMatches(ctx, pattern, MatchOptions{Unspent: !opts.History})
+	if err != nil {
+		return nil, err
+	}
+	return matchesSupply(*matches, assetFilter(pattern)), nil
+}
+
+// assetFilter returns a filter for the assets of an asset ID or policy ID
+// pattern, as matching outputs also hold unrelated assets, or nil for other
+// patterns
+func assetFilter(pattern string) func(AssetID) bool {
+	kind, err := Pattern(pattern).Kind()
+	if err != nil || (kind != PatternKindPolicyID && kind != PatternKindAssetID) {
+		return nil
+	}
+	return Pattern(pattern).MatchesAsset
+}
+
+func matchesSupply(matches Matches, include func(AssetID) bool) []AssetSupply {
+	supply := make(map[AssetID]*AssetSupply)
+	for asset, deltas := range assetDeltas(matches, include) {
+		entry := &AssetSupply{Asset: asset}
+		for _, delta := range deltas {
+			if delta > 0 {
+				entry.Minted += delta
+			} else {
+				entry.Burned -= delta
+			}
+		}
+		supply[asset] = entry
+	}
+	spent := false
+	for _, match := range matches {
+		if match.SpentAt != nil {
+			spent = true
+			continue
+		}
+		for asset, quantity := range match.Value.Assets {
+			if entry, ok := supply[AssetID(asset)]; ok {
+				entry.Circulating += quantity
+			}
+		}
+	}
+	ret := make([]AssetSupply, 0, len(supply))
+	for _, entry := range supply {
+		if !spent {
+			entry.Minted, entry.Burned = 0, 0
+		}
+		ret = append(ret, *entry)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Asset < ret[j].Asset
+	})
+	return ret
+}
+
+// assetDeltas returns the net change of the quantity of each asset held by
+// the matches per slot, as outputs are created and spent
+func assetDeltas(matches Matches, include func(AssetID) bool) map[AssetID]map[int]int {
+	ret := make(map[AssetID]map[int]int)
+	add := func(asset AssetID, slotNo int, quantity int) {
+		deltas, ok := ret[asset]
+		if !ok {
+			deltas = make(map[int]int)
+			ret[asset] = deltas
+		}
+		deltas[slotNo] += quantity
+	}
+	for _, match := range matches {
+		for name, quantity := range match.Value.Assets {
+			asset := AssetID(name)
+			if include != nil && !include(asset) {
+				continue
+			}
+			add(asset, match.CreatedAt.SlotNo, quantity)
+			if match.SpentAt != nil {
+				add(asset, match.SpentAt.SlotNo, -quantity)
+			}
+		}
+	}
+	return ret
+}
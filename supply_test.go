@@ -0,0 +1,59 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetAssetSupply(t *testing.T) {
+	asset := testPolicyID + ".01"
+	other := testPolicyID + ".02"
+	unrelated := "22222222222222222222222222222222222222222222222222222222.03"
+	matches := Matches{
+		// Minted 100 in slot 10, of which 40 are burned in slot 20
+		{Value: Value{Assets: Assets{asset: 100, unrelated: 5}}, CreatedAt: Point{SlotNo: 10}, SpentAt: &Point{SlotNo: 20}},
+		{Value: Value{Assets: Assets{asset: 60}}, CreatedAt: Point{SlotNo: 20}},
+		{Value: Value{Assets: Assets{other: 1}}, CreatedAt: Point{SlotNo: 30}},
+	}
+	var queries []string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.RawQuery)
+			ret := Matches{}
+			for _, match := range matches {
+				if r.URL.RawQuery != "unspent" || match.SpentAt == nil {
+					ret = append(ret, match)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(ret)
+		}),
+	)
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	supply, err := client.GetAssetSupply(string(MatchPolicy(testPolicyID)), SupplyOptions{History: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := []AssetSupply{
+		{Asset: AssetID(asset), Circulating: 60, Minted: 100, Burned: 40},
+		{Asset: AssetID(other), Circulating: 1, Minted: 1},
+	}
+	if !reflect.DeepEqual(supply, expected) {
+		t.Fatalf("Unexpected supply %+v", supply)
+	}
+
+	supply, err = client.GetAssetSupply(string(MatchAsset(AssetID(asset))), SupplyOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(supply, []AssetSupply{{Asset: AssetID(asset), Circulating: 60}}) {
+		t.Fatalf("Unexpected supply without history %+v", supply)
+	}
+	if !reflect.DeepEqual(queries, []string{"", "unspent"}) {
+		t.Fatalf("Unexpected queries %v", queries)
+	}
+}
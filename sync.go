@@ -0,0 +1,140 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+)
+
+// SyncResult holds the changes to the unspent outputs of a pattern between a
+// cursor and Kupo's most recent checkpoint
+type SyncResult struct {
+	// RollbackTo is set when the cursor is no longer on chain. Changes
+	// applied after this point must be discarded before applying Created and
+	// Spent, which are relative to it. It is the zero point if no common
+	// checkpoint remains, in which case Created holds the whole unspent set
+	RollbackTo *Point
+	// Created holds outputs created since the cursor which were unspent at
+	// the new cursor. Their SpentAt is cleared, as spends after the new
+	// cursor are reported by the next sync
+	Created Matches
+	// Spent holds outputs which existed at the cursor and were spent by the
+	// new cursor. Outputs both created and spent in between are omitted
+	Spent Matches
+	// Cursor is the point to pass to the next sync
+	Cursor Point
+}
+
+// SyncSince returns the changes to the unspent outputs of a pattern since a
+// cursor saved from a previous sync, and the new cursor. A nil cursor returns
+// the whole unspent set
+func (c *Client) SyncSince(pattern string, cursor *Point) (*SyncResult, error) {
+	result := &SyncResult{}
+	from := cursor
+	if cursor != nil {
+		ancestor, err := c.CheckRollback(*cursor)
+		if err != nil {
+			return nil, err
+		}
+		if ancestor != nil {
+			// The cursor was rolled back, so resume from the closest
+			// ancestor still on chain
+			result.RollbackTo = ancestor
+			from = ancestor
+			if ancestor.SlotNo == 0 {
+				from = nil
+			}
+		}
+	}
+	// Query the outputs created since the cursor first, learning the
+	// checkpoint Kupo answered at, which becomes the new cursor
+	createdOpts := MatchOptions{Unspent: true}
+	if from != nil {
+		createdOpts = MatchOptions{CreatedAfter: from.SlotNo}
+	}
+	created, tipSlot, err := c.getMatches(context.Background(), pattern, createdOpts)
+	if err != nil {
+		return nil, err
+	}
+	tip, err := c.syncTip(tipSlot)
+	if err != nil {
+		return nil, err
+	}
+	result.Cursor = *tip
+	for _, match := range *created {
+		if match.CreatedAt.SlotNo > tip.SlotNo {
+			continue
+		}
+		if match.SpentAt != nil && match.SpentAt.SlotNo <= tip.SlotNo {
+			continue
+		}
+		match.SpentAt = nil
+		result.Created = append(result.Created, match)
+	}
+	if from == nil {
+		// Outputs unspent at the tip which were spent before the query
+		spentLater, err := c.GetMatchesWithOptions(
+			pattern,
+			MatchOptions{
+				CreatedBefore: tip.SlotNo + 1,
+				SpentAfter:    tip.SlotNo,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range *spentLater {
+			match.SpentAt = nil
+			result.Created = append(result.Created, match)
+		}
+		return result, nil
+	}
+	spent, err := c.GetMatchesWithOptions(
+		pattern,
+		MatchOptions{
+			CreatedBefore: from.SlotNo + 1,
+			SpentAfter:    from.SlotNo,
+			SpentBefore:   tip.SlotNo + 1,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.Spent = *spent
+	return result, nil
+}
+
+// syncTip resolves the checkpoint a query was answered at, falling back to the
+// most recent checkpoint if the slot is unknown
+func (c *Client) syncTip(slotNo int) (*Point, error) {
+	if slotNo < 0 {
+		checkpoints, err := c.GetCheckpoints()
+		if err != nil {
+			return nil, err
+		}
+		if len(*checkpoints) == 0 {
+			return &Point{}, nil
+		}
+		return &(*checkpoints)[0], nil
+	}
+	point, err := c.GetCheckpointBySlot(slotNo, false)
+	if err != nil {
+		return nil, err
+	}
+	if point == nil {
+		return &Point{SlotNo: slotNo}, nil
+	}
+	return point, nil
+}
@@ -0,0 +1,72 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_SyncSince(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var resp interface{}
+			switch r.URL.Path {
+			case "/checkpoints/100":
+				if r.URL.Query().Has("strict") {
+					// Slot 100 was rolled back
+					resp = nil
+				} else {
+					resp = Point{SlotNo: 90, HeaderHash: "h90"}
+				}
+			case "/checkpoints/200":
+				resp = Point{SlotNo: 200, HeaderHash: "h200"}
+			case "/matches/addr1":
+				switch r.URL.RawQuery {
+				case "created_after=90":
+					resp = Matches{
+						{TransactionID: "aa", CreatedAt: Point{SlotNo: 95}},
+						{TransactionID: "bb", CreatedAt: Point{SlotNo: 150}, SpentAt: &Point{SlotNo: 180}},
+						{TransactionID: "cc", CreatedAt: Point{SlotNo: 190}, SpentAt: &Point{SlotNo: 210}},
+						{TransactionID: "dd", CreatedAt: Point{SlotNo: 205}},
+					}
+				case "created_before=91&spent_after=90&spent_before=201":
+					resp = Matches{
+						{TransactionID: "ee", CreatedAt: Point{SlotNo: 10}, SpentAt: &Point{SlotNo: 120}},
+					}
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.Header().Set("X-Most-Recent-Checkpoint", "200")
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			respBody, _ := json.Marshal(resp)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	client := &Client{KupoUrl: server.URL}
+	result, err := client.SyncSince("addr1", &Point{SlotNo: 100, HeaderHash: "h100"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if result.RollbackTo == nil || result.RollbackTo.SlotNo != 90 {
+		t.Errorf("Expected rollback to slot 90, got %v", result.RollbackTo)
+	}
+	if result.Cursor != (Point{SlotNo: 200, HeaderHash: "h200"}) {
+		t.Errorf("Unexpected cursor: %v", result.Cursor)
+	}
+	if len(result.Created) != 2 ||
+		result.Created[0].TransactionID != "aa" ||
+		result.Created[1].TransactionID != "cc" ||
+		result.Created[1].SpentAt != nil {
+		t.Errorf("Unexpected created outputs: %v", result.Created)
+	}
+	if len(result.Spent) != 1 || result.Spent[0].TransactionID != "ee" {
+		t.Errorf("Unexpected spent outputs: %v", result.Spent)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// defaultSyncSamples is the default number of health samples a
+// SyncEstimator bases its rate on
+const defaultSyncSamples = 10
+
+// SyncProgress estimates how far Kupo is from the node tip
+type SyncProgress struct {
+	Checkpoint int
+	NodeTip    int
+	// Lag is the number of slots between the checkpoint and the node tip
+	Lag int
+	// Synchronization is the fraction of the chain indexed, from 0 to 1
+	Synchronization float64
+	// SlotsPerSecond is the rate at which Kupo indexes slots
+	SlotsPerSecond float64
+	// Remaining is the estimated time to reach the tip, which keeps moving
+	// while Kupo catches up. It is only meaningful if Estimated is true
+	Remaining time.Duration
+	// Estimated is false until two samples were taken, or if Kupo is not
+	// gaining on the tip
+	Estimated bool
+}
+
+// String describes the progress, such as "sync 82.0%, ~3h0m0s remaining"
+func (p SyncProgress) String() string {
+	ret := fmt.Sprintf("sync %.1f%%", p.Synchronization*100)
+	if p.Lag <= 0 {
+		return ret
+	}
+	if !p.Estimated {
+		return ret + ", estimating time remaining"
+	}
+	return fmt.Sprintf("%s, ~%s remaining", ret, p.Remaining.Round(time.Second))
+}
+
+type syncSample struct {
+	time       time.Time
+	checkpoint int
+	nodeTip    int
+}
+
+// SyncEstimator estimates sync progress from health reports sampled over
+// time. It keeps the most recent samples, so the estimate follows changes in
+// indexing speed
+type SyncEstimator struct {
+	samples    []syncSample
+	maxSamples int
+}
+
+// NewSyncEstimator returns an estimator basing its rate on up to maxSamples
+// samples, defaulting to 10
+func NewSyncEstimator(maxSamples int) *SyncEstimator {
+	if maxSamples < 2 {
+		maxSamples = defaultSyncSamples
+	}
+	return &SyncEstimator{maxSamples: maxSamples}
+}
+
+// Add records a health report taken at a time and returns the resulting
+// estimate. It returns false if the report lacks the checkpoint or node tip
+func (e *SyncEstimator) Add(t time.Time, health Health) (SyncProgress, bool) {
+	if health.MostRecentCheckpoint == nil || health.MostRecentNodeTip == nil {
+		return SyncProgress{}, false
+	}
+	sample := syncSample{
+		time:       t,
+		checkpoint: *health.MostRecentCheckpoint,
+		nodeTip:    *health.MostRecentNodeTip,
+	}
+	e.samples = append(e.samples, sample)
+	if len(e.samples) > e.maxSamples {
+		e.samples = e.samples[len(e.samples)-e.maxSamples:]
+	}
+	progress := SyncProgress{
+		Checkpoint: sample.checkpoint,
+		NodeTip:    sample.nodeTip,
+		Lag:        max(sample.nodeTip-sample.checkpoint, 0),
+	}
+	if health.NetworkSynchronization != nil {
+		progress.Synchronization = *health.NetworkSynchronization
+	} else if sample.nodeTip > 0 {
+		progress.Synchronization = min(float64(sample.checkpoint)/float64(sample.nodeTip), 1)
+	}
+	oldest := e.samples[0]
+	elapsed := sample.time.Sub(oldest.time).Seconds()
+	if elapsed <= 0 {
+		return progress, true
+	}
+	progress.SlotsPerSecond = float64(sample.checkpoint-oldest.checkpoint) / elapsed
+	// The gap only closes as fast as Kupo outpaces the tip
+	closing := progress.SlotsPerSecond - float64(sample.nodeTip-oldest.nodeTip)/elapsed
+	if progress.Lag == 0 {
+		progress.Estimated = true
+	} else if closing > 0 {
+		progress.Remaining = time.Duration(float64(progress.Lag) / closing * float64(time.Second))
+		progress.Estimated = true
+	}
+	return progress, true
+}
+
+// TrackSyncProgress samples Kupo's health every interval and calls
+// onProgress with each estimate until Kupo is within maxSlotLag slots of the
+// node tip, or the context is done. Failed health requests are skipped
+func (c *Client) TrackSyncProgress(
+	ctx context.Context,
+	interval time.Duration,
+	maxSlotLag int,
+	onProgress func(SyncProgress),
+) error {
+	estimator := NewSyncEstimator(defaultSyncSamples)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		health, err := c.GetHealthContext(ctx)
+		if err == nil {
+			if progress, ok := estimator.Add(time.Now(), *health); ok {
+				if onProgress != nil {
+					onProgress(progress)
+				}
+				if progress.Lag <= maxSlotLag {
+					return nil
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package kupogo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSyncEstimator(t *testing.T) {
+	health := func(checkpoint int, nodeTip int) Health {
+		return Health{MostRecentCheckpoint: &checkpoint, MostRecentNodeTip: &nodeTip}
+	}
+	estimator := NewSyncEstimator(3)
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if _, ok := estimator.Add(start, Health{}); ok {
+		t.Fatalf("Expected a report without checkpoint to be skipped")
+	}
+	progress, ok := estimator.Add(start, health(1000, 11000))
+	if !ok || progress.Estimated || progress.Lag != 10000 {
+		t.Fatalf("Unexpected first estimate: %+v", progress)
+	}
+	if progress.String() != "sync 9.1%, estimating time remaining" {
+		t.Errorf("Unexpected description: %s", progress)
+	}
+	// Kupo indexes 101 slots per second while the tip moves by 1
+	progress, _ = estimator.Add(start.Add(10*time.Second), health(2010, 11010))
+	if !progress.Estimated || progress.SlotsPerSecond != 101 || progress.Remaining != 90*time.Second {
+		t.Errorf("Unexpected estimate: %+v", progress)
+	}
+	if progress.String() != "sync 18.3%, ~1m30s remaining" {
+		t.Errorf("Unexpected description: %s", progress)
+	}
+	// Kupo stalls, and the oldest sample has been dropped
+	estimator.Add(start.Add(20*time.Second), health(2010, 11020))
+	progress, _ = estimator.Add(start.Add(30*time.Second), health(2010, 11030))
+	if progress.Estimated || progress.SlotsPerSecond != 0 {
+		t.Errorf("Expected no estimate while stalled, got %+v", progress)
+	}
+}
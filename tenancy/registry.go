@@ -0,0 +1,173 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenancy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// Registry records which tenants own which patterns. Patterns are stored
+// normalized
+type Registry interface {
+	// Owners returns the tenants owning a pattern
+	Owners(pattern kupogo.Pattern) ([]string, error)
+	// Patterns returns the patterns owned by a tenant
+	Patterns(tenant string) ([]kupogo.Pattern, error)
+	// Claim records a tenant as an owner of a pattern
+	Claim(tenant string, pattern kupogo.Pattern) error
+	// Release removes a tenant from the owners of a pattern
+	Release(tenant string, pattern kupogo.Pattern) error
+}
+
+// MemoryRegistry is a Registry kept in memory
+type MemoryRegistry struct {
+	mu     sync.Mutex
+	owners map[string]map[kupogo.Pattern]bool
+}
+
+// NewMemoryRegistry returns an empty in-memory registry
+func NewMemoryRegistry() *MemoryRegistry {
+	return &MemoryRegistry{owners: make(map[string]map[kupogo.Pattern]bool)}
+}
+
+func (r *MemoryRegistry) Owners(pattern kupogo.Pattern) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ret []string
+	for tenant, patterns := range r.owners {
+		if patterns[pattern] {
+			ret = append(ret, tenant)
+		}
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func (r *MemoryRegistry) Patterns(tenant string) ([]kupogo.Pattern, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make([]kupogo.Pattern, 0, len(r.owners[tenant]))
+	for pattern := range r.owners[tenant] {
+		ret = append(ret, pattern)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i] < ret[j]
+	})
+	return ret, nil
+}
+
+func (r *MemoryRegistry) Claim(tenant string, pattern kupogo.Pattern) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.owners[tenant] == nil {
+		r.owners[tenant] = make(map[kupogo.Pattern]bool)
+	}
+	r.owners[tenant][pattern] = true
+	return nil
+}
+
+func (r *MemoryRegistry) Release(tenant string, pattern kupogo.Pattern) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.owners[tenant], pattern)
+	if len(r.owners[tenant]) == 0 {
+		delete(r.owners, tenant)
+	}
+	return nil
+}
+
+// FileRegistry is a Registry persisted as a JSON file mapping tenants to
+// their patterns. The file is rewritten on every change
+type FileRegistry struct {
+	*MemoryRegistry
+	path string
+	// Serializes changes and writes, so the file always reflects the last
+	// change
+	writeMu sync.Mutex
+}
+
+// NewFileRegistry loads a registry from a file, which need not exist yet
+func NewFileRegistry(path string) (*FileRegistry, error) {
+	r := &FileRegistry{
+		MemoryRegistry: NewMemoryRegistry(),
+		path:           path,
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry: %s", err)
+	}
+	var stored map[string][]kupogo.Pattern
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal registry: %s", err)
+	}
+	for tenant, patterns := range stored {
+		for _, pattern := range patterns {
+			_ = r.MemoryRegistry.Claim(tenant, pattern)
+		}
+	}
+	return r, nil
+}
+
+func (r *FileRegistry) Claim(tenant string, pattern kupogo.Pattern) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+	_ = r.MemoryRegistry.Claim(tenant, pattern)
+	return r.save()
+}
+
+func (r *FileRegistry) Release(tenant string, pattern kupogo.Pattern) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+	_ = r.MemoryRegistry.Release(tenant, pattern)
+	return r.save()
+}
+
+// save writes the registry to a temporary file and renames it into place, so
+// a crash never leaves a truncated registry
+func (r *FileRegistry) save() error {
+	r.mu.Lock()
+	stored := make(map[string][]kupogo.Pattern, len(r.owners))
+	for tenant, patterns := range r.owners {
+		for pattern := range patterns {
+			stored[tenant] = append(stored[tenant], pattern)
+		}
+		sort.Slice(stored[tenant], func(i, j int) bool {
+			return stored[tenant][i] < stored[tenant][j]
+		})
+	}
+	r.mu.Unlock()
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal registry: %s", err)
+	}
+	tmpPath := r.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write registry: %s", err)
+	}
+	if err := os.Rename(tmpPath, r.path); err != nil {
+		return fmt.Errorf("failed to write registry: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tenancy lets many tenants share one Kupo. Each pattern is tagged
+// with the tenants owning it in a local registry, so a tenant reconciling its
+// patterns never deletes patterns another tenant still needs, and match
+// queries are limited to the patterns a tenant owns
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+var ErrNotOwned = errors.New("pattern not owned by tenant")
+
+// Manager hands out tenant views of a shared Kupo
+type Manager struct {
+	client   *kupogo.Client
+	registry Registry
+	// Serializes pattern changes, which read then update shared ownership
+	mu sync.Mutex
+}
+
+// NewManager returns a manager of the tenants of a Kupo
+func NewManager(client *kupogo.Client, registry Registry) *Manager {
+	return &Manager{
+		client:   client,
+		registry: registry,
+	}
+}
+
+// Tenant returns the view of a tenant
+func (m *Manager) Tenant(name string) *Tenant {
+	return &Tenant{name: name, manager: m}
+}
+
+// Tenant is one tenant's view of a shared Kupo
+type Tenant struct {
+	name    string
+	manager *Manager
+}
+
+// Name returns the name of the tenant
+func (t *Tenant) Name() string {
+	return t.name
+}
+
+// Patterns returns the patterns owned by the tenant
+func (t *Tenant) Patterns() ([]kupogo.Pattern, error) {
+	return t.manager.registry.Patterns(t.name)
+}
+
+// Owns returns whether the tenant owns a pattern
+func (t *Tenant) Owns(pattern kupogo.Pattern) (bool, error) {
+	normalized, err := pattern.Normalize()
+	if err != nil {
+		return false, err
+	}
+	owners, err := t.manager.registry.Owners(normalized)
+	if err != nil {
+		return false, err
+	}
+	for _, owner := range owners {
+		if owner == t.name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// EnsurePatterns makes the patterns owned by the tenant match the desired
+// set. Patterns registered with Kupo by other tenants, or outside of any
+// tenant, are never deleted: a pattern is only removed from Kupo once no
+// tenant owns it anymore. The returned changes describe the tenant's
+// patterns, not Kupo's. KeepUnknown is ignored, as other tenants' patterns are
+// always kept
+func (t *Tenant) EnsurePatterns(
+	ctx context.Context,
+	desired []kupogo.Pattern,
+	opts kupogo.EnsurePatternsOptions,
+) (*kupogo.PatternChanges, error) {
+	m := t.manager
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	wanted := make(map[kupogo.Pattern]bool, len(desired))
+	for _, pattern := range desired {
+		normalized, err := pattern.Normalize()
+		if err != nil {
+			return nil, err
+		}
+		wanted[normalized] = true
+	}
+	owned, err := m.registry.Patterns(t.name)
+	if err != nil {
+		return nil, err
+	}
+	changes := &kupogo.PatternChanges{}
+	isOwned := make(map[kupogo.Pattern]bool, len(owned))
+	for _, pattern := range owned {
+		isOwned[pattern] = true
+		if !wanted[pattern] {
+			changes.Removed = append(changes.Removed, pattern)
+		}
+	}
+	for _, pattern := range desired {
+		normalized, _ := pattern.Normalize()
+		if !isOwned[normalized] {
+			changes.Added = append(changes.Added, normalized)
+			isOwned[normalized] = true
+		}
+	}
+	if opts.DryRun || changes.Empty() {
+		return changes, nil
+	}
+	if len(changes.Added) > 0 {
+		if err := t.add(ctx, changes.Added, opts); err != nil {
+			return nil, err
+		}
+	}
+	for _, pattern := range changes.Removed {
+		if err := m.registry.Release(t.name, pattern); err != nil {
+			return nil, err
+		}
+		owners, err := m.registry.Owners(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(owners) > 0 {
+			continue
+		}
+		if _, err := m.client.DeletePatternContext(ctx, string(pattern)); err != nil {
+			return nil, fmt.Errorf("failed to remove pattern %s: %s", pattern, err)
+		}
+	}
+	return changes, nil
+}
+
+// add registers the patterns missing from Kupo and claims all of them
+func (t *Tenant) add(
+	ctx context.Context,
+	patterns kupogo.Patterns,
+	opts kupogo.EnsurePatternsOptions,
+) error {
+	m := t.manager
+	current, err := m.client.GetAllPatternsContext(ctx)
+	if err != nil {
+		return err
+	}
+	registered := make(map[kupogo.Pattern]bool, len(*current))
+	for _, pattern := range *current {
+		normalized, err := pattern.Normalize()
+		if err != nil {
+			normalized = pattern
+		}
+		registered[normalized] = true
+	}
+	var missing []string
+	for _, pattern := range patterns {
+		if !registered[pattern] {
+			missing = append(missing, string(pattern))
+		}
+	}
+	if len(missing) > 0 {
+		rollbackTo := opts.RollbackTo
+		if rollbackTo == nil {
+			checkpoints, err := m.client.GetCheckpointsContext(ctx)
+			if err != nil {
+				return err
+			}
+			if len(*checkpoints) == 0 {
+				return errors.New("no checkpoint to roll back to")
+			}
+			rollbackTo = &(*checkpoints)[0]
+		}
+		if _, err := m.client.AddPatternsContext(ctx, missing, *rollbackTo, opts.Limit); err != nil {
+			return err
+		}
+	}
+	for _, pattern := range patterns {
+		if err := m.registry.Claim(t.name, pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetMatches returns the matches of a pattern owned by the tenant
+func (t *Tenant) GetMatches(
+	ctx context.Context,
+	pattern kupogo.Pattern,
+	opts kupogo.MatchOptions,
+) (*kupogo.Matches, error) {
+	owns, err := t.Owns(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if !owns {
+		return nil, fmt.Errorf("%w: %s", ErrNotOwned, pattern)
+	}
+	return t.manager.client.GetMatchesWithOptionsContext(ctx, string(pattern), opts)
+}
+
+// GetAllMatches returns the matches of every pattern owned by the tenant.
+// Outputs matching several patterns are returned once
+func (t *Tenant) GetAllMatches(
+	ctx context.Context,
+	opts kupogo.MatchOptions,
+) (kupogo.Matches, error) {
+	patterns, err := t.Patterns()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[kupogo.OutputReference]bool)
+	ret := kupogo.Matches{}
+	for _, pattern := range patterns {
+		matches, err := t.manager.client.GetMatchesWithOptionsContext(ctx, string(pattern), opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range *matches {
+			if seen[match.OutputReference()] {
+				continue
+			}
+			seen[match.OutputReference()] = true
+			ret = append(ret, match)
+		}
+	}
+	return ret, nil
+}
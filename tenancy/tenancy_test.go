@@ -0,0 +1,117 @@
+package tenancy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+var (
+	policyA = kupogo.MatchPolicy(strings.Repeat("a", 56))
+	policyB = kupogo.MatchPolicy(strings.Repeat("b", 56))
+	policyC = kupogo.MatchPolicy(strings.Repeat("c", 56))
+)
+
+func TestTenant_EnsurePatterns(t *testing.T) {
+	var mu sync.Mutex
+	registered := map[string]bool{"*": true}
+	var deleted []string
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/patterns":
+				var patterns []string
+				for pattern := range registered {
+					patterns = append(patterns, pattern)
+				}
+				_ = json.NewEncoder(w).Encode(patterns)
+			case r.Method == http.MethodGet && r.URL.Path == "/checkpoints":
+				_, _ = w.Write([]byte(`[{"slot_no":100,"header_hash":"aa"}]`))
+			case r.Method == http.MethodPut && r.URL.Path == "/patterns":
+				body, _ := io.ReadAll(r.Body)
+				var req struct {
+					Patterns []string `json:"patterns"`
+				}
+				_ = json.Unmarshal(body, &req)
+				for _, pattern := range req.Patterns {
+					registered[pattern] = true
+				}
+				_, _ = w.Write([]byte(`[]`))
+			case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/patterns/"):
+				pattern := strings.TrimPrefix(r.URL.Path, "/patterns/")
+				delete(registered, pattern)
+				deleted = append(deleted, pattern)
+				_, _ = w.Write([]byte(`{"deleted":1}`))
+			case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/matches/"):
+				_, _ = w.Write([]byte(`[]`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer server.Close()
+	registry, err := NewFileRegistry(filepath.Join(t.TempDir(), "tenants.json"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	manager := NewManager(kupogo.NewClient(server.URL), registry)
+	alice := manager.Tenant("alice")
+	bob := manager.Tenant("bob")
+	ctx := context.Background()
+
+	if _, err := alice.EnsurePatterns(ctx, []kupogo.Pattern{policyA, policyB}, kupogo.EnsurePatternsOptions{}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if _, err := bob.EnsurePatterns(ctx, []kupogo.Pattern{policyB}, kupogo.EnsurePatternsOptions{}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	changes, err := alice.EnsurePatterns(ctx, []kupogo.Pattern{policyC}, kupogo.EnsurePatternsOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := &kupogo.PatternChanges{
+		Added:   kupogo.Patterns{policyC},
+		Removed: kupogo.Patterns{policyA, policyB},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, changes)
+	}
+	// bb.* is still owned by bob, and * was never owned by a tenant
+	if !reflect.DeepEqual(deleted, []string{string(policyA)}) {
+		t.Errorf("Unexpected deleted patterns: %v", deleted)
+	}
+	if !registered[string(policyB)] || !registered["*"] || !registered[string(policyC)] {
+		t.Errorf("Unexpected registered patterns: %v", registered)
+	}
+
+	if _, err := bob.GetMatches(ctx, policyC, kupogo.MatchOptions{}); !errors.Is(err, ErrNotOwned) {
+		t.Errorf("Expected ErrNotOwned, got %v", err)
+	}
+	if _, err := bob.GetMatches(ctx, policyB, kupogo.MatchOptions{}); err != nil {
+		t.Errorf("Expected no error, got %s", err)
+	}
+
+	reloaded, err := NewFileRegistry(registry.path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	patterns, err := reloaded.Patterns("alice")
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(patterns, []kupogo.Pattern{policyC}) {
+		t.Errorf("Unexpected reloaded patterns: %v", patterns)
+	}
+}
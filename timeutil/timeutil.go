@@ -0,0 +1,178 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package timeutil converts between slots, epochs and wall-clock time using
+// the era parameters of Cardano networks
+package timeutil
+
+import (
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// Era is a span of the chain with fixed slot and epoch lengths
+type Era struct {
+	StartSlot   int
+	StartEpoch  int
+	StartTime   time.Time
+	SlotLength  time.Duration
+	EpochLength int
+}
+
+// Eras are the eras of a network, oldest first
+type Eras []Era
+
+var (
+	// Mainnet has a Byron era of 20 second slots before Shelley
+	Mainnet = Eras{
+		{
+			StartTime:   time.Date(2017, time.September, 23, 21, 44, 51, 0, time.UTC),
+			SlotLength:  20 * time.Second,
+			EpochLength: 21600,
+		},
+		{
+			StartSlot:   4492800,
+			StartEpoch:  208,
+			StartTime:   time.Date(2020, time.July, 29, 21, 44, 51, 0, time.UTC),
+			SlotLength:  time.Second,
+			EpochLength: 432000,
+		},
+	}
+	// Preprod has a Byron era of four epochs
+	Preprod = Eras{
+		{
+			StartTime:   time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC),
+			SlotLength:  20 * time.Second,
+			EpochLength: 21600,
+		},
+		{
+			StartSlot:   86400,
+			StartEpoch:  4,
+			StartTime:   time.Date(2022, time.June, 21, 0, 0, 0, 0, time.UTC),
+			SlotLength:  time.Second,
+			EpochLength: 432000,
+		},
+	}
+	// Preview starts in Shelley with one day epochs
+	Preview = Eras{
+		{
+			StartTime:   time.Date(2022, time.October, 25, 0, 0, 0, 0, time.UTC),
+			SlotLength:  time.Second,
+			EpochLength: 86400,
+		},
+	}
+)
+
+// eraOfSlot returns the era containing a slot, or the first era for slots
+// before it
+func (e Eras) eraOfSlot(slotNo int) Era {
+	ret := e[0]
+	for _, era := range e[1:] {
+		if slotNo < era.StartSlot {
+			break
+		}
+		ret = era
+	}
+	return ret
+}
+
+// SlotToTime returns the start time of a slot
+func (e Eras) SlotToTime(slotNo int) time.Time {
+	era := e.eraOfSlot(slotNo)
+	return era.StartTime.Add(time.Duration(slotNo-era.StartSlot) * era.SlotLength)
+}
+
+// TimeToSlot returns the slot in progress at a time
+func (e Eras) TimeToSlot(t time.Time) int {
+	era := e[0]
+	for _, next := range e[1:] {
+		if t.Before(next.StartTime) {
+			break
+		}
+		era = next
+	}
+	return era.SlotConfig().TimeToSlot(t)
+}
+
+// SlotToEpoch returns the epoch of a slot and the slot's index within it
+func (e Eras) SlotToEpoch(slotNo int) (int, int) {
+	era := e.eraOfSlot(slotNo)
+	offset := slotNo - era.StartSlot
+	return era.StartEpoch + offset/era.EpochLength, offset % era.EpochLength
+}
+
+// EpochToSlot returns the first slot of an epoch
+func (e Eras) EpochToSlot(epoch int) int {
+	era := e[0]
+	for _, next := range e[1:] {
+		if epoch < next.StartEpoch {
+			break
+		}
+		era = next
+	}
+	return era.StartSlot + (epoch-era.StartEpoch)*era.EpochLength
+}
+
+// SlotConfig returns the slot configuration of the current era, valid for
+// any slot since its start
+func (e Eras) SlotConfig() kupogo.SlotConfig {
+	return e[len(e)-1].SlotConfig()
+}
+
+// SlotConfig returns the slot configuration of the era
+func (e Era) SlotConfig() kupogo.SlotConfig {
+	return kupogo.SlotConfig{
+		ZeroSlot:   e.StartSlot,
+		ZeroTime:   e.StartTime,
+		SlotLength: e.SlotLength,
+	}
+}
+
+// CreatedTime returns the time the output of a match was created
+func (e Eras) CreatedTime(match kupogo.Match) time.Time {
+	return e.SlotToTime(match.CreatedAt.SlotNo)
+}
+
+// SpentTime returns the time the output of a match was spent, or nil if it
+// is unspent
+func (e Eras) SpentTime(match kupogo.Match) *time.Time {
+	if match.SpentAt == nil {
+		return nil
+	}
+	ret := e.SlotToTime(match.SpentAt.SlotNo)
+	return &ret
+}
+
+// ForNetwork returns the eras of a network. For networks other than the
+// presets, only the era since Shelley is known
+func ForNetwork(network kupogo.Network) Eras {
+	switch network.Name {
+	case kupogo.NetworkMainnet.Name:
+		return Mainnet
+	case kupogo.NetworkPreprod.Name:
+		return Preprod
+	case kupogo.NetworkPreview.Name:
+		return Preview
+	}
+	return Eras{
+		{
+			StartSlot:   network.SlotConfig.ZeroSlot,
+			StartEpoch:  network.ShelleyStartEpoch,
+			StartTime:   network.SlotConfig.ZeroTime,
+			SlotLength:  network.SlotConfig.SlotLength,
+			EpochLength: network.EpochLength,
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package timeutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestSlotToTime(t *testing.T) {
+	testDefs := []struct {
+		eras     Eras
+		slotNo   int
+		expected time.Time
+		epoch    int
+	}{
+		// Last Byron slot of mainnet
+		{Mainnet, 4492799, time.Date(2020, time.July, 29, 21, 44, 31, 0, time.UTC), 207},
+		{Mainnet, 4492800, time.Date(2020, time.July, 29, 21, 44, 51, 0, time.UTC), 208},
+		{Mainnet, 112500909, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), 458},
+		{Preprod, 86400, time.Date(2022, time.June, 21, 0, 0, 0, 0, time.UTC), 4},
+		{Preprod, 48384000, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), 115},
+		{Preview, 86400, time.Date(2022, time.October, 26, 0, 0, 0, 0, time.UTC), 1},
+	}
+	for _, testDef := range testDefs {
+		if slotTime := testDef.eras.SlotToTime(testDef.slotNo); !slotTime.Equal(testDef.expected) {
+			t.Errorf("Expected %s for slot %d, got %s", testDef.expected, testDef.slotNo, slotTime)
+		}
+		if slotNo := testDef.eras.TimeToSlot(testDef.expected); slotNo != testDef.slotNo {
+			t.Errorf("Expected slot %d for %s, got %d", testDef.slotNo, testDef.expected, slotNo)
+		}
+		if epoch, _ := testDef.eras.SlotToEpoch(testDef.slotNo); epoch != testDef.epoch {
+			t.Errorf("Expected epoch %d for slot %d, got %d", testDef.epoch, testDef.slotNo, epoch)
+		}
+	}
+}
+
+func TestEpochToSlot(t *testing.T) {
+	if slotNo := Mainnet.EpochToSlot(1); slotNo != 21600 {
+		t.Fatalf("Expected slot 21600, got %d", slotNo)
+	}
+	if slotNo := Mainnet.EpochToSlot(209); slotNo != 4924800 {
+		t.Fatalf("Expected slot 4924800, got %d", slotNo)
+	}
+}
+
+func TestMatchTimes(t *testing.T) {
+	match := kupogo.Match{CreatedAt: kupogo.Point{SlotNo: 4492800}}
+	if created := Mainnet.CreatedTime(match); !created.Equal(Mainnet[1].StartTime) {
+		t.Fatalf("Unexpected created time: %s", created)
+	}
+	if spent := Mainnet.SpentTime(match); spent != nil {
+		t.Fatalf("Expected no spent time, got %s", spent)
+	}
+}
+
+func TestForNetwork(t *testing.T) {
+	for _, network := range []kupogo.Network{kupogo.NetworkMainnet, kupogo.NetworkPreprod, kupogo.NetworkPreview} {
+		eras := ForNetwork(network)
+		if eras.SlotConfig() != network.SlotConfig {
+			t.Errorf("Expected eras of %s to match its slot config", network.Name)
+		}
+	}
+	custom := kupogo.NetworkPreprod
+	custom.Name = "custom"
+	eras := ForNetwork(custom)
+	if len(eras) != 1 || eras.SlotToTime(86400) != custom.SlotConfig.ZeroTime {
+		t.Fatalf("Unexpected eras for custom network: %+v", eras)
+	}
+	if epoch, _ := eras.SlotToEpoch(48384000); epoch != 115 {
+		t.Fatalf("Expected epoch 115, got %d", epoch)
+	}
+}
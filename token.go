@@ -0,0 +1,52 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TokenFunc returns the access token to send to Kupo, such as one obtained
+// from an OIDC provider. It is called for every request, so it should cache
+// the token until it needs refreshing
+type TokenFunc func(ctx context.Context) (string, error)
+
+// WithBearerToken authenticates requests with the token returned by the given
+// function in the Authorization header, for Kupo instances exposed behind an
+// authenticating gateway
+func WithBearerToken(token TokenFunc) ClientOption {
+	return WithMiddleware(BearerTokenMiddleware(token))
+}
+
+// BearerTokenMiddleware sets the Authorization header of requests to the
+// token returned by the given function
+func BearerTokenMiddleware(token TokenFunc) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if IsCrossHostRedirect(req) {
+				return next.RoundTrip(req)
+			}
+			value, err := token(req.Context())
+			if err != nil {
+				return nil, fmt.Errorf("failed to get access token: %s", err)
+			}
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", "Bearer "+value)
+			return next.RoundTrip(req)
+		})
+	}
+}
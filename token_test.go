@@ -0,0 +1,33 @@
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithBearerToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithBearerToken(func(context.Context) (string, error) {
+		return "secret", nil
+	}))
+	if _, err := client.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	client = NewClient(server.URL, WithBearerToken(func(context.Context) (string, error) {
+		return "", errors.New("provider unavailable")
+	}))
+	if _, err := client.GetMatches("*"); err == nil {
+		t.Fatalf("Expected an error when no token can be obtained")
+	}
+}
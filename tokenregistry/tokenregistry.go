@@ -0,0 +1,376 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tokenregistry resolves display metadata for native assets from the
+// Cardano off-chain token registry, falling back to CIP-68 reference token
+// datums found through Kupo
+package tokenregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/internal/cbor"
+)
+
+// DefaultURL is the mainnet token registry
+const DefaultURL = "https://tokens.cardano.org"
+
+// Source identifies where token metadata was resolved from
+type Source string
+
+const (
+	SourceRegistry Source = "registry"
+	SourceCIP68    Source = "cip68"
+)
+
+// Token is the display metadata of an asset
+type Token struct {
+	AssetID     kupogo.AssetID `json:"asset_id"`
+	Name        string         `json:"name"`
+	Ticker      string         `json:"ticker,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Decimals    int            `json:"decimals"`
+	// Logo is a base64 encoded PNG for registry tokens, or a URI for CIP-68
+	// tokens
+	Logo   string `json:"logo,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Source Source `json:"source"`
+}
+
+// Config configures a Resolver
+type Config struct {
+	// URL of the token registry, defaulting to DefaultURL. Set it to "-" to
+	// disable registry lookups
+	URL        string
+	HTTPClient *http.Client
+	// Kupo enables the CIP-68 fallback for assets not in the registry
+	Kupo kupogo.KupoClient
+}
+
+// Resolver resolves and caches token metadata. Assets without metadata are
+// cached too
+type Resolver struct {
+	config Config
+	mu     sync.Mutex
+	tokens map[kupogo.AssetID]*Token
+}
+
+// New creates a resolver
+func New(config Config) *Resolver {
+	if config.URL == "" {
+		config.URL = DefaultURL
+	}
+	config.URL = strings.TrimSuffix(config.URL, "/")
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	return &Resolver{
+		config: config,
+		tokens: make(map[kupogo.AssetID]*Token),
+	}
+}
+
+// Resolve returns the metadata of an asset, or nil if none is known
+func (r *Resolver) Resolve(ctx context.Context, assetID kupogo.AssetID) (*Token, error) {
+	tokens, err := r.ResolveAll(ctx, []kupogo.AssetID{assetID})
+	if err != nil {
+		return nil, err
+	}
+	return tokens[assetID], nil
+}
+
+// ResolveAll returns the metadata of the assets that have any. Registry
+// lookups are batched into a single request
+func (r *Resolver) ResolveAll(
+	ctx context.Context,
+	assetIDs []kupogo.AssetID,
+) (map[kupogo.AssetID]*Token, error) {
+	ret := make(map[kupogo.AssetID]*Token)
+	var missing []kupogo.AssetID
+	r.mu.Lock()
+	for _, assetID := range assetIDs {
+		token, ok := r.tokens[assetID]
+		if !ok {
+			missing = append(missing, assetID)
+		} else if token != nil {
+			ret[assetID] = token
+		}
+	}
+	r.mu.Unlock()
+	if len(missing) == 0 {
+		return ret, nil
+	}
+	found := make(map[kupogo.AssetID]*Token)
+	if r.config.URL != "-" {
+		tokens, err := r.queryRegistry(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		found = tokens
+	}
+	if r.config.Kupo != nil {
+		for _, assetID := range missing {
+			if found[assetID] != nil {
+				continue
+			}
+			token, err := r.resolveCIP68(ctx, assetID)
+			if err != nil {
+				return nil, err
+			}
+			if token != nil {
+				found[assetID] = token
+			}
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, assetID := range missing {
+		token := found[assetID]
+		r.tokens[assetID] = token
+		if token != nil {
+			ret[assetID] = token
+		}
+	}
+	return ret, nil
+}
+
+type registryProperty struct {
+	Value json.RawMessage `json:"value"`
+}
+
+type registrySubject struct {
+	Subject     string            `json:"subject"`
+	Name        *registryProperty `json:"name"`
+	Ticker      *registryProperty `json:"ticker"`
+	Description *registryProperty `json:"description"`
+	Decimals    *registryProperty `json:"decimals"`
+	Logo        *registryProperty `json:"logo"`
+	URL         *registryProperty `json:"url"`
+}
+
+func (r *Resolver) queryRegistry(
+	ctx context.Context,
+	assetIDs []kupogo.AssetID,
+) (map[kupogo.AssetID]*Token, error) {
+	subjects := make(map[string]kupogo.AssetID, len(assetIDs))
+	query := struct {
+		Subjects   []string `json:"subjects"`
+		Properties []string `json:"properties"`
+	}{
+		Properties: []string{"name", "ticker", "description", "decimals", "logo", "url"},
+	}
+	for _, assetID := range assetIDs {
+		subject := assetID.PolicyID() + assetID.AssetName()
+		subjects[subject] = assetID
+		query.Subjects = append(query.Subjects, subject)
+	}
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		r.config.URL+"/metadata/query",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query token registry: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to query token registry: status code %d", resp.StatusCode)
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Subjects []registrySubject `json:"subjects"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode token registry response: %s", err)
+	}
+	ret := make(map[kupogo.AssetID]*Token)
+	for _, subject := range result.Subjects {
+		assetID, ok := subjects[subject.Subject]
+		if !ok {
+			continue
+		}
+		token := &Token{
+			AssetID:     assetID,
+			Name:        subject.Name.string(),
+			Ticker:      subject.Ticker.string(),
+			Description: subject.Description.string(),
+			Logo:        subject.Logo.string(),
+			URL:         subject.URL.string(),
+			Source:      SourceRegistry,
+		}
+		if subject.Decimals != nil {
+			if err := json.Unmarshal(subject.Decimals.Value, &token.Decimals); err != nil {
+				return nil, fmt.Errorf("invalid decimals for %s: %s", subject.Subject, err)
+			}
+		}
+		ret[assetID] = token
+	}
+	return ret, nil
+}
+
+func (p *registryProperty) string() string {
+	if p == nil {
+		return ""
+	}
+	var ret string
+	_ = json.Unmarshal(p.Value, &ret)
+	return ret
+}
+
+// resolveCIP68 reads the metadata of a CIP-68 user token from the datum of
+// its reference token
+func (r *Resolver) resolveCIP68(ctx context.Context, assetID kupogo.AssetID) (*Token, error) {
+	label, ok := assetID.CIP67Label()
+	if !ok || !label.IsUser() {
+		return nil, nil
+	}
+	reference, _ := assetID.WithCIP67Label(kupogo.CIP67LabelReferenceNFT)
+	matches, err := r.config.Kupo.GetMatchesWithOptionsContext(
+		ctx,
+		string(reference),
+		kupogo.MatchOptions{Unspent: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, match := range *matches {
+		if match.DatumHash == nil {
+			continue
+		}
+		datum, err := r.config.Kupo.GetDatumByHashContext(ctx, *match.DatumHash)
+		if err != nil {
+			return nil, err
+		}
+		if datum == nil {
+			continue
+		}
+		token, err := decodeCIP68Datum(datum.Datum)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIP-68 datum for %s: %s", reference, err)
+		}
+		token.AssetID = assetID
+		return token, nil
+	}
+	return nil, nil
+}
+
+// decodeCIP68Datum decodes a CIP-68 datum, Constr 0 [metadata, version, extra]
+func decodeCIP68Datum(datumHex string) (*Token, error) {
+	data, err := hex.DecodeString(datumHex)
+	if err != nil {
+		return nil, err
+	}
+	value, err := cbor.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	constr, ok := value.(cbor.Tag)
+	if !ok || constr.Number != 121 {
+		return nil, fmt.Errorf("expected constructor 0")
+	}
+	fields, ok := constr.Content.([]any)
+	if !ok || len(fields) < 2 {
+		return nil, fmt.Errorf("expected metadata and version fields")
+	}
+	metadata, ok := fields[0].(cbor.Map)
+	if !ok {
+		return nil, fmt.Errorf("expected metadata map")
+	}
+	token := &Token{Source: SourceCIP68}
+	for _, entry := range metadata {
+		key, ok := entry.Key.([]byte)
+		if !ok {
+			continue
+		}
+		switch string(key) {
+		case "name":
+			token.Name = cip68String(entry.Value)
+		case "ticker":
+			token.Ticker = cip68String(entry.Value)
+		case "description":
+			token.Description = cip68String(entry.Value)
+		case "url":
+			token.URL = cip68String(entry.Value)
+		case "logo", "image":
+			if token.Logo == "" || string(key) == "logo" {
+				token.Logo = cip68String(entry.Value)
+			}
+		case "decimals":
+			if decimals, ok := entry.Value.(uint64); ok {
+				token.Decimals = int(decimals)
+			}
+		}
+	}
+	return token, nil
+}
+
+func cip68String(value any) string {
+	switch v := value.(type) {
+	case []byte:
+		return string(v)
+	case string:
+		return v
+	default:
+		return ""
+	}
+}
+
+// Formatter resolves the assets of a value and returns a formatter which
+// displays them by ticker, or name, with their decimals
+func (r *Resolver) Formatter(ctx context.Context, value kupogo.Value) (kupogo.ValueFormatter, error) {
+	assetIDs := make([]kupogo.AssetID, 0, len(value.Assets))
+	for asset := range value.Assets {
+		assetIDs = append(assetIDs, kupogo.AssetID(asset))
+	}
+	tokens, err := r.ResolveAll(ctx, assetIDs)
+	if err != nil {
+		return kupogo.ValueFormatter{}, err
+	}
+	formatter := kupogo.ValueFormatter{
+		Assets: make(map[kupogo.AssetID]kupogo.AssetFormat, len(tokens)),
+	}
+	for assetID, token := range tokens {
+		ticker := token.Ticker
+		if ticker == "" {
+			ticker = token.Name
+		}
+		formatter.Assets[assetID] = kupogo.AssetFormat{
+			Ticker:   ticker,
+			Decimals: token.Decimals,
+		}
+	}
+	return formatter, nil
+}
@@ -0,0 +1,101 @@
+package tokenregistry
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/internal/cbor"
+	"github.com/blinklabs-io/kupogo/kupogotest"
+)
+
+const testPolicyID = "29d222ce763455e3d7a09a665ce554f00ac89d2e99a1a83d267170c6"
+
+func TestResolveRegistry(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != http.MethodPost || r.URL.Path != "/metadata/query" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		}
+		var query struct {
+			Subjects []string `json:"subjects"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+			t.Errorf("Expected no error, got %s", err)
+		}
+		if !reflect.DeepEqual(query.Subjects, []string{testPolicyID + "4d494e", testPolicyID + "00"}) {
+			t.Errorf("Unexpected subjects: %v", query.Subjects)
+		}
+		_, _ = w.Write([]byte(`{"subjects":[{"subject":"` + testPolicyID + `4d494e",` +
+			`"name":{"value":"Minswap"},"ticker":{"value":"MIN"},"decimals":{"value":6},"logo":{"value":"iVBOR"}}]}`))
+	}))
+	defer server.Close()
+	resolver := New(Config{URL: server.URL})
+	min := kupogo.NewAssetID(testPolicyID, "4d494e")
+	unknown := kupogo.NewAssetID(testPolicyID, "00")
+	for i := 0; i < 2; i++ {
+		tokens, err := resolver.ResolveAll(context.Background(), []kupogo.AssetID{min, unknown})
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		expected := &Token{AssetID: min, Name: "Minswap", Ticker: "MIN", Decimals: 6, Logo: "iVBOR", Source: SourceRegistry}
+		if len(tokens) != 1 || !reflect.DeepEqual(tokens[min], expected) {
+			t.Fatalf("Expected %+v, got %+v", expected, tokens)
+		}
+	}
+	formatter, err := resolver.Formatter(
+		context.Background(),
+		kupogo.Value{Coins: 1000000, Assets: kupogo.Assets{string(min): 1500000}},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if formatted := formatter.Format(kupogo.Value{Coins: 1000000, Assets: kupogo.Assets{string(min): 1500000}}); formatted != "1 ADA + 1.5 MIN" {
+		t.Fatalf("Unexpected formatted value: %s", formatted)
+	}
+	if requests != 1 {
+		t.Fatalf("Expected results to be cached, got %d requests", requests)
+	}
+}
+
+func TestResolveCIP68(t *testing.T) {
+	var datum []byte
+	datum = cbor.AppendTag(datum, 121)
+	datum = cbor.AppendArrayHeader(datum, 3)
+	datum = cbor.AppendMapHeader(datum, 3)
+	datum = cbor.AppendBytes(datum, []byte("name"))
+	datum = cbor.AppendBytes(datum, []byte("Token"))
+	datum = cbor.AppendBytes(datum, []byte("ticker"))
+	datum = cbor.AppendBytes(datum, []byte("TKN"))
+	datum = cbor.AppendBytes(datum, []byte("decimals"))
+	datum = cbor.AppendUint(datum, 4)
+	datum = cbor.AppendUint(datum, 1)
+	datum = cbor.AppendTag(datum, 121)
+	datum = cbor.AppendArrayHeader(datum, 0)
+	fake := kupogotest.NewFake()
+	datumHash := "dd"
+	user := kupogo.NewAssetID(testPolicyID, kupogo.CIP67LabelFT.AssetName("544b4e"))
+	reference, _ := user.WithCIP67Label(kupogo.CIP67LabelReferenceNFT)
+	fake.AddMatches(kupogo.Match{
+		TransactionID: "aa",
+		Address:       "addr1",
+		Value:         kupogo.Value{Coins: 2000000, Assets: kupogo.Assets{string(reference): 1}},
+		DatumHash:     &datumHash,
+	})
+	fake.AddDatum(datumHash, hex.EncodeToString(datum))
+	resolver := New(Config{URL: "-", Kupo: fake})
+	token, err := resolver.Resolve(context.Background(), user)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := &Token{AssetID: user, Name: "Token", Ticker: "TKN", Decimals: 4, Source: SourceCIP68}
+	if !reflect.DeepEqual(token, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, token)
+	}
+}
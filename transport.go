@@ -0,0 +1,201 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// HTTP2Mode selects how the client uses HTTP/2
+type HTTP2Mode int
+
+const (
+	// HTTP2Auto negotiates HTTP/2 with Kupo over TLS and uses HTTP/1.1
+	// otherwise
+	HTTP2Auto HTTP2Mode = iota
+	// HTTP2Disabled only uses HTTP/1.1
+	HTTP2Disabled
+	// HTTP2Cleartext speaks HTTP/2 without TLS using prior knowledge (h2c),
+	// multiplexing every request over a single connection. The server, or
+	// the proxy in front of Kupo, must support it
+	HTTP2Cleartext
+)
+
+// TransportConfig tunes the connections made to Kupo. Zero values keep the
+// defaults of http.DefaultTransport
+type TransportConfig struct {
+	// MaxIdleConns limits idle connections kept across all hosts
+	MaxIdleConns int
+	// MaxIdleConnsPerHost limits idle connections kept to Kupo. net/http
+	// defaults to 2, so clients making more concurrent requests keep opening
+	// new connections unless it is raised to their concurrency
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost limits connections to Kupo, including active ones
+	MaxConnsPerHost int
+	// IdleConnTimeout closes connections idle for longer
+	IdleConnTimeout time.Duration
+	// HTTP2 selects how HTTP/2 is used. The connection pool settings do not
+	// apply to HTTP2Cleartext
+	HTTP2 HTTP2Mode
+	// DialContext, if not nil, opens the connections to Kupo instead of a
+	// net.Dialer
+	DialContext DialContextFunc
+	// KeepAlive is the interval of TCP keep-alive probes on connections to
+	// Kupo, defaulting to 30 seconds. Negative disables them. It does not
+	// apply with DialContext
+	KeepAlive time.Duration
+	// Pins, if not nil, restricts the certificates Kupo may present over
+	// TLS. They do not apply to HTTP2Cleartext, which does not use TLS
+	Pins *CertificatePins
+}
+
+// defaultDialTimeout and defaultKeepAlive match http.DefaultTransport
+const (
+	defaultDialTimeout = 30 * time.Second
+	defaultKeepAlive   = 30 * time.Second
+	minIdleConnTimeout = 90 * time.Second
+)
+
+// PollingTransportConfig returns a transport tuning for clients running up to
+// concurrency pollers, such as Watchers, every interval. Enough idle
+// connections are kept for every poller, and for longer than the interval,
+// so polls reuse connections instead of reconnecting each time
+func PollingTransportConfig(concurrency int, interval time.Duration) TransportConfig {
+	idleTimeout := 3 * interval
+	if idleTimeout < minIdleConnTimeout {
+		idleTimeout = minIdleConnTimeout
+	}
+	return TransportConfig{
+		MaxIdleConns:        concurrency,
+		MaxIdleConnsPerHost: concurrency,
+		IdleConnTimeout:     idleTimeout,
+	}
+}
+
+// DialContextFunc opens a connection, like net.Dialer.DialContext
+type DialContextFunc func(ctx context.Context, network string, addr string) (net.Conn, error)
+
+// NewTransport creates a transport with the given tuning
+func NewTransport(config TransportConfig) http.RoundTripper {
+	if config.HTTP2 == HTTP2Cleartext {
+		return &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(
+				ctx context.Context,
+				network string,
+				addr string,
+				_ *tls.Config,
+			) (net.Conn, error) {
+				if config.DialContext != nil {
+					return config.DialContext(ctx, network, addr)
+				}
+				return config.dialer().DialContext(ctx, network, addr)
+			},
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.MaxIdleConns > 0 {
+		transport.MaxIdleConns = config.MaxIdleConns
+	}
+	if config.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	}
+	if config.MaxConnsPerHost > 0 {
+		transport.MaxConnsPerHost = config.MaxConnsPerHost
+	}
+	if config.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = config.IdleConnTimeout
+	}
+	if config.DialContext != nil {
+		transport.DialContext = config.DialContext
+	} else if config.KeepAlive != 0 {
+		transport.DialContext = config.dialer().DialContext
+	}
+	if config.Pins != nil {
+		transport.TLSClientConfig = config.Pins.tlsConfig()
+	}
+	switch config.HTTP2 {
+	case HTTP2Auto:
+		transport.ForceAttemptHTTP2 = true
+	case HTTP2Disabled:
+		transport.ForceAttemptHTTP2 = false
+		// A non-nil empty map disables the automatic HTTP/2 upgrade
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	return transport
+}
+
+func (config TransportConfig) dialer() *net.Dialer {
+	keepAlive := config.KeepAlive
+	if keepAlive == 0 {
+		keepAlive = defaultKeepAlive
+	}
+	return &net.Dialer{
+		Timeout:   defaultDialTimeout,
+		KeepAlive: keepAlive,
+	}
+}
+
+// WithTransport uses a transport created with NewTransport for requests. It
+// replaces the transport of a client given with WithHTTPClient, keeping its
+// other settings. A dial function or pins set by earlier options are kept
+// unless config sets its own
+func WithTransport(config TransportConfig) ClientOption {
+	return func(c *Client) {
+		if config.DialContext == nil && c.transport != nil {
+			config.DialContext = c.transport.DialContext
+		}
+		if config.Pins == nil && c.transport != nil {
+			config.Pins = c.transport.Pins
+		}
+		c.transport = &config
+	}
+}
+
+// WithDialContext opens the connections to Kupo with the given function, for
+// example to dial through a VPN, a tailnet or a SOCKS proxy. Like
+// WithTransport, it replaces the transport of a client given with
+// WithHTTPClient
+func WithDialContext(dial DialContextFunc) ClientOption {
+	return func(c *Client) {
+		var config TransportConfig
+		if c.transport != nil {
+			config = *c.transport
+		}
+		config.DialContext = dial
+		c.transport = &config
+	}
+}
+
+// applyTransport replaces the HTTP client with a copy using the configured
+// transport
+func (c *Client) applyTransport() {
+	if c.transport == nil {
+		return
+	}
+	base := c.httpClient
+	if base == nil {
+		base = defaultHTTPClient
+	}
+	httpClient := *base
+	httpClient.Transport = NewTransport(*c.transport)
+	c.httpClient = &httpClient
+}
@@ -0,0 +1,153 @@
+package kupogo
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func TestNewTransport(t *testing.T) {
+	transport, ok := NewTransport(TransportConfig{
+		MaxIdleConnsPerHost: 64,
+		MaxConnsPerHost:     128,
+		IdleConnTimeout:     time.Minute,
+		HTTP2:               HTTP2Disabled,
+	}).(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected an http.Transport")
+	}
+	if transport.MaxIdleConnsPerHost != 64 || transport.MaxConnsPerHost != 128 ||
+		transport.IdleConnTimeout != time.Minute {
+		t.Fatalf("Unexpected transport settings %+v", transport)
+	}
+	if transport.ForceAttemptHTTP2 || transport.TLSNextProto == nil {
+		t.Fatalf("Expected HTTP/2 to be disabled")
+	}
+	if transport == http.DefaultTransport {
+		t.Fatalf("Expected the default transport not to be modified")
+	}
+}
+
+func TestWithTransportKeepsHTTPClient(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+	client := NewClient(
+		"http://localhost",
+		WithHTTPClient(httpClient),
+		WithTransport(TransportConfig{MaxIdleConnsPerHost: 10}),
+	)
+	if client.httpClient == httpClient || client.httpClient.Timeout != time.Second {
+		t.Fatalf("Expected a copy of the HTTP client with its timeout")
+	}
+	if httpClient.Transport != nil {
+		t.Fatalf("Expected the given HTTP client not to be modified")
+	}
+}
+
+func TestTransportCleartextHTTP2(t *testing.T) {
+	server := httptest.NewServer(
+		h2c.NewHandler(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.ProtoMajor != 2 {
+					w.WriteHeader(http.StatusHTTPVersionNotSupported)
+					return
+				}
+				_, _ = w.Write([]byte(`[{"slot_no":1,"header_hash":"aa"}]`))
+			}),
+			&http2.Server{},
+		),
+	)
+	defer server.Close()
+	client := NewClient(server.URL, WithTransport(TransportConfig{HTTP2: HTTP2Cleartext}))
+	checkpoints, err := client.GetCheckpoints()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(*checkpoints) != 1 {
+		t.Fatalf("Expected 1 checkpoint, got %d", len(*checkpoints))
+	}
+}
+
+func TestWithDialContext(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+
+	var dialed []string
+	client := NewClient(
+		"http://kupo.internal",
+		WithDialContext(func(ctx context.Context, network string, addr string) (net.Conn, error) {
+			dialed = append(dialed, addr)
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, server.Listener.Addr().String())
+		}),
+		WithTransport(TransportConfig{MaxIdleConnsPerHost: 4}),
+	)
+	if _, err := client.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(dialed) != 1 || dialed[0] != "kupo.internal:80" {
+		t.Fatalf("Expected the dial hook to be used, got %v", dialed)
+	}
+}
+
+func TestPollingTransportConfig(t *testing.T) {
+	config := PollingTransportConfig(50, time.Minute)
+	if config.MaxIdleConnsPerHost != 50 || config.MaxIdleConns != 50 {
+		t.Fatalf("Expected an idle connection per poller, got %+v", config)
+	}
+	if config.IdleConnTimeout != 3*time.Minute {
+		t.Fatalf("Expected idle connections to outlive the interval, got %s", config.IdleConnTimeout)
+	}
+	if PollingTransportConfig(1, time.Second).IdleConnTimeout != 90*time.Second {
+		t.Fatalf("Expected the idle timeout not to go below the default")
+	}
+	config.KeepAlive = -1
+	if _, ok := NewTransport(config).(*http.Transport); !ok {
+		t.Fatalf("Expected an http.Transport")
+	}
+}
+
+func TestWithCertificatePins(t *testing.T) {
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[]`))
+		}),
+	)
+	defer server.Close()
+
+	cert := server.Certificate()
+	testDefs := []struct {
+		name  string
+		pins  CertificatePins
+		valid bool
+	}{
+		{"spki", CertificatePins{SPKI: []string{SPKIPin(cert)}, SkipCAVerification: true}, true},
+		{"curl spki", CertificatePins{SPKI: []string{"sha256//" + SPKIPin(cert)}, SkipCAVerification: true}, true},
+		{"fingerprint", CertificatePins{Fingerprints: []string{CertificateFingerprint(cert)}, SkipCAVerification: true}, true},
+		{"mismatch", CertificatePins{SPKI: []string{"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="}, SkipCAVerification: true}, false},
+		{"untrusted ca", CertificatePins{SPKI: []string{SPKIPin(cert)}}, false},
+	}
+	for _, testDef := range testDefs {
+		client := NewClient(
+			server.URL,
+			WithCertificatePins(testDef.pins),
+			WithTransport(TransportConfig{MaxIdleConnsPerHost: 4}),
+		)
+		_, err := client.GetMatches("*")
+		if testDef.valid && err != nil {
+			t.Fatalf("%s: Expected no error, got %s", testDef.name, err)
+		}
+		if !testDef.valid && err == nil {
+			t.Fatalf("%s: Expected an error", testDef.name)
+		}
+	}
+}
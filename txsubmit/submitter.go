@@ -0,0 +1,160 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txsubmit
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrRejected is returned when the submission endpoint rejects a transaction
+var ErrRejected = errors.New("transaction rejected")
+
+// Submitter submits a signed transaction to the network and returns its ID
+type Submitter interface {
+	Submit(ctx context.Context, tx []byte) (string, error)
+}
+
+// SubmitterFunc adapts a function to the Submitter interface
+type SubmitterFunc func(ctx context.Context, tx []byte) (string, error)
+
+func (f SubmitterFunc) Submit(ctx context.Context, tx []byte) (string, error) {
+	return f(ctx, tx)
+}
+
+// SubmitAPI submits transactions through cardano-submit-api
+type SubmitAPI struct {
+	// URL of the API, such as http://localhost:8090
+	URL string
+	// HTTPClient defaults to http.DefaultClient
+	HTTPClient *http.Client
+}
+
+func (s *SubmitAPI) Submit(ctx context.Context, tx []byte) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		strings.TrimSuffix(s.URL, "/")+"/api/submit/tx",
+		bytes.NewReader(tx),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/cbor")
+	resp, err := httpClient(s.HTTPClient).Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to submit transaction: %s", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return "", fmt.Errorf("%w: status code %d: %s", ErrRejected, resp.StatusCode, bytes.TrimSpace(body))
+	}
+	var txID string
+	if err := json.Unmarshal(body, &txID); err != nil {
+		return "", fmt.Errorf("failed to unmarshal transaction ID: %s", err)
+	}
+	return txID, nil
+}
+
+// Ogmios submits transactions through the JSON-RPC interface of Ogmios v6
+// over HTTP
+type Ogmios struct {
+	// URL of Ogmios, such as http://localhost:1337
+	URL string
+	// HTTPClient defaults to http.DefaultClient
+	HTTPClient *http.Client
+}
+
+type ogmiosRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	Params  struct {
+		Transaction struct {
+			CBOR string `json:"cbor"`
+		} `json:"transaction"`
+	} `json:"params"`
+}
+
+type ogmiosResponse struct {
+	Result *struct {
+		Transaction struct {
+			ID string `json:"id"`
+		} `json:"transaction"`
+	} `json:"result"`
+	Error *struct {
+		Code    int             `json:"code"`
+		Message string          `json:"message"`
+		Data    json.RawMessage `json:"data"`
+	} `json:"error"`
+}
+
+func (o *Ogmios) Submit(ctx context.Context, tx []byte) (string, error) {
+	reqBody := ogmiosRequest{JSONRPC: "2.0", Method: "submitTransaction"}
+	reqBody.Params.Transaction.CBOR = hex.EncodeToString(tx)
+	reqBodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %s", err)
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		o.URL,
+		bytes.NewReader(reqBodyBytes),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := httpClient(o.HTTPClient).Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to submit transaction: %s", err)
+	}
+	defer resp.Body.Close()
+	var respBody ogmiosResponse
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return "", fmt.Errorf("failed to unmarshal response: %s", err)
+	}
+	if respBody.Error != nil {
+		return "", fmt.Errorf(
+			"%w: %s (code %d) %s",
+			ErrRejected,
+			respBody.Error.Message,
+			respBody.Error.Code,
+			respBody.Error.Data,
+		)
+	}
+	if respBody.Result == nil {
+		return "", fmt.Errorf("failed to submit transaction: status code %d", resp.StatusCode)
+	}
+	return respBody.Result.Transaction.ID, nil
+}
+
+func httpClient(client *http.Client) *http.Client {
+	if client == nil {
+		return http.DefaultClient
+	}
+	return client
+}
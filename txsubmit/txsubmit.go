@@ -0,0 +1,204 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package txsubmit closes the loop from a Kupo query to on-chain settlement:
+// it hands the result of coin selection to a pluggable transaction builder,
+// submits the transaction through Ogmios or cardano-submit-api and tracks its
+// inputs and outputs in Kupo until it is included
+package txsubmit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/coinselect"
+)
+
+const defaultInterval = 10 * time.Second
+
+// ErrConflict is returned when the inputs of a transaction are spent but
+// none of its outputs appear, which happens when another transaction spent
+// them first. Outputs only appear if Kupo indexes them, so set
+// Config.RequireOutputs only when a pattern covers the change address
+var ErrConflict = errors.New("inputs spent by another transaction")
+
+// Output is a payment made by a transaction
+type Output struct {
+	Address string
+	Value   kupogo.Value
+}
+
+// Draft is the transaction to build: the inputs picked by coin selection,
+// the payments and the change going back to ChangeAddress
+type Draft struct {
+	Inputs        kupogo.Matches
+	Outputs       []Output
+	Change        kupogo.Value
+	ChangeAddress string
+}
+
+// Builder assembles and signs a transaction from a draft, returning its CBOR
+// encoding. Builders are expected to deduct the fee from the change
+type Builder interface {
+	Build(ctx context.Context, draft Draft) ([]byte, error)
+}
+
+// BuilderFunc adapts a function to the Builder interface
+type BuilderFunc func(ctx context.Context, draft Draft) ([]byte, error)
+
+func (f BuilderFunc) Build(ctx context.Context, draft Draft) ([]byte, error) {
+	return f(ctx, draft)
+}
+
+// Config configures a Handoff
+type Config struct {
+	Builder   Builder
+	Submitter Submitter
+	// Interval between polls while awaiting inclusion, defaulting to 10
+	// seconds
+	Interval time.Duration
+	// RequireOutputs only reports a transaction as included once one of its
+	// outputs is indexed by Kupo, and reports ErrConflict if its inputs are
+	// spent without any. Otherwise spending every input is enough, which
+	// requires Kupo to keep spent outputs rather than pruning them
+	RequireOutputs bool
+}
+
+// Handoff builds, submits and tracks transactions
+type Handoff struct {
+	client *kupogo.Client
+	config Config
+}
+
+// New returns a handoff using the client to track submitted transactions
+func New(client *kupogo.Client, config Config) (*Handoff, error) {
+	if config.Builder == nil || config.Submitter == nil {
+		return nil, errors.New("handoff needs a builder and a submitter")
+	}
+	if config.Interval <= 0 {
+		config.Interval = defaultInterval
+	}
+	return &Handoff{client: client, config: config}, nil
+}
+
+// Submit builds a transaction spending the selected inputs, submits it and
+// returns a handle to await its inclusion
+func (h *Handoff) Submit(
+	ctx context.Context,
+	selection *coinselect.Result,
+	outputs []Output,
+	changeAddress string,
+) (*Pending, error) {
+	if selection == nil || len(selection.Inputs) == 0 {
+		return nil, errors.New("selection has no inputs")
+	}
+	tx, err := h.config.Builder.Build(ctx, Draft{
+		Inputs:        selection.Inputs,
+		Outputs:       outputs,
+		Change:        selection.Change,
+		ChangeAddress: changeAddress,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction: %w", err)
+	}
+	txID, err := h.config.Submitter.Submit(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return &Pending{
+		TxID:    txID,
+		Inputs:  selection.Inputs,
+		handoff: h,
+		watcher: kupogo.NewWatcher(h.client, kupogo.WatcherConfig{
+			Pattern: string(kupogo.MatchTransaction(txID)),
+		}),
+	}, nil
+}
+
+// Inclusion describes a transaction found on-chain
+type Inclusion struct {
+	TxID string
+	// Point is the block including the transaction
+	Point kupogo.Point
+	// Created are the outputs of the transaction indexed by Kupo
+	Created kupogo.Matches
+}
+
+// Pending tracks a submitted transaction
+type Pending struct {
+	TxID    string
+	Inputs  kupogo.Matches
+	handoff *Handoff
+	watcher *kupogo.Watcher
+	created kupogo.Matches
+}
+
+// Poll checks once whether the transaction is included, returning nil if it
+// is still pending
+func (p *Pending) Poll(ctx context.Context) (*Inclusion, error) {
+	events, err := p.watcher.Poll()
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		if event.Type == kupogo.WatchEventCreated {
+			p.created = append(p.created, event.Match)
+		}
+	}
+	if len(p.created) > 0 {
+		return &Inclusion{
+			TxID:    p.TxID,
+			Point:   p.created[0].CreatedAt,
+			Created: p.created,
+		}, nil
+	}
+	var point kupogo.Point
+	for _, input := range p.Inputs {
+		matches, err := p.handoff.client.GetMatchesContext(
+			ctx,
+			string(kupogo.MatchOutputRef(input.TransactionID, input.OutputIndex)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(*matches) == 0 || (*matches)[0].SpentAt == nil {
+			return nil, nil
+		}
+		point = *(*matches)[0].SpentAt
+	}
+	if p.handoff.config.RequireOutputs {
+		return nil, fmt.Errorf("%w: %s", ErrConflict, p.TxID)
+	}
+	return &Inclusion{TxID: p.TxID, Point: point}, nil
+}
+
+// Await polls until the transaction is included or the context is done
+func (p *Pending) Await(ctx context.Context) (*Inclusion, error) {
+	ticker := time.NewTicker(p.handoff.config.Interval)
+	defer ticker.Stop()
+	for {
+		inclusion, err := p.Poll(ctx)
+		if err != nil || inclusion != nil {
+			return inclusion, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
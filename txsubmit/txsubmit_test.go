@@ -0,0 +1,146 @@
+package txsubmit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/coinselect"
+)
+
+func TestSubmitAPI(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if r.URL.Path != "/api/submit/tx" || r.Header.Get("Content-Type") != "application/cbor" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if string(body) == "bad" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`"invalid"`))
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`"abcd"`))
+		}),
+	)
+	defer server.Close()
+	submitter := &SubmitAPI{URL: server.URL}
+	txID, err := submitter.Submit(context.Background(), []byte("tx"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if txID != "abcd" {
+		t.Fatalf("Expected transaction ID abcd, got %s", txID)
+	}
+	if _, err := submitter.Submit(context.Background(), []byte("bad")); !errors.Is(err, ErrRejected) {
+		t.Fatalf("Expected a rejection, got %v", err)
+	}
+}
+
+func TestOgmios(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req ogmiosRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req.Method != "submitTransaction" || req.Params.Transaction.CBOR == "00" {
+				_, _ = w.Write([]byte(`{"jsonrpc":"2.0","error":{"code":3005,"message":"era mismatch"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","result":{"transaction":{"id":"abcd"}}}`))
+		}),
+	)
+	defer server.Close()
+	submitter := &Ogmios{URL: server.URL}
+	txID, err := submitter.Submit(context.Background(), []byte{0x84})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if txID != "abcd" {
+		t.Fatalf("Expected transaction ID abcd, got %s", txID)
+	}
+	if _, err := submitter.Submit(context.Background(), []byte{0}); !errors.Is(err, ErrRejected) {
+		t.Fatalf("Expected a rejection, got %v", err)
+	}
+}
+
+func TestHandoff(t *testing.T) {
+	var mu sync.Mutex
+	included := false
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			var resp kupogo.Matches
+			switch r.URL.Path {
+			case "/matches/0@aa":
+				resp = kupogo.Matches{{TransactionID: "aa", Value: kupogo.Value{Coins: 5000000}}}
+				if included {
+					resp[0].SpentAt = &kupogo.Point{SlotNo: 42}
+				}
+			case "/matches/*@bb":
+				if included {
+					resp = kupogo.Matches{{TransactionID: "bb", CreatedAt: kupogo.Point{SlotNo: 42}}}
+				}
+			}
+			respBody, _ := json.Marshal(resp)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	var draft Draft
+	handoff, err := New(kupogo.NewClient(server.URL), Config{
+		Builder: BuilderFunc(func(ctx context.Context, d Draft) ([]byte, error) {
+			draft = d
+			return []byte("tx"), nil
+		}),
+		Submitter: SubmitterFunc(func(ctx context.Context, tx []byte) (string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			included = true
+			return "bb", nil
+		}),
+		Interval:       time.Millisecond,
+		RequireOutputs: true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	selection, err := coinselect.LargestFirst(
+		kupogo.Matches{{TransactionID: "aa", Value: kupogo.Value{Coins: 5000000}}},
+		kupogo.Value{Coins: 2000000},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	pending, err := handoff.Submit(
+		context.Background(),
+		selection,
+		[]Output{{Address: "addr_test1", Value: kupogo.Value{Coins: 2000000}}},
+		"addr_test2",
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(draft.Inputs) != 1 || draft.Change.Coins != 3000000 || draft.ChangeAddress != "addr_test2" {
+		t.Fatalf("Unexpected draft %+v", draft)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	inclusion, err := pending.Await(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if inclusion.TxID != "bb" || inclusion.Point.SlotNo != 42 || len(inclusion.Created) != 1 {
+		t.Fatalf("Unexpected inclusion %+v", inclusion)
+	}
+}
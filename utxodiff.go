@@ -0,0 +1,68 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+// UTxODiff holds the outputs created and spent within a slot interval
+type UTxODiff struct {
+	FromSlot int
+	ToSlot   int
+	Created  Matches
+	Spent    Matches
+}
+
+// DiffUTxO returns the outputs matching a pattern which were created or spent
+// after slotA and up to and including slotB
+func (c *Client) DiffUTxO(pattern string, slotA int, slotB int) (*UTxODiff, error) {
+	created, err := c.GetMatchesWithOptions(
+		pattern,
+		MatchOptions{
+			CreatedAfter:  slotA,
+			CreatedBefore: slotB + 1,
+			Order:         MatchOrderOldestFirst,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	spent, err := c.GetMatchesWithOptions(
+		pattern,
+		MatchOptions{
+			SpentAfter:  slotA,
+			SpentBefore: slotB + 1,
+			Order:       MatchOrderOldestFirst,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &UTxODiff{
+		FromSlot: slotA,
+		ToSlot:   slotB,
+		Created:  *created,
+		Spent:    *spent,
+	}, nil
+}
+
+// Net returns the change in value over the interval
+func (d *UTxODiff) Net() Value {
+	var ret Value
+	for _, match := range d.Created {
+		ret = ret.Add(match.Value)
+	}
+	for _, match := range d.Spent {
+		ret = ret.Sub(match.Value)
+	}
+	return ret
+}
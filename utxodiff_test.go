@@ -0,0 +1,55 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_DiffUTxO(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var matches Matches
+			switch r.URL.RawQuery {
+			case "created_after=100&created_before=201&order=oldest_first":
+				matches = Matches{
+					{TransactionID: "aa", Value: Value{Coins: 10}, CreatedAt: Point{SlotNo: 150}},
+				}
+			case "order=oldest_first&spent_after=100&spent_before=201":
+				matches = Matches{
+					{
+						TransactionID: "bb",
+						Value:         Value{Coins: 4},
+						CreatedAt:     Point{SlotNo: 50},
+						SpentAt:       &Point{SlotNo: 180},
+					},
+				}
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			respBody, _ := json.Marshal(matches)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	client := &Client{KupoUrl: server.URL}
+	diff, err := client.DiffUTxO("*", 100, 200)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(diff.Created) != 1 || diff.Created[0].TransactionID != "aa" {
+		t.Errorf("Unexpected created outputs: %v", diff.Created)
+	}
+	if len(diff.Spent) != 1 || diff.Spent[0].TransactionID != "bb" {
+		t.Errorf("Unexpected spent outputs: %v", diff.Spent)
+	}
+	expectedNet := Value{Coins: 6}
+	if net := diff.Net(); !reflect.DeepEqual(net, expectedNet) {
+		t.Errorf("Expected net %v, got %v", expectedNet, net)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package utxolock tracks UTxOs reserved by in-progress transactions so that
+// concurrent transaction builders do not select the same outputs
+package utxolock
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+var ErrReserved = errors.New("output already reserved")
+
+// Reservation marks an output as in use by an owner until it expires
+type Reservation struct {
+	OutputReference kupogo.OutputReference
+	Owner           string
+	ExpiresAt       time.Time
+}
+
+// Store persists reservations. Implementations must apply Reserve atomically:
+// either all outputs are reserved or none are
+type Store interface {
+	// Reserve records the reservations, failing with ErrReserved if any of
+	// the outputs holds a reservation that has not expired as of now
+	Reserve(reservations []Reservation, now time.Time) error
+	// ReleaseOwner removes all reservations held by the owner
+	ReleaseOwner(owner string) error
+	// Get returns the reservation for an output, if any
+	Get(ref kupogo.OutputReference) (*Reservation, error)
+	// Expire removes all reservations which expired as of now
+	Expire(now time.Time) error
+}
+
+// Manager hands out UTxO reservations with a timeout
+type Manager struct {
+	store Store
+	ttl   time.Duration
+	now   func() time.Time
+}
+
+// NewManager creates a manager backed by the given store, or an in-memory
+// store if nil. Reservations which are not released expire after ttl
+func NewManager(store Store, ttl time.Duration) *Manager {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &Manager{
+		store: store,
+		ttl:   ttl,
+		now:   time.Now,
+	}
+}
+
+// Reserve reserves the UTxOs for the owner, typically an identifier of the
+// transaction being built
+func (m *Manager) Reserve(owner string, utxos kupogo.Matches) error {
+	now := m.now()
+	reservations := make([]Reservation, 0, len(utxos))
+	for _, utxo := range utxos {
+		reservations = append(
+			reservations,
+			Reservation{
+				OutputReference: utxo.OutputReference(),
+				Owner:           owner,
+				ExpiresAt:       now.Add(m.ttl),
+			},
+		)
+	}
+	return m.store.Reserve(reservations, now)
+}
+
+// Release drops the owner's reservations, e.g. when a transaction is abandoned
+func (m *Manager) Release(owner string) error {
+	return m.store.ReleaseOwner(owner)
+}
+
+// Confirm drops the owner's reservations once its transaction is confirmed
+// and the outputs are spent on-chain
+func (m *Manager) Confirm(owner string) error {
+	return m.store.ReleaseOwner(owner)
+}
+
+// IsReserved returns true if the output holds an unexpired reservation
+func (m *Manager) IsReserved(ref kupogo.OutputReference) (bool, error) {
+	reservation, err := m.store.Get(ref)
+	if err != nil {
+		return false, err
+	}
+	return reservation != nil && reservation.ExpiresAt.After(m.now()), nil
+}
+
+// Available filters out the UTxOs which are currently reserved
+func (m *Manager) Available(utxos kupogo.Matches) (kupogo.Matches, error) {
+	ret := make(kupogo.Matches, 0, len(utxos))
+	for _, utxo := range utxos {
+		reserved, err := m.IsReserved(utxo.OutputReference())
+		if err != nil {
+			return nil, err
+		}
+		if !reserved {
+			ret = append(ret, utxo)
+		}
+	}
+	return ret, nil
+}
+
+// Expire removes expired reservations from the store
+func (m *Manager) Expire() error {
+	return m.store.Expire(m.now())
+}
+
+// MemoryStore is a Store keeping reservations in memory
+type MemoryStore struct {
+	mu           sync.Mutex
+	reservations map[kupogo.OutputReference]Reservation
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		reservations: make(map[kupogo.OutputReference]Reservation),
+	}
+}
+
+func (s *MemoryStore) Reserve(reservations []Reservation, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, reservation := range reservations {
+		existing, ok := s.reservations[reservation.OutputReference]
+		if ok && existing.ExpiresAt.After(now) && existing.Owner != reservation.Owner {
+			return ErrReserved
+		}
+	}
+	for _, reservation := range reservations {
+		s.reservations[reservation.OutputReference] = reservation
+	}
+	return nil
+}
+
+func (s *MemoryStore) ReleaseOwner(owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ref, reservation := range s.reservations {
+		if reservation.Owner == owner {
+			delete(s.reservations, ref)
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) Get(ref kupogo.OutputReference) (*Reservation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	reservation, ok := s.reservations[ref]
+	if !ok {
+		return nil, nil
+	}
+	return &reservation, nil
+}
+
+func (s *MemoryStore) Expire(now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ref, reservation := range s.reservations {
+		if !reservation.ExpiresAt.After(now) {
+			delete(s.reservations, ref)
+		}
+	}
+	return nil
+}
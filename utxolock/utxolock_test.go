@@ -0,0 +1,49 @@
+package utxolock
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestManager(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	manager := NewManager(nil, time.Minute)
+	manager.now = func() time.Time { return now }
+
+	utxos := kupogo.Matches{
+		{TransactionID: "aa", OutputIndex: 0},
+		{TransactionID: "aa", OutputIndex: 1},
+		{TransactionID: "bb", OutputIndex: 0},
+	}
+	if err := manager.Reserve("tx1", utxos[:2]); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := manager.Reserve("tx2", utxos[1:]); !errors.Is(err, ErrReserved) {
+		t.Fatalf("Expected reservation conflict, got %v", err)
+	}
+	// A failed reservation must not leave partial reservations behind
+	available, _ := manager.Available(utxos)
+	if len(available) != 1 || available[0].TransactionID != "bb" {
+		t.Errorf("Expected only bb to be available, got %v", available)
+	}
+
+	if err := manager.Release("tx1"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := manager.Reserve("tx2", utxos[1:]); err != nil {
+		t.Fatalf("Expected no error after release, got %s", err)
+	}
+
+	// Reservations lapse after the TTL
+	now = now.Add(2 * time.Minute)
+	available, _ = manager.Available(utxos)
+	if len(available) != 3 {
+		t.Errorf("Expected all outputs to be available after expiry, got %v", available)
+	}
+	if err := manager.Reserve("tx3", utxos); err != nil {
+		t.Fatalf("Expected no error after expiry, got %s", err)
+	}
+}
@@ -0,0 +1,356 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package utxorpc implements the query service of the UTxO RPC standard
+// (https://utxorpc.org) on top of kupogo.
+//
+// The types mirror the messages of utxorpc's query and cardano protobuf
+// packages. This package does not depend on the generated protobuf code, so a
+// gRPC server built with github.com/utxorpc/go-codegen registers thin
+// wrappers which convert between those messages and these types
+package utxorpc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/address"
+)
+
+// ErrUnsupportedPredicate is returned for predicates which Kupo patterns
+// cannot express
+var ErrUnsupportedPredicate = errors.New("unsupported predicate")
+
+// TxoRef references a transaction output
+type TxoRef struct {
+	Hash  []byte
+	Index uint32
+}
+
+// ChainPoint is the chain point a query was answered at
+type ChainPoint struct {
+	Slot uint64
+	Hash []byte
+}
+
+// Asset is a native asset quantity
+type Asset struct {
+	Name         []byte
+	OutputCoin   uint64
+	MintCoin     int64
+	Fingerprint  string
+	NameAsString string
+}
+
+// Multiasset groups assets by policy
+type Multiasset struct {
+	PolicyID []byte
+	Assets   []Asset
+}
+
+// Datum is the datum of an output. OriginalCbor is only set for inline
+// datums
+type Datum struct {
+	Hash         []byte
+	OriginalCbor []byte
+}
+
+// Script is a reference script
+type Script struct {
+	Language string
+	Cbor     []byte
+}
+
+// TxOutput is a parsed transaction output
+type TxOutput struct {
+	Address []byte
+	Coin    uint64
+	Assets  []Multiasset
+	Datum   *Datum
+	Script  *Script
+}
+
+// AnyUtxoData is an output returned by ReadUtxos and SearchUtxos. Kupo does
+// not store the original output CBOR, so NativeBytes is empty
+type AnyUtxoData struct {
+	NativeBytes []byte
+	TxoRef      TxoRef
+	Parsed      *TxOutput
+}
+
+// AddressPattern matches outputs by address. Exactly one of the fields is set
+type AddressPattern struct {
+	ExactAddress   []byte
+	PaymentPart    []byte
+	DelegationPart []byte
+}
+
+// AssetPattern matches outputs holding an asset. AssetName may be empty to
+// match any asset of the policy
+type AssetPattern struct {
+	PolicyID  []byte
+	AssetName []byte
+}
+
+// UtxoPredicate selects outputs by address or asset
+type UtxoPredicate struct {
+	Address *AddressPattern
+	Asset   *AssetPattern
+}
+
+// Service answers UTxO RPC queries from Kupo
+type Service struct {
+	client kupogo.KupoClient
+	// ResolveDatums fetches inline datums and reference scripts for each
+	// output, at the cost of extra requests
+	ResolveDatums bool
+}
+
+// NewService creates a query service backed by a Kupo client
+func NewService(client kupogo.KupoClient) *Service {
+	return &Service{client: client, ResolveDatums: true}
+}
+
+// ReadUtxos returns the unspent outputs among refs. Spent or unknown outputs
+// are omitted
+func (s *Service) ReadUtxos(ctx context.Context, refs []TxoRef) ([]AnyUtxoData, *ChainPoint, error) {
+	var ret []AnyUtxoData
+	for _, ref := range refs {
+		pattern := kupogo.MatchOutputRef(hex.EncodeToString(ref.Hash), int(ref.Index)).String()
+		utxos, err := s.search(ctx, pattern, kupogo.MatchOptions{Unspent: true})
+		if err != nil {
+			return nil, nil, err
+		}
+		ret = append(ret, utxos...)
+	}
+	point, err := s.tip(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ret, point, nil
+}
+
+// SearchUtxos returns the unspent outputs matching a predicate
+func (s *Service) SearchUtxos(
+	ctx context.Context,
+	predicate UtxoPredicate,
+) ([]AnyUtxoData, *ChainPoint, error) {
+	pattern, opts, err := PredicatePattern(predicate)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts.Unspent = true
+	ret, err := s.search(ctx, pattern, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	point, err := s.tip(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ret, point, nil
+}
+
+// PredicatePattern converts a predicate into a Kupo pattern and filters
+func PredicatePattern(predicate UtxoPredicate) (string, kupogo.MatchOptions, error) {
+	var opts kupogo.MatchOptions
+	switch {
+	case predicate.Address != nil && predicate.Asset == nil:
+		address := predicate.Address
+		switch {
+		case len(address.ExactAddress) > 0:
+			pattern, err := addressBech32(address.ExactAddress)
+			return pattern, opts, err
+		case len(address.PaymentPart) > 0 && len(address.DelegationPart) > 0:
+			return kupogo.MatchCredentials(
+				hex.EncodeToString(address.PaymentPart),
+				hex.EncodeToString(address.DelegationPart),
+			).String(), opts, nil
+		case len(address.PaymentPart) > 0:
+			return kupogo.MatchCredential(hex.EncodeToString(address.PaymentPart)).String(), opts, nil
+		case len(address.DelegationPart) > 0:
+			return kupogo.MatchStakeCredential(hex.EncodeToString(address.DelegationPart)).String(), opts, nil
+		}
+	case predicate.Asset != nil:
+		asset := predicate.Asset
+		if len(asset.PolicyID) == 0 {
+			break
+		}
+		pattern := kupogo.MatchPolicy(hex.EncodeToString(asset.PolicyID)).String()
+		if len(asset.AssetName) > 0 {
+			pattern = kupogo.MatchAsset(kupogo.NewAssetID(
+				hex.EncodeToString(asset.PolicyID),
+				hex.EncodeToString(asset.AssetName),
+			)).String()
+		}
+		if predicate.Address == nil {
+			return pattern, opts, nil
+		}
+		// Narrow the address pattern by the asset with a filter
+		addressPattern, _, err := PredicatePattern(UtxoPredicate{Address: predicate.Address})
+		if err != nil {
+			return "", opts, err
+		}
+		opts.PolicyID = hex.EncodeToString(asset.PolicyID)
+		opts.AssetName = hex.EncodeToString(asset.AssetName)
+		return addressPattern, opts, nil
+	}
+	return "", opts, ErrUnsupportedPredicate
+}
+
+func (s *Service) search(
+	ctx context.Context,
+	pattern string,
+	opts kupogo.MatchOptions,
+) ([]AnyUtxoData, error) {
+	matches, err := s.client.GetMatchesWithOptionsContext(ctx, pattern, opts)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]AnyUtxoData, 0, len(*matches))
+	for _, match := range *matches {
+		utxo, err := ToAnyUtxoData(match)
+		if err != nil {
+			return nil, err
+		}
+		if s.ResolveDatums {
+			if err := s.resolve(ctx, match, utxo.Parsed); err != nil {
+				return nil, err
+			}
+		}
+		ret = append(ret, utxo)
+	}
+	return ret, nil
+}
+
+func (s *Service) resolve(ctx context.Context, match kupogo.Match, output *TxOutput) error {
+	if output.Datum != nil && match.DatumType != nil && *match.DatumType == "inline" {
+		datum, err := s.client.GetDatumByHashContext(ctx, *match.DatumHash)
+		if err != nil {
+			return err
+		}
+		if datum != nil {
+			output.Datum.OriginalCbor, err = hex.DecodeString(datum.Datum)
+			if err != nil {
+				return fmt.Errorf("invalid datum: %s", err)
+			}
+		}
+	}
+	if match.ScriptHash != nil {
+		script, err := s.client.GetScriptByHashContext(ctx, *match.ScriptHash)
+		if err != nil {
+			return err
+		}
+		if script != nil {
+			cbor, err := hex.DecodeString(script.Script)
+			if err != nil {
+				return fmt.Errorf("invalid script: %s", err)
+			}
+			output.Script = &Script{Language: script.Language, Cbor: cbor}
+		}
+	}
+	return nil
+}
+
+// tip returns the most recent checkpoint, if any
+func (s *Service) tip(ctx context.Context) (*ChainPoint, error) {
+	checkpoints, err := s.client.GetCheckpointsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(*checkpoints) == 0 {
+		return nil, nil
+	}
+	latest := (*checkpoints)[0]
+	hash, err := hex.DecodeString(latest.HeaderHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header hash: %s", err)
+	}
+	return &ChainPoint{Slot: uint64(latest.SlotNo), Hash: hash}, nil
+}
+
+// ToAnyUtxoData converts a match into a UTxO RPC output
+func ToAnyUtxoData(match kupogo.Match) (AnyUtxoData, error) {
+	txHash, err := hex.DecodeString(match.TransactionID)
+	if err != nil {
+		return AnyUtxoData{}, fmt.Errorf("invalid transaction ID: %s", err)
+	}
+	output := &TxOutput{
+		Coin: uint64(match.Value.Coins),
+	}
+	if parsed, err := address.Parse(match.Address); err == nil {
+		output.Address = parsed.Bytes()
+	}
+	policies := make(map[string]*Multiasset)
+	for asset, quantity := range match.Value.Assets {
+		assetID := kupogo.AssetID(asset)
+		policy, ok := policies[assetID.PolicyID()]
+		if !ok {
+			policyID, err := hex.DecodeString(assetID.PolicyID())
+			if err != nil {
+				return AnyUtxoData{}, fmt.Errorf("invalid policy ID: %s", err)
+			}
+			policy = &Multiasset{PolicyID: policyID}
+			policies[assetID.PolicyID()] = policy
+		}
+		name, err := hex.DecodeString(assetID.AssetName())
+		if err != nil {
+			return AnyUtxoData{}, fmt.Errorf("invalid asset name: %s", err)
+		}
+		fingerprint, _ := assetID.Fingerprint()
+		policy.Assets = append(policy.Assets, Asset{
+			Name:         name,
+			OutputCoin:   uint64(quantity),
+			Fingerprint:  fingerprint,
+			NameAsString: string(name),
+		})
+	}
+	for _, policy := range policies {
+		sort.Slice(policy.Assets, func(i, j int) bool {
+			return string(policy.Assets[i].Name) < string(policy.Assets[j].Name)
+		})
+		output.Assets = append(output.Assets, *policy)
+	}
+	sort.Slice(output.Assets, func(i, j int) bool {
+		return string(output.Assets[i].PolicyID) < string(output.Assets[j].PolicyID)
+	})
+	if match.DatumHash != nil {
+		hash, err := hex.DecodeString(*match.DatumHash)
+		if err != nil {
+			return AnyUtxoData{}, fmt.Errorf("invalid datum hash: %s", err)
+		}
+		output.Datum = &Datum{Hash: hash}
+	}
+	return AnyUtxoData{
+		TxoRef: TxoRef{Hash: txHash, Index: uint32(match.OutputIndex)},
+		Parsed: output,
+	}, nil
+}
+
+// addressBech32 encodes a Shelley address in bech32, choosing the prefix from
+// its header, or a Byron address in base58, as Kupo patterns expect
+func addressBech32(raw []byte) (string, error) {
+	if len(raw) == 0 {
+		return "", ErrUnsupportedPredicate
+	}
+	parsed, err := address.FromBytes(raw)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedPredicate, err)
+	}
+	return parsed.String(), nil
+}
@@ -0,0 +1,134 @@
+package utxorpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/internal/bech32"
+	"github.com/blinklabs-io/kupogo/kupogotest"
+)
+
+const (
+	testAddress = "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x"
+	testPolicy  = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	testTx      = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+)
+
+func testService() *Service {
+	fake := kupogotest.NewFake()
+	datumHash := "dd"
+	inline := "inline"
+	fake.AddMatches(
+		kupogo.Match{
+			TransactionID: testTx,
+			OutputIndex:   0,
+			Address:       testAddress,
+			Value: kupogo.Value{
+				Coins:  1000000,
+				Assets: kupogo.Assets{testPolicy + ".746f6b656e": 5},
+			},
+			DatumHash: &datumHash,
+			DatumType: &inline,
+			CreatedAt: kupogo.Point{SlotNo: 10},
+		},
+		kupogo.Match{
+			TransactionID: testTx,
+			OutputIndex:   1,
+			Address:       testAddress,
+			Value:         kupogo.Value{Coins: 2000000},
+			CreatedAt:     kupogo.Point{SlotNo: 10},
+			SpentAt:       &kupogo.Point{SlotNo: 11},
+		},
+	)
+	fake.AddDatum("dd", "d87980")
+	fake.AddCheckpoints(kupogo.Point{SlotNo: 12, HeaderHash: "beef"})
+	return NewService(fake)
+}
+
+func TestReadUtxos(t *testing.T) {
+	service := testService()
+	txHash, _ := hex.DecodeString(testTx)
+	utxos, point, err := service.ReadUtxos(
+		context.Background(),
+		[]TxoRef{{Hash: txHash, Index: 0}, {Hash: txHash, Index: 1}},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(utxos) != 1 {
+		t.Fatalf("Expected only the unspent output, got %d", len(utxos))
+	}
+	parsed := utxos[0].Parsed
+	if parsed.Coin != 1000000 || len(parsed.Assets) != 1 ||
+		string(parsed.Assets[0].Assets[0].Name) != "token" {
+		t.Fatalf("Unexpected output: %+v", parsed)
+	}
+	if parsed.Datum == nil || !bytes.Equal(parsed.Datum.OriginalCbor, []byte{0xd8, 0x79, 0x80}) {
+		t.Fatalf("Unexpected datum: %+v", parsed.Datum)
+	}
+	if point == nil || point.Slot != 12 {
+		t.Fatalf("Unexpected chain point: %+v", point)
+	}
+}
+
+func TestSearchUtxos(t *testing.T) {
+	service := testService()
+	_, address, _ := bech32.Decode(testAddress)
+	utxos, _, err := service.SearchUtxos(
+		context.Background(),
+		UtxoPredicate{Address: &AddressPattern{ExactAddress: address}},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(utxos) != 1 || !bytes.Equal(utxos[0].Parsed.Address, address) {
+		t.Fatalf("Unexpected outputs: %+v", utxos)
+	}
+	policyID, _ := hex.DecodeString(testPolicy)
+	utxos, _, err = service.SearchUtxos(
+		context.Background(),
+		UtxoPredicate{Asset: &AssetPattern{PolicyID: policyID}},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(utxos) != 1 {
+		t.Fatalf("Expected 1 output, got %d", len(utxos))
+	}
+	if _, _, err := service.SearchUtxos(context.Background(), UtxoPredicate{}); !errors.Is(err, ErrUnsupportedPredicate) {
+		t.Fatalf("Expected ErrUnsupportedPredicate, got %v", err)
+	}
+}
+
+func TestPredicatePattern(t *testing.T) {
+	payment := bytes.Repeat([]byte{0x01}, 28)
+	pattern, _, err := PredicatePattern(UtxoPredicate{Address: &AddressPattern{PaymentPart: payment}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if pattern != hex.EncodeToString(payment)+"/*" {
+		t.Fatalf("Unexpected pattern: %s", pattern)
+	}
+}
+
+func TestByronAddress(t *testing.T) {
+	byron := "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi"
+	data, err := ToAnyUtxoData(kupogo.Match{TransactionID: testTx, Address: byron})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(data.Parsed.Address) == 0 {
+		t.Fatalf("Expected Byron address bytes")
+	}
+	pattern, _, err := PredicatePattern(UtxoPredicate{Address: &AddressPattern{ExactAddress: data.Parsed.Address}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if pattern != byron {
+		t.Fatalf("Expected %s, got %s", byron, pattern)
+	}
+}
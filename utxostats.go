@@ -0,0 +1,133 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// CoinsPerUTxOByteMainnet is the coinsPerUTxOByte protocol parameter of
+// mainnet since the Babbage era
+const CoinsPerUTxOByteMainnet = 4310
+
+// defaultUTxOBuckets are powers of ten from 1 to 1,000,000 ADA
+var defaultUTxOBuckets = []int{1e6, 1e7, 1e8, 1e9, 1e10, 1e11, 1e12}
+
+// UTxOStatsOptions configures UTxO statistics
+type UTxOStatsOptions struct {
+	// CoinsPerUTxOByte is the protocol parameter used to compute the dust
+	// threshold, defaulting to CoinsPerUTxOByteMainnet
+	CoinsPerUTxOByte int
+	// DustThreshold is the lovelace below which an output is counted as
+	// dust, defaulting to the minimum lovelace of an ADA-only output
+	DustThreshold int
+	// Buckets are the ascending upper bounds, in lovelace, of the histogram
+	// buckets. They default to powers of ten from 1 to 1,000,000 ADA
+	Buckets []int
+}
+
+// UTxOBucket counts the outputs whose lovelace is at most UpperBound and
+// above the bound of the previous bucket. The last bucket has no bound and
+// an UpperBound of math.MaxInt
+type UTxOBucket struct {
+	UpperBound int
+	Count      int
+	Coins      int
+}
+
+// UTxOStats describes the fragmentation of a set of unspent outputs
+type UTxOStats struct {
+	Count int
+	Coins int
+	// WithAssets is the number of outputs carrying native assets
+	WithAssets int
+	// MedianCoins is the median lovelace of the outputs
+	MedianCoins int
+	Histogram   []UTxOBucket
+	// Dust and DustCoins are the number and total lovelace of the outputs
+	// below the dust threshold
+	Dust      int
+	DustCoins int
+}
+
+// UTxOStats reports the count, lovelace distribution and dust of the unspent
+// matches
+func (m Matches) UTxOStats(opts UTxOStatsOptions) UTxOStats {
+	if opts.CoinsPerUTxOByte <= 0 {
+		opts.CoinsPerUTxOByte = CoinsPerUTxOByteMainnet
+	}
+	if opts.DustThreshold <= 0 {
+		opts.DustThreshold = MinLovelace(opts.CoinsPerUTxOByte, nil)
+	}
+	if len(opts.Buckets) == 0 {
+		opts.Buckets = defaultUTxOBuckets
+	}
+	ret := UTxOStats{Histogram: make([]UTxOBucket, len(opts.Buckets)+1)}
+	for i, bound := range opts.Buckets {
+		ret.Histogram[i].UpperBound = bound
+	}
+	ret.Histogram[len(opts.Buckets)].UpperBound = math.MaxInt
+	var coins []int
+	for _, match := range m {
+		if match.SpentAt != nil {
+			continue
+		}
+		ret.Count++
+		ret.Coins += match.Value.Coins
+		coins = append(coins, match.Value.Coins)
+		if len(match.Value.Assets) > 0 {
+			ret.WithAssets++
+		}
+		if match.Value.Coins < opts.DustThreshold {
+			ret.Dust++
+			ret.DustCoins += match.Value.Coins
+		}
+		bucket := sort.Search(len(opts.Buckets), func(i int) bool {
+			return match.Value.Coins <= opts.Buckets[i]
+		})
+		ret.Histogram[bucket].Count++
+		ret.Histogram[bucket].Coins += match.Value.Coins
+	}
+	if len(coins) > 0 {
+		sort.Ints(coins)
+		ret.MedianCoins = coins[len(coins)/2]
+		if len(coins)%2 == 0 {
+			ret.MedianCoins = (coins[len(coins)/2-1] + coins[len(coins)/2]) / 2
+		}
+	}
+	return ret
+}
+
+// GetUTxOStats fetches the unspent matches of a pattern and reports their
+// fragmentation
+func (c *Client) GetUTxOStats(pattern string, opts UTxOStatsOptions) (*UTxOStats, error) {
+	return c.GetUTxOStatsContext(context.Background(), pattern, opts)
+}
+
+// GetUTxOStatsContext is like GetUTxOStats with a request context
+func (c *Client) GetUTxOStatsContext(
+	ctx context.Context,
+	pattern string,
+	opts UTxOStatsOptions,
+) (*UTxOStats, error) {
+	matches, _, err := c.getMatches(ctx, pattern, MatchOptions{Unspent: true})
+	if err != nil {
+		return nil, err
+	}
+	stats := matches.UTxOStats(opts)
+	return &stats, nil
+}
@@ -0,0 +1,54 @@
+package kupogo
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetUTxOStats(t *testing.T) {
+	matches := Matches{
+		{Value: Value{Coins: 500000}},
+		{Value: Value{Coins: 2000000, Assets: Assets{"a.b": 1}}},
+		{Value: Value{Coins: 3000000}},
+		{Value: Value{Coins: 50000000}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.RawQuery != "unspent" {
+				t.Errorf("Unexpected query %s", r.URL.RawQuery)
+			}
+			_ = json.NewEncoder(w).Encode(matches)
+		}),
+	)
+	defer server.Close()
+
+	stats, err := NewClient(server.URL).GetUTxOStats("addr", UTxOStatsOptions{Buckets: []int{1000000, 10000000}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	expected := &UTxOStats{
+		Count:       4,
+		Coins:       55500000,
+		WithAssets:  1,
+		MedianCoins: 2500000,
+		Histogram: []UTxOBucket{
+			{UpperBound: 1000000, Count: 1, Coins: 500000},
+			{UpperBound: 10000000, Count: 2, Coins: 5000000},
+			{UpperBound: math.MaxInt, Count: 1, Coins: 50000000},
+		},
+		Dust:      1,
+		DustCoins: 500000,
+	}
+	if !reflect.DeepEqual(stats, expected) {
+		t.Fatalf("Unexpected stats %+v", stats)
+	}
+
+	stats2 := matches.UTxOStats(UTxOStatsOptions{DustThreshold: 2500000})
+	if stats2.Dust != 2 || len(stats2.Histogram) != len(defaultUTxOBuckets)+1 {
+		t.Fatalf("Unexpected stats with custom threshold %+v", stats2)
+	}
+}
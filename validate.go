@@ -0,0 +1,74 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// requiredFieldsError reports missing required fields, using the messages of
+// the struct validation previously done with go-playground/validator
+func requiredFieldsError(structName string, fields ...string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(
+			messages,
+			fmt.Sprintf(
+				"Key: '%s.%s' Error:Field validation for '%s' failed on the 'required' tag",
+				structName,
+				field,
+				field,
+			),
+		)
+	}
+	return errors.New(strings.Join(messages, "\n"))
+}
+
+func (m *MetadataItem) validate() error {
+	var missing []string
+	if m.Hash == "" {
+		missing = append(missing, "Hash")
+	}
+	if len(m.Raw) == 0 && len(m.rawHex) == 0 {
+		missing = append(missing, "Raw")
+	}
+	if m.Schema == nil {
+		missing = append(missing, "Schema")
+	}
+	return requiredFieldsError("MetadataItem", missing...)
+}
+
+func (s *ScriptResponse) validate() error {
+	var missing []string
+	if s.Language == "" {
+		missing = append(missing, "Language")
+	}
+	if s.Script == "" {
+		missing = append(missing, "Script")
+	}
+	return requiredFieldsError("ScriptResponse", missing...)
+}
+
+func (d *DatumResponse) validate() error {
+	if d.Datum == "" {
+		return requiredFieldsError("DatumResponse", "Datum")
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+// Clone returns a deep copy of the value
+func (v Value) Clone() Value {
+	ret := Value{Coins: v.Coins}
+	if v.Assets != nil {
+		ret.Assets = make(Assets, len(v.Assets))
+		for asset, quantity := range v.Assets {
+			ret.Assets[asset] = quantity
+		}
+	}
+	return ret
+}
+
+// Add returns the sum of two values
+func (v Value) Add(other Value) Value {
+	ret := v.Clone()
+	ret.Coins += other.Coins
+	for asset, quantity := range other.Assets {
+		if ret.Assets == nil {
+			ret.Assets = make(Assets)
+		}
+		ret.Assets[asset] += quantity
+	}
+	ret.Assets.prune()
+	return ret
+}
+
+// Sub returns the difference of two values. Quantities may become negative
+func (v Value) Sub(other Value) Value {
+	ret := v.Clone()
+	ret.Coins -= other.Coins
+	for asset, quantity := range other.Assets {
+		if ret.Assets == nil {
+			ret.Assets = make(Assets)
+		}
+		ret.Assets[asset] -= quantity
+	}
+	ret.Assets.prune()
+	return ret
+}
+
+// IsZero returns true if the value carries no coins and no assets
+func (v Value) IsZero() bool {
+	return v.Coins == 0 && len(v.Assets) == 0
+}
+
+// Covers returns true if the value holds at least the coins and assets of the
+// other value
+func (v Value) Covers(other Value) bool {
+	if v.Coins < other.Coins {
+		return false
+	}
+	for asset, quantity := range other.Assets {
+		if v.Assets[asset] < quantity {
+			return false
+		}
+	}
+	return true
+}
+
+// prune removes assets with a zero quantity
+func (a Assets) prune() {
+	for asset, quantity := range a {
+		if quantity == 0 {
+			delete(a, asset)
+		}
+	}
+}
+
+// Balance returns the total value of the unspent matches
+func (m Matches) Balance() Value {
+	var ret Value
+	for _, match := range m {
+		if match.SpentAt != nil {
+			continue
+		}
+		ret = ret.Add(match.Value)
+	}
+	return ret
+}
@@ -0,0 +1,30 @@
+package kupogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValue_Arithmetic(t *testing.T) {
+	a := Value{Coins: 10, Assets: Assets{"aa.01": 5, "bb": 1}}
+	b := Value{Coins: 4, Assets: Assets{"aa.01": 5, "cc": 2}}
+	sum := a.Add(b)
+	expectedSum := Value{Coins: 14, Assets: Assets{"aa.01": 10, "bb": 1, "cc": 2}}
+	if !reflect.DeepEqual(sum, expectedSum) {
+		t.Errorf("Expected sum %v, got %v", expectedSum, sum)
+	}
+	diff := a.Sub(b)
+	expectedDiff := Value{Coins: 6, Assets: Assets{"bb": 1, "cc": -2}}
+	if !reflect.DeepEqual(diff, expectedDiff) {
+		t.Errorf("Expected difference %v, got %v", expectedDiff, diff)
+	}
+	if a.Assets["aa.01"] != 5 {
+		t.Errorf("Expected operands to be left untouched")
+	}
+	if !sum.Covers(a) || a.Covers(b) {
+		t.Errorf("Unexpected Covers result")
+	}
+	if !a.Sub(a).IsZero() {
+		t.Errorf("Expected zero value")
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrKupoVersion is wrapped by errors of clients connected to a Kupo older
+// than the version required with WithMinKupoVersion
+var ErrKupoVersion = errors.New("unsupported kupo version")
+
+type versionCheck struct {
+	min  string
+	mu   sync.Mutex
+	done bool
+	err  error
+}
+
+// WithMinKupoVersion requires the connected Kupo to be at least the given
+// version, such as "2.8.0". The version is checked on the first call, which
+// and every later call fail with an error wrapping ErrKupoVersion if it is
+// older. Use CheckVersion to fail fast at startup instead
+func WithMinKupoVersion(version string) ClientOption {
+	return func(c *Client) {
+		c.versionCheck = &versionCheck{min: version}
+	}
+}
+
+// CheckVersion checks the version of the connected Kupo against the one
+// required with WithMinKupoVersion, if any. A result is remembered once
+// Kupo's version is known
+func (c *Client) CheckVersion(ctx context.Context) error {
+	if c.versionCheck == nil {
+		return nil
+	}
+	return c.versionCheck.check(ctx, c)
+}
+
+func (v *versionCheck) check(ctx context.Context, c *Client) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.done {
+		return v.err
+	}
+	health, err := c.GetHealthContext(ctx)
+	if err != nil {
+		// Transient failures are not remembered
+		return fmt.Errorf("failed to check kupo version: %s", err)
+	}
+	v.done = true
+	v.err = checkMinVersion(health.Version, v.min)
+	return v.err
+}
+
+func checkMinVersion(version string, minVersion string) error {
+	required, err := parseVersion(minVersion)
+	if err != nil {
+		return fmt.Errorf("%w: invalid minimum version %q", ErrKupoVersion, minVersion)
+	}
+	actual, err := parseVersion(version)
+	if err != nil {
+		return fmt.Errorf("%w: unrecognized version %q", ErrKupoVersion, version)
+	}
+	for i := range required {
+		if actual[i] != required[i] {
+			if actual[i] < required[i] {
+				return fmt.Errorf("%w: %s is older than %s", ErrKupoVersion, version, minVersion)
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// parseVersion parses a version such as "v2.8.0", "2.8" or "2.9.0-beta" into
+// its major, minor and patch numbers, ignoring any pre-release suffix
+func parseVersion(version string) ([3]int, error) {
+	var ret [3]int
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(version, "-+ "); i >= 0 {
+		version = version[:i]
+	}
+	parts := strings.Split(version, ".")
+	if len(parts) > len(ret) {
+		return ret, fmt.Errorf("invalid version %q", version)
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return ret, fmt.Errorf("invalid version %q", version)
+		}
+		ret[i] = n
+	}
+	return ret, nil
+}
+
+// checkVersionBeforeRequest runs the version check for any request but the
+// health request it relies on
+func (c *Client) checkVersionBeforeRequest(req *http.Request) error {
+	if c.versionCheck == nil || strings.HasSuffix(req.URL.Path, "/health") {
+		return nil
+	}
+	return c.versionCheck.check(req.Context(), c)
+}
@@ -0,0 +1,74 @@
+package kupogo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCheckMinVersion(t *testing.T) {
+	testDefs := []struct {
+		version string
+		min     string
+		ok      bool
+	}{
+		{"v2.8.0", "2.8.0", true},
+		{"v2.9.1", "2.8", true},
+		{"3.0.0", "v2.11.0", true},
+		{"v2.10.0-beta", "2.9.0", true},
+		{"v2.7.3", "2.8.0", false},
+		{"1.9.9", "2", false},
+		{"nightly", "2.8.0", false},
+		{"v2.8.0", "latest", false},
+	}
+	for _, testDef := range testDefs {
+		err := checkMinVersion(testDef.version, testDef.min)
+		if testDef.ok && err != nil {
+			t.Errorf("%s >= %s: expected no error, got %s", testDef.version, testDef.min, err)
+		}
+		if !testDef.ok && !errors.Is(err, ErrKupoVersion) {
+			t.Errorf("%s >= %s: expected ErrKupoVersion, got %v", testDef.version, testDef.min, err)
+		}
+	}
+}
+
+func TestWithMinKupoVersion(t *testing.T) {
+	var healthCalls, matchCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health":
+			healthCalls.Add(1)
+			_, _ = w.Write([]byte(`{"connection_status":"connected","version":"v2.7.2"}`))
+		default:
+			matchCalls.Add(1)
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithMinKupoVersion("2.8.0"))
+	for i := 0; i < 2; i++ {
+		_, err := client.GetMatches("*")
+		if err == nil || !strings.Contains(err.Error(), ErrKupoVersion.Error()) {
+			t.Fatalf("Expected version error, got %v", err)
+		}
+	}
+	if err := client.CheckVersion(context.Background()); !errors.Is(err, ErrKupoVersion) {
+		t.Fatalf("Expected ErrKupoVersion, got %v", err)
+	}
+	if healthCalls.Load() != 1 || matchCalls.Load() != 0 {
+		t.Fatalf("Unexpected calls: %d health, %d matches", healthCalls.Load(), matchCalls.Load())
+	}
+
+	client = NewClient(server.URL, WithMinKupoVersion("2.7"))
+	if _, err := client.GetMatches("*"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if matchCalls.Load() != 1 {
+		t.Fatalf("Expected the request to go through, got %d calls", matchCalls.Load())
+	}
+}
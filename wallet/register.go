@@ -0,0 +1,192 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/address"
+	"github.com/blinklabs-io/kupogo/hdwallet"
+)
+
+// defaultGapLimit is the number of addresses of each role registered for an
+// account extended public key
+const defaultGapLimit = 20
+
+// Generated identifies a newly generated wallet, such as a bursa.Wallet. Its
+// patterns are the payment credential of PaymentAddress and the stake
+// credential of StakeAddress, so that every address of the account is caught
+// once it is delegated. With AccountXPub, the payment credentials of the
+// first addresses of the account are derived as well, which catches the
+// outputs of the addresses a wallet hands out before it is delegated
+type Generated struct {
+	PaymentAddress string
+	StakeAddress   string
+	// AccountXPub is the CIP-1852 account extended public key, as hex or a
+	// bech32 acct_xvk string
+	AccountXPub string
+	// GapLimit is the number of external and internal addresses derived from
+	// AccountXPub, defaulting to 20. Addresses past it are only caught
+	// through the stake credential, or by scanning with hdwallet.Scanner
+	GapLimit int
+}
+
+// Patterns returns the Kupo patterns matching the outputs of the wallet
+func (g Generated) Patterns() ([]kupogo.Pattern, error) {
+	ret, err := g.addressPatterns()
+	if err != nil {
+		return nil, err
+	}
+	if g.AccountXPub != "" {
+		derived, err := g.xpubPatterns()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, derived...)
+	}
+	if len(ret) == 0 {
+		return nil, errors.New("wallet has no address")
+	}
+	// The addresses usually share credentials with the account
+	seen := make(map[kupogo.Pattern]bool, len(ret))
+	unique := ret[:0]
+	for _, pattern := range ret {
+		if !seen[pattern] {
+			seen[pattern] = true
+			unique = append(unique, pattern)
+		}
+	}
+	return unique, nil
+}
+
+// xpubPatterns returns the stake credential pattern of the account and the
+// payment credential patterns of its first addresses
+func (g Generated) xpubPatterns() ([]kupogo.Pattern, error) {
+	xpub, err := hdwallet.ParseAccountXPub(g.AccountXPub)
+	if err != nil {
+		return nil, err
+	}
+	stakeKey, err := xpub.DerivePath(hdwallet.RoleStaking, 0)
+	if err != nil {
+		return nil, err
+	}
+	ret := []kupogo.Pattern{
+		kupogo.MatchStakeCredential(hex.EncodeToString(stakeKey.KeyHash())),
+	}
+	gapLimit := g.GapLimit
+	if gapLimit <= 0 {
+		gapLimit = defaultGapLimit
+	}
+	scanner := hdwallet.Scanner{XPub: xpub}
+	for _, role := range []uint32{hdwallet.RoleExternal, hdwallet.RoleInternal} {
+		derived, err := scanner.Derive(role, 0, uint32(gapLimit))
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive addresses: %w", err)
+		}
+		for _, address := range derived {
+			ret = append(ret, kupogo.Pattern(address.Pattern))
+		}
+	}
+	return ret, nil
+}
+
+// addressPatterns returns the patterns of PaymentAddress and StakeAddress
+func (g Generated) addressPatterns() ([]kupogo.Pattern, error) {
+	var ret []kupogo.Pattern
+	if g.PaymentAddress != "" {
+		pattern, err := address.PaymentCredentialPattern(g.PaymentAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse payment address: %w", err)
+		}
+		ret = append(ret, kupogo.Pattern(pattern))
+	}
+	if g.StakeAddress != "" {
+		parsed, err := address.Parse(g.StakeAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse stake address: %w", err)
+		}
+		pattern, err := parsed.StakePattern()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, kupogo.Pattern(pattern))
+	}
+	return ret, nil
+}
+
+// RegisterConfig configures the registration of a generated wallet
+type RegisterConfig struct {
+	// RollbackTo is the point Kupo indexes the wallet from. It defaults to
+	// the most recent checkpoint, as a new wallet has no history
+	RollbackTo *kupogo.Point
+	// Limit defaults to kupogo.RollbackLimitWithinSafeZone
+	Limit kupogo.RollbackLimit
+	// Subscribe configures the returned subscription
+	Subscribe SubscribeConfig
+}
+
+// Register adds the patterns of a generated wallet to Kupo and returns a
+// subscription to its changes, going from a new wallet to an indexed one in
+// one call. The addresses derived from an account extended public key are
+// registered with a single request. Call Run on the subscription to start
+// watching
+func Register(
+	ctx context.Context,
+	client *kupogo.Client,
+	generated Generated,
+	config RegisterConfig,
+) (*Subscription, error) {
+	patterns, err := generated.Patterns()
+	if err != nil {
+		return nil, err
+	}
+	rollbackTo := config.RollbackTo
+	if rollbackTo == nil {
+		checkpoints, err := client.GetCheckpointsContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(*checkpoints) == 0 {
+			return nil, errors.New("kupo has no checkpoint to index from")
+		}
+		latest := (*checkpoints)[0]
+		for _, checkpoint := range *checkpoints {
+			if checkpoint.SlotNo > latest.SlotNo {
+				latest = checkpoint
+			}
+		}
+		rollbackTo = &latest
+	}
+	limit := config.Limit
+	if limit == "" {
+		limit = kupogo.RollbackLimitWithinSafeZone
+	}
+	strs := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		strs = append(strs, string(pattern))
+	}
+	if _, err := client.AddPatternsContext(ctx, strs, *rollbackTo, limit); err != nil {
+		return nil, fmt.Errorf("failed to register wallet patterns: %w", err)
+	}
+	w, err := New(client, patterns...)
+	if err != nil {
+		return nil, err
+	}
+	return w.Subscribe(config.Subscribe), nil
+}
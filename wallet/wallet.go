@@ -0,0 +1,267 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wallet provides a watch-only wallet over Kupo: a set of patterns
+// built from addresses, an account extended public key or a stake credential,
+// with the balance, UTxO, history and change notification queries wallet
+// backends need
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/hdwallet"
+)
+
+const defaultInterval = 10 * time.Second
+
+// Wallet is a watch-only wallet. It is safe for concurrent use
+type Wallet struct {
+	client   *kupogo.Client
+	patterns []kupogo.Pattern
+}
+
+// New returns a wallet watching the outputs matching any of the patterns
+func New(client *kupogo.Client, patterns ...kupogo.Pattern) (*Wallet, error) {
+	if len(patterns) == 0 {
+		return nil, errors.New("wallet needs at least one pattern")
+	}
+	return &Wallet{
+		client:   client,
+		patterns: append([]kupogo.Pattern(nil), patterns...),
+	}, nil
+}
+
+// FromAddresses returns a wallet watching the given addresses
+func FromAddresses(client *kupogo.Client, addresses ...string) (*Wallet, error) {
+	patterns := make([]kupogo.Pattern, 0, len(addresses))
+	for _, address := range addresses {
+		pattern := kupogo.MatchAddress(address)
+		if err := pattern.Validate(); err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, pattern)
+	}
+	return New(client, patterns...)
+}
+
+// FromStakeCredential returns a wallet watching every address delegating to
+// a stake key or script hash
+func FromStakeCredential(client *kupogo.Client, hash string) (*Wallet, error) {
+	pattern := kupogo.MatchStakeCredential(hash)
+	if err := pattern.Validate(); err != nil {
+		return nil, err
+	}
+	return New(client, pattern)
+}
+
+// FromXPub returns a wallet watching a CIP-1852 account: every address using
+// its first stake key, and the payment credentials of its used addresses,
+// which also catches their outputs at addresses without that stake key. The
+// account is scanned for used addresses with scanner, whose Client and XPub
+// are set from the arguments
+func FromXPub(client *kupogo.Client, xpub *hdwallet.XPub, scanner hdwallet.Scanner) (*Wallet, error) {
+	scanner.Client = client
+	scanner.XPub = xpub
+	stakeKey, err := xpub.DerivePath(hdwallet.RoleStaking, 0)
+	if err != nil {
+		return nil, err
+	}
+	result, err := scanner.Scan()
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan account: %w", err)
+	}
+	patterns := []kupogo.Pattern{
+		kupogo.MatchStakeCredential(hex.EncodeToString(stakeKey.KeyHash())),
+	}
+	for _, address := range result.Addresses {
+		patterns = append(patterns, kupogo.Pattern(address.Pattern))
+	}
+	return New(client, patterns...)
+}
+
+// Patterns returns the patterns watched by the wallet
+func (w *Wallet) Patterns() []kupogo.Pattern {
+	return append([]kupogo.Pattern(nil), w.patterns...)
+}
+
+// UTxOs returns the unspent outputs of the wallet, sorted by output reference
+func (w *Wallet) UTxOs(ctx context.Context) (kupogo.Matches, error) {
+	return w.matches(ctx, kupogo.MatchOptions{Unspent: true})
+}
+
+// Balance returns the total value of the unspent outputs of the wallet
+func (w *Wallet) Balance(ctx context.Context) (kupogo.Value, error) {
+	utxos, err := w.UTxOs(ctx)
+	if err != nil {
+		return kupogo.Value{}, err
+	}
+	return utxos.Balance(), nil
+}
+
+// History returns the activity of the wallet per transaction, oldest first
+func (w *Wallet) History(ctx context.Context) ([]kupogo.AddressActivity, error) {
+	matches, err := w.matches(ctx, kupogo.MatchOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return matches.Activity(), nil
+}
+
+// matches returns the matches of every pattern, counting outputs matching
+// several patterns once
+func (w *Wallet) matches(ctx context.Context, opts kupogo.MatchOptions) (kupogo.Matches, error) {
+	seen := make(map[kupogo.OutputReference]bool)
+	ret := kupogo.Matches{}
+	for _, pattern := range w.patterns {
+		matches, err := w.client.GetMatchesWithOptionsContext(ctx, string(pattern), opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range *matches {
+			ref := match.OutputReference()
+			if seen[ref] {
+				continue
+			}
+			seen[ref] = true
+			ret = append(ret, match)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].TransactionID != ret[j].TransactionID {
+			return ret[i].TransactionID < ret[j].TransactionID
+		}
+		return ret[i].OutputIndex < ret[j].OutputIndex
+	})
+	return ret, nil
+}
+
+// SubscribeConfig configures a subscription to the changes of a wallet
+type SubscribeConfig struct {
+	// Interval between polls, defaulting to 10 seconds
+	Interval time.Duration
+	// OnEvent is called for every output received or spent
+	OnEvent func(kupogo.WatchEvent)
+	// OnBalance is called with the new balance after every poll which found
+	// changes
+	OnBalance func(kupogo.Value)
+	// OnError is called when a poll fails
+	OnError func(error)
+	// SkipInitial reports only the changes after the first poll, instead of
+	// reporting every unspent output as received
+	SkipInitial bool
+}
+
+// Subscription tracks the UTxO set of a wallet and reports its changes
+type Subscription struct {
+	wallet *Wallet
+	config SubscribeConfig
+	mu     sync.Mutex
+	utxos  map[kupogo.OutputReference]kupogo.Match
+	polled bool
+}
+
+// Subscribe returns a subscription to the changes of the wallet. Call Run to
+// start polling, or Poll to drive it manually
+func (w *Wallet) Subscribe(config SubscribeConfig) *Subscription {
+	if config.Interval <= 0 {
+		config.Interval = defaultInterval
+	}
+	return &Subscription{
+		wallet: w,
+		config: config,
+		utxos:  make(map[kupogo.OutputReference]kupogo.Match),
+	}
+}
+
+// Poll fetches the unspent outputs of the wallet and returns the changes
+// since the previous poll
+func (s *Subscription) Poll(ctx context.Context) ([]kupogo.WatchEvent, error) {
+	utxos, err := s.wallet.UTxOs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[kupogo.OutputReference]kupogo.Match, len(utxos))
+	for _, match := range utxos {
+		current[match.OutputReference()] = match
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []kupogo.WatchEvent
+	if s.polled || !s.config.SkipInitial {
+		for _, match := range utxos {
+			if _, ok := s.utxos[match.OutputReference()]; !ok {
+				events = append(events, kupogo.WatchEvent{Type: kupogo.WatchEventCreated, Match: match})
+			}
+		}
+		for ref, match := range s.utxos {
+			if _, ok := current[ref]; !ok {
+				events = append(events, kupogo.WatchEvent{Type: kupogo.WatchEventSpent, Match: match})
+			}
+		}
+	}
+	s.utxos = current
+	s.polled = true
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Match.CreatedAt.SlotNo < events[j].Match.CreatedAt.SlotNo
+	})
+	return events, nil
+}
+
+// Balance returns the balance as of the last poll
+func (s *Subscription) Balance() kupogo.Value {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ret kupogo.Value
+	for _, match := range s.utxos {
+		ret = ret.Add(match.Value)
+	}
+	return ret
+}
+
+// Run polls the wallet every interval until the context is done, delivering
+// changes to the callbacks
+func (s *Subscription) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+	for {
+		events, err := s.Poll(ctx)
+		if err != nil {
+			if s.config.OnError != nil {
+				s.config.OnError(err)
+			}
+		} else if len(events) > 0 {
+			if s.config.OnEvent != nil {
+				for _, event := range events {
+					s.config.OnEvent(event)
+				}
+			}
+			if s.config.OnBalance != nil {
+				s.config.OnBalance(s.Balance())
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
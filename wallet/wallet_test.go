@@ -0,0 +1,160 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/blinklabs-io/kupogo"
+	"github.com/blinklabs-io/kupogo/hdwallet"
+)
+
+const testAddress = "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"
+
+func TestWallet(t *testing.T) {
+	var mu sync.Mutex
+	unspent := kupogo.Matches{
+		{TransactionID: "t1", Value: kupogo.Value{Coins: 1}, CreatedAt: kupogo.Point{SlotNo: 10}},
+		{TransactionID: "t2", Value: kupogo.Value{Coins: 2}, CreatedAt: kupogo.Point{SlotNo: 20}},
+	}
+	spent := kupogo.Matches{
+		{TransactionID: "t0", Value: kupogo.Value{Coins: 5}, CreatedAt: kupogo.Point{SlotNo: 5}, SpentAt: &kupogo.Point{SlotNo: 10}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			resp := append(kupogo.Matches{}, unspent...)
+			if r.URL.RawQuery != "unspent" {
+				resp = append(resp, spent...)
+			}
+			respBody, _ := json.Marshal(resp)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+	client := kupogo.NewClient(server.URL)
+	if _, err := FromAddresses(client, "not-an-address"); err == nil {
+		t.Fatalf("Expected an error for an invalid address")
+	}
+	// Both patterns match the same outputs, which must be counted once
+	w, err := New(client, kupogo.MatchAddress(testAddress), kupogo.MatchAny())
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	ctx := context.Background()
+
+	balance, err := w.Balance(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if balance.Coins != 3 {
+		t.Errorf("Expected 3 coins, got %d", balance.Coins)
+	}
+	history, err := w.History(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(history) != 3 || history[0].TransactionID != "t0" {
+		t.Errorf("Unexpected history: %+v", history)
+	}
+
+	sub := w.Subscribe(SubscribeConfig{SkipInitial: true})
+	events, err := sub.Poll(ctx)
+	if err != nil || len(events) != 0 {
+		t.Fatalf("Expected no initial events, got %v, %v", events, err)
+	}
+	mu.Lock()
+	unspent = kupogo.Matches{
+		unspent[1],
+		{TransactionID: "t3", Value: kupogo.Value{Coins: 3}, CreatedAt: kupogo.Point{SlotNo: 30}},
+	}
+	mu.Unlock()
+	events, err = sub.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(events) != 2 ||
+		events[0].Type != kupogo.WatchEventSpent || events[0].Match.TransactionID != "t1" ||
+		events[1].Type != kupogo.WatchEventCreated || events[1].Match.TransactionID != "t3" {
+		t.Errorf("Unexpected events: %+v", events)
+	}
+	if sub.Balance().Coins != 5 {
+		t.Errorf("Expected 5 coins, got %d", sub.Balance().Coins)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	var registered struct {
+		Patterns   []string `json:"patterns"`
+		RollbackTo struct {
+			SlotNo uint64 `json:"slot_no"`
+		} `json:"rollback_to"`
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/checkpoints":
+				_, _ = w.Write([]byte(`[{"slot_no":200,"header_hash":"bb"},{"slot_no":100,"header_hash":"aa"}]`))
+			case r.Method == http.MethodPut && r.URL.Path == "/patterns":
+				_ = json.NewDecoder(r.Body).Decode(&registered)
+				respBody, _ := json.Marshal(registered.Patterns)
+				_, _ = w.Write(respBody)
+			default:
+				_, _ = w.Write([]byte(`[]`))
+			}
+		}),
+	)
+	defer server.Close()
+	client := kupogo.NewClient(server.URL)
+	if _, err := Register(context.Background(), client, Generated{}, RegisterConfig{}); err == nil {
+		t.Fatalf("Expected an error for a wallet without addresses")
+	}
+	sub, err := Register(
+		context.Background(),
+		client,
+		Generated{
+			PaymentAddress: testAddress,
+			StakeAddress:   "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+		},
+		RegisterConfig{},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(registered.Patterns) != 2 || registered.RollbackTo.SlotNo != 200 {
+		t.Fatalf("Unexpected registration %+v", registered)
+	}
+	if _, err := sub.Poll(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	// The first addresses of the account are derived from its xpub
+	const accountXPub = "4c32058b53e4df920ac3b50f0859d7d8a4f5c92e92c65d1075f1d8a9a0e07b2591db495d11691045874102cbf1bb9f6c9c868ebfa5ce6d3056d976175b0064d4"
+	sub, err = Register(
+		context.Background(),
+		client,
+		Generated{AccountXPub: accountXPub, GapLimit: 2},
+		RegisterConfig{},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	xpub, _ := hdwallet.ParseAccountXPub(accountXPub)
+	internal, err := (&hdwallet.Scanner{XPub: xpub}).Derive(hdwallet.RoleInternal, 0, 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	// The stake credential, then external 0-1 and internal 0-1
+	if len(registered.Patterns) != 5 || registered.Patterns[4] != internal[1].Pattern ||
+		!strings.HasPrefix(registered.Patterns[0], "*/") {
+		t.Fatalf("Unexpected registration %+v", registered)
+	}
+	if len(sub.wallet.Patterns()) != 5 {
+		t.Fatalf("Expected the wallet to watch the derived patterns, got %v", sub.wallet.Patterns())
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kupogo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// WatchEventType identifies the kind of change reported by a Watcher
+type WatchEventType int
+
+const (
+	WatchEventCreated WatchEventType = iota
+	WatchEventSpent
+)
+
+func (t WatchEventType) String() string {
+	switch t {
+	case WatchEventCreated:
+		return "created"
+	case WatchEventSpent:
+		return "spent"
+	default:
+		return "unknown"
+	}
+}
+
+// WatchEvent describes an output appearing in or disappearing from the unspent
+// set of a watched pattern
+type WatchEvent struct {
+	Type  WatchEventType
+	Match Match
+}
+
+// WatcherConfig configures a Watcher
+type WatcherConfig struct {
+	Pattern string
+	// Interval between polls, defaulting to 10 seconds
+	Interval time.Duration
+	// OnEvent is called for every change found while running
+	OnEvent func(WatchEvent)
+	// OnError is called when a poll fails while running
+	OnError func(error)
+	// CursorStore, if set, persists the checkpoint up to which events have
+	// been delivered by Run. On restart, only changes after the saved
+	// checkpoint are reported instead of every unspent output
+	CursorStore CursorStore
+	// CursorName identifies the watcher in the cursor store, defaulting to
+	// the pattern
+	CursorName string
+}
+
+const defaultWatchInterval = 10 * time.Second
+
+// Watcher tracks the unspent outputs of a pattern by polling Kupo
+type Watcher struct {
+	client *Client
+	config WatcherConfig
+	mu     sync.Mutex
+	utxos  map[OutputReference]Match
+	polled bool
+	cursor *Point
+}
+
+// NewWatcher creates a watcher for the configured pattern
+func NewWatcher(client *Client, config WatcherConfig) *Watcher {
+	if config.Interval <= 0 {
+		config.Interval = defaultWatchInterval
+	}
+	if config.CursorName == "" {
+		config.CursorName = config.Pattern
+	}
+	return &Watcher{
+		client: client,
+		config: config,
+		utxos:  make(map[OutputReference]Match),
+	}
+}
+
+// Pattern returns the pattern being watched
+func (w *Watcher) Pattern() string {
+	return w.config.Pattern
+}
+
+// Poll fetches the current unspent outputs of the pattern and returns the
+// changes since the previous poll. The first poll reports every unspent
+// output as created, unless a cursor was saved, in which case it reports the
+// changes since the cursor
+func (w *Watcher) Poll() ([]WatchEvent, error) {
+	w.mu.Lock()
+	polled := w.polled
+	w.mu.Unlock()
+	var resumeFrom *Point
+	if !polled && w.config.CursorStore != nil {
+		cursor, err := w.config.CursorStore.LoadCursor(w.config.CursorName)
+		if err != nil {
+			return nil, err
+		}
+		resumeFrom = cursor
+	}
+	matches, checkpoint, err := w.client.getMatches(
+		context.Background(),
+		w.config.Pattern,
+		MatchOptions{Unspent: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[OutputReference]Match, len(*matches))
+	for _, match := range *matches {
+		current[match.OutputReference()] = match
+	}
+	var events []WatchEvent
+	if resumeFrom != nil {
+		events, err = w.resumeEvents(*resumeFrom, checkpoint, current)
+		if err != nil {
+			return nil, err
+		}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if resumeFrom == nil {
+		for ref, match := range current {
+			if _, ok := w.utxos[ref]; !ok {
+				events = append(events, WatchEvent{Type: WatchEventCreated, Match: match})
+			}
+		}
+		for ref, match := range w.utxos {
+			if _, ok := current[ref]; !ok {
+				events = append(events, WatchEvent{Type: WatchEventSpent, Match: match})
+			}
+		}
+	}
+	w.utxos = current
+	w.polled = true
+	if checkpoint >= 0 {
+		w.cursor = &Point{SlotNo: checkpoint}
+	}
+	sortWatchEvents(events)
+	return events, nil
+}
+
+// resumeEvents computes the changes between a saved cursor and the current
+// unspent outputs, answered at the given checkpoint
+func (w *Watcher) resumeEvents(
+	from Point,
+	checkpoint int,
+	current map[OutputReference]Match,
+) ([]WatchEvent, error) {
+	var events []WatchEvent
+	for _, match := range current {
+		if match.CreatedAt.SlotNo > from.SlotNo {
+			events = append(events, WatchEvent{Type: WatchEventCreated, Match: match})
+		}
+	}
+	// Outputs which existed at the cursor and have been spent since. Those
+	// spent after the checkpoint are still in the current set and will be
+	// reported by a later poll
+	spent, err := w.client.GetMatchesWithOptions(
+		w.config.Pattern,
+		MatchOptions{
+			CreatedBefore: from.SlotNo + 1,
+			SpentAfter:    from.SlotNo,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, match := range *spent {
+		if _, ok := current[match.OutputReference()]; ok {
+			continue
+		}
+		if checkpoint >= 0 && match.SpentAt != nil &&
+			match.SpentAt.SlotNo > checkpoint {
+			continue
+		}
+		events = append(events, WatchEvent{Type: WatchEventSpent, Match: match})
+	}
+	return events, nil
+}
+
+// Cursor returns the checkpoint the last poll was answered at, or nil if it
+// is unknown
+func (w *Watcher) Cursor() *Point {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.cursor == nil {
+		return nil
+	}
+	cursor := *w.cursor
+	return &cursor
+}
+
+// SaveCursor persists the checkpoint of the last poll to the cursor store.
+// Run calls it after delivering each poll's events
+func (w *Watcher) SaveCursor() error {
+	cursor := w.Cursor()
+	if w.config.CursorStore == nil || cursor == nil {
+		return nil
+	}
+	return w.config.CursorStore.SaveCursor(w.config.CursorName, *cursor)
+}
+
+// Run polls the pattern until the context is cancelled, delivering changes
+// and errors to the configured callbacks
+func (w *Watcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+	for {
+		events, err := w.Poll()
+		if err != nil {
+			if w.config.OnError != nil {
+				w.config.OnError(err)
+			}
+		} else {
+			if w.config.OnEvent != nil {
+				for _, event := range events {
+					w.config.OnEvent(event)
+				}
+			}
+			if err := w.SaveCursor(); err != nil && w.config.OnError != nil {
+				w.config.OnError(err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// UTxOs returns the unspent outputs as of the last poll, oldest first
+func (w *Watcher) UTxOs() Matches {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	ret := make(Matches, 0, len(w.utxos))
+	for _, match := range w.utxos {
+		ret = append(ret, match)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return matchLess(ret[i], ret[j])
+	})
+	return ret
+}
+
+// Balance returns the total value of the unspent outputs as of the last poll
+func (w *Watcher) Balance() Value {
+	return w.UTxOs().Balance()
+}
+
+// sortWatchEvents orders events by the creation point of their outputs
+func sortWatchEvents(events []WatchEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return matchLess(events[i].Match, events[j].Match)
+	})
+}
+
+// matchLess orders matches by creation slot, transaction index and output index
+func matchLess(a Match, b Match) bool {
+	if a.CreatedAt.SlotNo != b.CreatedAt.SlotNo {
+		return a.CreatedAt.SlotNo < b.CreatedAt.SlotNo
+	}
+	if a.TransactionIndex != b.TransactionIndex {
+		return a.TransactionIndex < b.TransactionIndex
+	}
+	if a.TransactionID != b.TransactionID {
+		return a.TransactionID < b.TransactionID
+	}
+	return a.OutputIndex < b.OutputIndex
+}
@@ -0,0 +1,98 @@
+package kupogo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatcher_Poll(t *testing.T) {
+	var mu sync.Mutex
+	utxos := Matches{
+		{TransactionID: "aa", Value: Value{Coins: 10}, CreatedAt: Point{SlotNo: 1}},
+		{TransactionID: "bb", Value: Value{Coins: 20}, CreatedAt: Point{SlotNo: 2}},
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/matches/addr1" || r.URL.RawQuery != "unspent" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mu.Lock()
+			respBody, _ := json.Marshal(utxos)
+			mu.Unlock()
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(respBody)
+		}),
+	)
+	defer server.Close()
+
+	watcher := NewWatcher(
+		&Client{KupoUrl: server.URL},
+		WatcherConfig{Pattern: "addr1"},
+	)
+	events, err := watcher.Poll()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(events) != 2 || events[0].Match.TransactionID != "aa" || events[0].Type != WatchEventCreated {
+		t.Fatalf("Unexpected initial events: %v", events)
+	}
+
+	mu.Lock()
+	utxos = Matches{
+		utxos[1],
+		{TransactionID: "cc", Value: Value{Coins: 5}, CreatedAt: Point{SlotNo: 3}},
+	}
+	mu.Unlock()
+	events, err = watcher.Poll()
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %v", events)
+	}
+	if events[0].Type != WatchEventSpent || events[0].Match.TransactionID != "aa" {
+		t.Errorf("Expected aa to be spent, got %v", events[0])
+	}
+	if events[1].Type != WatchEventCreated || events[1].Match.TransactionID != "cc" {
+		t.Errorf("Expected cc to be created, got %v", events[1])
+	}
+	if balance := watcher.Balance(); balance.Coins != 25 {
+		t.Errorf("Expected balance of 25, got %d", balance.Coins)
+	}
+}
+
+func TestWatcher_Run(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`[{"transaction_id": "aa", "output_index": 0}]`))
+		}),
+	)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var events []WatchEvent
+	watcher := NewWatcher(
+		&Client{KupoUrl: server.URL},
+		WatcherConfig{
+			Pattern:  "*",
+			Interval: time.Millisecond,
+			OnEvent: func(event WatchEvent) {
+				events = append(events, event)
+				cancel()
+			},
+		},
+	)
+	if err := watcher.Run(ctx); err != context.Canceled {
+		t.Errorf("Expected context cancellation, got %v", err)
+	}
+	if len(events) != 1 {
+		t.Errorf("Expected a single event, got %v", events)
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook delivers Kupo events to webhook URLs as signed JSON, so
+// systems not written in Go can react to them without polling
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+// Headers set on each delivery
+const (
+	EventHeader     = "X-Kupogo-Event"
+	TimestampHeader = "X-Kupogo-Timestamp"
+	SignatureHeader = "X-Kupogo-Signature"
+)
+
+var (
+	// ErrInvalidSignature is returned by Verify for deliveries whose
+	// signature does not match
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrStaleDelivery is returned by Verify for deliveries signed too long
+	// ago, which may be replayed
+	ErrStaleDelivery = errors.New("stale webhook delivery")
+	// ErrQueueFull is returned by Enqueue when too many events are waiting to
+	// be delivered
+	ErrQueueFull = errors.New("webhook queue full")
+)
+
+// EventType identifies the kind of an event
+type EventType string
+
+const (
+	EventMatchCreated EventType = "match.created"
+	EventMatchSpent   EventType = "match.spent"
+	EventRollback     EventType = "rollback"
+	EventSyncLag      EventType = "sync.lag"
+)
+
+// Event is the JSON body of a delivery
+type Event struct {
+	// ID is unique to the event, for receivers to ignore redeliveries. It is
+	// derived from the type, pattern, output reference and point of the
+	// event, so an event sent again keeps its ID
+	ID   string    `json:"id"`
+	Type EventType `json:"type"`
+	Time time.Time `json:"time"`
+	// Pattern is the pattern the event relates to, if any
+	Pattern string `json:"pattern,omitempty"`
+	// Match is the created or spent output
+	Match *kupogo.Match `json:"match,omitempty"`
+	// Point is the point rolled back to
+	Point *kupogo.Point `json:"point,omitempty"`
+	// SlotLag is the number of slots Kupo is behind its node
+	SlotLag int `json:"slot_lag,omitempty"`
+}
+
+// Config configures a Notifier
+type Config struct {
+	// URLs receive every event
+	URLs []string
+	// Secret signs deliveries with HMAC-SHA256 if set
+	Secret []byte
+	// MaxAttempts limits the deliveries of an event to each URL, defaulting
+	// to 5
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled for each of the
+	// following ones, defaulting to 1 second
+	Backoff time.Duration
+	// HTTPClient makes the deliveries, defaulting to one with a 30 second
+	// timeout
+	HTTPClient *http.Client
+	// OnError is called when an event could not be queued or delivered by
+	// the handlers returned by Notifier
+	OnError func(error)
+	// QueueSize limits the events waiting to be delivered by Run, defaulting
+	// to 1024
+	QueueSize int
+}
+
+const (
+	defaultMaxAttempts = 5
+	defaultBackoff     = time.Second
+	defaultQueueSize   = 1024
+)
+
+var defaultHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// Notifier posts events to webhook URLs
+type Notifier struct {
+	config Config
+	queue  chan Event
+}
+
+// New creates a notifier
+func New(config Config) *Notifier {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.Backoff <= 0 {
+		config.Backoff = defaultBackoff
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = defaultHTTPClient
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = defaultQueueSize
+	}
+	return &Notifier{config: config, queue: make(chan Event, config.QueueSize)}
+}
+
+// Send delivers an event to every URL, retrying failed deliveries with
+// exponential backoff. The ID and time of the event are set if empty
+func (n *Notifier) Send(ctx context.Context, event Event) error {
+	event = prepare(event)
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %s", err)
+	}
+	var errs []error
+	for _, url := range n.config.URLs {
+		if err := n.deliver(ctx, url, event.Type, body); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n *Notifier) deliver(ctx context.Context, url string, eventType EventType, body []byte) error {
+	backoff := n.config.Backoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		var retry bool
+		retry, err = n.post(ctx, url, eventType, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= n.config.MaxAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to deliver %s event to %s: %s", eventType, url, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	return fmt.Errorf("failed to deliver %s event to %s: %s", eventType, url, err)
+}
+
+// post makes a delivery, returning whether a failure may be retried
+func (n *Notifier) post(ctx context.Context, url string, eventType EventType, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(eventType))
+	req.Header.Set(TimestampHeader, timestamp)
+	if n.config.Secret != nil {
+		req.Header.Set(SignatureHeader, Sign(n.config.Secret, timestamp, body))
+	}
+	resp, err := n.config.HTTPClient.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode >= http.StatusInternalServerError ||
+		resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("status code %d", resp.StatusCode)
+}
+
+// Sign returns the signature header value for a delivery: "sha256=" followed
+// by the hex encoded HMAC-SHA256 of the timestamp, a dot and the body
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(timestamp + "."))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a received delivery, rejecting deliveries
+// signed more than maxAge ago if maxAge is positive
+func Verify(secret []byte, header http.Header, body []byte, maxAge time.Duration) error {
+	timestamp := header.Get(TimestampHeader)
+	expected := Sign(secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(header.Get(SignatureHeader))) {
+		return ErrInvalidSignature
+	}
+	if maxAge > 0 {
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return ErrInvalidSignature
+		}
+		if time.Since(time.Unix(seconds, 0)) > maxAge {
+			return ErrStaleDelivery
+		}
+	}
+	return nil
+}
+
+// Enqueue queues an event for delivery by Run, without waiting for it. The ID
+// and time of the event are set if empty
+func (n *Notifier) Enqueue(event Event) error {
+	select {
+	case n.queue <- prepare(event):
+		return nil
+	default:
+		return fmt.Errorf("failed to queue %s event: %w", event.Type, ErrQueueFull)
+	}
+}
+
+// Run delivers the queued events in order until the context is done. Events
+// which could not be delivered are reported to Config.OnError
+func (n *Notifier) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event := <-n.queue:
+			n.report(n.Send(ctx, event))
+		}
+	}
+}
+
+// WatchHandler returns a function queueing the events of a watcher of the
+// given pattern, for WatcherConfig.OnEvent. The events are delivered by Run
+func (n *Notifier) WatchHandler(pattern string) func(kupogo.WatchEvent) {
+	return func(watchEvent kupogo.WatchEvent) {
+		match := watchEvent.Match
+		event := Event{Type: EventMatchCreated, Pattern: pattern, Match: &match}
+		if watchEvent.Type == kupogo.WatchEventSpent {
+			event.Type = EventMatchSpent
+		}
+		n.report(n.Enqueue(event))
+	}
+}
+
+// FollowerHandlers returns functions queueing the rollbacks and changes of a
+// follower of the given pattern, for FollowerConfig.OnRollBackward and
+// OnRollForward. The events are delivered by Run. A full queue fails the
+// callbacks, so the follower delivers the changes again, and the events
+// already queued are sent again with the same IDs
+func (n *Notifier) FollowerHandlers(pattern string) (
+	func(kupogo.Point) error,
+	func(kupogo.RollForward) error,
+) {
+	onRollBackward := func(point kupogo.Point) error {
+		return n.Enqueue(Event{Type: EventRollback, Pattern: pattern, Point: &point})
+	}
+	onRollForward := func(rollForward kupogo.RollForward) error {
+		for _, events := range []struct {
+			eventType EventType
+			matches   kupogo.Matches
+		}{
+			{EventMatchSpent, rollForward.Spent},
+			{EventMatchCreated, rollForward.Created},
+		} {
+			for i := range events.matches {
+				err := n.Enqueue(Event{
+					Type:    events.eventType,
+					Pattern: pattern,
+					Match:   &events.matches[i],
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return onRollBackward, onRollForward
+}
+
+// CheckSyncLag delivers a sync lag event if Kupo is more than maxLag slots
+// behind its node
+func (n *Notifier) CheckSyncLag(ctx context.Context, client *kupogo.Client, maxLag int) error {
+	health, err := client.GetHealthContext(ctx)
+	if err != nil {
+		return err
+	}
+	lag, ok := health.SyncLag()
+	if !ok || lag <= maxLag {
+		return nil
+	}
+	return n.Send(ctx, Event{Type: EventSyncLag, SlotLag: lag})
+}
+
+func (n *Notifier) report(err error) {
+	if err != nil && n.config.OnError != nil {
+		n.config.OnError(err)
+	}
+}
+
+// prepare sets the ID and time of an event if empty
+func prepare(event Event) Event {
+	if event.ID == "" {
+		event.ID = eventID(event)
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	return event
+}
+
+// eventID derives the ID of an event from what it describes. Sync lag events
+// describe no point on the chain, so they get a random ID
+func eventID(event Event) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n", event.Type, event.Pattern)
+	switch {
+	case event.Match != nil:
+		point := event.Match.CreatedAt
+		if event.Type == EventMatchSpent && event.Match.SpentAt != nil {
+			point = *event.Match.SpentAt
+		}
+		fmt.Fprintf(hash, "%s\n%d\n%s\n", event.Match.OutputReference(), point.SlotNo, point.HeaderHash)
+	case event.Point != nil:
+		fmt.Fprintf(hash, "%d\n%s\n", event.Point.SlotNo, event.Point.HeaderHash)
+	default:
+		return randomID()
+	}
+	return hex.EncodeToString(hash.Sum(nil)[:16])
+}
+
+func randomID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,146 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/kupogo"
+)
+
+func TestNotifierSend(t *testing.T) {
+	secret := []byte("secret")
+	var mu sync.Mutex
+	var attempts int
+	var received []Event
+	delivered := make(chan struct{})
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			attempts++
+			if attempts == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			if err := Verify(secret, r.Header, body, time.Minute); err != nil {
+				t.Errorf("Expected a valid signature, got %s", err)
+			}
+			var event Event
+			if err := json.Unmarshal(body, &event); err != nil {
+				t.Errorf("Expected no error, got %s", err)
+			}
+			if r.Header.Get(EventHeader) != string(event.Type) {
+				t.Errorf("Unexpected event header: %s", r.Header.Get(EventHeader))
+			}
+			received = append(received, event)
+			close(delivered)
+		}),
+	)
+	defer server.Close()
+
+	notifier := New(Config{
+		URLs:    []string{server.URL},
+		Secret:  secret,
+		Backoff: time.Millisecond,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = notifier.Run(ctx)
+	}()
+	handler := notifier.WatchHandler("addr1")
+	handler(kupogo.WatchEvent{
+		Type:  kupogo.WatchEventSpent,
+		Match: kupogo.Match{TransactionID: "aa"},
+	})
+	select {
+	case <-delivered:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the queued event to be delivered")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if attempts != 2 || len(received) != 1 {
+		t.Fatalf("Expected a retried delivery, got %d attempts and %d events", attempts, len(received))
+	}
+	event := received[0]
+	if event.Type != EventMatchSpent || event.Pattern != "addr1" || event.Match.TransactionID != "aa" ||
+		event.ID == "" {
+		t.Fatalf("Unexpected event: %+v", event)
+	}
+}
+
+func TestNotifierGivesUp(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusBadRequest)
+		}),
+	)
+	defer server.Close()
+
+	notifier := New(Config{URLs: []string{server.URL}, Backoff: time.Millisecond})
+	if err := notifier.Send(context.Background(), Event{Type: EventRollback}); err == nil {
+		t.Fatalf("Expected an error")
+	}
+	if attempts != 1 {
+		t.Fatalf("Expected client errors not to be retried, got %d attempts", attempts)
+	}
+}
+
+func TestNotifierEventIDs(t *testing.T) {
+	notifier := New(Config{QueueSize: 3})
+	_, onRollForward := notifier.FollowerHandlers("addr1")
+	rollForward := kupogo.RollForward{
+		Created: kupogo.Matches{
+			{TransactionID: "aa", CreatedAt: kupogo.Point{SlotNo: 10, HeaderHash: "bb"}},
+			{TransactionID: "aa", OutputIndex: 1, CreatedAt: kupogo.Point{SlotNo: 10, HeaderHash: "bb"}},
+		},
+	}
+	if err := onRollForward(rollForward); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	// The queue is full, so the follower has to deliver the changes again
+	if err := onRollForward(rollForward); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("Expected ErrQueueFull, got %v", err)
+	}
+	var ids []string
+	for i := 0; i < 3; i++ {
+		ids = append(ids, (<-notifier.queue).ID)
+	}
+	if ids[0] == ids[1] || ids[0] != ids[2] {
+		t.Fatalf("Expected redelivered events to keep their IDs, got %v", ids)
+	}
+	spent := Event{
+		Type:    EventMatchSpent,
+		Pattern: "addr1",
+		Match:   &kupogo.Match{TransactionID: "aa", SpentAt: &kupogo.Point{SlotNo: 20, HeaderHash: "cc"}},
+	}
+	if eventID(spent) == ids[0] {
+		t.Fatalf("Expected the spent event to have its own ID")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	header := http.Header{}
+	header.Set(TimestampHeader, "1700000000")
+	header.Set(SignatureHeader, Sign([]byte("secret"), "1700000000", []byte("{}")))
+	if err := Verify([]byte("secret"), header, []byte("{}"), 0); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := Verify([]byte("other"), header, []byte("{}"), 0); err != ErrInvalidSignature {
+		t.Fatalf("Expected ErrInvalidSignature, got %v", err)
+	}
+	if err := Verify([]byte("secret"), header, []byte("{}"), time.Minute); err != ErrStaleDelivery {
+		t.Fatalf("Expected ErrStaleDelivery, got %v", err)
+	}
+}